@@ -10,9 +10,13 @@ import (
 	"syscall"
 	"time"
 
+	"gigaboo.io/lem/internal/apikey"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/database"
+	"gigaboo.io/lem/internal/ratelimit"
 	"gigaboo.io/lem/internal/routes"
+	"gigaboo.io/lem/internal/services"
+	"gigaboo.io/lem/internal/webhook"
 )
 
 func main() {
@@ -37,7 +41,7 @@ func main() {
 
 	// Run migrations
 	ctx := context.Background()
-	if err := database.Migrate(ctx, client); err != nil {
+	if err := database.Migrate(ctx, cfg, client); err != nil {
 		log.Fatalf("Failed to run migrations: %v", err)
 	}
 
@@ -61,6 +65,37 @@ func main() {
 		}
 	}()
 
+	// Run background jobs until shutdown
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+
+	// Send queued webhooks
+	dispatchDone := make(chan struct{})
+	go func() {
+		webhook.NewDispatcher(client, apikey.NewSealer(cfg.SecretKeyEncryptionKey()), cfg.Env != "prod").Run(jobsCtx)
+		close(dispatchDone)
+	}()
+
+	// Delete rate limiter state that has expired
+	if cfg.RateLimitBackend == "postgres" {
+		go ratelimit.NewPostgresStore(client).RunSweeper(jobsCtx, 10*time.Minute)
+	}
+
+	// Process received Stripe events, and re-sync subscriptions from Stripe
+	// in case events were missed
+	stripeDone := make(chan struct{})
+	if cfg.StripeSecretKey != "" {
+		stripeService := services.NewStripeService(cfg, client)
+		go func() {
+			stripeService.RunWebhookProcessor(jobsCtx)
+			close(stripeDone)
+		}()
+		if cfg.StripeReconcileHours > 0 {
+			go stripeService.RunReconciliation(jobsCtx, time.Duration(cfg.StripeReconcileHours)*time.Hour)
+		}
+	} else {
+		close(stripeDone)
+	}
+
 	// Wait for interrupt signal
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
@@ -76,5 +111,11 @@ func main() {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
 
+	// Let webhooks being sent and Stripe events being processed finish and
+	// record their outcome
+	stopJobs()
+	<-dispatchDone
+	<-stripeDone
+
 	log.Println("Server exited gracefully")
 }
@@ -0,0 +1,77 @@
+// Command webhook-receiver is a local endpoint for trying out the webhooks
+// lem sends. It logs every webhook it receives and whether its signature is
+// valid, and can be made to fail to see deliveries being retried.
+//
+// Point an app's webhook URL at it, e.g. http://localhost:9000/webhooks, and
+// send a test webhook from the admin console:
+//
+//	go run ./cmd/webhook-receiver -secret lem_sk_... -status 200
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/webhook"
+)
+
+func main() {
+	addr := flag.String("addr", ":9000", "Address to listen on")
+	secret := flag.String("secret", "", "The app's secret key, to verify signatures with")
+	status := flag.Int("status", http.StatusOK, "Status code to respond with")
+	flag.Parse()
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "Failed to read body", http.StatusBadRequest)
+			return
+		}
+
+		log.Printf("%s %s: %s event %s", r.Method, r.URL.RequestURI(),
+			r.Header.Get(webhook.EventHeader), r.Header.Get(webhook.DeliveryHeader))
+		log.Printf("Signature: %s", verify(r, body, *secret))
+
+		var pretty bytes.Buffer
+		if json.Indent(&pretty, body, "", "  ") == nil {
+			log.Printf("Payload:\n%s", pretty.String())
+		} else {
+			log.Printf("Payload: %s", body)
+		}
+
+		w.WriteHeader(*status)
+	})
+
+	log.Printf("Receiving webhooks on %s, responding with %d", *addr, *status)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
+
+// verify describes whether a webhook's signature is valid.
+func verify(r *http.Request, body []byte, secret string) string {
+	signature := r.Header.Get(apikey.SignatureHeader)
+	if signature == "" {
+		return "none (the app has no secret key to sign with)"
+	}
+	if secret == "" {
+		return "not checked (no -secret given)"
+	}
+
+	timestamp, err := strconv.ParseInt(r.Header.Get(apikey.TimestampHeader), 10, 64)
+	if err != nil {
+		return "INVALID (bad timestamp)"
+	}
+	if age := time.Since(time.Unix(timestamp, 0)); age > 5*time.Minute || age < -5*time.Minute {
+		return "INVALID (timestamp out of tolerance)"
+	}
+	if !apikey.VerifySignature(signature, secret, timestamp, r.Method, r.URL.RequestURI(), body) {
+		return "INVALID"
+	}
+	return "valid"
+}
@@ -0,0 +1,159 @@
+// Package apikey generates app API keys and secret keys and hashes them for
+// storage. Keys are shown once when they are created and their hash is kept
+// to check them.
+//
+// An app has two kinds of key. Its publishable API key identifies the app
+// and may be shipped to browsers. Its secret key never leaves the app's
+// servers: it is the OpenID Connect client secret and signs server-to-server
+// requests (see Sign). Since lem needs the secret key itself to check and
+// make signatures, it is also stored encrypted with a key only the server
+// has (see Sealer), so that reading the database is not enough to sign.
+package apikey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Prefixes of generated publishable and secret keys.
+const (
+	KeyPrefix    = "lem_pk_"
+	SecretPrefix = "lem_sk_"
+)
+
+// Headers carrying the timestamp and signature of a signed request, both
+// from apps to lem and of the webhooks lem sends them.
+const (
+	TimestampHeader = "X-Lem-Timestamp"
+	SignatureHeader = "X-Lem-Signature"
+)
+
+// SignatureVersion prefixes signatures made by Sign.
+const SignatureVersion = "v1="
+
+// prefixLength is the number of characters of a key kept for display.
+const prefixLength = len(KeyPrefix) + 8
+
+// NewKey returns a new random API key.
+func NewKey() (string, error) {
+	return generate(KeyPrefix)
+}
+
+// NewSecret returns a new random secret key.
+func NewSecret() (string, error) {
+	return generate(SecretPrefix)
+}
+
+func generate(prefix string) (string, error) {
+	bytes := make([]byte, 32)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return prefix + hex.EncodeToString(bytes), nil
+}
+
+// Hash returns the hex-encoded SHA-256 hash of a key for storage.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Matches reports whether key hashes to hash, in constant time.
+func Matches(key, hash string) bool {
+	if hash == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(hash)) == 1
+}
+
+// Prefix returns the start of a key, safe to show to identify it. At most
+// half of a short key is returned.
+func Prefix(key string) string {
+	n := prefixLength
+	if n > len(key)/2 {
+		n = len(key) / 2
+	}
+	return key[:n]
+}
+
+// IsSecret reports whether key is a secret key.
+func IsSecret(key string) bool {
+	return strings.HasPrefix(key, SecretPrefix)
+}
+
+// Sign returns the signature of a request with a secret key:
+//
+//	v1=hex(HMAC-SHA256(secret, timestamp + "\n" + method + "\n" + uri + "\n" + hex(SHA-256(body))))
+//
+// timestamp is in Unix seconds and uri is the request path with its query.
+func Sign(secret string, timestamp int64, method, uri string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "\n" + method + "\n" + uri + "\n" + hex.EncodeToString(bodyHash[:])))
+	return SignatureVersion + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature is Sign's signature of a request
+// with secret, in constant time.
+func VerifySignature(signature, secret string, timestamp int64, method, uri string, body []byte) bool {
+	if secret == "" {
+		return false
+	}
+	expected := Sign(secret, timestamp, method, uri, body)
+	return hmac.Equal([]byte(signature), []byte(expected))
+}
+
+// Sealer encrypts secret keys for storage with AES-256-GCM.
+type Sealer struct {
+	aead cipher.AEAD
+}
+
+// NewSealer creates a new sealer encrypting with key.
+func NewSealer(key [32]byte) *Sealer {
+	// Neither fails for a 32-byte AES key
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+	return &Sealer{
+		aead: aead,
+	}
+}
+
+// Seal returns a secret key encrypted for storage.
+func (s *Sealer) Seal(secret string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := s.aead.Seal(nonce, nonce, []byte(secret), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Open returns the secret key a Seal result was made from. It fails if the
+// result was sealed with another key or changed.
+func (s *Sealer) Open(sealed string) (string, error) {
+	data, err := base64.RawStdEncoding.DecodeString(sealed)
+	if err != nil || len(data) < s.aead.NonceSize() {
+		return "", errors.New("sealed secret key is malformed")
+	}
+	nonce, ciphertext := data[:s.aead.NonceSize()], data[s.aead.NonceSize():]
+	secret, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", errors.New("sealed secret key cannot be decrypted")
+	}
+	return string(secret), nil
+}
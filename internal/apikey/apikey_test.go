@@ -0,0 +1,75 @@
+package apikey
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSealer(t *testing.T) {
+	sealer := NewSealer([32]byte{1})
+	secret, err := NewSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sealed, err := sealer.Seal(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(sealed, secret) || strings.Contains(sealed, Hash(secret)) {
+		t.Fatalf("sealed secret key %q reveals the key", sealed)
+	}
+	if opened, err := sealer.Open(sealed); err != nil || opened != secret {
+		t.Fatalf("Open = %q, %v, want %q", opened, err, secret)
+	}
+
+	again, _ := sealer.Seal(secret)
+	if again == sealed {
+		t.Error("sealing twice gave the same result")
+	}
+
+	if _, err := NewSealer([32]byte{2}).Open(sealed); err == nil {
+		t.Error("opened with another key")
+	}
+	tampered := []byte(sealed)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := sealer.Open(string(tampered)); err == nil {
+		t.Error("opened a changed secret key")
+	}
+	if _, err := sealer.Open("not sealed"); err == nil {
+		t.Error("opened a malformed secret key")
+	}
+}
+
+func TestVerifySignature(t *testing.T) {
+	secret := "lem_sk_test"
+	body := []byte(`{"to":"user@example.com"}`)
+	signature := Sign(secret, 1700000000, "POST", "/api/v1/email/send", body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		method    string
+		uri       string
+		body      []byte
+		valid     bool
+	}{
+		{"same request", secret, 1700000000, "POST", "/api/v1/email/send", body, true},
+		{"hash of the secret", Hash(secret), 1700000000, "POST", "/api/v1/email/send", body, false},
+		{"other secret", "lem_sk_other", 1700000000, "POST", "/api/v1/email/send", body, false},
+		{"no secret", "", 1700000000, "POST", "/api/v1/email/send", body, false},
+		{"other timestamp", secret, 1700000001, "POST", "/api/v1/email/send", body, false},
+		{"other method", secret, 1700000000, "PUT", "/api/v1/email/send", body, false},
+		{"other uri", secret, 1700000000, "POST", "/api/v1/email/send?x=1", body, false},
+		{"other body", secret, 1700000000, "POST", "/api/v1/email/send", []byte(`{}`), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(signature, tt.secret, tt.timestamp, tt.method, tt.uri, tt.body); got != tt.valid {
+				t.Errorf("VerifySignature = %v, want %v", got, tt.valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,287 @@
+// Package audit records who changed what in the append-only audit log.
+//
+// Requests carry the acting admin or user in their context (see NewContext).
+// Changes to the entities listed in targetTypes are then recorded by an ent
+// hook with the values of the changed fields before and after; actions that
+// span many rows, such as resetting a user's progress, are recorded with
+// Record.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/adminaccount"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/hook"
+)
+
+// Actor types.
+const (
+	ActorAdmin = auditevent.ActorTypeADMIN
+	ActorUser  = auditevent.ActorTypeUSER
+)
+
+// Actor is whoever makes a request.
+type Actor struct {
+	Name      string
+	Type      auditevent.ActorType
+	IPAddress string
+	UserAgent string
+	// AppID is the app the request is made for, or 0 if there is none.
+	AppID int
+}
+
+type contextKey struct{}
+
+// NewContext returns a context carrying actor. Audited changes made with it
+// are attributed to actor.
+func NewContext(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, contextKey{}, actor)
+}
+
+// FromContext returns the actor stored in ctx.
+func FromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(contextKey{}).(Actor)
+	return actor, ok
+}
+
+// targetTypes maps the audited entity types to the target type recorded
+// for them.
+var targetTypes = map[string]string{
+	ent.TypeAdminAccount:           "admin_account",
+	ent.TypeApp:                    "app",
+	ent.TypeEmailTemplate:          "email_template",
+	ent.TypeOrganization:           "organization",
+	ent.TypeOrganizationDomain:     "organization_domain",
+	ent.TypeOrganizationInvitation: "organization_invitation",
+	ent.TypeOrganizationMember:     "organization_member",
+	ent.TypePlan:                   "plan",
+	ent.TypeSSOConnection:          "sso_connection",
+}
+
+// Event is an action to record that is not a single entity change.
+type Event struct {
+	Action     string
+	TargetType string
+	TargetID   string
+	Metadata   map[string]interface{}
+}
+
+// Register installs the audit hooks on client. Audit events cannot be
+// changed or deleted once written.
+func Register(client *ent.Client) {
+	client.Use(Hook())
+	client.AuditEvent.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+// Record writes e to the audit log, attributed to the actor in ctx.
+func Record(ctx context.Context, client *ent.Client, e Event) error {
+	actor, ok := FromContext(ctx)
+	if !ok {
+		return errors.New("no actor to attribute audit event to")
+	}
+
+	create := newEvent(client, actor, e.Action, e.TargetType, e.TargetID)
+	if e.Metadata != nil {
+		create.SetMetadata(e.Metadata)
+	}
+	return create.Exec(ctx)
+}
+
+// Hook records every change to an audited entity made with an actor in the
+// context. The event is written with the change's client so that it is part
+// of the same transaction.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			actor, ok := FromContext(ctx)
+			targetType, audited := targetTypes[m.Type()]
+			if !ok || !audited {
+				return next.Mutate(ctx, m)
+			}
+
+			mut, ok := m.(mutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			client := mut.Client()
+
+			var ids []int
+			before := make(map[int]map[string]interface{})
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mut.IDs(ctx); err != nil {
+					return nil, err
+				}
+				for _, id := range ids {
+					if before[id], err = snapshot(ctx, client, m.Type(), id); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				if id, exists := mut.ID(); exists {
+					ids = []int{id}
+				}
+			}
+
+			action := targetType + "." + operation(m.Op())
+			for _, id := range ids {
+				var after map[string]interface{}
+				if !m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+					if after, err = snapshot(ctx, client, m.Type(), id); err != nil {
+						return nil, err
+					}
+				}
+
+				changedBefore, changedAfter := diff(before[id], after)
+				if len(changedBefore) == 0 && len(changedAfter) == 0 {
+					continue
+				}
+
+				err := newEvent(client, actor, action, targetType, strconv.Itoa(id)).
+					SetBefore(changedBefore).
+					SetAfter(changedAfter).
+					Exec(ctx)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// mutation is implemented by all generated mutations.
+type mutation interface {
+	Client() *ent.Client
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+}
+
+func newEvent(client *ent.Client, actor Actor, action, targetType, targetID string) *ent.AuditEventCreate {
+	create := client.AuditEvent.Create().
+		SetActor(actor.Name).
+		SetActorType(actor.Type).
+		SetAction(action).
+		SetTargetType(targetType).
+		SetTargetID(targetID).
+		SetIPAddress(actor.IPAddress).
+		SetUserAgent(actor.UserAgent)
+	if actor.AppID != 0 {
+		create.SetAppID(actor.AppID)
+	}
+	return create
+}
+
+func operation(op ent.Op) string {
+	switch {
+	case op.Is(ent.OpCreate):
+		return "create"
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		return "delete"
+	default:
+		return "update"
+	}
+}
+
+// snapshot returns the fields of an audited entity as they would be
+// serialized in an API response, so sensitive fields are left out.
+func snapshot(ctx context.Context, client *ent.Client, typ string, id int) (map[string]interface{}, error) {
+	var (
+		v   interface{}
+		err error
+	)
+	switch typ {
+	case ent.TypeAdminAccount:
+		// The apps an admin manages are part of its permissions
+		a, err := client.AdminAccount.Query().
+			Where(adminaccount.ID(id)).
+			WithApps().
+			Only(ctx)
+		if err != nil {
+			return nil, err
+		}
+		appIDs := make([]int, len(a.Edges.Apps))
+		for i, ap := range a.Edges.Apps {
+			appIDs[i] = ap.ID
+		}
+		fields, err := toFields(a)
+		if err != nil {
+			return nil, err
+		}
+		fields["app_ids"] = appIDs
+		return fields, nil
+	case ent.TypeApp:
+		v, err = client.App.Get(ctx, id)
+	case ent.TypeEmailTemplate:
+		v, err = client.EmailTemplate.Get(ctx, id)
+	case ent.TypeOrganization:
+		v, err = client.Organization.Get(ctx, id)
+	case ent.TypeOrganizationDomain:
+		v, err = client.OrganizationDomain.Get(ctx, id)
+	case ent.TypeOrganizationInvitation:
+		v, err = client.OrganizationInvitation.Get(ctx, id)
+	case ent.TypeOrganizationMember:
+		v, err = client.OrganizationMember.Get(ctx, id)
+	case ent.TypePlan:
+		v, err = client.Plan.Get(ctx, id)
+	case ent.TypeSSOConnection:
+		v, err = client.SSOConnection.Get(ctx, id)
+	default:
+		return nil, errors.New("audit: unsupported type " + typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toFields(v)
+}
+
+// toFields converts an entity to a map of its JSON fields.
+func toFields(v interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "id")
+	delete(fields, "edges")
+	return fields, nil
+}
+
+// diff returns the fields that differ between before and after.
+func diff(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	changedBefore := make(map[string]interface{})
+	changedAfter := make(map[string]interface{})
+	for k, v := range before {
+		if !reflect.DeepEqual(v, after[k]) {
+			changedBefore[k] = v
+			changedAfter[k] = after[k]
+		}
+	}
+	for k, v := range after {
+		if _, ok := before[k]; !ok {
+			changedAfter[k] = v
+		}
+	}
+	return changedBefore, changedAfter
+}
@@ -0,0 +1,140 @@
+// Package authz decides what users may do with Shenbi resources.
+//
+// Policy is a pure function of a Subject, an Action and the facts about a
+// Resource, so that every rule can be tested without a database. The Loader
+// gathers those facts.
+package authz
+
+import (
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+)
+
+// Action is something a user asks to do.
+type Action string
+
+// Actions on the user's own data: profile, progress, achievements,
+// settings and the lists of their classrooms.
+const (
+	OwnData Action = "own_data"
+)
+
+// Classroom actions. Their resource is the classroom in the path.
+const (
+	ClassroomCreate      Action = "classroom.create"
+	ClassroomView        Action = "classroom.view"
+	ClassroomUpdate      Action = "classroom.update"
+	ClassroomDelete      Action = "classroom.delete"
+	ClassroomJoin        Action = "classroom.join"
+	ClassroomViewMembers Action = "classroom.view_members"
+	AssignmentList       Action = "assignment.list"
+	AssignmentCreate     Action = "assignment.create"
+	AssignmentPublish    Action = "assignment.publish"
+	AssignmentSubmit     Action = "assignment.submit"
+	SubmissionList       Action = "submission.list"
+)
+
+// Live session actions. LiveSessionCreate's resource is the classroom the
+// session is for; the others' is the live session.
+const (
+	LiveSessionCreate   Action = "live_session.create"
+	LiveSessionView     Action = "live_session.view"
+	LiveSessionManage   Action = "live_session.manage"
+	LiveSessionJoin     Action = "live_session.join"
+	LiveSessionComplete Action = "live_session.complete"
+)
+
+// Battle actions. Their resource is the battle room.
+const (
+	BattleCreate   Action = "battle.create"
+	BattleJoin     Action = "battle.join"
+	BattleView     Action = "battle.view"
+	BattleStart    Action = "battle.start"
+	BattleComplete Action = "battle.complete"
+)
+
+// Classroom session actions. Joining's resource is the classroom; the others'
+// is the classroom session.
+const (
+	SessionJoin          Action = "session.join"
+	SessionJoinAsTeacher Action = "session.join_as_teacher"
+	SessionView          Action = "session.view"
+	SessionLeave         Action = "session.leave"
+)
+
+// Subject is the user making a request.
+type Subject struct {
+	UserID int
+	AppID  int
+	// Role is the user's Shenbi profile role, empty if they have no profile
+	Role shenbiprofile.Role
+}
+
+// Resource holds the facts about a resource that policy depends on.
+type Resource struct {
+	// AppID is the app the resource belongs to
+	AppID int
+	// OwnerID is the classroom or live session teacher, the battle host or
+	// the classroom session user
+	OwnerID int
+	// GuestID is the battle guest
+	GuestID int
+	// Member is whether the subject is an active member of the classroom
+	// the resource belongs to
+	Member bool
+}
+
+// isStaff reports whether the subject may teach.
+func (s Subject) isStaff() bool {
+	return s.Role == shenbiprofile.RoleTEACHER || s.Role == shenbiprofile.RoleADMIN
+}
+
+// manages reports whether the subject runs the resource: its teacher, or an
+// ADMIN of the app.
+func (s Subject) manages(r Resource) bool {
+	return s.UserID == r.OwnerID || s.Role == shenbiprofile.RoleADMIN
+}
+
+// Can reports whether subject may take action on resource.
+func Can(subject Subject, action Action, resource Resource) bool {
+	if subject.UserID == 0 || subject.AppID == 0 {
+		return false
+	}
+
+	// Nothing crosses apps
+	if resource.AppID != 0 && resource.AppID != subject.AppID {
+		return false
+	}
+
+	switch action {
+	case OwnData, ClassroomJoin, BattleCreate, BattleJoin, BattleView:
+		return true
+
+	case ClassroomCreate:
+		return subject.isStaff()
+
+	case ClassroomView, AssignmentList, LiveSessionView:
+		return subject.manages(resource) || resource.Member
+
+	case ClassroomUpdate, ClassroomDelete, ClassroomViewMembers,
+		AssignmentCreate, AssignmentPublish, SubmissionList,
+		LiveSessionCreate, LiveSessionManage, SessionJoinAsTeacher:
+		return subject.manages(resource)
+
+	case AssignmentSubmit, LiveSessionJoin, LiveSessionComplete:
+		return resource.Member
+
+	case SessionJoin:
+		return resource.Member || subject.manages(resource)
+
+	case BattleStart:
+		return subject.UserID == resource.OwnerID
+
+	case BattleComplete:
+		return subject.UserID == resource.OwnerID || subject.UserID == resource.GuestID
+
+	case SessionView, SessionLeave:
+		return subject.UserID == resource.OwnerID
+	}
+
+	return false
+}
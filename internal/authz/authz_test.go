@@ -0,0 +1,145 @@
+package authz
+
+import (
+	"testing"
+
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+)
+
+const (
+	appID      = 1
+	otherAppID = 2
+	teacherID  = 10
+	studentID  = 20
+	outsiderID = 30
+	adminID    = 40
+)
+
+var (
+	teacher  = Subject{UserID: teacherID, AppID: appID, Role: shenbiprofile.RoleTEACHER}
+	student  = Subject{UserID: studentID, AppID: appID, Role: shenbiprofile.RoleSTUDENT}
+	outsider = Subject{UserID: outsiderID, AppID: appID, Role: shenbiprofile.RoleSTUDENT}
+	admin    = Subject{UserID: adminID, AppID: appID, Role: shenbiprofile.RoleADMIN}
+
+	otherTeacher = Subject{UserID: outsiderID, AppID: appID, Role: shenbiprofile.RoleTEACHER}
+	noProfile    = Subject{UserID: outsiderID, AppID: appID}
+	otherApp     = Subject{UserID: teacherID, AppID: otherAppID, Role: shenbiprofile.RoleADMIN}
+)
+
+// classroomOf is a classroom taught by teacher, as seen by a member or not.
+func classroomOf(member bool) Resource {
+	return Resource{AppID: appID, OwnerID: teacherID, Member: member}
+}
+
+func TestCan(t *testing.T) {
+	tests := []struct {
+		name     string
+		subject  Subject
+		action   Action
+		resource Resource
+		want     bool
+	}{
+		// Own data
+		{"user reads own data", student, OwnData, Resource{}, true},
+		{"user without profile reads own data", noProfile, OwnData, Resource{}, true},
+		{"anonymous is denied", Subject{AppID: appID}, OwnData, Resource{}, false},
+		{"no app is denied", Subject{UserID: studentID}, OwnData, Resource{}, false},
+
+		// Creating classrooms needs a teaching role
+		{"teacher creates classroom", teacher, ClassroomCreate, Resource{}, true},
+		{"admin creates classroom", admin, ClassroomCreate, Resource{}, true},
+		{"student cannot create classroom", student, ClassroomCreate, Resource{}, false},
+		{"user without profile cannot create classroom", noProfile, ClassroomCreate, Resource{}, false},
+
+		// Viewing a classroom
+		{"teacher views own classroom", teacher, ClassroomView, classroomOf(false), true},
+		{"member views classroom", student, ClassroomView, classroomOf(true), true},
+		{"outsider cannot view classroom", outsider, ClassroomView, classroomOf(false), false},
+		{"other teacher cannot view classroom", otherTeacher, ClassroomView, classroomOf(false), false},
+		{"admin views any classroom", admin, ClassroomView, classroomOf(false), true},
+
+		// Managing a classroom
+		{"teacher updates own classroom", teacher, ClassroomUpdate, classroomOf(false), true},
+		{"other teacher cannot update classroom", otherTeacher, ClassroomUpdate, classroomOf(false), false},
+		{"member cannot update classroom", student, ClassroomUpdate, classroomOf(true), false},
+		{"admin updates any classroom", admin, ClassroomUpdate, classroomOf(false), true},
+		{"teacher deletes own classroom", teacher, ClassroomDelete, classroomOf(false), true},
+		{"other teacher cannot delete classroom", otherTeacher, ClassroomDelete, classroomOf(false), false},
+		{"member cannot delete classroom", student, ClassroomDelete, classroomOf(true), false},
+		{"teacher views members", teacher, ClassroomViewMembers, classroomOf(false), true},
+		{"member cannot view members", student, ClassroomViewMembers, classroomOf(true), false},
+
+		// Joining a classroom by code
+		{"student joins classroom", outsider, ClassroomJoin, classroomOf(false), true},
+		{"cannot join classroom of another app", otherApp, ClassroomJoin, classroomOf(false), false},
+
+		// Assignments
+		{"member lists assignments", student, AssignmentList, classroomOf(true), true},
+		{"outsider cannot list assignments", outsider, AssignmentList, classroomOf(false), false},
+		{"teacher creates assignment", teacher, AssignmentCreate, classroomOf(false), true},
+		{"member cannot create assignment", student, AssignmentCreate, classroomOf(true), false},
+		{"teacher publishes assignment", teacher, AssignmentPublish, classroomOf(false), true},
+		{"other teacher cannot publish assignment", otherTeacher, AssignmentPublish, classroomOf(false), false},
+		{"member cannot publish assignment", student, AssignmentPublish, classroomOf(true), false},
+		{"member submits assignment", student, AssignmentSubmit, classroomOf(true), true},
+		{"outsider cannot submit assignment", outsider, AssignmentSubmit, classroomOf(false), false},
+		{"teacher lists submissions", teacher, SubmissionList, classroomOf(false), true},
+		{"admin lists submissions", admin, SubmissionList, classroomOf(false), true},
+		{"other teacher cannot list submissions", otherTeacher, SubmissionList, classroomOf(false), false},
+		{"member cannot list submissions", student, SubmissionList, classroomOf(true), false},
+
+		// Live sessions
+		{"teacher creates live session for own classroom", teacher, LiveSessionCreate, classroomOf(false), true},
+		{"other teacher cannot create live session", otherTeacher, LiveSessionCreate, classroomOf(false), false},
+		{"member cannot create live session", student, LiveSessionCreate, classroomOf(true), false},
+		{"member views live session", student, LiveSessionView, classroomOf(true), true},
+		{"outsider cannot view live session", outsider, LiveSessionView, classroomOf(false), false},
+		{"teacher manages live session", teacher, LiveSessionManage, classroomOf(false), true},
+		{"admin manages live session", admin, LiveSessionManage, classroomOf(false), true},
+		{"other teacher cannot end live session", otherTeacher, LiveSessionManage, classroomOf(false), false},
+		{"member cannot end live session", student, LiveSessionManage, classroomOf(true), false},
+		{"member joins live session", student, LiveSessionJoin, classroomOf(true), true},
+		{"outsider cannot join live session", outsider, LiveSessionJoin, classroomOf(false), false},
+		{"member completes live session level", student, LiveSessionComplete, classroomOf(true), true},
+		{"outsider cannot complete live session level", outsider, LiveSessionComplete, classroomOf(false), false},
+
+		// Battles
+		{"anyone creates battle", student, BattleCreate, Resource{}, true},
+		{"anyone joins battle of the app", outsider, BattleJoin, Resource{AppID: appID, OwnerID: studentID}, true},
+		{"cannot join battle of another app", otherApp, BattleJoin, Resource{AppID: appID, OwnerID: studentID}, false},
+		{"anyone views battle of the app", outsider, BattleView, Resource{AppID: appID, OwnerID: studentID}, true},
+		{"host starts battle", student, BattleStart, Resource{AppID: appID, OwnerID: studentID, GuestID: outsiderID}, true},
+		{"guest cannot start battle", outsider, BattleStart, Resource{AppID: appID, OwnerID: studentID, GuestID: outsiderID}, false},
+		{"host completes battle", student, BattleComplete, Resource{AppID: appID, OwnerID: studentID, GuestID: outsiderID}, true},
+		{"guest completes battle", outsider, BattleComplete, Resource{AppID: appID, OwnerID: studentID, GuestID: outsiderID}, true},
+		{"spectator cannot complete battle", teacher, BattleComplete, Resource{AppID: appID, OwnerID: studentID, GuestID: outsiderID}, false},
+		{"nobody completes battle without guest", teacher, BattleComplete, Resource{AppID: appID, OwnerID: studentID}, false},
+
+		// Classroom sessions
+		{"member joins session", student, SessionJoin, classroomOf(true), true},
+		{"teacher joins session", teacher, SessionJoin, classroomOf(false), true},
+		{"outsider cannot join session", outsider, SessionJoin, classroomOf(false), false},
+		{"teacher joins session as teacher", teacher, SessionJoinAsTeacher, classroomOf(false), true},
+		{"member cannot join session as teacher", student, SessionJoinAsTeacher, classroomOf(true), false},
+		{"user views own session", student, SessionView, Resource{AppID: appID, OwnerID: studentID}, true},
+		{"user cannot view another's session", outsider, SessionView, Resource{AppID: appID, OwnerID: studentID}, false},
+		{"user leaves own session", student, SessionLeave, Resource{AppID: appID, OwnerID: studentID}, true},
+		{"user cannot leave another's session", outsider, SessionLeave, Resource{AppID: appID, OwnerID: studentID}, false},
+
+		// App scoping
+		{"owner in another app cannot view classroom", Subject{UserID: teacherID, AppID: otherAppID, Role: shenbiprofile.RoleTEACHER}, ClassroomView, classroomOf(false), false},
+		{"admin of another app cannot update classroom", otherApp, ClassroomUpdate, classroomOf(false), false},
+		{"admin of another app cannot end live session", otherApp, LiveSessionManage, classroomOf(false), false},
+
+		// Unknown actions are denied
+		{"unknown action", admin, Action("classroom.transfer"), classroomOf(false), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Can(tt.subject, tt.action, tt.resource); got != tt.want {
+				t.Errorf("Can(%+v, %q, %+v) = %v, want %v", tt.subject, tt.action, tt.resource, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package authz
+
+import (
+	"context"
+	"errors"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/assignment"
+	"gigaboo.io/lem/internal/ent/battleroom"
+	"gigaboo.io/lem/internal/ent/classroom"
+	"gigaboo.io/lem/internal/ent/classroommembership"
+	"gigaboo.io/lem/internal/ent/classroomsession"
+	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// ErrNotFound is returned when the resource of a request does not exist.
+var ErrNotFound = errors.New("resource not found")
+
+// Loader reads the facts policy depends on from the database.
+type Loader struct {
+	client *ent.Client
+}
+
+// NewLoader creates a new loader.
+func NewLoader(client *ent.Client) *Loader {
+	return &Loader{
+		client: client,
+	}
+}
+
+// Subject returns the subject for a user of an app.
+func (l *Loader) Subject(ctx context.Context, userID, appID int) (Subject, error) {
+	subject := Subject{UserID: userID, AppID: appID}
+
+	profile, err := l.client.ShenbiProfile.Query().
+		Where(
+			shenbiprofile.HasAppWith(app.ID(appID)),
+			shenbiprofile.HasUserWith(user.ID(userID)),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return Subject{}, err
+	}
+	if profile != nil {
+		subject.Role = profile.Role
+	}
+	return subject, nil
+}
+
+// Classroom returns the facts about a classroom for a user.
+func (l *Loader) Classroom(ctx context.Context, classroomID, userID int) (Resource, error) {
+	cr, err := l.client.Classroom.Query().
+		Where(classroom.ID(classroomID)).
+		WithApp().
+		WithTeacher().
+		Only(ctx)
+	if err != nil {
+		return Resource{}, notFound(err)
+	}
+	return l.classroomResource(ctx, cr, userID)
+}
+
+// ClassroomByJoinCode returns the facts about the classroom with a join code.
+func (l *Loader) ClassroomByJoinCode(ctx context.Context, joinCode string, userID int) (Resource, error) {
+	cr, err := l.client.Classroom.Query().
+		Where(classroom.JoinCode(joinCode)).
+		WithApp().
+		WithTeacher().
+		Only(ctx)
+	if err != nil {
+		return Resource{}, notFound(err)
+	}
+	return l.classroomResource(ctx, cr, userID)
+}
+
+// Assignment returns the facts about the classroom of an assignment. The
+// assignment must belong to classroomID.
+func (l *Loader) Assignment(ctx context.Context, classroomID, assignmentID, userID int) (Resource, error) {
+	exists, err := l.client.Assignment.Query().
+		Where(
+			assignment.ID(assignmentID),
+			assignment.HasClassroomWith(classroom.ID(classroomID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return Resource{}, err
+	}
+	if !exists {
+		return Resource{}, ErrNotFound
+	}
+	return l.Classroom(ctx, classroomID, userID)
+}
+
+// LiveSession returns the facts about a live session for a user. Membership
+// is that of the session's classroom.
+func (l *Loader) LiveSession(ctx context.Context, roomCode string, userID int) (Resource, error) {
+	session, err := l.client.LiveSession.Query().
+		Where(livesession.RoomCode(roomCode)).
+		WithApp().
+		WithTeacher().
+		WithClassroom().
+		Only(ctx)
+	if err != nil {
+		return Resource{}, notFound(err)
+	}
+
+	member, err := l.isMember(ctx, session.Edges.Classroom.ID, userID)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	return Resource{
+		AppID:   session.Edges.App.ID,
+		OwnerID: session.Edges.Teacher.ID,
+		Member:  member,
+	}, nil
+}
+
+// BattleRoom returns the facts about a battle room.
+func (l *Loader) BattleRoom(ctx context.Context, roomCode string) (Resource, error) {
+	room, err := l.client.BattleRoom.Query().
+		Where(battleroom.RoomCode(roomCode)).
+		WithApp().
+		WithHost().
+		Only(ctx)
+	if err != nil {
+		return Resource{}, notFound(err)
+	}
+
+	resource := Resource{
+		AppID:   room.Edges.App.ID,
+		OwnerID: room.Edges.Host.ID,
+	}
+	if room.GuestID != nil {
+		resource.GuestID = *room.GuestID
+	}
+	return resource, nil
+}
+
+// ClassroomSession returns the facts about a classroom session.
+func (l *Loader) ClassroomSession(ctx context.Context, sessionID int) (Resource, error) {
+	session, err := l.client.ClassroomSession.Query().
+		Where(classroomsession.ID(sessionID)).
+		WithApp().
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return Resource{}, notFound(err)
+	}
+
+	return Resource{
+		AppID:   session.Edges.App.ID,
+		OwnerID: session.Edges.User.ID,
+	}, nil
+}
+
+func (l *Loader) classroomResource(ctx context.Context, cr *ent.Classroom, userID int) (Resource, error) {
+	member, err := l.isMember(ctx, cr.ID, userID)
+	if err != nil {
+		return Resource{}, err
+	}
+
+	return Resource{
+		AppID:   cr.Edges.App.ID,
+		OwnerID: cr.Edges.Teacher.ID,
+		Member:  member,
+	}, nil
+}
+
+// isMember reports whether a user is an active student of a classroom.
+func (l *Loader) isMember(ctx context.Context, classroomID, userID int) (bool, error) {
+	return l.client.ClassroomMembership.Query().
+		Where(
+			classroommembership.HasClassroomWith(classroom.ID(classroomID)),
+			classroommembership.HasStudentWith(user.ID(userID)),
+			classroommembership.StatusEQ(classroommembership.StatusACTIVE),
+		).
+		Exist(ctx)
+}
+
+func notFound(err error) error {
+	if ent.IsNotFound(err) {
+		return ErrNotFound
+	}
+	return err
+}
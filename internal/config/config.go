@@ -1,6 +1,9 @@
 package config
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"os"
 	"strconv"
 	"strings"
@@ -25,18 +28,41 @@ type Config struct {
 	// JWT
 	JWTSecretKey             string
 	JWTAlgorithm             string
+	JWTSigningKeys           []string
+	AdminJWTSecretKey        string
+	AdminJWTSigningKeys      []string
 	AccessTokenExpireMinutes int
 	RefreshTokenExpireDays   int
 
+	// SecretEncryptionKey encrypts the app secret keys lem signs with
+	SecretEncryptionKey string
+
 	// Stripe
 	StripeSecretKey      string
 	StripeWebhookSecret  string
 	StripePublishableKey string
+	// StripeReconcileHours is how often subscriptions are re-synced from
+	// Stripe; 0 disables it
+	StripeReconcileHours int
+	// StripeAPIURL overrides Stripe's API URL, e.g. to run against
+	// stripe-mock; empty uses Stripe's
+	StripeAPIURL string
 
 	// Google OAuth
 	GoogleClientID     string
 	GoogleClientSecret string
 
+	// OpenID Connect provider
+	OIDCLoginURL string
+
+	// Enterprise SSO
+	SAMLSPKeyPath  string
+	SAMLSPCertPath string
+
+	// Apple Sign In
+	AppleClientIDs []string
+	AppleJWKSURL   string
+
 	// Google Cloud Storage
 	GCSCredentialsPath string
 	GCSBucketName      string
@@ -59,8 +85,21 @@ type Config struct {
 	// CORS
 	CORSOrigins []string
 
-	// Admin
+	// Admin. AdminEmails are made super-admins on startup; other admin
+	// accounts are stored in the database.
 	AdminEmails []string
+	// AdminAPIKey authenticates the /api/v1/admin API, which is disabled
+	// while it is empty.
+	AdminAPIKey string
+
+	// Rate limiting
+	RateLimitBackend        string
+	RateLimitIPPerMinute    int
+	RateLimitAppPerMinute   int
+	RateLimitUserPerMinute  int
+	RateLimitEmailPerMinute int
+	LoginMaxFailures        int
+	LoginLockoutMinutes     int
 }
 
 // Load loads configuration from environment variables.
@@ -90,18 +129,36 @@ func Load(env string) (*Config, error) {
 		// JWT
 		JWTSecretKey:             getEnv("JWT_SECRET_KEY", "your-secret-key-change-in-production"),
 		JWTAlgorithm:             getEnv("JWT_ALGORITHM", "HS256"),
+		JWTSigningKeys:           getEnvSlice("JWT_SIGNING_KEYS", []string{}),
+		AdminJWTSecretKey:        getEnv("ADMIN_JWT_SECRET_KEY", ""),
+		AdminJWTSigningKeys:      getEnvSlice("ADMIN_JWT_SIGNING_KEYS", []string{}),
 		AccessTokenExpireMinutes: getEnvInt("ACCESS_TOKEN_EXPIRE_MINUTES", 30),
 		RefreshTokenExpireDays:   getEnvInt("REFRESH_TOKEN_EXPIRE_DAYS", 7),
 
+		SecretEncryptionKey: getEnv("SECRET_ENCRYPTION_KEY", ""),
+
 		// Stripe
 		StripeSecretKey:      getEnv("STRIPE_SECRET_KEY", ""),
 		StripeWebhookSecret:  getEnv("STRIPE_WEBHOOK_SECRET", ""),
 		StripePublishableKey: getEnv("STRIPE_PUBLISHABLE_KEY", ""),
+		StripeReconcileHours: getEnvInt("STRIPE_RECONCILE_HOURS", 24),
+		StripeAPIURL:         getEnv("STRIPE_API_URL", ""),
 
 		// Google OAuth
 		GoogleClientID:     getEnv("GOOGLE_CLIENT_ID", ""),
 		GoogleClientSecret: getEnv("GOOGLE_CLIENT_SECRET", ""),
 
+		// OpenID Connect provider
+		OIDCLoginURL: getEnv("OIDC_LOGIN_URL", ""),
+
+		// Enterprise SSO
+		SAMLSPKeyPath:  getEnv("SAML_SP_KEY_PATH", ""),
+		SAMLSPCertPath: getEnv("SAML_SP_CERT_PATH", ""),
+
+		// Apple Sign In
+		AppleClientIDs: getEnvSlice("APPLE_CLIENT_IDS", []string{}),
+		AppleJWKSURL:   getEnv("APPLE_JWKS_URL", "https://appleid.apple.com/auth/keys"),
+
 		// Google Cloud Storage
 		GCSCredentialsPath: getEnv("GCS_CREDENTIALS_PATH", ""),
 		GCSBucketName:      getEnv("GCS_BUCKET_NAME", ""),
@@ -126,6 +183,16 @@ func Load(env string) (*Config, error) {
 
 		// Admin
 		AdminEmails: getEnvSlice("ADMIN_EMAILS", []string{}),
+		AdminAPIKey: getEnv("ADMIN_API_KEY", ""),
+
+		// Rate limiting
+		RateLimitBackend:        getEnv("RATE_LIMIT_BACKEND", "memory"),
+		RateLimitIPPerMinute:    getEnvInt("RATE_LIMIT_IP_PER_MINUTE", 300),
+		RateLimitAppPerMinute:   getEnvInt("RATE_LIMIT_APP_PER_MINUTE", 3000),
+		RateLimitUserPerMinute:  getEnvInt("RATE_LIMIT_USER_PER_MINUTE", 300),
+		RateLimitEmailPerMinute: getEnvInt("RATE_LIMIT_EMAIL_PER_MINUTE", 10),
+		LoginMaxFailures:        getEnvInt("LOGIN_MAX_FAILURES", 5),
+		LoginLockoutMinutes:     getEnvInt("LOGIN_LOCKOUT_MINUTES", 1),
 	}
 
 	return cfg, nil
@@ -141,6 +208,33 @@ func (c *Config) RefreshTokenDuration() time.Duration {
 	return time.Duration(c.RefreshTokenExpireDays) * 24 * time.Hour
 }
 
+// AdminJWTSecret returns the secret for HS256 admin sessions. Unless set
+// explicitly it is derived from JWTSecretKey, so that end-user tokens can
+// never be passed off as admin sessions.
+func (c *Config) AdminJWTSecret() string {
+	if c.AdminJWTSecretKey != "" {
+		return c.AdminJWTSecretKey
+	}
+	mac := hmac.New(sha256.New, []byte(c.JWTSecretKey))
+	mac.Write([]byte("admin_session"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SecretKeyEncryptionKey returns the AES-256 key that app secret keys and
+// TOTP secrets are encrypted with. Unless set explicitly it is derived from
+// JWTSecretKey, in which case changing that makes stored secret keys unusable
+// until they are rotated, and TOTP secrets until they are set up again.
+func (c *Config) SecretKeyEncryptionKey() [32]byte {
+	if c.SecretEncryptionKey != "" {
+		return sha256.Sum256([]byte(c.SecretEncryptionKey))
+	}
+	mac := hmac.New(sha256.New, []byte(c.JWTSecretKey))
+	mac.Write([]byte("secret_encryption"))
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+	return key
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
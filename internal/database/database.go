@@ -9,9 +9,14 @@ import (
 	"entgo.io/ent/dialect/sql"
 	_ "github.com/lib/pq"
 
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/audit"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/migrate"
+	"gigaboo.io/lem/internal/tenant"
+	"gigaboo.io/lem/internal/webhook"
 )
 
 // Connect creates a new database connection using the config.
@@ -24,12 +29,15 @@ func Connect(cfg *config.Config) (*ent.Client, error) {
 
 	// Create ent client
 	client := ent.NewClient(ent.Driver(drv))
+	tenant.Register(client)
+	audit.Register(client)
+	webhook.Register(client)
 
 	return client, nil
 }
 
 // Migrate runs auto-migration on the database schema.
-func Migrate(ctx context.Context, client *ent.Client) error {
+func Migrate(ctx context.Context, cfg *config.Config, client *ent.Client) error {
 	log.Println("Running database migrations...")
 
 	err := client.Schema.Create(
@@ -41,10 +49,52 @@ func Migrate(ctx context.Context, client *ent.Client) error {
 		return fmt.Errorf("failed creating schema resources: %w", err)
 	}
 
+	if err := hashAppKeys(ctx, client, apikey.NewSealer(cfg.SecretKeyEncryptionKey())); err != nil {
+		return fmt.Errorf("failed hashing app keys: %w", err)
+	}
+
 	log.Println("Database migrations completed successfully")
 	return nil
 }
 
+// hashAppKeys replaces the plaintext API keys and secret keys of apps
+// created before keys were hashed with their hashes, and secret keys also
+// with their encryption.
+func hashAppKeys(ctx context.Context, client *ent.Client, sealer *apikey.Sealer) error {
+	apps, err := client.App.Query().
+		Where(app.Or(
+			app.APIKeyNotNil(),
+			app.APISecretNEQ(""),
+		)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range apps {
+		update := client.App.UpdateOne(a).
+			ClearAPIKey().
+			ClearAPISecret()
+		if a.APIKey != nil && *a.APIKey != "" {
+			update.SetAPIKeyHash(apikey.Hash(*a.APIKey)).
+				SetAPIKeyPrefix(apikey.Prefix(*a.APIKey))
+		}
+		if a.APISecret != "" {
+			sealed, err := sealer.Seal(a.APISecret)
+			if err != nil {
+				return err
+			}
+			update.SetAPISecretHash(apikey.Hash(a.APISecret)).
+				SetAPISecretEncrypted(sealed)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return err
+		}
+		log.Printf("Hashed API key of app %s", a.Slug)
+	}
+	return nil
+}
+
 // Close closes the database connection.
 func Close(client *ent.Client) error {
 	return client.Close()
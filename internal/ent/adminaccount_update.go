@@ -0,0 +1,617 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/adminaccount"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// AdminAccountUpdate is the builder for updating AdminAccount entities.
+type AdminAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AdminAccountMutation
+}
+
+// Where appends a list predicates to the AdminAccountUpdate builder.
+func (_u *AdminAccountUpdate) Where(ps ...predicate.AdminAccount) *AdminAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *AdminAccountUpdate) SetEmail(v string) *AdminAccountUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AdminAccountUpdate) SetNillableEmail(v *string) *AdminAccountUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminAccountUpdate) SetName(v string) *AdminAccountUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminAccountUpdate) SetNillableName(v *string) *AdminAccountUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AdminAccountUpdate) ClearName() *AdminAccountUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AdminAccountUpdate) SetRole(v adminaccount.Role) *AdminAccountUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AdminAccountUpdate) SetNillableRole(v *adminaccount.Role) *AdminAccountUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *AdminAccountUpdate) SetIsActive(v bool) *AdminAccountUpdate {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *AdminAccountUpdate) SetNillableIsActive(v *bool) *AdminAccountUpdate {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *AdminAccountUpdate) SetLastLoginAt(v time.Time) *AdminAccountUpdate {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *AdminAccountUpdate) SetNillableLastLoginAt(v *time.Time) *AdminAccountUpdate {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *AdminAccountUpdate) ClearLastLoginAt() *AdminAccountUpdate {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminAccountUpdate) SetUpdatedAt(v time.Time) *AdminAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddAppIDs adds the "apps" edge to the App entity by IDs.
+func (_u *AdminAccountUpdate) AddAppIDs(ids ...int) *AdminAccountUpdate {
+	_u.mutation.AddAppIDs(ids...)
+	return _u
+}
+
+// AddApps adds the "apps" edges to the App entity.
+func (_u *AdminAccountUpdate) AddApps(v ...*App) *AdminAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppIDs(ids...)
+}
+
+// Mutation returns the AdminAccountMutation object of the builder.
+func (_u *AdminAccountUpdate) Mutation() *AdminAccountMutation {
+	return _u.mutation
+}
+
+// ClearApps clears all "apps" edges to the App entity.
+func (_u *AdminAccountUpdate) ClearApps() *AdminAccountUpdate {
+	_u.mutation.ClearApps()
+	return _u
+}
+
+// RemoveAppIDs removes the "apps" edge to App entities by IDs.
+func (_u *AdminAccountUpdate) RemoveAppIDs(ids ...int) *AdminAccountUpdate {
+	_u.mutation.RemoveAppIDs(ids...)
+	return _u
+}
+
+// RemoveApps removes "apps" edges to App entities.
+func (_u *AdminAccountUpdate) RemoveApps(v ...*App) *AdminAccountUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AdminAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AdminAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminAccountUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := adminaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AdminAccount.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := adminaccount.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AdminAccount.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminaccount.Table, adminaccount.Columns, sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(adminaccount.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminaccount.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(adminaccount.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(adminaccount.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(adminaccount.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(adminaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(adminaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppsIDs(); len(nodes) > 0 && !_u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AdminAccountUpdateOne is the builder for updating a single AdminAccount entity.
+type AdminAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AdminAccountMutation
+}
+
+// SetEmail sets the "email" field.
+func (_u *AdminAccountUpdateOne) SetEmail(v string) *AdminAccountUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *AdminAccountUpdateOne) SetNillableEmail(v *string) *AdminAccountUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *AdminAccountUpdateOne) SetName(v string) *AdminAccountUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AdminAccountUpdateOne) SetNillableName(v *string) *AdminAccountUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *AdminAccountUpdateOne) ClearName() *AdminAccountUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *AdminAccountUpdateOne) SetRole(v adminaccount.Role) *AdminAccountUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *AdminAccountUpdateOne) SetNillableRole(v *adminaccount.Role) *AdminAccountUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetIsActive sets the "is_active" field.
+func (_u *AdminAccountUpdateOne) SetIsActive(v bool) *AdminAccountUpdateOne {
+	_u.mutation.SetIsActive(v)
+	return _u
+}
+
+// SetNillableIsActive sets the "is_active" field if the given value is not nil.
+func (_u *AdminAccountUpdateOne) SetNillableIsActive(v *bool) *AdminAccountUpdateOne {
+	if v != nil {
+		_u.SetIsActive(*v)
+	}
+	return _u
+}
+
+// SetLastLoginAt sets the "last_login_at" field.
+func (_u *AdminAccountUpdateOne) SetLastLoginAt(v time.Time) *AdminAccountUpdateOne {
+	_u.mutation.SetLastLoginAt(v)
+	return _u
+}
+
+// SetNillableLastLoginAt sets the "last_login_at" field if the given value is not nil.
+func (_u *AdminAccountUpdateOne) SetNillableLastLoginAt(v *time.Time) *AdminAccountUpdateOne {
+	if v != nil {
+		_u.SetLastLoginAt(*v)
+	}
+	return _u
+}
+
+// ClearLastLoginAt clears the value of the "last_login_at" field.
+func (_u *AdminAccountUpdateOne) ClearLastLoginAt() *AdminAccountUpdateOne {
+	_u.mutation.ClearLastLoginAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *AdminAccountUpdateOne) SetUpdatedAt(v time.Time) *AdminAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddAppIDs adds the "apps" edge to the App entity by IDs.
+func (_u *AdminAccountUpdateOne) AddAppIDs(ids ...int) *AdminAccountUpdateOne {
+	_u.mutation.AddAppIDs(ids...)
+	return _u
+}
+
+// AddApps adds the "apps" edges to the App entity.
+func (_u *AdminAccountUpdateOne) AddApps(v ...*App) *AdminAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAppIDs(ids...)
+}
+
+// Mutation returns the AdminAccountMutation object of the builder.
+func (_u *AdminAccountUpdateOne) Mutation() *AdminAccountMutation {
+	return _u.mutation
+}
+
+// ClearApps clears all "apps" edges to the App entity.
+func (_u *AdminAccountUpdateOne) ClearApps() *AdminAccountUpdateOne {
+	_u.mutation.ClearApps()
+	return _u
+}
+
+// RemoveAppIDs removes the "apps" edge to App entities by IDs.
+func (_u *AdminAccountUpdateOne) RemoveAppIDs(ids ...int) *AdminAccountUpdateOne {
+	_u.mutation.RemoveAppIDs(ids...)
+	return _u
+}
+
+// RemoveApps removes "apps" edges to App entities.
+func (_u *AdminAccountUpdateOne) RemoveApps(v ...*App) *AdminAccountUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAppIDs(ids...)
+}
+
+// Where appends a list predicates to the AdminAccountUpdate builder.
+func (_u *AdminAccountUpdateOne) Where(ps ...predicate.AdminAccount) *AdminAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *AdminAccountUpdateOne) Select(field string, fields ...string) *AdminAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated AdminAccount entity.
+func (_u *AdminAccountUpdateOne) Save(ctx context.Context) (*AdminAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AdminAccountUpdateOne) SaveX(ctx context.Context) *AdminAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *AdminAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AdminAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AdminAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := adminaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *AdminAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := adminaccount.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "AdminAccount.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := adminaccount.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "AdminAccount.role": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *AdminAccountUpdateOne) sqlSave(ctx context.Context) (_node *AdminAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(adminaccount.Table, adminaccount.Columns, sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "AdminAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, adminaccount.FieldID)
+		for _, f := range fields {
+			if !adminaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != adminaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(adminaccount.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(adminaccount.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(adminaccount.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(adminaccount.FieldRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.IsActive(); ok {
+		_spec.SetField(adminaccount.FieldIsActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LastLoginAt(); ok {
+		_spec.SetField(adminaccount.FieldLastLoginAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastLoginAtCleared() {
+		_spec.ClearField(adminaccount.FieldLastLoginAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(adminaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAppsIDs(); len(nodes) > 0 && !_u.mutation.AppsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   adminaccount.AppsTable,
+			Columns: adminaccount.AppsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &AdminAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{adminaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -23,15 +23,41 @@ type App struct {
 	// Slug holds the value of the "slug" field.
 	Slug string `json:"slug,omitempty"`
 	// APIKey holds the value of the "api_key" field.
-	APIKey string `json:"-"`
+	APIKey *string `json:"-"`
 	// APISecret holds the value of the "api_secret" field.
 	APISecret string `json:"-"`
+	// APIKeyHash holds the value of the "api_key_hash" field.
+	APIKeyHash *string `json:"-"`
+	// APIKeyPrefix holds the value of the "api_key_prefix" field.
+	APIKeyPrefix string `json:"api_key_prefix,omitempty"`
+	// PreviousAPIKeyHash holds the value of the "previous_api_key_hash" field.
+	PreviousAPIKeyHash *string `json:"-"`
+	// PreviousAPIKeyExpiresAt holds the value of the "previous_api_key_expires_at" field.
+	PreviousAPIKeyExpiresAt *time.Time `json:"previous_api_key_expires_at,omitempty"`
+	// APISecretHash holds the value of the "api_secret_hash" field.
+	APISecretHash string `json:"-"`
+	// PreviousAPISecretHash holds the value of the "previous_api_secret_hash" field.
+	PreviousAPISecretHash string `json:"-"`
+	// APISecretEncrypted holds the value of the "api_secret_encrypted" field.
+	APISecretEncrypted string `json:"-"`
+	// PreviousAPISecretEncrypted holds the value of the "previous_api_secret_encrypted" field.
+	PreviousAPISecretEncrypted string `json:"-"`
+	// PreviousAPISecretExpiresAt holds the value of the "previous_api_secret_expires_at" field.
+	PreviousAPISecretExpiresAt *time.Time `json:"previous_api_secret_expires_at,omitempty"`
 	// AllowedOrigins holds the value of the "allowed_origins" field.
 	AllowedOrigins []string `json:"allowed_origins,omitempty"`
+	// OauthRedirectUris holds the value of the "oauth_redirect_uris" field.
+	OauthRedirectUris []string `json:"oauth_redirect_uris,omitempty"`
 	// WebhookURL holds the value of the "webhook_url" field.
 	WebhookURL string `json:"webhook_url,omitempty"`
 	// StripeProductID holds the value of the "stripe_product_id" field.
 	StripeProductID string `json:"stripe_product_id,omitempty"`
+	// RateLimitPerMinute holds the value of the "rate_limit_per_minute" field.
+	RateLimitPerMinute *int `json:"rate_limit_per_minute,omitempty"`
+	// RateLimitBurst holds the value of the "rate_limit_burst" field.
+	RateLimitBurst *int `json:"rate_limit_burst,omitempty"`
+	// MagicLinkSignup holds the value of the "magic_link_signup" field.
+	MagicLinkSignup bool `json:"magic_link_signup,omitempty"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -74,9 +100,23 @@ type AppEdges struct {
 	ClassroomSessions []*ClassroomSession `json:"classroom_sessions,omitempty"`
 	// ShenbiSettings holds the value of the shenbi_settings edge.
 	ShenbiSettings []*ShenbiSettings `json:"shenbi_settings,omitempty"`
+	// AuthSessions holds the value of the auth_sessions edge.
+	AuthSessions []*AuthSession `json:"auth_sessions,omitempty"`
+	// VerificationTokens holds the value of the verification_tokens edge.
+	VerificationTokens []*VerificationToken `json:"verification_tokens,omitempty"`
+	// OauthConsents holds the value of the oauth_consents edge.
+	OauthConsents []*OAuthConsent `json:"oauth_consents,omitempty"`
+	// OauthAuthorizationCodes holds the value of the oauth_authorization_codes edge.
+	OauthAuthorizationCodes []*OAuthAuthorizationCode `json:"oauth_authorization_codes,omitempty"`
+	// AuditEvents holds the value of the audit_events edge.
+	AuditEvents []*AuditEvent `json:"audit_events,omitempty"`
+	// WebhookDeliveries holds the value of the webhook_deliveries edge.
+	WebhookDeliveries []*WebhookDelivery `json:"webhook_deliveries,omitempty"`
+	// Admins holds the value of the admins edge.
+	Admins []*AdminAccount `json:"admins,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [14]bool
+	loadedTypes [21]bool
 }
 
 // UserAppsOrErr returns the UserApps value or an error if the edge
@@ -205,20 +245,83 @@ func (e AppEdges) ShenbiSettingsOrErr() ([]*ShenbiSettings, error) {
 	return nil, &NotLoadedError{edge: "shenbi_settings"}
 }
 
+// AuthSessionsOrErr returns the AuthSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) AuthSessionsOrErr() ([]*AuthSession, error) {
+	if e.loadedTypes[14] {
+		return e.AuthSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "auth_sessions"}
+}
+
+// VerificationTokensOrErr returns the VerificationTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) VerificationTokensOrErr() ([]*VerificationToken, error) {
+	if e.loadedTypes[15] {
+		return e.VerificationTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "verification_tokens"}
+}
+
+// OauthConsentsOrErr returns the OauthConsents value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) OauthConsentsOrErr() ([]*OAuthConsent, error) {
+	if e.loadedTypes[16] {
+		return e.OauthConsents, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_consents"}
+}
+
+// OauthAuthorizationCodesOrErr returns the OauthAuthorizationCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) OauthAuthorizationCodesOrErr() ([]*OAuthAuthorizationCode, error) {
+	if e.loadedTypes[17] {
+		return e.OauthAuthorizationCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_authorization_codes"}
+}
+
+// AuditEventsOrErr returns the AuditEvents value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) AuditEventsOrErr() ([]*AuditEvent, error) {
+	if e.loadedTypes[18] {
+		return e.AuditEvents, nil
+	}
+	return nil, &NotLoadedError{edge: "audit_events"}
+}
+
+// WebhookDeliveriesOrErr returns the WebhookDeliveries value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) WebhookDeliveriesOrErr() ([]*WebhookDelivery, error) {
+	if e.loadedTypes[19] {
+		return e.WebhookDeliveries, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_deliveries"}
+}
+
+// AdminsOrErr returns the Admins value or an error if the edge
+// was not loaded in eager-loading.
+func (e AppEdges) AdminsOrErr() ([]*AdminAccount, error) {
+	if e.loadedTypes[20] {
+		return e.Admins, nil
+	}
+	return nil, &NotLoadedError{edge: "admins"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*App) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case app.FieldAllowedOrigins:
+		case app.FieldAllowedOrigins, app.FieldOauthRedirectUris:
 			values[i] = new([]byte)
-		case app.FieldIsActive:
+		case app.FieldMagicLinkSignup, app.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case app.FieldID:
+		case app.FieldID, app.FieldRateLimitPerMinute, app.FieldRateLimitBurst:
 			values[i] = new(sql.NullInt64)
-		case app.FieldName, app.FieldSlug, app.FieldAPIKey, app.FieldAPISecret, app.FieldWebhookURL, app.FieldStripeProductID:
+		case app.FieldName, app.FieldSlug, app.FieldAPIKey, app.FieldAPISecret, app.FieldAPIKeyHash, app.FieldAPIKeyPrefix, app.FieldPreviousAPIKeyHash, app.FieldAPISecretHash, app.FieldPreviousAPISecretHash, app.FieldAPISecretEncrypted, app.FieldPreviousAPISecretEncrypted, app.FieldWebhookURL, app.FieldStripeProductID:
 			values[i] = new(sql.NullString)
-		case app.FieldCreatedAt, app.FieldUpdatedAt:
+		case app.FieldPreviousAPIKeyExpiresAt, app.FieldPreviousAPISecretExpiresAt, app.FieldCreatedAt, app.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -257,7 +360,8 @@ func (_m *App) assignValues(columns []string, values []any) error {
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field api_key", values[i])
 			} else if value.Valid {
-				_m.APIKey = value.String
+				_m.APIKey = new(string)
+				*_m.APIKey = value.String
 			}
 		case app.FieldAPISecret:
 			if value, ok := values[i].(*sql.NullString); !ok {
@@ -265,6 +369,64 @@ func (_m *App) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.APISecret = value.String
 			}
+		case app.FieldAPIKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_hash", values[i])
+			} else if value.Valid {
+				_m.APIKeyHash = new(string)
+				*_m.APIKeyHash = value.String
+			}
+		case app.FieldAPIKeyPrefix:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_key_prefix", values[i])
+			} else if value.Valid {
+				_m.APIKeyPrefix = value.String
+			}
+		case app.FieldPreviousAPIKeyHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_key_hash", values[i])
+			} else if value.Valid {
+				_m.PreviousAPIKeyHash = new(string)
+				*_m.PreviousAPIKeyHash = value.String
+			}
+		case app.FieldPreviousAPIKeyExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_key_expires_at", values[i])
+			} else if value.Valid {
+				_m.PreviousAPIKeyExpiresAt = new(time.Time)
+				*_m.PreviousAPIKeyExpiresAt = value.Time
+			}
+		case app.FieldAPISecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_secret_hash", values[i])
+			} else if value.Valid {
+				_m.APISecretHash = value.String
+			}
+		case app.FieldPreviousAPISecretHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_secret_hash", values[i])
+			} else if value.Valid {
+				_m.PreviousAPISecretHash = value.String
+			}
+		case app.FieldAPISecretEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field api_secret_encrypted", values[i])
+			} else if value.Valid {
+				_m.APISecretEncrypted = value.String
+			}
+		case app.FieldPreviousAPISecretEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_secret_encrypted", values[i])
+			} else if value.Valid {
+				_m.PreviousAPISecretEncrypted = value.String
+			}
+		case app.FieldPreviousAPISecretExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field previous_api_secret_expires_at", values[i])
+			} else if value.Valid {
+				_m.PreviousAPISecretExpiresAt = new(time.Time)
+				*_m.PreviousAPISecretExpiresAt = value.Time
+			}
 		case app.FieldAllowedOrigins:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field allowed_origins", values[i])
@@ -273,6 +435,14 @@ func (_m *App) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field allowed_origins: %w", err)
 				}
 			}
+		case app.FieldOauthRedirectUris:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field oauth_redirect_uris", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OauthRedirectUris); err != nil {
+					return fmt.Errorf("unmarshal field oauth_redirect_uris: %w", err)
+				}
+			}
 		case app.FieldWebhookURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field webhook_url", values[i])
@@ -285,6 +455,26 @@ func (_m *App) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.StripeProductID = value.String
 			}
+		case app.FieldRateLimitPerMinute:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_per_minute", values[i])
+			} else if value.Valid {
+				_m.RateLimitPerMinute = new(int)
+				*_m.RateLimitPerMinute = int(value.Int64)
+			}
+		case app.FieldRateLimitBurst:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rate_limit_burst", values[i])
+			} else if value.Valid {
+				_m.RateLimitBurst = new(int)
+				*_m.RateLimitBurst = int(value.Int64)
+			}
+		case app.FieldMagicLinkSignup:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field magic_link_signup", values[i])
+			} else if value.Valid {
+				_m.MagicLinkSignup = value.Bool
+			}
 		case app.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
@@ -386,6 +576,41 @@ func (_m *App) QueryShenbiSettings() *ShenbiSettingsQuery {
 	return NewAppClient(_m.config).QueryShenbiSettings(_m)
 }
 
+// QueryAuthSessions queries the "auth_sessions" edge of the App entity.
+func (_m *App) QueryAuthSessions() *AuthSessionQuery {
+	return NewAppClient(_m.config).QueryAuthSessions(_m)
+}
+
+// QueryVerificationTokens queries the "verification_tokens" edge of the App entity.
+func (_m *App) QueryVerificationTokens() *VerificationTokenQuery {
+	return NewAppClient(_m.config).QueryVerificationTokens(_m)
+}
+
+// QueryOauthConsents queries the "oauth_consents" edge of the App entity.
+func (_m *App) QueryOauthConsents() *OAuthConsentQuery {
+	return NewAppClient(_m.config).QueryOauthConsents(_m)
+}
+
+// QueryOauthAuthorizationCodes queries the "oauth_authorization_codes" edge of the App entity.
+func (_m *App) QueryOauthAuthorizationCodes() *OAuthAuthorizationCodeQuery {
+	return NewAppClient(_m.config).QueryOauthAuthorizationCodes(_m)
+}
+
+// QueryAuditEvents queries the "audit_events" edge of the App entity.
+func (_m *App) QueryAuditEvents() *AuditEventQuery {
+	return NewAppClient(_m.config).QueryAuditEvents(_m)
+}
+
+// QueryWebhookDeliveries queries the "webhook_deliveries" edge of the App entity.
+func (_m *App) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	return NewAppClient(_m.config).QueryWebhookDeliveries(_m)
+}
+
+// QueryAdmins queries the "admins" edge of the App entity.
+func (_m *App) QueryAdmins() *AdminAccountQuery {
+	return NewAppClient(_m.config).QueryAdmins(_m)
+}
+
 // Update returns a builder for updating this App.
 // Note that you need to call App.Unwrap() before calling this method if this App
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -419,15 +644,56 @@ func (_m *App) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("api_secret=<sensitive>")
 	builder.WriteString(", ")
+	builder.WriteString("api_key_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("api_key_prefix=")
+	builder.WriteString(_m.APIKeyPrefix)
+	builder.WriteString(", ")
+	builder.WriteString("previous_api_key_hash=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.PreviousAPIKeyExpiresAt; v != nil {
+		builder.WriteString("previous_api_key_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("api_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_api_secret_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("api_secret_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("previous_api_secret_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	if v := _m.PreviousAPISecretExpiresAt; v != nil {
+		builder.WriteString("previous_api_secret_expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("allowed_origins=")
 	builder.WriteString(fmt.Sprintf("%v", _m.AllowedOrigins))
 	builder.WriteString(", ")
+	builder.WriteString("oauth_redirect_uris=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OauthRedirectUris))
+	builder.WriteString(", ")
 	builder.WriteString("webhook_url=")
 	builder.WriteString(_m.WebhookURL)
 	builder.WriteString(", ")
 	builder.WriteString("stripe_product_id=")
 	builder.WriteString(_m.StripeProductID)
 	builder.WriteString(", ")
+	if v := _m.RateLimitPerMinute; v != nil {
+		builder.WriteString("rate_limit_per_minute=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.RateLimitBurst; v != nil {
+		builder.WriteString("rate_limit_burst=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("magic_link_signup=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MagicLinkSignup))
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
@@ -22,12 +22,38 @@ const (
 	FieldAPIKey = "api_key"
 	// FieldAPISecret holds the string denoting the api_secret field in the database.
 	FieldAPISecret = "api_secret"
+	// FieldAPIKeyHash holds the string denoting the api_key_hash field in the database.
+	FieldAPIKeyHash = "api_key_hash"
+	// FieldAPIKeyPrefix holds the string denoting the api_key_prefix field in the database.
+	FieldAPIKeyPrefix = "api_key_prefix"
+	// FieldPreviousAPIKeyHash holds the string denoting the previous_api_key_hash field in the database.
+	FieldPreviousAPIKeyHash = "previous_api_key_hash"
+	// FieldPreviousAPIKeyExpiresAt holds the string denoting the previous_api_key_expires_at field in the database.
+	FieldPreviousAPIKeyExpiresAt = "previous_api_key_expires_at"
+	// FieldAPISecretHash holds the string denoting the api_secret_hash field in the database.
+	FieldAPISecretHash = "api_secret_hash"
+	// FieldPreviousAPISecretHash holds the string denoting the previous_api_secret_hash field in the database.
+	FieldPreviousAPISecretHash = "previous_api_secret_hash"
+	// FieldAPISecretEncrypted holds the string denoting the api_secret_encrypted field in the database.
+	FieldAPISecretEncrypted = "api_secret_encrypted"
+	// FieldPreviousAPISecretEncrypted holds the string denoting the previous_api_secret_encrypted field in the database.
+	FieldPreviousAPISecretEncrypted = "previous_api_secret_encrypted"
+	// FieldPreviousAPISecretExpiresAt holds the string denoting the previous_api_secret_expires_at field in the database.
+	FieldPreviousAPISecretExpiresAt = "previous_api_secret_expires_at"
 	// FieldAllowedOrigins holds the string denoting the allowed_origins field in the database.
 	FieldAllowedOrigins = "allowed_origins"
+	// FieldOauthRedirectUris holds the string denoting the oauth_redirect_uris field in the database.
+	FieldOauthRedirectUris = "oauth_redirect_uris"
 	// FieldWebhookURL holds the string denoting the webhook_url field in the database.
 	FieldWebhookURL = "webhook_url"
 	// FieldStripeProductID holds the string denoting the stripe_product_id field in the database.
 	FieldStripeProductID = "stripe_product_id"
+	// FieldRateLimitPerMinute holds the string denoting the rate_limit_per_minute field in the database.
+	FieldRateLimitPerMinute = "rate_limit_per_minute"
+	// FieldRateLimitBurst holds the string denoting the rate_limit_burst field in the database.
+	FieldRateLimitBurst = "rate_limit_burst"
+	// FieldMagicLinkSignup holds the string denoting the magic_link_signup field in the database.
+	FieldMagicLinkSignup = "magic_link_signup"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -62,6 +88,20 @@ const (
 	EdgeClassroomSessions = "classroom_sessions"
 	// EdgeShenbiSettings holds the string denoting the shenbi_settings edge name in mutations.
 	EdgeShenbiSettings = "shenbi_settings"
+	// EdgeAuthSessions holds the string denoting the auth_sessions edge name in mutations.
+	EdgeAuthSessions = "auth_sessions"
+	// EdgeVerificationTokens holds the string denoting the verification_tokens edge name in mutations.
+	EdgeVerificationTokens = "verification_tokens"
+	// EdgeOauthConsents holds the string denoting the oauth_consents edge name in mutations.
+	EdgeOauthConsents = "oauth_consents"
+	// EdgeOauthAuthorizationCodes holds the string denoting the oauth_authorization_codes edge name in mutations.
+	EdgeOauthAuthorizationCodes = "oauth_authorization_codes"
+	// EdgeAuditEvents holds the string denoting the audit_events edge name in mutations.
+	EdgeAuditEvents = "audit_events"
+	// EdgeWebhookDeliveries holds the string denoting the webhook_deliveries edge name in mutations.
+	EdgeWebhookDeliveries = "webhook_deliveries"
+	// EdgeAdmins holds the string denoting the admins edge name in mutations.
+	EdgeAdmins = "admins"
 	// Table holds the table name of the app in the database.
 	Table = "apps"
 	// UserAppsTable is the table that holds the user_apps relation/edge.
@@ -162,6 +202,53 @@ const (
 	ShenbiSettingsInverseTable = "shenbi_settings"
 	// ShenbiSettingsColumn is the table column denoting the shenbi_settings relation/edge.
 	ShenbiSettingsColumn = "app_shenbi_settings"
+	// AuthSessionsTable is the table that holds the auth_sessions relation/edge.
+	AuthSessionsTable = "auth_sessions"
+	// AuthSessionsInverseTable is the table name for the AuthSession entity.
+	// It exists in this package in order to avoid circular dependency with the "authsession" package.
+	AuthSessionsInverseTable = "auth_sessions"
+	// AuthSessionsColumn is the table column denoting the auth_sessions relation/edge.
+	AuthSessionsColumn = "app_auth_sessions"
+	// VerificationTokensTable is the table that holds the verification_tokens relation/edge.
+	VerificationTokensTable = "verification_tokens"
+	// VerificationTokensInverseTable is the table name for the VerificationToken entity.
+	// It exists in this package in order to avoid circular dependency with the "verificationtoken" package.
+	VerificationTokensInverseTable = "verification_tokens"
+	// VerificationTokensColumn is the table column denoting the verification_tokens relation/edge.
+	VerificationTokensColumn = "app_verification_tokens"
+	// OauthConsentsTable is the table that holds the oauth_consents relation/edge.
+	OauthConsentsTable = "oauth_consents"
+	// OauthConsentsInverseTable is the table name for the OAuthConsent entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthconsent" package.
+	OauthConsentsInverseTable = "oauth_consents"
+	// OauthConsentsColumn is the table column denoting the oauth_consents relation/edge.
+	OauthConsentsColumn = "app_oauth_consents"
+	// OauthAuthorizationCodesTable is the table that holds the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesInverseTable is the table name for the OAuthAuthorizationCode entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthauthorizationcode" package.
+	OauthAuthorizationCodesInverseTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesColumn is the table column denoting the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesColumn = "app_oauth_authorization_codes"
+	// AuditEventsTable is the table that holds the audit_events relation/edge.
+	AuditEventsTable = "audit_events"
+	// AuditEventsInverseTable is the table name for the AuditEvent entity.
+	// It exists in this package in order to avoid circular dependency with the "auditevent" package.
+	AuditEventsInverseTable = "audit_events"
+	// AuditEventsColumn is the table column denoting the audit_events relation/edge.
+	AuditEventsColumn = "app_audit_events"
+	// WebhookDeliveriesTable is the table that holds the webhook_deliveries relation/edge.
+	WebhookDeliveriesTable = "webhook_deliveries"
+	// WebhookDeliveriesInverseTable is the table name for the WebhookDelivery entity.
+	// It exists in this package in order to avoid circular dependency with the "webhookdelivery" package.
+	WebhookDeliveriesInverseTable = "webhook_deliveries"
+	// WebhookDeliveriesColumn is the table column denoting the webhook_deliveries relation/edge.
+	WebhookDeliveriesColumn = "app_webhook_deliveries"
+	// AdminsTable is the table that holds the admins relation/edge. The primary key declared below.
+	AdminsTable = "admin_account_apps"
+	// AdminsInverseTable is the table name for the AdminAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "adminaccount" package.
+	AdminsInverseTable = "admin_accounts"
 )
 
 // Columns holds all SQL columns for app fields.
@@ -171,14 +258,33 @@ var Columns = []string{
 	FieldSlug,
 	FieldAPIKey,
 	FieldAPISecret,
+	FieldAPIKeyHash,
+	FieldAPIKeyPrefix,
+	FieldPreviousAPIKeyHash,
+	FieldPreviousAPIKeyExpiresAt,
+	FieldAPISecretHash,
+	FieldPreviousAPISecretHash,
+	FieldAPISecretEncrypted,
+	FieldPreviousAPISecretEncrypted,
+	FieldPreviousAPISecretExpiresAt,
 	FieldAllowedOrigins,
+	FieldOauthRedirectUris,
 	FieldWebhookURL,
 	FieldStripeProductID,
+	FieldRateLimitPerMinute,
+	FieldRateLimitBurst,
+	FieldMagicLinkSignup,
 	FieldIsActive,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
+var (
+	// AdminsPrimaryKey and AdminsColumn2 are the table columns denoting the
+	// primary key for the admins relation (M2M).
+	AdminsPrimaryKey = []string{"admin_account_id", "app_id"}
+)
+
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -194,8 +300,8 @@ var (
 	NameValidator func(string) error
 	// SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	SlugValidator func(string) error
-	// APIKeyValidator is a validator for the "api_key" field. It is called by the builders before save.
-	APIKeyValidator func(string) error
+	// DefaultMagicLinkSignup holds the default value on creation for the "magic_link_signup" field.
+	DefaultMagicLinkSignup bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -234,6 +340,51 @@ func ByAPISecret(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAPISecret, opts...).ToFunc()
 }
 
+// ByAPIKeyHash orders the results by the api_key_hash field.
+func ByAPIKeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyHash, opts...).ToFunc()
+}
+
+// ByAPIKeyPrefix orders the results by the api_key_prefix field.
+func ByAPIKeyPrefix(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPIKeyPrefix, opts...).ToFunc()
+}
+
+// ByPreviousAPIKeyHash orders the results by the previous_api_key_hash field.
+func ByPreviousAPIKeyHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAPIKeyHash, opts...).ToFunc()
+}
+
+// ByPreviousAPIKeyExpiresAt orders the results by the previous_api_key_expires_at field.
+func ByPreviousAPIKeyExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAPIKeyExpiresAt, opts...).ToFunc()
+}
+
+// ByAPISecretHash orders the results by the api_secret_hash field.
+func ByAPISecretHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPISecretHash, opts...).ToFunc()
+}
+
+// ByPreviousAPISecretHash orders the results by the previous_api_secret_hash field.
+func ByPreviousAPISecretHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAPISecretHash, opts...).ToFunc()
+}
+
+// ByAPISecretEncrypted orders the results by the api_secret_encrypted field.
+func ByAPISecretEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAPISecretEncrypted, opts...).ToFunc()
+}
+
+// ByPreviousAPISecretEncrypted orders the results by the previous_api_secret_encrypted field.
+func ByPreviousAPISecretEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAPISecretEncrypted, opts...).ToFunc()
+}
+
+// ByPreviousAPISecretExpiresAt orders the results by the previous_api_secret_expires_at field.
+func ByPreviousAPISecretExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPreviousAPISecretExpiresAt, opts...).ToFunc()
+}
+
 // ByWebhookURL orders the results by the webhook_url field.
 func ByWebhookURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWebhookURL, opts...).ToFunc()
@@ -244,6 +395,21 @@ func ByStripeProductID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStripeProductID, opts...).ToFunc()
 }
 
+// ByRateLimitPerMinute orders the results by the rate_limit_per_minute field.
+func ByRateLimitPerMinute(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitPerMinute, opts...).ToFunc()
+}
+
+// ByRateLimitBurst orders the results by the rate_limit_burst field.
+func ByRateLimitBurst(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRateLimitBurst, opts...).ToFunc()
+}
+
+// ByMagicLinkSignup orders the results by the magic_link_signup field.
+func ByMagicLinkSignup(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMagicLinkSignup, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
@@ -454,6 +620,104 @@ func ByShenbiSettings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newShenbiSettingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAuthSessionsCount orders the results by auth_sessions count.
+func ByAuthSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuthSessionsStep(), opts...)
+	}
+}
+
+// ByAuthSessions orders the results by auth_sessions terms.
+func ByAuthSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVerificationTokensCount orders the results by verification_tokens count.
+func ByVerificationTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVerificationTokensStep(), opts...)
+	}
+}
+
+// ByVerificationTokens orders the results by verification_tokens terms.
+func ByVerificationTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerificationTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthConsentsCount orders the results by oauth_consents count.
+func ByOauthConsentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthConsentsStep(), opts...)
+	}
+}
+
+// ByOauthConsents orders the results by oauth_consents terms.
+func ByOauthConsents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthConsentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthAuthorizationCodesCount orders the results by oauth_authorization_codes count.
+func ByOauthAuthorizationCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthAuthorizationCodesStep(), opts...)
+	}
+}
+
+// ByOauthAuthorizationCodes orders the results by oauth_authorization_codes terms.
+func ByOauthAuthorizationCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthAuthorizationCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAuditEventsCount orders the results by audit_events count.
+func ByAuditEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuditEventsStep(), opts...)
+	}
+}
+
+// ByAuditEvents orders the results by audit_events terms.
+func ByAuditEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuditEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWebhookDeliveriesCount orders the results by webhook_deliveries count.
+func ByWebhookDeliveriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWebhookDeliveriesStep(), opts...)
+	}
+}
+
+// ByWebhookDeliveries orders the results by webhook_deliveries terms.
+func ByWebhookDeliveries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWebhookDeliveriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByAdminsCount orders the results by admins count.
+func ByAdminsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAdminsStep(), opts...)
+	}
+}
+
+// ByAdmins orders the results by admins terms.
+func ByAdmins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAdminsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserAppsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -552,3 +816,52 @@ func newShenbiSettingsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ShenbiSettingsTable, ShenbiSettingsColumn),
 	)
 }
+func newAuthSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuthSessionsTable, AuthSessionsColumn),
+	)
+}
+func newVerificationTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerificationTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VerificationTokensTable, VerificationTokensColumn),
+	)
+}
+func newOauthConsentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthConsentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+	)
+}
+func newOauthAuthorizationCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthAuthorizationCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+	)
+}
+func newAuditEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuditEventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuditEventsTable, AuditEventsColumn),
+	)
+}
+func newWebhookDeliveriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WebhookDeliveriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WebhookDeliveriesTable, WebhookDeliveriesColumn),
+	)
+}
+func newAdminsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AdminsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2M, true, AdminsTable, AdminsPrimaryKey...),
+	)
+}
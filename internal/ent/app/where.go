@@ -75,6 +75,51 @@ func APISecret(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldAPISecret, v))
 }
 
+// APIKeyHash applies equality check predicate on the "api_key_hash" field. It's identical to APIKeyHashEQ.
+func APIKeyHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyPrefix applies equality check predicate on the "api_key_prefix" field. It's identical to APIKeyPrefixEQ.
+func APIKeyPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPIKeyPrefix, v))
+}
+
+// PreviousAPIKeyHash applies equality check predicate on the "previous_api_key_hash" field. It's identical to PreviousAPIKeyHashEQ.
+func PreviousAPIKeyHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyExpiresAt applies equality check predicate on the "previous_api_key_expires_at" field. It's identical to PreviousAPIKeyExpiresAtEQ.
+func PreviousAPIKeyExpiresAt(v time.Time) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// APISecretHash applies equality check predicate on the "api_secret_hash" field. It's identical to APISecretHashEQ.
+func APISecretHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPISecretHash, v))
+}
+
+// PreviousAPISecretHash applies equality check predicate on the "previous_api_secret_hash" field. It's identical to PreviousAPISecretHashEQ.
+func PreviousAPISecretHash(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretHash, v))
+}
+
+// APISecretEncrypted applies equality check predicate on the "api_secret_encrypted" field. It's identical to APISecretEncryptedEQ.
+func APISecretEncrypted(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncrypted applies equality check predicate on the "previous_api_secret_encrypted" field. It's identical to PreviousAPISecretEncryptedEQ.
+func PreviousAPISecretEncrypted(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretExpiresAt applies equality check predicate on the "previous_api_secret_expires_at" field. It's identical to PreviousAPISecretExpiresAtEQ.
+func PreviousAPISecretExpiresAt(v time.Time) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretExpiresAt, v))
+}
+
 // WebhookURL applies equality check predicate on the "webhook_url" field. It's identical to WebhookURLEQ.
 func WebhookURL(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldWebhookURL, v))
@@ -85,6 +130,21 @@ func StripeProductID(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldStripeProductID, v))
 }
 
+// RateLimitPerMinute applies equality check predicate on the "rate_limit_per_minute" field. It's identical to RateLimitPerMinuteEQ.
+func RateLimitPerMinute(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitBurst applies equality check predicate on the "rate_limit_burst" field. It's identical to RateLimitBurstEQ.
+func RateLimitBurst(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRateLimitBurst, v))
+}
+
+// MagicLinkSignup applies equality check predicate on the "magic_link_signup" field. It's identical to MagicLinkSignupEQ.
+func MagicLinkSignup(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMagicLinkSignup, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldIsActive, v))
@@ -285,6 +345,16 @@ func APIKeyHasSuffix(v string) predicate.App {
 	return predicate.App(sql.FieldHasSuffix(FieldAPIKey, v))
 }
 
+// APIKeyIsNil applies the IsNil predicate on the "api_key" field.
+func APIKeyIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAPIKey))
+}
+
+// APIKeyNotNil applies the NotNil predicate on the "api_key" field.
+func APIKeyNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAPIKey))
+}
+
 // APIKeyEqualFold applies the EqualFold predicate on the "api_key" field.
 func APIKeyEqualFold(v string) predicate.App {
 	return predicate.App(sql.FieldEqualFold(FieldAPIKey, v))
@@ -370,6 +440,631 @@ func APISecretContainsFold(v string) predicate.App {
 	return predicate.App(sql.FieldContainsFold(FieldAPISecret, v))
 }
 
+// APIKeyHashEQ applies the EQ predicate on the "api_key_hash" field.
+func APIKeyHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashNEQ applies the NEQ predicate on the "api_key_hash" field.
+func APIKeyHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashIn applies the In predicate on the "api_key_hash" field.
+func APIKeyHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldAPIKeyHash, vs...))
+}
+
+// APIKeyHashNotIn applies the NotIn predicate on the "api_key_hash" field.
+func APIKeyHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldAPIKeyHash, vs...))
+}
+
+// APIKeyHashGT applies the GT predicate on the "api_key_hash" field.
+func APIKeyHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashGTE applies the GTE predicate on the "api_key_hash" field.
+func APIKeyHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashLT applies the LT predicate on the "api_key_hash" field.
+func APIKeyHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashLTE applies the LTE predicate on the "api_key_hash" field.
+func APIKeyHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashContains applies the Contains predicate on the "api_key_hash" field.
+func APIKeyHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashHasPrefix applies the HasPrefix predicate on the "api_key_hash" field.
+func APIKeyHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashHasSuffix applies the HasSuffix predicate on the "api_key_hash" field.
+func APIKeyHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashIsNil applies the IsNil predicate on the "api_key_hash" field.
+func APIKeyHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAPIKeyHash))
+}
+
+// APIKeyHashNotNil applies the NotNil predicate on the "api_key_hash" field.
+func APIKeyHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAPIKeyHash))
+}
+
+// APIKeyHashEqualFold applies the EqualFold predicate on the "api_key_hash" field.
+func APIKeyHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldAPIKeyHash, v))
+}
+
+// APIKeyHashContainsFold applies the ContainsFold predicate on the "api_key_hash" field.
+func APIKeyHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldAPIKeyHash, v))
+}
+
+// APIKeyPrefixEQ applies the EQ predicate on the "api_key_prefix" field.
+func APIKeyPrefixEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixNEQ applies the NEQ predicate on the "api_key_prefix" field.
+func APIKeyPrefixNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixIn applies the In predicate on the "api_key_prefix" field.
+func APIKeyPrefixIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldAPIKeyPrefix, vs...))
+}
+
+// APIKeyPrefixNotIn applies the NotIn predicate on the "api_key_prefix" field.
+func APIKeyPrefixNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldAPIKeyPrefix, vs...))
+}
+
+// APIKeyPrefixGT applies the GT predicate on the "api_key_prefix" field.
+func APIKeyPrefixGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixGTE applies the GTE predicate on the "api_key_prefix" field.
+func APIKeyPrefixGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixLT applies the LT predicate on the "api_key_prefix" field.
+func APIKeyPrefixLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixLTE applies the LTE predicate on the "api_key_prefix" field.
+func APIKeyPrefixLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixContains applies the Contains predicate on the "api_key_prefix" field.
+func APIKeyPrefixContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixHasPrefix applies the HasPrefix predicate on the "api_key_prefix" field.
+func APIKeyPrefixHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixHasSuffix applies the HasSuffix predicate on the "api_key_prefix" field.
+func APIKeyPrefixHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixIsNil applies the IsNil predicate on the "api_key_prefix" field.
+func APIKeyPrefixIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAPIKeyPrefix))
+}
+
+// APIKeyPrefixNotNil applies the NotNil predicate on the "api_key_prefix" field.
+func APIKeyPrefixNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAPIKeyPrefix))
+}
+
+// APIKeyPrefixEqualFold applies the EqualFold predicate on the "api_key_prefix" field.
+func APIKeyPrefixEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldAPIKeyPrefix, v))
+}
+
+// APIKeyPrefixContainsFold applies the ContainsFold predicate on the "api_key_prefix" field.
+func APIKeyPrefixContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldAPIKeyPrefix, v))
+}
+
+// PreviousAPIKeyHashEQ applies the EQ predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashNEQ applies the NEQ predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashIn applies the In predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldPreviousAPIKeyHash, vs...))
+}
+
+// PreviousAPIKeyHashNotIn applies the NotIn predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldPreviousAPIKeyHash, vs...))
+}
+
+// PreviousAPIKeyHashGT applies the GT predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashGTE applies the GTE predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashLT applies the LT predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashLTE applies the LTE predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashContains applies the Contains predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashHasPrefix applies the HasPrefix predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashHasSuffix applies the HasSuffix predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashIsNil applies the IsNil predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPreviousAPIKeyHash))
+}
+
+// PreviousAPIKeyHashNotNil applies the NotNil predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPreviousAPIKeyHash))
+}
+
+// PreviousAPIKeyHashEqualFold applies the EqualFold predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyHashContainsFold applies the ContainsFold predicate on the "previous_api_key_hash" field.
+func PreviousAPIKeyHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldPreviousAPIKeyHash, v))
+}
+
+// PreviousAPIKeyExpiresAtEQ applies the EQ predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtEQ(v time.Time) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtNEQ applies the NEQ predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtNEQ(v time.Time) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtIn applies the In predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtIn(vs ...time.Time) predicate.App {
+	return predicate.App(sql.FieldIn(FieldPreviousAPIKeyExpiresAt, vs...))
+}
+
+// PreviousAPIKeyExpiresAtNotIn applies the NotIn predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtNotIn(vs ...time.Time) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldPreviousAPIKeyExpiresAt, vs...))
+}
+
+// PreviousAPIKeyExpiresAtGT applies the GT predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtGT(v time.Time) predicate.App {
+	return predicate.App(sql.FieldGT(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtGTE applies the GTE predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtGTE(v time.Time) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtLT applies the LT predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtLT(v time.Time) predicate.App {
+	return predicate.App(sql.FieldLT(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtLTE applies the LTE predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtLTE(v time.Time) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldPreviousAPIKeyExpiresAt, v))
+}
+
+// PreviousAPIKeyExpiresAtIsNil applies the IsNil predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPreviousAPIKeyExpiresAt))
+}
+
+// PreviousAPIKeyExpiresAtNotNil applies the NotNil predicate on the "previous_api_key_expires_at" field.
+func PreviousAPIKeyExpiresAtNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPreviousAPIKeyExpiresAt))
+}
+
+// APISecretHashEQ applies the EQ predicate on the "api_secret_hash" field.
+func APISecretHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPISecretHash, v))
+}
+
+// APISecretHashNEQ applies the NEQ predicate on the "api_secret_hash" field.
+func APISecretHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldAPISecretHash, v))
+}
+
+// APISecretHashIn applies the In predicate on the "api_secret_hash" field.
+func APISecretHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldAPISecretHash, vs...))
+}
+
+// APISecretHashNotIn applies the NotIn predicate on the "api_secret_hash" field.
+func APISecretHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldAPISecretHash, vs...))
+}
+
+// APISecretHashGT applies the GT predicate on the "api_secret_hash" field.
+func APISecretHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldAPISecretHash, v))
+}
+
+// APISecretHashGTE applies the GTE predicate on the "api_secret_hash" field.
+func APISecretHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldAPISecretHash, v))
+}
+
+// APISecretHashLT applies the LT predicate on the "api_secret_hash" field.
+func APISecretHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldAPISecretHash, v))
+}
+
+// APISecretHashLTE applies the LTE predicate on the "api_secret_hash" field.
+func APISecretHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldAPISecretHash, v))
+}
+
+// APISecretHashContains applies the Contains predicate on the "api_secret_hash" field.
+func APISecretHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldAPISecretHash, v))
+}
+
+// APISecretHashHasPrefix applies the HasPrefix predicate on the "api_secret_hash" field.
+func APISecretHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldAPISecretHash, v))
+}
+
+// APISecretHashHasSuffix applies the HasSuffix predicate on the "api_secret_hash" field.
+func APISecretHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldAPISecretHash, v))
+}
+
+// APISecretHashIsNil applies the IsNil predicate on the "api_secret_hash" field.
+func APISecretHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAPISecretHash))
+}
+
+// APISecretHashNotNil applies the NotNil predicate on the "api_secret_hash" field.
+func APISecretHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAPISecretHash))
+}
+
+// APISecretHashEqualFold applies the EqualFold predicate on the "api_secret_hash" field.
+func APISecretHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldAPISecretHash, v))
+}
+
+// APISecretHashContainsFold applies the ContainsFold predicate on the "api_secret_hash" field.
+func APISecretHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldAPISecretHash, v))
+}
+
+// PreviousAPISecretHashEQ applies the EQ predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashNEQ applies the NEQ predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashIn applies the In predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldPreviousAPISecretHash, vs...))
+}
+
+// PreviousAPISecretHashNotIn applies the NotIn predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldPreviousAPISecretHash, vs...))
+}
+
+// PreviousAPISecretHashGT applies the GT predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashGTE applies the GTE predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashLT applies the LT predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashLTE applies the LTE predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashContains applies the Contains predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashHasPrefix applies the HasPrefix predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashHasSuffix applies the HasSuffix predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashIsNil applies the IsNil predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPreviousAPISecretHash))
+}
+
+// PreviousAPISecretHashNotNil applies the NotNil predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPreviousAPISecretHash))
+}
+
+// PreviousAPISecretHashEqualFold applies the EqualFold predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldPreviousAPISecretHash, v))
+}
+
+// PreviousAPISecretHashContainsFold applies the ContainsFold predicate on the "previous_api_secret_hash" field.
+func PreviousAPISecretHashContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldPreviousAPISecretHash, v))
+}
+
+// APISecretEncryptedEQ applies the EQ predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedNEQ applies the NEQ predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedIn applies the In predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldAPISecretEncrypted, vs...))
+}
+
+// APISecretEncryptedNotIn applies the NotIn predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldAPISecretEncrypted, vs...))
+}
+
+// APISecretEncryptedGT applies the GT predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedGTE applies the GTE predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedLT applies the LT predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedLTE applies the LTE predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedContains applies the Contains predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedHasPrefix applies the HasPrefix predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedHasSuffix applies the HasSuffix predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedIsNil applies the IsNil predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldAPISecretEncrypted))
+}
+
+// APISecretEncryptedNotNil applies the NotNil predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldAPISecretEncrypted))
+}
+
+// APISecretEncryptedEqualFold applies the EqualFold predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldAPISecretEncrypted, v))
+}
+
+// APISecretEncryptedContainsFold applies the ContainsFold predicate on the "api_secret_encrypted" field.
+func APISecretEncryptedContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedEQ applies the EQ predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedEQ(v string) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedNEQ applies the NEQ predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedNEQ(v string) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedIn applies the In predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldIn(FieldPreviousAPISecretEncrypted, vs...))
+}
+
+// PreviousAPISecretEncryptedNotIn applies the NotIn predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedNotIn(vs ...string) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldPreviousAPISecretEncrypted, vs...))
+}
+
+// PreviousAPISecretEncryptedGT applies the GT predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedGT(v string) predicate.App {
+	return predicate.App(sql.FieldGT(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedGTE applies the GTE predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedGTE(v string) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedLT applies the LT predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedLT(v string) predicate.App {
+	return predicate.App(sql.FieldLT(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedLTE applies the LTE predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedLTE(v string) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedContains applies the Contains predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedContains(v string) predicate.App {
+	return predicate.App(sql.FieldContains(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedHasPrefix applies the HasPrefix predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedHasPrefix(v string) predicate.App {
+	return predicate.App(sql.FieldHasPrefix(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedHasSuffix applies the HasSuffix predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedHasSuffix(v string) predicate.App {
+	return predicate.App(sql.FieldHasSuffix(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedIsNil applies the IsNil predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPreviousAPISecretEncrypted))
+}
+
+// PreviousAPISecretEncryptedNotNil applies the NotNil predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPreviousAPISecretEncrypted))
+}
+
+// PreviousAPISecretEncryptedEqualFold applies the EqualFold predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedEqualFold(v string) predicate.App {
+	return predicate.App(sql.FieldEqualFold(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretEncryptedContainsFold applies the ContainsFold predicate on the "previous_api_secret_encrypted" field.
+func PreviousAPISecretEncryptedContainsFold(v string) predicate.App {
+	return predicate.App(sql.FieldContainsFold(FieldPreviousAPISecretEncrypted, v))
+}
+
+// PreviousAPISecretExpiresAtEQ applies the EQ predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtEQ(v time.Time) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtNEQ applies the NEQ predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNEQ(v time.Time) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtIn applies the In predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtIn(vs ...time.Time) predicate.App {
+	return predicate.App(sql.FieldIn(FieldPreviousAPISecretExpiresAt, vs...))
+}
+
+// PreviousAPISecretExpiresAtNotIn applies the NotIn predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNotIn(vs ...time.Time) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldPreviousAPISecretExpiresAt, vs...))
+}
+
+// PreviousAPISecretExpiresAtGT applies the GT predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtGT(v time.Time) predicate.App {
+	return predicate.App(sql.FieldGT(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtGTE applies the GTE predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtGTE(v time.Time) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtLT applies the LT predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtLT(v time.Time) predicate.App {
+	return predicate.App(sql.FieldLT(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtLTE applies the LTE predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtLTE(v time.Time) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldPreviousAPISecretExpiresAt, v))
+}
+
+// PreviousAPISecretExpiresAtIsNil applies the IsNil predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldPreviousAPISecretExpiresAt))
+}
+
+// PreviousAPISecretExpiresAtNotNil applies the NotNil predicate on the "previous_api_secret_expires_at" field.
+func PreviousAPISecretExpiresAtNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldPreviousAPISecretExpiresAt))
+}
+
 // AllowedOriginsIsNil applies the IsNil predicate on the "allowed_origins" field.
 func AllowedOriginsIsNil() predicate.App {
 	return predicate.App(sql.FieldIsNull(FieldAllowedOrigins))
@@ -380,6 +1075,16 @@ func AllowedOriginsNotNil() predicate.App {
 	return predicate.App(sql.FieldNotNull(FieldAllowedOrigins))
 }
 
+// OauthRedirectUrisIsNil applies the IsNil predicate on the "oauth_redirect_uris" field.
+func OauthRedirectUrisIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldOauthRedirectUris))
+}
+
+// OauthRedirectUrisNotNil applies the NotNil predicate on the "oauth_redirect_uris" field.
+func OauthRedirectUrisNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldOauthRedirectUris))
+}
+
 // WebhookURLEQ applies the EQ predicate on the "webhook_url" field.
 func WebhookURLEQ(v string) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldWebhookURL, v))
@@ -530,6 +1235,116 @@ func StripeProductIDContainsFold(v string) predicate.App {
 	return predicate.App(sql.FieldContainsFold(FieldStripeProductID, v))
 }
 
+// RateLimitPerMinuteEQ applies the EQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteNEQ applies the NEQ predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIn applies the In predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteNotIn applies the NotIn predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldRateLimitPerMinute, vs...))
+}
+
+// RateLimitPerMinuteGT applies the GT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteGTE applies the GTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLT applies the LT predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteLTE applies the LTE predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldRateLimitPerMinute, v))
+}
+
+// RateLimitPerMinuteIsNil applies the IsNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldRateLimitPerMinute))
+}
+
+// RateLimitPerMinuteNotNil applies the NotNil predicate on the "rate_limit_per_minute" field.
+func RateLimitPerMinuteNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldRateLimitPerMinute))
+}
+
+// RateLimitBurstEQ applies the EQ predicate on the "rate_limit_burst" field.
+func RateLimitBurstEQ(v int) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstNEQ applies the NEQ predicate on the "rate_limit_burst" field.
+func RateLimitBurstNEQ(v int) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstIn applies the In predicate on the "rate_limit_burst" field.
+func RateLimitBurstIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldIn(FieldRateLimitBurst, vs...))
+}
+
+// RateLimitBurstNotIn applies the NotIn predicate on the "rate_limit_burst" field.
+func RateLimitBurstNotIn(vs ...int) predicate.App {
+	return predicate.App(sql.FieldNotIn(FieldRateLimitBurst, vs...))
+}
+
+// RateLimitBurstGT applies the GT predicate on the "rate_limit_burst" field.
+func RateLimitBurstGT(v int) predicate.App {
+	return predicate.App(sql.FieldGT(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstGTE applies the GTE predicate on the "rate_limit_burst" field.
+func RateLimitBurstGTE(v int) predicate.App {
+	return predicate.App(sql.FieldGTE(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstLT applies the LT predicate on the "rate_limit_burst" field.
+func RateLimitBurstLT(v int) predicate.App {
+	return predicate.App(sql.FieldLT(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstLTE applies the LTE predicate on the "rate_limit_burst" field.
+func RateLimitBurstLTE(v int) predicate.App {
+	return predicate.App(sql.FieldLTE(FieldRateLimitBurst, v))
+}
+
+// RateLimitBurstIsNil applies the IsNil predicate on the "rate_limit_burst" field.
+func RateLimitBurstIsNil() predicate.App {
+	return predicate.App(sql.FieldIsNull(FieldRateLimitBurst))
+}
+
+// RateLimitBurstNotNil applies the NotNil predicate on the "rate_limit_burst" field.
+func RateLimitBurstNotNil() predicate.App {
+	return predicate.App(sql.FieldNotNull(FieldRateLimitBurst))
+}
+
+// MagicLinkSignupEQ applies the EQ predicate on the "magic_link_signup" field.
+func MagicLinkSignupEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldEQ(FieldMagicLinkSignup, v))
+}
+
+// MagicLinkSignupNEQ applies the NEQ predicate on the "magic_link_signup" field.
+func MagicLinkSignupNEQ(v bool) predicate.App {
+	return predicate.App(sql.FieldNEQ(FieldMagicLinkSignup, v))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.App {
 	return predicate.App(sql.FieldEQ(FieldIsActive, v))
@@ -942,6 +1757,167 @@ func HasShenbiSettingsWith(preds ...predicate.ShenbiSettings) predicate.App {
 	})
 }
 
+// HasAuthSessions applies the HasEdge predicate on the "auth_sessions" edge.
+func HasAuthSessions() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthSessionsTable, AuthSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthSessionsWith applies the HasEdge predicate on the "auth_sessions" edge with a given conditions (other predicates).
+func HasAuthSessionsWith(preds ...predicate.AuthSession) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newAuthSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVerificationTokens applies the HasEdge predicate on the "verification_tokens" edge.
+func HasVerificationTokens() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VerificationTokensTable, VerificationTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerificationTokensWith applies the HasEdge predicate on the "verification_tokens" edge with a given conditions (other predicates).
+func HasVerificationTokensWith(preds ...predicate.VerificationToken) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newVerificationTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthConsents applies the HasEdge predicate on the "oauth_consents" edge.
+func HasOauthConsents() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthConsentsWith applies the HasEdge predicate on the "oauth_consents" edge with a given conditions (other predicates).
+func HasOauthConsentsWith(preds ...predicate.OAuthConsent) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newOauthConsentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthAuthorizationCodes applies the HasEdge predicate on the "oauth_authorization_codes" edge.
+func HasOauthAuthorizationCodes() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthAuthorizationCodesWith applies the HasEdge predicate on the "oauth_authorization_codes" edge with a given conditions (other predicates).
+func HasOauthAuthorizationCodesWith(preds ...predicate.OAuthAuthorizationCode) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newOauthAuthorizationCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAuditEvents applies the HasEdge predicate on the "audit_events" edge.
+func HasAuditEvents() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuditEventsTable, AuditEventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuditEventsWith applies the HasEdge predicate on the "audit_events" edge with a given conditions (other predicates).
+func HasAuditEventsWith(preds ...predicate.AuditEvent) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newAuditEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWebhookDeliveries applies the HasEdge predicate on the "webhook_deliveries" edge.
+func HasWebhookDeliveries() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WebhookDeliveriesTable, WebhookDeliveriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWebhookDeliveriesWith applies the HasEdge predicate on the "webhook_deliveries" edge with a given conditions (other predicates).
+func HasWebhookDeliveriesWith(preds ...predicate.WebhookDelivery) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newWebhookDeliveriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAdmins applies the HasEdge predicate on the "admins" edge.
+func HasAdmins() predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, AdminsTable, AdminsPrimaryKey...),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAdminsWith applies the HasEdge predicate on the "admins" edge with a given conditions (other predicates).
+func HasAdminsWith(preds ...predicate.AdminAccount) predicate.App {
+	return predicate.App(func(s *sql.Selector) {
+		step := newAdminsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.App) predicate.App {
 	return predicate.App(sql.AndPredicates(predicates...))
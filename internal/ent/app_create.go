@@ -11,13 +11,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/plan"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
@@ -25,6 +30,8 @@ import (
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
 )
 
 // AppCreate is the builder for creating a App entity.
@@ -52,6 +59,14 @@ func (_c *AppCreate) SetAPIKey(v string) *AppCreate {
 	return _c
 }
 
+// SetNillableAPIKey sets the "api_key" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAPIKey(v *string) *AppCreate {
+	if v != nil {
+		_c.SetAPIKey(*v)
+	}
+	return _c
+}
+
 // SetAPISecret sets the "api_secret" field.
 func (_c *AppCreate) SetAPISecret(v string) *AppCreate {
 	_c.mutation.SetAPISecret(v)
@@ -66,12 +81,144 @@ func (_c *AppCreate) SetNillableAPISecret(v *string) *AppCreate {
 	return _c
 }
 
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (_c *AppCreate) SetAPIKeyHash(v string) *AppCreate {
+	_c.mutation.SetAPIKeyHash(v)
+	return _c
+}
+
+// SetNillableAPIKeyHash sets the "api_key_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAPIKeyHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetAPIKeyHash(*v)
+	}
+	return _c
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (_c *AppCreate) SetAPIKeyPrefix(v string) *AppCreate {
+	_c.mutation.SetAPIKeyPrefix(v)
+	return _c
+}
+
+// SetNillableAPIKeyPrefix sets the "api_key_prefix" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAPIKeyPrefix(v *string) *AppCreate {
+	if v != nil {
+		_c.SetAPIKeyPrefix(*v)
+	}
+	return _c
+}
+
+// SetPreviousAPIKeyHash sets the "previous_api_key_hash" field.
+func (_c *AppCreate) SetPreviousAPIKeyHash(v string) *AppCreate {
+	_c.mutation.SetPreviousAPIKeyHash(v)
+	return _c
+}
+
+// SetNillablePreviousAPIKeyHash sets the "previous_api_key_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillablePreviousAPIKeyHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetPreviousAPIKeyHash(*v)
+	}
+	return _c
+}
+
+// SetPreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field.
+func (_c *AppCreate) SetPreviousAPIKeyExpiresAt(v time.Time) *AppCreate {
+	_c.mutation.SetPreviousAPIKeyExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field if the given value is not nil.
+func (_c *AppCreate) SetNillablePreviousAPIKeyExpiresAt(v *time.Time) *AppCreate {
+	if v != nil {
+		_c.SetPreviousAPIKeyExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (_c *AppCreate) SetAPISecretHash(v string) *AppCreate {
+	_c.mutation.SetAPISecretHash(v)
+	return _c
+}
+
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAPISecretHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetAPISecretHash(*v)
+	}
+	return _c
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (_c *AppCreate) SetPreviousAPISecretHash(v string) *AppCreate {
+	_c.mutation.SetPreviousAPISecretHash(v)
+	return _c
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (_c *AppCreate) SetNillablePreviousAPISecretHash(v *string) *AppCreate {
+	if v != nil {
+		_c.SetPreviousAPISecretHash(*v)
+	}
+	return _c
+}
+
+// SetAPISecretEncrypted sets the "api_secret_encrypted" field.
+func (_c *AppCreate) SetAPISecretEncrypted(v string) *AppCreate {
+	_c.mutation.SetAPISecretEncrypted(v)
+	return _c
+}
+
+// SetNillableAPISecretEncrypted sets the "api_secret_encrypted" field if the given value is not nil.
+func (_c *AppCreate) SetNillableAPISecretEncrypted(v *string) *AppCreate {
+	if v != nil {
+		_c.SetAPISecretEncrypted(*v)
+	}
+	return _c
+}
+
+// SetPreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field.
+func (_c *AppCreate) SetPreviousAPISecretEncrypted(v string) *AppCreate {
+	_c.mutation.SetPreviousAPISecretEncrypted(v)
+	return _c
+}
+
+// SetNillablePreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field if the given value is not nil.
+func (_c *AppCreate) SetNillablePreviousAPISecretEncrypted(v *string) *AppCreate {
+	if v != nil {
+		_c.SetPreviousAPISecretEncrypted(*v)
+	}
+	return _c
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (_c *AppCreate) SetPreviousAPISecretExpiresAt(v time.Time) *AppCreate {
+	_c.mutation.SetPreviousAPISecretExpiresAt(v)
+	return _c
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (_c *AppCreate) SetNillablePreviousAPISecretExpiresAt(v *time.Time) *AppCreate {
+	if v != nil {
+		_c.SetPreviousAPISecretExpiresAt(*v)
+	}
+	return _c
+}
+
 // SetAllowedOrigins sets the "allowed_origins" field.
 func (_c *AppCreate) SetAllowedOrigins(v []string) *AppCreate {
 	_c.mutation.SetAllowedOrigins(v)
 	return _c
 }
 
+// SetOauthRedirectUris sets the "oauth_redirect_uris" field.
+func (_c *AppCreate) SetOauthRedirectUris(v []string) *AppCreate {
+	_c.mutation.SetOauthRedirectUris(v)
+	return _c
+}
+
 // SetWebhookURL sets the "webhook_url" field.
 func (_c *AppCreate) SetWebhookURL(v string) *AppCreate {
 	_c.mutation.SetWebhookURL(v)
@@ -100,6 +247,48 @@ func (_c *AppCreate) SetNillableStripeProductID(v *string) *AppCreate {
 	return _c
 }
 
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_c *AppCreate) SetRateLimitPerMinute(v int) *AppCreate {
+	_c.mutation.SetRateLimitPerMinute(v)
+	return _c
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_c *AppCreate) SetNillableRateLimitPerMinute(v *int) *AppCreate {
+	if v != nil {
+		_c.SetRateLimitPerMinute(*v)
+	}
+	return _c
+}
+
+// SetRateLimitBurst sets the "rate_limit_burst" field.
+func (_c *AppCreate) SetRateLimitBurst(v int) *AppCreate {
+	_c.mutation.SetRateLimitBurst(v)
+	return _c
+}
+
+// SetNillableRateLimitBurst sets the "rate_limit_burst" field if the given value is not nil.
+func (_c *AppCreate) SetNillableRateLimitBurst(v *int) *AppCreate {
+	if v != nil {
+		_c.SetRateLimitBurst(*v)
+	}
+	return _c
+}
+
+// SetMagicLinkSignup sets the "magic_link_signup" field.
+func (_c *AppCreate) SetMagicLinkSignup(v bool) *AppCreate {
+	_c.mutation.SetMagicLinkSignup(v)
+	return _c
+}
+
+// SetNillableMagicLinkSignup sets the "magic_link_signup" field if the given value is not nil.
+func (_c *AppCreate) SetNillableMagicLinkSignup(v *bool) *AppCreate {
+	if v != nil {
+		_c.SetMagicLinkSignup(*v)
+	}
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *AppCreate) SetIsActive(v bool) *AppCreate {
 	_c.mutation.SetIsActive(v)
@@ -352,6 +541,111 @@ func (_c *AppCreate) AddShenbiSettings(v ...*ShenbiSettings) *AppCreate {
 	return _c.AddShenbiSettingIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_c *AppCreate) AddAuthSessionIDs(ids ...int) *AppCreate {
+	_c.mutation.AddAuthSessionIDs(ids...)
+	return _c
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_c *AppCreate) AddAuthSessions(v ...*AuthSession) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_c *AppCreate) AddVerificationTokenIDs(ids ...int) *AppCreate {
+	_c.mutation.AddVerificationTokenIDs(ids...)
+	return _c
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_c *AppCreate) AddVerificationTokens(v ...*VerificationToken) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_c *AppCreate) AddOauthConsentIDs(ids ...int) *AppCreate {
+	_c.mutation.AddOauthConsentIDs(ids...)
+	return _c
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_c *AppCreate) AddOauthConsents(v ...*OAuthConsent) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_c *AppCreate) AddOauthAuthorizationCodeIDs(ids ...int) *AppCreate {
+	_c.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _c
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_c *AppCreate) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddAuditEventIDs adds the "audit_events" edge to the AuditEvent entity by IDs.
+func (_c *AppCreate) AddAuditEventIDs(ids ...int) *AppCreate {
+	_c.mutation.AddAuditEventIDs(ids...)
+	return _c
+}
+
+// AddAuditEvents adds the "audit_events" edges to the AuditEvent entity.
+func (_c *AppCreate) AddAuditEvents(v ...*AuditEvent) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuditEventIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_c *AppCreate) AddWebhookDeliveryIDs(ids ...int) *AppCreate {
+	_c.mutation.AddWebhookDeliveryIDs(ids...)
+	return _c
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_c *AppCreate) AddWebhookDeliveries(v ...*WebhookDelivery) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddAdminIDs adds the "admins" edge to the AdminAccount entity by IDs.
+func (_c *AppCreate) AddAdminIDs(ids ...int) *AppCreate {
+	_c.mutation.AddAdminIDs(ids...)
+	return _c
+}
+
+// AddAdmins adds the "admins" edges to the AdminAccount entity.
+func (_c *AppCreate) AddAdmins(v ...*AdminAccount) *AppCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAdminIDs(ids...)
+}
+
 // Mutation returns the AppMutation object of the builder.
 func (_c *AppCreate) Mutation() *AppMutation {
 	return _c.mutation
@@ -387,6 +681,10 @@ func (_c *AppCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *AppCreate) defaults() {
+	if _, ok := _c.mutation.MagicLinkSignup(); !ok {
+		v := app.DefaultMagicLinkSignup
+		_c.mutation.SetMagicLinkSignup(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := app.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -419,13 +717,8 @@ func (_c *AppCreate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "App.slug": %w`, err)}
 		}
 	}
-	if _, ok := _c.mutation.APIKey(); !ok {
-		return &ValidationError{Name: "api_key", err: errors.New(`ent: missing required field "App.api_key"`)}
-	}
-	if v, ok := _c.mutation.APIKey(); ok {
-		if err := app.APIKeyValidator(v); err != nil {
-			return &ValidationError{Name: "api_key", err: fmt.Errorf(`ent: validator failed for field "App.api_key": %w`, err)}
-		}
+	if _, ok := _c.mutation.MagicLinkSignup(); !ok {
+		return &ValidationError{Name: "magic_link_signup", err: errors.New(`ent: missing required field "App.magic_link_signup"`)}
 	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "App.is_active"`)}
@@ -472,16 +765,56 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 	}
 	if value, ok := _c.mutation.APIKey(); ok {
 		_spec.SetField(app.FieldAPIKey, field.TypeString, value)
-		_node.APIKey = value
+		_node.APIKey = &value
 	}
 	if value, ok := _c.mutation.APISecret(); ok {
 		_spec.SetField(app.FieldAPISecret, field.TypeString, value)
 		_node.APISecret = value
 	}
+	if value, ok := _c.mutation.APIKeyHash(); ok {
+		_spec.SetField(app.FieldAPIKeyHash, field.TypeString, value)
+		_node.APIKeyHash = &value
+	}
+	if value, ok := _c.mutation.APIKeyPrefix(); ok {
+		_spec.SetField(app.FieldAPIKeyPrefix, field.TypeString, value)
+		_node.APIKeyPrefix = value
+	}
+	if value, ok := _c.mutation.PreviousAPIKeyHash(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyHash, field.TypeString, value)
+		_node.PreviousAPIKeyHash = &value
+	}
+	if value, ok := _c.mutation.PreviousAPIKeyExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyExpiresAt, field.TypeTime, value)
+		_node.PreviousAPIKeyExpiresAt = &value
+	}
+	if value, ok := _c.mutation.APISecretHash(); ok {
+		_spec.SetField(app.FieldAPISecretHash, field.TypeString, value)
+		_node.APISecretHash = value
+	}
+	if value, ok := _c.mutation.PreviousAPISecretHash(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretHash, field.TypeString, value)
+		_node.PreviousAPISecretHash = value
+	}
+	if value, ok := _c.mutation.APISecretEncrypted(); ok {
+		_spec.SetField(app.FieldAPISecretEncrypted, field.TypeString, value)
+		_node.APISecretEncrypted = value
+	}
+	if value, ok := _c.mutation.PreviousAPISecretEncrypted(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretEncrypted, field.TypeString, value)
+		_node.PreviousAPISecretEncrypted = value
+	}
+	if value, ok := _c.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretExpiresAt, field.TypeTime, value)
+		_node.PreviousAPISecretExpiresAt = &value
+	}
 	if value, ok := _c.mutation.AllowedOrigins(); ok {
 		_spec.SetField(app.FieldAllowedOrigins, field.TypeJSON, value)
 		_node.AllowedOrigins = value
 	}
+	if value, ok := _c.mutation.OauthRedirectUris(); ok {
+		_spec.SetField(app.FieldOauthRedirectUris, field.TypeJSON, value)
+		_node.OauthRedirectUris = value
+	}
 	if value, ok := _c.mutation.WebhookURL(); ok {
 		_spec.SetField(app.FieldWebhookURL, field.TypeString, value)
 		_node.WebhookURL = value
@@ -490,6 +823,18 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 		_spec.SetField(app.FieldStripeProductID, field.TypeString, value)
 		_node.StripeProductID = value
 	}
+	if value, ok := _c.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(app.FieldRateLimitPerMinute, field.TypeInt, value)
+		_node.RateLimitPerMinute = &value
+	}
+	if value, ok := _c.mutation.RateLimitBurst(); ok {
+		_spec.SetField(app.FieldRateLimitBurst, field.TypeInt, value)
+		_node.RateLimitBurst = &value
+	}
+	if value, ok := _c.mutation.MagicLinkSignup(); ok {
+		_spec.SetField(app.FieldMagicLinkSignup, field.TypeBool, value)
+		_node.MagicLinkSignup = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(app.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
@@ -726,6 +1071,118 @@ func (_c *AppCreate) createSpec() (*App, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AuditEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,17 +9,23 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/plan"
 	"gigaboo.io/lem/internal/ent/predicate"
@@ -28,29 +34,39 @@ import (
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
 )
 
 // AppQuery is the builder for querying App entities.
 type AppQuery struct {
 	config
-	ctx                   *QueryContext
-	order                 []app.OrderOption
-	inters                []Interceptor
-	predicates            []predicate.App
-	withUserApps          *UserAppQuery
-	withOrganizations     *OrganizationQuery
-	withPlans             *PlanQuery
-	withSubscriptions     *SubscriptionQuery
-	withEmailTemplates    *EmailTemplateQuery
-	withShenbiProfiles    *ShenbiProfileQuery
-	withClassrooms        *ClassroomQuery
-	withUserProgress      *UserProgressQuery
-	withAchievements      *AchievementQuery
-	withBattleRooms       *BattleRoomQuery
-	withBattleSessions    *BattleSessionQuery
-	withLiveSessions      *LiveSessionQuery
-	withClassroomSessions *ClassroomSessionQuery
-	withShenbiSettings    *ShenbiSettingsQuery
+	ctx                         *QueryContext
+	order                       []app.OrderOption
+	inters                      []Interceptor
+	predicates                  []predicate.App
+	withUserApps                *UserAppQuery
+	withOrganizations           *OrganizationQuery
+	withPlans                   *PlanQuery
+	withSubscriptions           *SubscriptionQuery
+	withEmailTemplates          *EmailTemplateQuery
+	withShenbiProfiles          *ShenbiProfileQuery
+	withClassrooms              *ClassroomQuery
+	withUserProgress            *UserProgressQuery
+	withAchievements            *AchievementQuery
+	withBattleRooms             *BattleRoomQuery
+	withBattleSessions          *BattleSessionQuery
+	withLiveSessions            *LiveSessionQuery
+	withClassroomSessions       *ClassroomSessionQuery
+	withShenbiSettings          *ShenbiSettingsQuery
+	withAuthSessions            *AuthSessionQuery
+	withVerificationTokens      *VerificationTokenQuery
+	withOauthConsents           *OAuthConsentQuery
+	withOauthAuthorizationCodes *OAuthAuthorizationCodeQuery
+	withAuditEvents             *AuditEventQuery
+	withWebhookDeliveries       *WebhookDeliveryQuery
+	withAdmins                  *AdminAccountQuery
+	modifiers                   []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -395,6 +411,160 @@ func (_q *AppQuery) QueryShenbiSettings() *ShenbiSettingsQuery {
 	return query
 }
 
+// QueryAuthSessions chains the current query on the "auth_sessions" edge.
+func (_q *AppQuery) QueryAuthSessions() *AuthSessionQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.AuthSessionsTable, app.AuthSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVerificationTokens chains the current query on the "verification_tokens" edge.
+func (_q *AppQuery) QueryVerificationTokens() *VerificationTokenQuery {
+	query := (&VerificationTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(verificationtoken.Table, verificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.VerificationTokensTable, app.VerificationTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOauthConsents chains the current query on the "oauth_consents" edge.
+func (_q *AppQuery) QueryOauthConsents() *OAuthConsentQuery {
+	query := (&OAuthConsentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(oauthconsent.Table, oauthconsent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.OauthConsentsTable, app.OauthConsentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOauthAuthorizationCodes chains the current query on the "oauth_authorization_codes" edge.
+func (_q *AppQuery) QueryOauthAuthorizationCodes() *OAuthAuthorizationCodeQuery {
+	query := (&OAuthAuthorizationCodeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.OauthAuthorizationCodesTable, app.OauthAuthorizationCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAuditEvents chains the current query on the "audit_events" edge.
+func (_q *AppQuery) QueryAuditEvents() *AuditEventQuery {
+	query := (&AuditEventClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(auditevent.Table, auditevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.AuditEventsTable, app.AuditEventsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWebhookDeliveries chains the current query on the "webhook_deliveries" edge.
+func (_q *AppQuery) QueryWebhookDeliveries() *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.WebhookDeliveriesTable, app.WebhookDeliveriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryAdmins chains the current query on the "admins" edge.
+func (_q *AppQuery) QueryAdmins() *AdminAccountQuery {
+	query := (&AdminAccountClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, selector),
+			sqlgraph.To(adminaccount.Table, adminaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, app.AdminsTable, app.AdminsPrimaryKey...),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first App entity from the query.
 // Returns a *NotFoundError when no App was found.
 func (_q *AppQuery) First(ctx context.Context) (*App, error) {
@@ -582,25 +752,32 @@ func (_q *AppQuery) Clone() *AppQuery {
 		return nil
 	}
 	return &AppQuery{
-		config:                _q.config,
-		ctx:                   _q.ctx.Clone(),
-		order:                 append([]app.OrderOption{}, _q.order...),
-		inters:                append([]Interceptor{}, _q.inters...),
-		predicates:            append([]predicate.App{}, _q.predicates...),
-		withUserApps:          _q.withUserApps.Clone(),
-		withOrganizations:     _q.withOrganizations.Clone(),
-		withPlans:             _q.withPlans.Clone(),
-		withSubscriptions:     _q.withSubscriptions.Clone(),
-		withEmailTemplates:    _q.withEmailTemplates.Clone(),
-		withShenbiProfiles:    _q.withShenbiProfiles.Clone(),
-		withClassrooms:        _q.withClassrooms.Clone(),
-		withUserProgress:      _q.withUserProgress.Clone(),
-		withAchievements:      _q.withAchievements.Clone(),
-		withBattleRooms:       _q.withBattleRooms.Clone(),
-		withBattleSessions:    _q.withBattleSessions.Clone(),
-		withLiveSessions:      _q.withLiveSessions.Clone(),
-		withClassroomSessions: _q.withClassroomSessions.Clone(),
-		withShenbiSettings:    _q.withShenbiSettings.Clone(),
+		config:                      _q.config,
+		ctx:                         _q.ctx.Clone(),
+		order:                       append([]app.OrderOption{}, _q.order...),
+		inters:                      append([]Interceptor{}, _q.inters...),
+		predicates:                  append([]predicate.App{}, _q.predicates...),
+		withUserApps:                _q.withUserApps.Clone(),
+		withOrganizations:           _q.withOrganizations.Clone(),
+		withPlans:                   _q.withPlans.Clone(),
+		withSubscriptions:           _q.withSubscriptions.Clone(),
+		withEmailTemplates:          _q.withEmailTemplates.Clone(),
+		withShenbiProfiles:          _q.withShenbiProfiles.Clone(),
+		withClassrooms:              _q.withClassrooms.Clone(),
+		withUserProgress:            _q.withUserProgress.Clone(),
+		withAchievements:            _q.withAchievements.Clone(),
+		withBattleRooms:             _q.withBattleRooms.Clone(),
+		withBattleSessions:          _q.withBattleSessions.Clone(),
+		withLiveSessions:            _q.withLiveSessions.Clone(),
+		withClassroomSessions:       _q.withClassroomSessions.Clone(),
+		withShenbiSettings:          _q.withShenbiSettings.Clone(),
+		withAuthSessions:            _q.withAuthSessions.Clone(),
+		withVerificationTokens:      _q.withVerificationTokens.Clone(),
+		withOauthConsents:           _q.withOauthConsents.Clone(),
+		withOauthAuthorizationCodes: _q.withOauthAuthorizationCodes.Clone(),
+		withAuditEvents:             _q.withAuditEvents.Clone(),
+		withWebhookDeliveries:       _q.withWebhookDeliveries.Clone(),
+		withAdmins:                  _q.withAdmins.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -761,6 +938,83 @@ func (_q *AppQuery) WithShenbiSettings(opts ...func(*ShenbiSettingsQuery)) *AppQ
 	return _q
 }
 
+// WithAuthSessions tells the query-builder to eager-load the nodes that are connected to
+// the "auth_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithAuthSessions(opts ...func(*AuthSessionQuery)) *AppQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthSessions = query
+	return _q
+}
+
+// WithVerificationTokens tells the query-builder to eager-load the nodes that are connected to
+// the "verification_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithVerificationTokens(opts ...func(*VerificationTokenQuery)) *AppQuery {
+	query := (&VerificationTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVerificationTokens = query
+	return _q
+}
+
+// WithOauthConsents tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_consents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithOauthConsents(opts ...func(*OAuthConsentQuery)) *AppQuery {
+	query := (&OAuthConsentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthConsents = query
+	return _q
+}
+
+// WithOauthAuthorizationCodes tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_authorization_codes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithOauthAuthorizationCodes(opts ...func(*OAuthAuthorizationCodeQuery)) *AppQuery {
+	query := (&OAuthAuthorizationCodeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthAuthorizationCodes = query
+	return _q
+}
+
+// WithAuditEvents tells the query-builder to eager-load the nodes that are connected to
+// the "audit_events" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithAuditEvents(opts ...func(*AuditEventQuery)) *AppQuery {
+	query := (&AuditEventClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuditEvents = query
+	return _q
+}
+
+// WithWebhookDeliveries tells the query-builder to eager-load the nodes that are connected to
+// the "webhook_deliveries" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithWebhookDeliveries(opts ...func(*WebhookDeliveryQuery)) *AppQuery {
+	query := (&WebhookDeliveryClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWebhookDeliveries = query
+	return _q
+}
+
+// WithAdmins tells the query-builder to eager-load the nodes that are connected to
+// the "admins" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *AppQuery) WithAdmins(opts ...func(*AdminAccountQuery)) *AppQuery {
+	query := (&AdminAccountClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAdmins = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -839,7 +1093,7 @@ func (_q *AppQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*App, err
 	var (
 		nodes       = []*App{}
 		_spec       = _q.querySpec()
-		loadedTypes = [14]bool{
+		loadedTypes = [21]bool{
 			_q.withUserApps != nil,
 			_q.withOrganizations != nil,
 			_q.withPlans != nil,
@@ -854,6 +1108,13 @@ func (_q *AppQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*App, err
 			_q.withLiveSessions != nil,
 			_q.withClassroomSessions != nil,
 			_q.withShenbiSettings != nil,
+			_q.withAuthSessions != nil,
+			_q.withVerificationTokens != nil,
+			_q.withOauthConsents != nil,
+			_q.withOauthAuthorizationCodes != nil,
+			_q.withAuditEvents != nil,
+			_q.withWebhookDeliveries != nil,
+			_q.withAdmins != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -865,6 +1126,9 @@ func (_q *AppQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*App, err
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -972,6 +1236,57 @@ func (_q *AppQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*App, err
 			return nil, err
 		}
 	}
+	if query := _q.withAuthSessions; query != nil {
+		if err := _q.loadAuthSessions(ctx, query, nodes,
+			func(n *App) { n.Edges.AuthSessions = []*AuthSession{} },
+			func(n *App, e *AuthSession) { n.Edges.AuthSessions = append(n.Edges.AuthSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVerificationTokens; query != nil {
+		if err := _q.loadVerificationTokens(ctx, query, nodes,
+			func(n *App) { n.Edges.VerificationTokens = []*VerificationToken{} },
+			func(n *App, e *VerificationToken) { n.Edges.VerificationTokens = append(n.Edges.VerificationTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOauthConsents; query != nil {
+		if err := _q.loadOauthConsents(ctx, query, nodes,
+			func(n *App) { n.Edges.OauthConsents = []*OAuthConsent{} },
+			func(n *App, e *OAuthConsent) { n.Edges.OauthConsents = append(n.Edges.OauthConsents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOauthAuthorizationCodes; query != nil {
+		if err := _q.loadOauthAuthorizationCodes(ctx, query, nodes,
+			func(n *App) { n.Edges.OauthAuthorizationCodes = []*OAuthAuthorizationCode{} },
+			func(n *App, e *OAuthAuthorizationCode) {
+				n.Edges.OauthAuthorizationCodes = append(n.Edges.OauthAuthorizationCodes, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAuditEvents; query != nil {
+		if err := _q.loadAuditEvents(ctx, query, nodes,
+			func(n *App) { n.Edges.AuditEvents = []*AuditEvent{} },
+			func(n *App, e *AuditEvent) { n.Edges.AuditEvents = append(n.Edges.AuditEvents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWebhookDeliveries; query != nil {
+		if err := _q.loadWebhookDeliveries(ctx, query, nodes,
+			func(n *App) { n.Edges.WebhookDeliveries = []*WebhookDelivery{} },
+			func(n *App, e *WebhookDelivery) { n.Edges.WebhookDeliveries = append(n.Edges.WebhookDeliveries, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withAdmins; query != nil {
+		if err := _q.loadAdmins(ctx, query, nodes,
+			func(n *App) { n.Edges.Admins = []*AdminAccount{} },
+			func(n *App, e *AdminAccount) { n.Edges.Admins = append(n.Edges.Admins, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1409,9 +1724,259 @@ func (_q *AppQuery) loadShenbiSettings(ctx context.Context, query *ShenbiSetting
 	}
 	return nil
 }
+func (_q *AppQuery) loadAuthSessions(ctx context.Context, query *AuthSessionQuery, nodes []*App, init func(*App), assign func(*App, *AuthSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuthSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.AuthSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_auth_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_auth_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_auth_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadVerificationTokens(ctx context.Context, query *VerificationTokenQuery, nodes []*App, init func(*App), assign func(*App, *VerificationToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.VerificationToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.VerificationTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_verification_tokens
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_verification_tokens" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_verification_tokens" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadOauthConsents(ctx context.Context, query *OAuthConsentQuery, nodes []*App, init func(*App), assign func(*App, *OAuthConsent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OAuthConsent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.OauthConsentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_oauth_consents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_oauth_consents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_oauth_consents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadOauthAuthorizationCodes(ctx context.Context, query *OAuthAuthorizationCodeQuery, nodes []*App, init func(*App), assign func(*App, *OAuthAuthorizationCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.OauthAuthorizationCodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_oauth_authorization_codes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_oauth_authorization_codes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_oauth_authorization_codes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadAuditEvents(ctx context.Context, query *AuditEventQuery, nodes []*App, init func(*App), assign func(*App, *AuditEvent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuditEvent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.AuditEventsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_audit_events
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_audit_events" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_audit_events" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadWebhookDeliveries(ctx context.Context, query *WebhookDeliveryQuery, nodes []*App, init func(*App), assign func(*App, *WebhookDelivery)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*App)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.WebhookDelivery(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(app.WebhookDeliveriesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.app_webhook_deliveries
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "app_webhook_deliveries" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "app_webhook_deliveries" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *AppQuery) loadAdmins(ctx context.Context, query *AdminAccountQuery, nodes []*App, init func(*App), assign func(*App, *AdminAccount)) error {
+	edgeIDs := make([]driver.Value, len(nodes))
+	byID := make(map[int]*App)
+	nids := make(map[int]map[*App]struct{})
+	for i, node := range nodes {
+		edgeIDs[i] = node.ID
+		byID[node.ID] = node
+		if init != nil {
+			init(node)
+		}
+	}
+	query.Where(func(s *sql.Selector) {
+		joinT := sql.Table(app.AdminsTable)
+		s.Join(joinT).On(s.C(adminaccount.FieldID), joinT.C(app.AdminsPrimaryKey[0]))
+		s.Where(sql.InValues(joinT.C(app.AdminsPrimaryKey[1]), edgeIDs...))
+		columns := s.SelectedColumns()
+		s.Select(joinT.C(app.AdminsPrimaryKey[1]))
+		s.AppendSelect(columns...)
+		s.SetDistinct(false)
+	})
+	if err := query.prepareQuery(ctx); err != nil {
+		return err
+	}
+	qr := QuerierFunc(func(ctx context.Context, q Query) (Value, error) {
+		return query.sqlAll(ctx, func(_ context.Context, spec *sqlgraph.QuerySpec) {
+			assign := spec.Assign
+			values := spec.ScanValues
+			spec.ScanValues = func(columns []string) ([]any, error) {
+				values, err := values(columns[1:])
+				if err != nil {
+					return nil, err
+				}
+				return append([]any{new(sql.NullInt64)}, values...), nil
+			}
+			spec.Assign = func(columns []string, values []any) error {
+				outValue := int(values[0].(*sql.NullInt64).Int64)
+				inValue := int(values[1].(*sql.NullInt64).Int64)
+				if nids[inValue] == nil {
+					nids[inValue] = map[*App]struct{}{byID[outValue]: {}}
+					return assign(columns[1:], values[1:])
+				}
+				nids[inValue][byID[outValue]] = struct{}{}
+				return nil
+			}
+		})
+	})
+	neighbors, err := withInterceptors[[]*AdminAccount](ctx, query, qr, query.inters)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected "admins" node returned %v`, n.ID)
+		}
+		for kn := range nodes {
+			assign(kn, n)
+		}
+	}
+	return nil
+}
 
 func (_q *AppQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1474,6 +2039,9 @@ func (_q *AppQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1491,6 +2059,32 @@ func (_q *AppQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *AppQuery) ForUpdate(opts ...sql.LockOption) *AppQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *AppQuery) ForShare(opts ...sql.LockOption) *AppQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // AppGroupBy is the group-by builder for App entities.
 type AppGroupBy struct {
 	selector
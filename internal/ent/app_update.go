@@ -13,13 +13,18 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/plan"
 	"gigaboo.io/lem/internal/ent/predicate"
@@ -28,6 +33,8 @@ import (
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
 )
 
 // AppUpdate is the builder for updating App entities.
@@ -85,6 +92,12 @@ func (_u *AppUpdate) SetNillableAPIKey(v *string) *AppUpdate {
 	return _u
 }
 
+// ClearAPIKey clears the value of the "api_key" field.
+func (_u *AppUpdate) ClearAPIKey() *AppUpdate {
+	_u.mutation.ClearAPIKey()
+	return _u
+}
+
 // SetAPISecret sets the "api_secret" field.
 func (_u *AppUpdate) SetAPISecret(v string) *AppUpdate {
 	_u.mutation.SetAPISecret(v)
@@ -105,6 +118,186 @@ func (_u *AppUpdate) ClearAPISecret() *AppUpdate {
 	return _u
 }
 
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (_u *AppUpdate) SetAPIKeyHash(v string) *AppUpdate {
+	_u.mutation.SetAPIKeyHash(v)
+	return _u
+}
+
+// SetNillableAPIKeyHash sets the "api_key_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableAPIKeyHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyHash clears the value of the "api_key_hash" field.
+func (_u *AppUpdate) ClearAPIKeyHash() *AppUpdate {
+	_u.mutation.ClearAPIKeyHash()
+	return _u
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (_u *AppUpdate) SetAPIKeyPrefix(v string) *AppUpdate {
+	_u.mutation.SetAPIKeyPrefix(v)
+	return _u
+}
+
+// SetNillableAPIKeyPrefix sets the "api_key_prefix" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableAPIKeyPrefix(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetAPIKeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyPrefix clears the value of the "api_key_prefix" field.
+func (_u *AppUpdate) ClearAPIKeyPrefix() *AppUpdate {
+	_u.mutation.ClearAPIKeyPrefix()
+	return _u
+}
+
+// SetPreviousAPIKeyHash sets the "previous_api_key_hash" field.
+func (_u *AppUpdate) SetPreviousAPIKeyHash(v string) *AppUpdate {
+	_u.mutation.SetPreviousAPIKeyHash(v)
+	return _u
+}
+
+// SetNillablePreviousAPIKeyHash sets the "previous_api_key_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillablePreviousAPIKeyHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetPreviousAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPIKeyHash clears the value of the "previous_api_key_hash" field.
+func (_u *AppUpdate) ClearPreviousAPIKeyHash() *AppUpdate {
+	_u.mutation.ClearPreviousAPIKeyHash()
+	return _u
+}
+
+// SetPreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field.
+func (_u *AppUpdate) SetPreviousAPIKeyExpiresAt(v time.Time) *AppUpdate {
+	_u.mutation.SetPreviousAPIKeyExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field if the given value is not nil.
+func (_u *AppUpdate) SetNillablePreviousAPIKeyExpiresAt(v *time.Time) *AppUpdate {
+	if v != nil {
+		_u.SetPreviousAPIKeyExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPIKeyExpiresAt clears the value of the "previous_api_key_expires_at" field.
+func (_u *AppUpdate) ClearPreviousAPIKeyExpiresAt() *AppUpdate {
+	_u.mutation.ClearPreviousAPIKeyExpiresAt()
+	return _u
+}
+
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (_u *AppUpdate) SetAPISecretHash(v string) *AppUpdate {
+	_u.mutation.SetAPISecretHash(v)
+	return _u
+}
+
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableAPISecretHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetAPISecretHash(*v)
+	}
+	return _u
+}
+
+// ClearAPISecretHash clears the value of the "api_secret_hash" field.
+func (_u *AppUpdate) ClearAPISecretHash() *AppUpdate {
+	_u.mutation.ClearAPISecretHash()
+	return _u
+}
+
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (_u *AppUpdate) SetPreviousAPISecretHash(v string) *AppUpdate {
+	_u.mutation.SetPreviousAPISecretHash(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (_u *AppUpdate) SetNillablePreviousAPISecretHash(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetPreviousAPISecretHash(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPISecretHash clears the value of the "previous_api_secret_hash" field.
+func (_u *AppUpdate) ClearPreviousAPISecretHash() *AppUpdate {
+	_u.mutation.ClearPreviousAPISecretHash()
+	return _u
+}
+
+// SetAPISecretEncrypted sets the "api_secret_encrypted" field.
+func (_u *AppUpdate) SetAPISecretEncrypted(v string) *AppUpdate {
+	_u.mutation.SetAPISecretEncrypted(v)
+	return _u
+}
+
+// SetNillableAPISecretEncrypted sets the "api_secret_encrypted" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableAPISecretEncrypted(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetAPISecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearAPISecretEncrypted clears the value of the "api_secret_encrypted" field.
+func (_u *AppUpdate) ClearAPISecretEncrypted() *AppUpdate {
+	_u.mutation.ClearAPISecretEncrypted()
+	return _u
+}
+
+// SetPreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field.
+func (_u *AppUpdate) SetPreviousAPISecretEncrypted(v string) *AppUpdate {
+	_u.mutation.SetPreviousAPISecretEncrypted(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field if the given value is not nil.
+func (_u *AppUpdate) SetNillablePreviousAPISecretEncrypted(v *string) *AppUpdate {
+	if v != nil {
+		_u.SetPreviousAPISecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPISecretEncrypted clears the value of the "previous_api_secret_encrypted" field.
+func (_u *AppUpdate) ClearPreviousAPISecretEncrypted() *AppUpdate {
+	_u.mutation.ClearPreviousAPISecretEncrypted()
+	return _u
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (_u *AppUpdate) SetPreviousAPISecretExpiresAt(v time.Time) *AppUpdate {
+	_u.mutation.SetPreviousAPISecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (_u *AppUpdate) SetNillablePreviousAPISecretExpiresAt(v *time.Time) *AppUpdate {
+	if v != nil {
+		_u.SetPreviousAPISecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPISecretExpiresAt clears the value of the "previous_api_secret_expires_at" field.
+func (_u *AppUpdate) ClearPreviousAPISecretExpiresAt() *AppUpdate {
+	_u.mutation.ClearPreviousAPISecretExpiresAt()
+	return _u
+}
+
 // SetAllowedOrigins sets the "allowed_origins" field.
 func (_u *AppUpdate) SetAllowedOrigins(v []string) *AppUpdate {
 	_u.mutation.SetAllowedOrigins(v)
@@ -123,6 +316,24 @@ func (_u *AppUpdate) ClearAllowedOrigins() *AppUpdate {
 	return _u
 }
 
+// SetOauthRedirectUris sets the "oauth_redirect_uris" field.
+func (_u *AppUpdate) SetOauthRedirectUris(v []string) *AppUpdate {
+	_u.mutation.SetOauthRedirectUris(v)
+	return _u
+}
+
+// AppendOauthRedirectUris appends value to the "oauth_redirect_uris" field.
+func (_u *AppUpdate) AppendOauthRedirectUris(v []string) *AppUpdate {
+	_u.mutation.AppendOauthRedirectUris(v)
+	return _u
+}
+
+// ClearOauthRedirectUris clears the value of the "oauth_redirect_uris" field.
+func (_u *AppUpdate) ClearOauthRedirectUris() *AppUpdate {
+	_u.mutation.ClearOauthRedirectUris()
+	return _u
+}
+
 // SetWebhookURL sets the "webhook_url" field.
 func (_u *AppUpdate) SetWebhookURL(v string) *AppUpdate {
 	_u.mutation.SetWebhookURL(v)
@@ -163,6 +374,74 @@ func (_u *AppUpdate) ClearStripeProductID() *AppUpdate {
 	return _u
 }
 
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_u *AppUpdate) SetRateLimitPerMinute(v int) *AppUpdate {
+	_u.mutation.ResetRateLimitPerMinute()
+	_u.mutation.SetRateLimitPerMinute(v)
+	return _u
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableRateLimitPerMinute(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetRateLimitPerMinute(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerMinute adds value to the "rate_limit_per_minute" field.
+func (_u *AppUpdate) AddRateLimitPerMinute(v int) *AppUpdate {
+	_u.mutation.AddRateLimitPerMinute(v)
+	return _u
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (_u *AppUpdate) ClearRateLimitPerMinute() *AppUpdate {
+	_u.mutation.ClearRateLimitPerMinute()
+	return _u
+}
+
+// SetRateLimitBurst sets the "rate_limit_burst" field.
+func (_u *AppUpdate) SetRateLimitBurst(v int) *AppUpdate {
+	_u.mutation.ResetRateLimitBurst()
+	_u.mutation.SetRateLimitBurst(v)
+	return _u
+}
+
+// SetNillableRateLimitBurst sets the "rate_limit_burst" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableRateLimitBurst(v *int) *AppUpdate {
+	if v != nil {
+		_u.SetRateLimitBurst(*v)
+	}
+	return _u
+}
+
+// AddRateLimitBurst adds value to the "rate_limit_burst" field.
+func (_u *AppUpdate) AddRateLimitBurst(v int) *AppUpdate {
+	_u.mutation.AddRateLimitBurst(v)
+	return _u
+}
+
+// ClearRateLimitBurst clears the value of the "rate_limit_burst" field.
+func (_u *AppUpdate) ClearRateLimitBurst() *AppUpdate {
+	_u.mutation.ClearRateLimitBurst()
+	return _u
+}
+
+// SetMagicLinkSignup sets the "magic_link_signup" field.
+func (_u *AppUpdate) SetMagicLinkSignup(v bool) *AppUpdate {
+	_u.mutation.SetMagicLinkSignup(v)
+	return _u
+}
+
+// SetNillableMagicLinkSignup sets the "magic_link_signup" field if the given value is not nil.
+func (_u *AppUpdate) SetNillableMagicLinkSignup(v *bool) *AppUpdate {
+	if v != nil {
+		_u.SetMagicLinkSignup(*v)
+	}
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *AppUpdate) SetIsActive(v bool) *AppUpdate {
 	_u.mutation.SetIsActive(v)
@@ -393,6 +672,111 @@ func (_u *AppUpdate) AddShenbiSettings(v ...*ShenbiSettings) *AppUpdate {
 	return _u.AddShenbiSettingIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *AppUpdate) AddAuthSessionIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *AppUpdate) AddAuthSessions(v ...*AuthSession) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_u *AppUpdate) AddVerificationTokenIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddVerificationTokenIDs(ids...)
+	return _u
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_u *AppUpdate) AddVerificationTokens(v ...*VerificationToken) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_u *AppUpdate) AddOauthConsentIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddOauthConsentIDs(ids...)
+	return _u
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_u *AppUpdate) AddOauthConsents(v ...*OAuthConsent) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_u *AppUpdate) AddOauthAuthorizationCodeIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *AppUpdate) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddAuditEventIDs adds the "audit_events" edge to the AuditEvent entity by IDs.
+func (_u *AppUpdate) AddAuditEventIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddAuditEventIDs(ids...)
+	return _u
+}
+
+// AddAuditEvents adds the "audit_events" edges to the AuditEvent entity.
+func (_u *AppUpdate) AddAuditEvents(v ...*AuditEvent) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditEventIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *AppUpdate) AddWebhookDeliveryIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *AppUpdate) AddWebhookDeliveries(v ...*WebhookDelivery) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddAdminIDs adds the "admins" edge to the AdminAccount entity by IDs.
+func (_u *AppUpdate) AddAdminIDs(ids ...int) *AppUpdate {
+	_u.mutation.AddAdminIDs(ids...)
+	return _u
+}
+
+// AddAdmins adds the "admins" edges to the AdminAccount entity.
+func (_u *AppUpdate) AddAdmins(v ...*AdminAccount) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdminIDs(ids...)
+}
+
 // Mutation returns the AppMutation object of the builder.
 func (_u *AppUpdate) Mutation() *AppMutation {
 	return _u.mutation
@@ -692,39 +1076,186 @@ func (_u *AppUpdate) RemoveShenbiSettings(v ...*ShenbiSettings) *AppUpdate {
 	return _u.RemoveShenbiSettingIDs(ids...)
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (_u *AppUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
-	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *AppUpdate) ClearAuthSessions() *AppUpdate {
+	_u.mutation.ClearAuthSessions()
+	return _u
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (_u *AppUpdate) SaveX(ctx context.Context) int {
-	affected, err := _u.Save(ctx)
-	if err != nil {
-		panic(err)
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *AppUpdate) RemoveAuthSessionIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
+	return _u
+}
+
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *AppUpdate) RemoveAuthSessions(v ...*AuthSession) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return affected
+	return _u.RemoveAuthSessionIDs(ids...)
 }
 
-// Exec executes the query.
-func (_u *AppUpdate) Exec(ctx context.Context) error {
-	_, err := _u.Save(ctx)
-	return err
+// ClearVerificationTokens clears all "verification_tokens" edges to the VerificationToken entity.
+func (_u *AppUpdate) ClearVerificationTokens() *AppUpdate {
+	_u.mutation.ClearVerificationTokens()
+	return _u
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (_u *AppUpdate) ExecX(ctx context.Context) {
-	if err := _u.Exec(ctx); err != nil {
-		panic(err)
+// RemoveVerificationTokenIDs removes the "verification_tokens" edge to VerificationToken entities by IDs.
+func (_u *AppUpdate) RemoveVerificationTokenIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveVerificationTokenIDs(ids...)
+	return _u
+}
+
+// RemoveVerificationTokens removes "verification_tokens" edges to VerificationToken entities.
+func (_u *AppUpdate) RemoveVerificationTokens(v ...*VerificationToken) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
+	return _u.RemoveVerificationTokenIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (_u *AppUpdate) defaults() {
-	if _, ok := _u.mutation.UpdatedAt(); !ok {
-		v := app.UpdateDefaultUpdatedAt()
-		_u.mutation.SetUpdatedAt(v)
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (_u *AppUpdate) ClearOauthConsents() *AppUpdate {
+	_u.mutation.ClearOauthConsents()
+	return _u
+}
+
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (_u *AppUpdate) RemoveOauthConsentIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveOauthConsentIDs(ids...)
+	return _u
+}
+
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (_u *AppUpdate) RemoveOauthConsents(v ...*OAuthConsent) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthConsentIDs(ids...)
+}
+
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *AppUpdate) ClearOauthAuthorizationCodes() *AppUpdate {
+	_u.mutation.ClearOauthAuthorizationCodes()
+	return _u
+}
+
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (_u *AppUpdate) RemoveOauthAuthorizationCodeIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (_u *AppUpdate) RemoveOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthAuthorizationCodeIDs(ids...)
+}
+
+// ClearAuditEvents clears all "audit_events" edges to the AuditEvent entity.
+func (_u *AppUpdate) ClearAuditEvents() *AppUpdate {
+	_u.mutation.ClearAuditEvents()
+	return _u
+}
+
+// RemoveAuditEventIDs removes the "audit_events" edge to AuditEvent entities by IDs.
+func (_u *AppUpdate) RemoveAuditEventIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveAuditEventIDs(ids...)
+	return _u
+}
+
+// RemoveAuditEvents removes "audit_events" edges to AuditEvent entities.
+func (_u *AppUpdate) RemoveAuditEvents(v ...*AuditEvent) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditEventIDs(ids...)
+}
+
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *AppUpdate) ClearWebhookDeliveries() *AppUpdate {
+	_u.mutation.ClearWebhookDeliveries()
+	return _u
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *AppUpdate) RemoveWebhookDeliveryIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (_u *AppUpdate) RemoveWebhookDeliveries(v ...*WebhookDelivery) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookDeliveryIDs(ids...)
+}
+
+// ClearAdmins clears all "admins" edges to the AdminAccount entity.
+func (_u *AppUpdate) ClearAdmins() *AppUpdate {
+	_u.mutation.ClearAdmins()
+	return _u
+}
+
+// RemoveAdminIDs removes the "admins" edge to AdminAccount entities by IDs.
+func (_u *AppUpdate) RemoveAdminIDs(ids ...int) *AppUpdate {
+	_u.mutation.RemoveAdminIDs(ids...)
+	return _u
+}
+
+// RemoveAdmins removes "admins" edges to AdminAccount entities.
+func (_u *AppUpdate) RemoveAdmins(v ...*AdminAccount) *AppUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdminIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *AppUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *AppUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *AppUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *AppUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *AppUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := app.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
 	}
 }
 
@@ -740,11 +1271,6 @@ func (_u *AppUpdate) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "App.slug": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.APIKey(); ok {
-		if err := app.APIKeyValidator(v); err != nil {
-			return &ValidationError{Name: "api_key", err: fmt.Errorf(`ent: validator failed for field "App.api_key": %w`, err)}
-		}
-	}
 	return nil
 }
 
@@ -769,12 +1295,69 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.APIKey(); ok {
 		_spec.SetField(app.FieldAPIKey, field.TypeString, value)
 	}
+	if _u.mutation.APIKeyCleared() {
+		_spec.ClearField(app.FieldAPIKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.APISecret(); ok {
 		_spec.SetField(app.FieldAPISecret, field.TypeString, value)
 	}
 	if _u.mutation.APISecretCleared() {
 		_spec.ClearField(app.FieldAPISecret, field.TypeString)
 	}
+	if value, ok := _u.mutation.APIKeyHash(); ok {
+		_spec.SetField(app.FieldAPIKeyHash, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyHashCleared() {
+		_spec.ClearField(app.FieldAPIKeyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.APIKeyPrefix(); ok {
+		_spec.SetField(app.FieldAPIKeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyPrefixCleared() {
+		_spec.ClearField(app.FieldAPIKeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPIKeyHash(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPIKeyHashCleared() {
+		_spec.ClearField(app.FieldPreviousAPIKeyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPIKeyExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousAPIKeyExpiresAtCleared() {
+		_spec.ClearField(app.FieldPreviousAPIKeyExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APISecretHash(); ok {
+		_spec.SetField(app.FieldAPISecretHash, field.TypeString, value)
+	}
+	if _u.mutation.APISecretHashCleared() {
+		_spec.ClearField(app.FieldAPISecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretHash(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPISecretHashCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.APISecretEncrypted(); ok {
+		_spec.SetField(app.FieldAPISecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.APISecretEncryptedCleared() {
+		_spec.ClearField(app.FieldAPISecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretEncrypted(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPISecretEncryptedCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousAPISecretExpiresAtCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.AllowedOrigins(); ok {
 		_spec.SetField(app.FieldAllowedOrigins, field.TypeJSON, value)
 	}
@@ -786,6 +1369,17 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.AllowedOriginsCleared() {
 		_spec.ClearField(app.FieldAllowedOrigins, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.OauthRedirectUris(); ok {
+		_spec.SetField(app.FieldOauthRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOauthRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldOauthRedirectUris, value)
+		})
+	}
+	if _u.mutation.OauthRedirectUrisCleared() {
+		_spec.ClearField(app.FieldOauthRedirectUris, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.WebhookURL(); ok {
 		_spec.SetField(app.FieldWebhookURL, field.TypeString, value)
 	}
@@ -798,6 +1392,27 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.StripeProductIDCleared() {
 		_spec.ClearField(app.FieldStripeProductID, field.TypeString)
 	}
+	if value, ok := _u.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(app.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(app.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(app.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RateLimitBurst(); ok {
+		_spec.SetField(app.FieldRateLimitBurst, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitBurst(); ok {
+		_spec.AddField(app.FieldRateLimitBurst, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitBurstCleared() {
+		_spec.ClearField(app.FieldRateLimitBurst, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MagicLinkSignup(); ok {
+		_spec.SetField(app.FieldMagicLinkSignup, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(app.FieldIsActive, field.TypeBool, value)
 	}
@@ -1434,6 +2049,321 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationTokensIDs(); len(nodes) > 0 && !_u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !_u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuditEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuditEventsIDs(); len(nodes) > 0 && !_u.mutation.AuditEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuditEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdminsIDs(); len(nodes) > 0 && !_u.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{app.Label}
@@ -1442,77 +2372,263 @@ func (_u *AppUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		return 0, err
 	}
-	_u.mutation.done = true
-	return _node, nil
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// AppUpdateOne is the builder for updating a single App entity.
+type AppUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AppMutation
+}
+
+// SetName sets the "name" field.
+func (_u *AppUpdateOne) SetName(v string) *AppUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableName(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSlug sets the "slug" field.
+func (_u *AppUpdateOne) SetSlug(v string) *AppUpdateOne {
+	_u.mutation.SetSlug(v)
+	return _u
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableSlug(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetSlug(*v)
+	}
+	return _u
+}
+
+// SetAPIKey sets the "api_key" field.
+func (_u *AppUpdateOne) SetAPIKey(v string) *AppUpdateOne {
+	_u.mutation.SetAPIKey(v)
+	return _u
+}
+
+// SetNillableAPIKey sets the "api_key" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPIKey(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetAPIKey(*v)
+	}
+	return _u
+}
+
+// ClearAPIKey clears the value of the "api_key" field.
+func (_u *AppUpdateOne) ClearAPIKey() *AppUpdateOne {
+	_u.mutation.ClearAPIKey()
+	return _u
+}
+
+// SetAPISecret sets the "api_secret" field.
+func (_u *AppUpdateOne) SetAPISecret(v string) *AppUpdateOne {
+	_u.mutation.SetAPISecret(v)
+	return _u
+}
+
+// SetNillableAPISecret sets the "api_secret" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPISecret(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetAPISecret(*v)
+	}
+	return _u
+}
+
+// ClearAPISecret clears the value of the "api_secret" field.
+func (_u *AppUpdateOne) ClearAPISecret() *AppUpdateOne {
+	_u.mutation.ClearAPISecret()
+	return _u
+}
+
+// SetAPIKeyHash sets the "api_key_hash" field.
+func (_u *AppUpdateOne) SetAPIKeyHash(v string) *AppUpdateOne {
+	_u.mutation.SetAPIKeyHash(v)
+	return _u
+}
+
+// SetNillableAPIKeyHash sets the "api_key_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPIKeyHash(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyHash clears the value of the "api_key_hash" field.
+func (_u *AppUpdateOne) ClearAPIKeyHash() *AppUpdateOne {
+	_u.mutation.ClearAPIKeyHash()
+	return _u
+}
+
+// SetAPIKeyPrefix sets the "api_key_prefix" field.
+func (_u *AppUpdateOne) SetAPIKeyPrefix(v string) *AppUpdateOne {
+	_u.mutation.SetAPIKeyPrefix(v)
+	return _u
+}
+
+// SetNillableAPIKeyPrefix sets the "api_key_prefix" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPIKeyPrefix(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetAPIKeyPrefix(*v)
+	}
+	return _u
+}
+
+// ClearAPIKeyPrefix clears the value of the "api_key_prefix" field.
+func (_u *AppUpdateOne) ClearAPIKeyPrefix() *AppUpdateOne {
+	_u.mutation.ClearAPIKeyPrefix()
+	return _u
+}
+
+// SetPreviousAPIKeyHash sets the "previous_api_key_hash" field.
+func (_u *AppUpdateOne) SetPreviousAPIKeyHash(v string) *AppUpdateOne {
+	_u.mutation.SetPreviousAPIKeyHash(v)
+	return _u
+}
+
+// SetNillablePreviousAPIKeyHash sets the "previous_api_key_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillablePreviousAPIKeyHash(v *string) *AppUpdateOne {
+	if v != nil {
+		_u.SetPreviousAPIKeyHash(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPIKeyHash clears the value of the "previous_api_key_hash" field.
+func (_u *AppUpdateOne) ClearPreviousAPIKeyHash() *AppUpdateOne {
+	_u.mutation.ClearPreviousAPIKeyHash()
+	return _u
+}
+
+// SetPreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field.
+func (_u *AppUpdateOne) SetPreviousAPIKeyExpiresAt(v time.Time) *AppUpdateOne {
+	_u.mutation.SetPreviousAPIKeyExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousAPIKeyExpiresAt sets the "previous_api_key_expires_at" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillablePreviousAPIKeyExpiresAt(v *time.Time) *AppUpdateOne {
+	if v != nil {
+		_u.SetPreviousAPIKeyExpiresAt(*v)
+	}
+	return _u
 }
 
-// AppUpdateOne is the builder for updating a single App entity.
-type AppUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *AppMutation
+// ClearPreviousAPIKeyExpiresAt clears the value of the "previous_api_key_expires_at" field.
+func (_u *AppUpdateOne) ClearPreviousAPIKeyExpiresAt() *AppUpdateOne {
+	_u.mutation.ClearPreviousAPIKeyExpiresAt()
+	return _u
 }
 
-// SetName sets the "name" field.
-func (_u *AppUpdateOne) SetName(v string) *AppUpdateOne {
-	_u.mutation.SetName(v)
+// SetAPISecretHash sets the "api_secret_hash" field.
+func (_u *AppUpdateOne) SetAPISecretHash(v string) *AppUpdateOne {
+	_u.mutation.SetAPISecretHash(v)
 	return _u
 }
 
-// SetNillableName sets the "name" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableName(v *string) *AppUpdateOne {
+// SetNillableAPISecretHash sets the "api_secret_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPISecretHash(v *string) *AppUpdateOne {
 	if v != nil {
-		_u.SetName(*v)
+		_u.SetAPISecretHash(*v)
 	}
 	return _u
 }
 
-// SetSlug sets the "slug" field.
-func (_u *AppUpdateOne) SetSlug(v string) *AppUpdateOne {
-	_u.mutation.SetSlug(v)
+// ClearAPISecretHash clears the value of the "api_secret_hash" field.
+func (_u *AppUpdateOne) ClearAPISecretHash() *AppUpdateOne {
+	_u.mutation.ClearAPISecretHash()
 	return _u
 }
 
-// SetNillableSlug sets the "slug" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableSlug(v *string) *AppUpdateOne {
+// SetPreviousAPISecretHash sets the "previous_api_secret_hash" field.
+func (_u *AppUpdateOne) SetPreviousAPISecretHash(v string) *AppUpdateOne {
+	_u.mutation.SetPreviousAPISecretHash(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretHash sets the "previous_api_secret_hash" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillablePreviousAPISecretHash(v *string) *AppUpdateOne {
 	if v != nil {
-		_u.SetSlug(*v)
+		_u.SetPreviousAPISecretHash(*v)
 	}
 	return _u
 }
 
-// SetAPIKey sets the "api_key" field.
-func (_u *AppUpdateOne) SetAPIKey(v string) *AppUpdateOne {
-	_u.mutation.SetAPIKey(v)
+// ClearPreviousAPISecretHash clears the value of the "previous_api_secret_hash" field.
+func (_u *AppUpdateOne) ClearPreviousAPISecretHash() *AppUpdateOne {
+	_u.mutation.ClearPreviousAPISecretHash()
 	return _u
 }
 
-// SetNillableAPIKey sets the "api_key" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableAPIKey(v *string) *AppUpdateOne {
+// SetAPISecretEncrypted sets the "api_secret_encrypted" field.
+func (_u *AppUpdateOne) SetAPISecretEncrypted(v string) *AppUpdateOne {
+	_u.mutation.SetAPISecretEncrypted(v)
+	return _u
+}
+
+// SetNillableAPISecretEncrypted sets the "api_secret_encrypted" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableAPISecretEncrypted(v *string) *AppUpdateOne {
 	if v != nil {
-		_u.SetAPIKey(*v)
+		_u.SetAPISecretEncrypted(*v)
 	}
 	return _u
 }
 
-// SetAPISecret sets the "api_secret" field.
-func (_u *AppUpdateOne) SetAPISecret(v string) *AppUpdateOne {
-	_u.mutation.SetAPISecret(v)
+// ClearAPISecretEncrypted clears the value of the "api_secret_encrypted" field.
+func (_u *AppUpdateOne) ClearAPISecretEncrypted() *AppUpdateOne {
+	_u.mutation.ClearAPISecretEncrypted()
 	return _u
 }
 
-// SetNillableAPISecret sets the "api_secret" field if the given value is not nil.
-func (_u *AppUpdateOne) SetNillableAPISecret(v *string) *AppUpdateOne {
+// SetPreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field.
+func (_u *AppUpdateOne) SetPreviousAPISecretEncrypted(v string) *AppUpdateOne {
+	_u.mutation.SetPreviousAPISecretEncrypted(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretEncrypted sets the "previous_api_secret_encrypted" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillablePreviousAPISecretEncrypted(v *string) *AppUpdateOne {
 	if v != nil {
-		_u.SetAPISecret(*v)
+		_u.SetPreviousAPISecretEncrypted(*v)
 	}
 	return _u
 }
 
-// ClearAPISecret clears the value of the "api_secret" field.
-func (_u *AppUpdateOne) ClearAPISecret() *AppUpdateOne {
-	_u.mutation.ClearAPISecret()
+// ClearPreviousAPISecretEncrypted clears the value of the "previous_api_secret_encrypted" field.
+func (_u *AppUpdateOne) ClearPreviousAPISecretEncrypted() *AppUpdateOne {
+	_u.mutation.ClearPreviousAPISecretEncrypted()
+	return _u
+}
+
+// SetPreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field.
+func (_u *AppUpdateOne) SetPreviousAPISecretExpiresAt(v time.Time) *AppUpdateOne {
+	_u.mutation.SetPreviousAPISecretExpiresAt(v)
+	return _u
+}
+
+// SetNillablePreviousAPISecretExpiresAt sets the "previous_api_secret_expires_at" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillablePreviousAPISecretExpiresAt(v *time.Time) *AppUpdateOne {
+	if v != nil {
+		_u.SetPreviousAPISecretExpiresAt(*v)
+	}
+	return _u
+}
+
+// ClearPreviousAPISecretExpiresAt clears the value of the "previous_api_secret_expires_at" field.
+func (_u *AppUpdateOne) ClearPreviousAPISecretExpiresAt() *AppUpdateOne {
+	_u.mutation.ClearPreviousAPISecretExpiresAt()
 	return _u
 }
 
@@ -1534,6 +2650,24 @@ func (_u *AppUpdateOne) ClearAllowedOrigins() *AppUpdateOne {
 	return _u
 }
 
+// SetOauthRedirectUris sets the "oauth_redirect_uris" field.
+func (_u *AppUpdateOne) SetOauthRedirectUris(v []string) *AppUpdateOne {
+	_u.mutation.SetOauthRedirectUris(v)
+	return _u
+}
+
+// AppendOauthRedirectUris appends value to the "oauth_redirect_uris" field.
+func (_u *AppUpdateOne) AppendOauthRedirectUris(v []string) *AppUpdateOne {
+	_u.mutation.AppendOauthRedirectUris(v)
+	return _u
+}
+
+// ClearOauthRedirectUris clears the value of the "oauth_redirect_uris" field.
+func (_u *AppUpdateOne) ClearOauthRedirectUris() *AppUpdateOne {
+	_u.mutation.ClearOauthRedirectUris()
+	return _u
+}
+
 // SetWebhookURL sets the "webhook_url" field.
 func (_u *AppUpdateOne) SetWebhookURL(v string) *AppUpdateOne {
 	_u.mutation.SetWebhookURL(v)
@@ -1574,6 +2708,74 @@ func (_u *AppUpdateOne) ClearStripeProductID() *AppUpdateOne {
 	return _u
 }
 
+// SetRateLimitPerMinute sets the "rate_limit_per_minute" field.
+func (_u *AppUpdateOne) SetRateLimitPerMinute(v int) *AppUpdateOne {
+	_u.mutation.ResetRateLimitPerMinute()
+	_u.mutation.SetRateLimitPerMinute(v)
+	return _u
+}
+
+// SetNillableRateLimitPerMinute sets the "rate_limit_per_minute" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableRateLimitPerMinute(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetRateLimitPerMinute(*v)
+	}
+	return _u
+}
+
+// AddRateLimitPerMinute adds value to the "rate_limit_per_minute" field.
+func (_u *AppUpdateOne) AddRateLimitPerMinute(v int) *AppUpdateOne {
+	_u.mutation.AddRateLimitPerMinute(v)
+	return _u
+}
+
+// ClearRateLimitPerMinute clears the value of the "rate_limit_per_minute" field.
+func (_u *AppUpdateOne) ClearRateLimitPerMinute() *AppUpdateOne {
+	_u.mutation.ClearRateLimitPerMinute()
+	return _u
+}
+
+// SetRateLimitBurst sets the "rate_limit_burst" field.
+func (_u *AppUpdateOne) SetRateLimitBurst(v int) *AppUpdateOne {
+	_u.mutation.ResetRateLimitBurst()
+	_u.mutation.SetRateLimitBurst(v)
+	return _u
+}
+
+// SetNillableRateLimitBurst sets the "rate_limit_burst" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableRateLimitBurst(v *int) *AppUpdateOne {
+	if v != nil {
+		_u.SetRateLimitBurst(*v)
+	}
+	return _u
+}
+
+// AddRateLimitBurst adds value to the "rate_limit_burst" field.
+func (_u *AppUpdateOne) AddRateLimitBurst(v int) *AppUpdateOne {
+	_u.mutation.AddRateLimitBurst(v)
+	return _u
+}
+
+// ClearRateLimitBurst clears the value of the "rate_limit_burst" field.
+func (_u *AppUpdateOne) ClearRateLimitBurst() *AppUpdateOne {
+	_u.mutation.ClearRateLimitBurst()
+	return _u
+}
+
+// SetMagicLinkSignup sets the "magic_link_signup" field.
+func (_u *AppUpdateOne) SetMagicLinkSignup(v bool) *AppUpdateOne {
+	_u.mutation.SetMagicLinkSignup(v)
+	return _u
+}
+
+// SetNillableMagicLinkSignup sets the "magic_link_signup" field if the given value is not nil.
+func (_u *AppUpdateOne) SetNillableMagicLinkSignup(v *bool) *AppUpdateOne {
+	if v != nil {
+		_u.SetMagicLinkSignup(*v)
+	}
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *AppUpdateOne) SetIsActive(v bool) *AppUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -1804,6 +3006,111 @@ func (_u *AppUpdateOne) AddShenbiSettings(v ...*ShenbiSettings) *AppUpdateOne {
 	return _u.AddShenbiSettingIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *AppUpdateOne) AddAuthSessionIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *AppUpdateOne) AddAuthSessions(v ...*AuthSession) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_u *AppUpdateOne) AddVerificationTokenIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddVerificationTokenIDs(ids...)
+	return _u
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_u *AppUpdateOne) AddVerificationTokens(v ...*VerificationToken) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_u *AppUpdateOne) AddOauthConsentIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddOauthConsentIDs(ids...)
+	return _u
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_u *AppUpdateOne) AddOauthConsents(v ...*OAuthConsent) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_u *AppUpdateOne) AddOauthAuthorizationCodeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *AppUpdateOne) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddAuditEventIDs adds the "audit_events" edge to the AuditEvent entity by IDs.
+func (_u *AppUpdateOne) AddAuditEventIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddAuditEventIDs(ids...)
+	return _u
+}
+
+// AddAuditEvents adds the "audit_events" edges to the AuditEvent entity.
+func (_u *AppUpdateOne) AddAuditEvents(v ...*AuditEvent) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuditEventIDs(ids...)
+}
+
+// AddWebhookDeliveryIDs adds the "webhook_deliveries" edge to the WebhookDelivery entity by IDs.
+func (_u *AppUpdateOne) AddWebhookDeliveryIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// AddWebhookDeliveries adds the "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *AppUpdateOne) AddWebhookDeliveries(v ...*WebhookDelivery) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWebhookDeliveryIDs(ids...)
+}
+
+// AddAdminIDs adds the "admins" edge to the AdminAccount entity by IDs.
+func (_u *AppUpdateOne) AddAdminIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.AddAdminIDs(ids...)
+	return _u
+}
+
+// AddAdmins adds the "admins" edges to the AdminAccount entity.
+func (_u *AppUpdateOne) AddAdmins(v ...*AdminAccount) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAdminIDs(ids...)
+}
+
 // Mutation returns the AppMutation object of the builder.
 func (_u *AppUpdateOne) Mutation() *AppMutation {
 	return _u.mutation
@@ -2103,6 +3410,153 @@ func (_u *AppUpdateOne) RemoveShenbiSettings(v ...*ShenbiSettings) *AppUpdateOne
 	return _u.RemoveShenbiSettingIDs(ids...)
 }
 
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *AppUpdateOne) ClearAuthSessions() *AppUpdateOne {
+	_u.mutation.ClearAuthSessions()
+	return _u
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *AppUpdateOne) RemoveAuthSessionIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
+	return _u
+}
+
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *AppUpdateOne) RemoveAuthSessions(v ...*AuthSession) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthSessionIDs(ids...)
+}
+
+// ClearVerificationTokens clears all "verification_tokens" edges to the VerificationToken entity.
+func (_u *AppUpdateOne) ClearVerificationTokens() *AppUpdateOne {
+	_u.mutation.ClearVerificationTokens()
+	return _u
+}
+
+// RemoveVerificationTokenIDs removes the "verification_tokens" edge to VerificationToken entities by IDs.
+func (_u *AppUpdateOne) RemoveVerificationTokenIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveVerificationTokenIDs(ids...)
+	return _u
+}
+
+// RemoveVerificationTokens removes "verification_tokens" edges to VerificationToken entities.
+func (_u *AppUpdateOne) RemoveVerificationTokens(v ...*VerificationToken) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVerificationTokenIDs(ids...)
+}
+
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (_u *AppUpdateOne) ClearOauthConsents() *AppUpdateOne {
+	_u.mutation.ClearOauthConsents()
+	return _u
+}
+
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (_u *AppUpdateOne) RemoveOauthConsentIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveOauthConsentIDs(ids...)
+	return _u
+}
+
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (_u *AppUpdateOne) RemoveOauthConsents(v ...*OAuthConsent) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthConsentIDs(ids...)
+}
+
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *AppUpdateOne) ClearOauthAuthorizationCodes() *AppUpdateOne {
+	_u.mutation.ClearOauthAuthorizationCodes()
+	return _u
+}
+
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (_u *AppUpdateOne) RemoveOauthAuthorizationCodeIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (_u *AppUpdateOne) RemoveOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthAuthorizationCodeIDs(ids...)
+}
+
+// ClearAuditEvents clears all "audit_events" edges to the AuditEvent entity.
+func (_u *AppUpdateOne) ClearAuditEvents() *AppUpdateOne {
+	_u.mutation.ClearAuditEvents()
+	return _u
+}
+
+// RemoveAuditEventIDs removes the "audit_events" edge to AuditEvent entities by IDs.
+func (_u *AppUpdateOne) RemoveAuditEventIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveAuditEventIDs(ids...)
+	return _u
+}
+
+// RemoveAuditEvents removes "audit_events" edges to AuditEvent entities.
+func (_u *AppUpdateOne) RemoveAuditEvents(v ...*AuditEvent) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuditEventIDs(ids...)
+}
+
+// ClearWebhookDeliveries clears all "webhook_deliveries" edges to the WebhookDelivery entity.
+func (_u *AppUpdateOne) ClearWebhookDeliveries() *AppUpdateOne {
+	_u.mutation.ClearWebhookDeliveries()
+	return _u
+}
+
+// RemoveWebhookDeliveryIDs removes the "webhook_deliveries" edge to WebhookDelivery entities by IDs.
+func (_u *AppUpdateOne) RemoveWebhookDeliveryIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveWebhookDeliveryIDs(ids...)
+	return _u
+}
+
+// RemoveWebhookDeliveries removes "webhook_deliveries" edges to WebhookDelivery entities.
+func (_u *AppUpdateOne) RemoveWebhookDeliveries(v ...*WebhookDelivery) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWebhookDeliveryIDs(ids...)
+}
+
+// ClearAdmins clears all "admins" edges to the AdminAccount entity.
+func (_u *AppUpdateOne) ClearAdmins() *AppUpdateOne {
+	_u.mutation.ClearAdmins()
+	return _u
+}
+
+// RemoveAdminIDs removes the "admins" edge to AdminAccount entities by IDs.
+func (_u *AppUpdateOne) RemoveAdminIDs(ids ...int) *AppUpdateOne {
+	_u.mutation.RemoveAdminIDs(ids...)
+	return _u
+}
+
+// RemoveAdmins removes "admins" edges to AdminAccount entities.
+func (_u *AppUpdateOne) RemoveAdmins(v ...*AdminAccount) *AppUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAdminIDs(ids...)
+}
+
 // Where appends a list predicates to the AppUpdate builder.
 func (_u *AppUpdateOne) Where(ps ...predicate.App) *AppUpdateOne {
 	_u.mutation.Where(ps...)
@@ -2164,11 +3618,6 @@ func (_u *AppUpdateOne) check() error {
 			return &ValidationError{Name: "slug", err: fmt.Errorf(`ent: validator failed for field "App.slug": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.APIKey(); ok {
-		if err := app.APIKeyValidator(v); err != nil {
-			return &ValidationError{Name: "api_key", err: fmt.Errorf(`ent: validator failed for field "App.api_key": %w`, err)}
-		}
-	}
 	return nil
 }
 
@@ -2210,12 +3659,69 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if value, ok := _u.mutation.APIKey(); ok {
 		_spec.SetField(app.FieldAPIKey, field.TypeString, value)
 	}
+	if _u.mutation.APIKeyCleared() {
+		_spec.ClearField(app.FieldAPIKey, field.TypeString)
+	}
 	if value, ok := _u.mutation.APISecret(); ok {
 		_spec.SetField(app.FieldAPISecret, field.TypeString, value)
 	}
 	if _u.mutation.APISecretCleared() {
 		_spec.ClearField(app.FieldAPISecret, field.TypeString)
 	}
+	if value, ok := _u.mutation.APIKeyHash(); ok {
+		_spec.SetField(app.FieldAPIKeyHash, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyHashCleared() {
+		_spec.ClearField(app.FieldAPIKeyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.APIKeyPrefix(); ok {
+		_spec.SetField(app.FieldAPIKeyPrefix, field.TypeString, value)
+	}
+	if _u.mutation.APIKeyPrefixCleared() {
+		_spec.ClearField(app.FieldAPIKeyPrefix, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPIKeyHash(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPIKeyHashCleared() {
+		_spec.ClearField(app.FieldPreviousAPIKeyHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPIKeyExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPIKeyExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousAPIKeyExpiresAtCleared() {
+		_spec.ClearField(app.FieldPreviousAPIKeyExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.APISecretHash(); ok {
+		_spec.SetField(app.FieldAPISecretHash, field.TypeString, value)
+	}
+	if _u.mutation.APISecretHashCleared() {
+		_spec.ClearField(app.FieldAPISecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretHash(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretHash, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPISecretHashCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.APISecretEncrypted(); ok {
+		_spec.SetField(app.FieldAPISecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.APISecretEncryptedCleared() {
+		_spec.ClearField(app.FieldAPISecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretEncrypted(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.PreviousAPISecretEncryptedCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.PreviousAPISecretExpiresAt(); ok {
+		_spec.SetField(app.FieldPreviousAPISecretExpiresAt, field.TypeTime, value)
+	}
+	if _u.mutation.PreviousAPISecretExpiresAtCleared() {
+		_spec.ClearField(app.FieldPreviousAPISecretExpiresAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.AllowedOrigins(); ok {
 		_spec.SetField(app.FieldAllowedOrigins, field.TypeJSON, value)
 	}
@@ -2227,6 +3733,17 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if _u.mutation.AllowedOriginsCleared() {
 		_spec.ClearField(app.FieldAllowedOrigins, field.TypeJSON)
 	}
+	if value, ok := _u.mutation.OauthRedirectUris(); ok {
+		_spec.SetField(app.FieldOauthRedirectUris, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOauthRedirectUris(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, app.FieldOauthRedirectUris, value)
+		})
+	}
+	if _u.mutation.OauthRedirectUrisCleared() {
+		_spec.ClearField(app.FieldOauthRedirectUris, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.WebhookURL(); ok {
 		_spec.SetField(app.FieldWebhookURL, field.TypeString, value)
 	}
@@ -2239,6 +3756,27 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 	if _u.mutation.StripeProductIDCleared() {
 		_spec.ClearField(app.FieldStripeProductID, field.TypeString)
 	}
+	if value, ok := _u.mutation.RateLimitPerMinute(); ok {
+		_spec.SetField(app.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitPerMinute(); ok {
+		_spec.AddField(app.FieldRateLimitPerMinute, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitPerMinuteCleared() {
+		_spec.ClearField(app.FieldRateLimitPerMinute, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RateLimitBurst(); ok {
+		_spec.SetField(app.FieldRateLimitBurst, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRateLimitBurst(); ok {
+		_spec.AddField(app.FieldRateLimitBurst, field.TypeInt, value)
+	}
+	if _u.mutation.RateLimitBurstCleared() {
+		_spec.ClearField(app.FieldRateLimitBurst, field.TypeInt)
+	}
+	if value, ok := _u.mutation.MagicLinkSignup(); ok {
+		_spec.SetField(app.FieldMagicLinkSignup, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(app.FieldIsActive, field.TypeBool, value)
 	}
@@ -2875,6 +4413,321 @@ func (_u *AppUpdateOne) sqlSave(ctx context.Context) (_node *App, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuthSessionsTable,
+			Columns: []string{app.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationTokensIDs(); len(nodes) > 0 && !_u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.VerificationTokensTable,
+			Columns: []string{app.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !_u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthConsentsTable,
+			Columns: []string{app.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.OauthAuthorizationCodesTable,
+			Columns: []string{app.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AuditEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuditEventsIDs(); len(nodes) > 0 && !_u.mutation.AuditEventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuditEventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.AuditEventsTable,
+			Columns: []string{app.AuditEventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(auditevent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWebhookDeliveriesIDs(); len(nodes) > 0 && !_u.mutation.WebhookDeliveriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WebhookDeliveriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   app.WebhookDeliveriesTable,
+			Columns: []string{app.WebhookDeliveriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAdminsIDs(); len(nodes) > 0 && !_u.mutation.AdminsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   app.AdminsTable,
+			Columns: app.AdminsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(adminaccount.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &App{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
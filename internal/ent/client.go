@@ -16,9 +16,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/assignment"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -27,16 +30,27 @@ import (
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/ratelimitbucket"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/ent/webhookevent"
 )
 
 // Client is the client that holds all ent builders.
@@ -46,12 +60,18 @@ type Client struct {
 	Schema *migrate.Schema
 	// Achievement is the client for interacting with the Achievement builders.
 	Achievement *AchievementClient
+	// AdminAccount is the client for interacting with the AdminAccount builders.
+	AdminAccount *AdminAccountClient
 	// App is the client for interacting with the App builders.
 	App *AppClient
 	// Assignment is the client for interacting with the Assignment builders.
 	Assignment *AssignmentClient
 	// AssignmentSubmission is the client for interacting with the AssignmentSubmission builders.
 	AssignmentSubmission *AssignmentSubmissionClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// AuthSession is the client for interacting with the AuthSession builders.
+	AuthSession *AuthSessionClient
 	// BattleRoom is the client for interacting with the BattleRoom builders.
 	BattleRoom *BattleRoomClient
 	// BattleSession is the client for interacting with the BattleSession builders.
@@ -68,14 +88,28 @@ type Client struct {
 	LiveSession *LiveSessionClient
 	// LiveSessionStudent is the client for interacting with the LiveSessionStudent builders.
 	LiveSessionStudent *LiveSessionStudentClient
+	// OAuthAuthorizationCode is the client for interacting with the OAuthAuthorizationCode builders.
+	OAuthAuthorizationCode *OAuthAuthorizationCodeClient
+	// OAuthConsent is the client for interacting with the OAuthConsent builders.
+	OAuthConsent *OAuthConsentClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// OrganizationDomain is the client for interacting with the OrganizationDomain builders.
+	OrganizationDomain *OrganizationDomainClient
 	// OrganizationInvitation is the client for interacting with the OrganizationInvitation builders.
 	OrganizationInvitation *OrganizationInvitationClient
 	// OrganizationMember is the client for interacting with the OrganizationMember builders.
 	OrganizationMember *OrganizationMemberClient
 	// Plan is the client for interacting with the Plan builders.
 	Plan *PlanClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// SSOConnection is the client for interacting with the SSOConnection builders.
+	SSOConnection *SSOConnectionClient
+	// SSOLogin is the client for interacting with the SSOLogin builders.
+	SSOLogin *SSOLoginClient
 	// ShenbiProfile is the client for interacting with the ShenbiProfile builders.
 	ShenbiProfile *ShenbiProfileClient
 	// ShenbiSettings is the client for interacting with the ShenbiSettings builders.
@@ -88,6 +122,14 @@ type Client struct {
 	UserApp *UserAppClient
 	// UserProgress is the client for interacting with the UserProgress builders.
 	UserProgress *UserProgressClient
+	// VerificationToken is the client for interacting with the VerificationToken builders.
+	VerificationToken *VerificationTokenClient
+	// WebhookAttempt is the client for interacting with the WebhookAttempt builders.
+	WebhookAttempt *WebhookAttemptClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -100,9 +142,12 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Achievement = NewAchievementClient(c.config)
+	c.AdminAccount = NewAdminAccountClient(c.config)
 	c.App = NewAppClient(c.config)
 	c.Assignment = NewAssignmentClient(c.config)
 	c.AssignmentSubmission = NewAssignmentSubmissionClient(c.config)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.AuthSession = NewAuthSessionClient(c.config)
 	c.BattleRoom = NewBattleRoomClient(c.config)
 	c.BattleSession = NewBattleSessionClient(c.config)
 	c.Classroom = NewClassroomClient(c.config)
@@ -111,16 +156,27 @@ func (c *Client) init() {
 	c.EmailTemplate = NewEmailTemplateClient(c.config)
 	c.LiveSession = NewLiveSessionClient(c.config)
 	c.LiveSessionStudent = NewLiveSessionStudentClient(c.config)
+	c.OAuthAuthorizationCode = NewOAuthAuthorizationCodeClient(c.config)
+	c.OAuthConsent = NewOAuthConsentClient(c.config)
 	c.Organization = NewOrganizationClient(c.config)
+	c.OrganizationDomain = NewOrganizationDomainClient(c.config)
 	c.OrganizationInvitation = NewOrganizationInvitationClient(c.config)
 	c.OrganizationMember = NewOrganizationMemberClient(c.config)
 	c.Plan = NewPlanClient(c.config)
+	c.RateLimitBucket = NewRateLimitBucketClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
+	c.SSOConnection = NewSSOConnectionClient(c.config)
+	c.SSOLogin = NewSSOLoginClient(c.config)
 	c.ShenbiProfile = NewShenbiProfileClient(c.config)
 	c.ShenbiSettings = NewShenbiSettingsClient(c.config)
 	c.Subscription = NewSubscriptionClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserApp = NewUserAppClient(c.config)
 	c.UserProgress = NewUserProgressClient(c.config)
+	c.VerificationToken = NewVerificationTokenClient(c.config)
+	c.WebhookAttempt = NewWebhookAttemptClient(c.config)
+	c.WebhookDelivery = NewWebhookDeliveryClient(c.config)
+	c.WebhookEvent = NewWebhookEventClient(c.config)
 }
 
 type (
@@ -214,9 +270,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ctx:                    ctx,
 		config:                 cfg,
 		Achievement:            NewAchievementClient(cfg),
+		AdminAccount:           NewAdminAccountClient(cfg),
 		App:                    NewAppClient(cfg),
 		Assignment:             NewAssignmentClient(cfg),
 		AssignmentSubmission:   NewAssignmentSubmissionClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		AuthSession:            NewAuthSessionClient(cfg),
 		BattleRoom:             NewBattleRoomClient(cfg),
 		BattleSession:          NewBattleSessionClient(cfg),
 		Classroom:              NewClassroomClient(cfg),
@@ -225,16 +284,27 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		EmailTemplate:          NewEmailTemplateClient(cfg),
 		LiveSession:            NewLiveSessionClient(cfg),
 		LiveSessionStudent:     NewLiveSessionStudentClient(cfg),
+		OAuthAuthorizationCode: NewOAuthAuthorizationCodeClient(cfg),
+		OAuthConsent:           NewOAuthConsentClient(cfg),
 		Organization:           NewOrganizationClient(cfg),
+		OrganizationDomain:     NewOrganizationDomainClient(cfg),
 		OrganizationInvitation: NewOrganizationInvitationClient(cfg),
 		OrganizationMember:     NewOrganizationMemberClient(cfg),
 		Plan:                   NewPlanClient(cfg),
+		RateLimitBucket:        NewRateLimitBucketClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		SSOConnection:          NewSSOConnectionClient(cfg),
+		SSOLogin:               NewSSOLoginClient(cfg),
 		ShenbiProfile:          NewShenbiProfileClient(cfg),
 		ShenbiSettings:         NewShenbiSettingsClient(cfg),
 		Subscription:           NewSubscriptionClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserApp:                NewUserAppClient(cfg),
 		UserProgress:           NewUserProgressClient(cfg),
+		VerificationToken:      NewVerificationTokenClient(cfg),
+		WebhookAttempt:         NewWebhookAttemptClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEvent:           NewWebhookEventClient(cfg),
 	}, nil
 }
 
@@ -255,9 +325,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ctx:                    ctx,
 		config:                 cfg,
 		Achievement:            NewAchievementClient(cfg),
+		AdminAccount:           NewAdminAccountClient(cfg),
 		App:                    NewAppClient(cfg),
 		Assignment:             NewAssignmentClient(cfg),
 		AssignmentSubmission:   NewAssignmentSubmissionClient(cfg),
+		AuditEvent:             NewAuditEventClient(cfg),
+		AuthSession:            NewAuthSessionClient(cfg),
 		BattleRoom:             NewBattleRoomClient(cfg),
 		BattleSession:          NewBattleSessionClient(cfg),
 		Classroom:              NewClassroomClient(cfg),
@@ -266,16 +339,27 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		EmailTemplate:          NewEmailTemplateClient(cfg),
 		LiveSession:            NewLiveSessionClient(cfg),
 		LiveSessionStudent:     NewLiveSessionStudentClient(cfg),
+		OAuthAuthorizationCode: NewOAuthAuthorizationCodeClient(cfg),
+		OAuthConsent:           NewOAuthConsentClient(cfg),
 		Organization:           NewOrganizationClient(cfg),
+		OrganizationDomain:     NewOrganizationDomainClient(cfg),
 		OrganizationInvitation: NewOrganizationInvitationClient(cfg),
 		OrganizationMember:     NewOrganizationMemberClient(cfg),
 		Plan:                   NewPlanClient(cfg),
+		RateLimitBucket:        NewRateLimitBucketClient(cfg),
+		RefreshToken:           NewRefreshTokenClient(cfg),
+		SSOConnection:          NewSSOConnectionClient(cfg),
+		SSOLogin:               NewSSOLoginClient(cfg),
 		ShenbiProfile:          NewShenbiProfileClient(cfg),
 		ShenbiSettings:         NewShenbiSettingsClient(cfg),
 		Subscription:           NewSubscriptionClient(cfg),
 		User:                   NewUserClient(cfg),
 		UserApp:                NewUserAppClient(cfg),
 		UserProgress:           NewUserProgressClient(cfg),
+		VerificationToken:      NewVerificationTokenClient(cfg),
+		WebhookAttempt:         NewWebhookAttemptClient(cfg),
+		WebhookDelivery:        NewWebhookDeliveryClient(cfg),
+		WebhookEvent:           NewWebhookEventClient(cfg),
 	}, nil
 }
 
@@ -305,11 +389,15 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Achievement, c.App, c.Assignment, c.AssignmentSubmission, c.BattleRoom,
-		c.BattleSession, c.Classroom, c.ClassroomMembership, c.ClassroomSession,
-		c.EmailTemplate, c.LiveSession, c.LiveSessionStudent, c.Organization,
-		c.OrganizationInvitation, c.OrganizationMember, c.Plan, c.ShenbiProfile,
-		c.ShenbiSettings, c.Subscription, c.User, c.UserApp, c.UserProgress,
+		c.Achievement, c.AdminAccount, c.App, c.Assignment, c.AssignmentSubmission,
+		c.AuditEvent, c.AuthSession, c.BattleRoom, c.BattleSession, c.Classroom,
+		c.ClassroomMembership, c.ClassroomSession, c.EmailTemplate, c.LiveSession,
+		c.LiveSessionStudent, c.OAuthAuthorizationCode, c.OAuthConsent, c.Organization,
+		c.OrganizationDomain, c.OrganizationInvitation, c.OrganizationMember, c.Plan,
+		c.RateLimitBucket, c.RefreshToken, c.SSOConnection, c.SSOLogin,
+		c.ShenbiProfile, c.ShenbiSettings, c.Subscription, c.User, c.UserApp,
+		c.UserProgress, c.VerificationToken, c.WebhookAttempt, c.WebhookDelivery,
+		c.WebhookEvent,
 	} {
 		n.Use(hooks...)
 	}
@@ -319,11 +407,15 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Achievement, c.App, c.Assignment, c.AssignmentSubmission, c.BattleRoom,
-		c.BattleSession, c.Classroom, c.ClassroomMembership, c.ClassroomSession,
-		c.EmailTemplate, c.LiveSession, c.LiveSessionStudent, c.Organization,
-		c.OrganizationInvitation, c.OrganizationMember, c.Plan, c.ShenbiProfile,
-		c.ShenbiSettings, c.Subscription, c.User, c.UserApp, c.UserProgress,
+		c.Achievement, c.AdminAccount, c.App, c.Assignment, c.AssignmentSubmission,
+		c.AuditEvent, c.AuthSession, c.BattleRoom, c.BattleSession, c.Classroom,
+		c.ClassroomMembership, c.ClassroomSession, c.EmailTemplate, c.LiveSession,
+		c.LiveSessionStudent, c.OAuthAuthorizationCode, c.OAuthConsent, c.Organization,
+		c.OrganizationDomain, c.OrganizationInvitation, c.OrganizationMember, c.Plan,
+		c.RateLimitBucket, c.RefreshToken, c.SSOConnection, c.SSOLogin,
+		c.ShenbiProfile, c.ShenbiSettings, c.Subscription, c.User, c.UserApp,
+		c.UserProgress, c.VerificationToken, c.WebhookAttempt, c.WebhookDelivery,
+		c.WebhookEvent,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -334,12 +426,18 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *AchievementMutation:
 		return c.Achievement.mutate(ctx, m)
+	case *AdminAccountMutation:
+		return c.AdminAccount.mutate(ctx, m)
 	case *AppMutation:
 		return c.App.mutate(ctx, m)
 	case *AssignmentMutation:
 		return c.Assignment.mutate(ctx, m)
 	case *AssignmentSubmissionMutation:
 		return c.AssignmentSubmission.mutate(ctx, m)
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
+	case *AuthSessionMutation:
+		return c.AuthSession.mutate(ctx, m)
 	case *BattleRoomMutation:
 		return c.BattleRoom.mutate(ctx, m)
 	case *BattleSessionMutation:
@@ -356,14 +454,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.LiveSession.mutate(ctx, m)
 	case *LiveSessionStudentMutation:
 		return c.LiveSessionStudent.mutate(ctx, m)
+	case *OAuthAuthorizationCodeMutation:
+		return c.OAuthAuthorizationCode.mutate(ctx, m)
+	case *OAuthConsentMutation:
+		return c.OAuthConsent.mutate(ctx, m)
 	case *OrganizationMutation:
 		return c.Organization.mutate(ctx, m)
+	case *OrganizationDomainMutation:
+		return c.OrganizationDomain.mutate(ctx, m)
 	case *OrganizationInvitationMutation:
 		return c.OrganizationInvitation.mutate(ctx, m)
 	case *OrganizationMemberMutation:
 		return c.OrganizationMember.mutate(ctx, m)
 	case *PlanMutation:
 		return c.Plan.mutate(ctx, m)
+	case *RateLimitBucketMutation:
+		return c.RateLimitBucket.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
+	case *SSOConnectionMutation:
+		return c.SSOConnection.mutate(ctx, m)
+	case *SSOLoginMutation:
+		return c.SSOLogin.mutate(ctx, m)
 	case *ShenbiProfileMutation:
 		return c.ShenbiProfile.mutate(ctx, m)
 	case *ShenbiSettingsMutation:
@@ -376,6 +488,14 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.UserApp.mutate(ctx, m)
 	case *UserProgressMutation:
 		return c.UserProgress.mutate(ctx, m)
+	case *VerificationTokenMutation:
+		return c.VerificationToken.mutate(ctx, m)
+	case *WebhookAttemptMutation:
+		return c.WebhookAttempt.mutate(ctx, m)
+	case *WebhookDeliveryMutation:
+		return c.WebhookDelivery.mutate(ctx, m)
+	case *WebhookEventMutation:
+		return c.WebhookEvent.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -546,6 +666,155 @@ func (c *AchievementClient) mutate(ctx context.Context, m *AchievementMutation)
 	}
 }
 
+// AdminAccountClient is a client for the AdminAccount schema.
+type AdminAccountClient struct {
+	config
+}
+
+// NewAdminAccountClient returns a client for the AdminAccount from the given config.
+func NewAdminAccountClient(c config) *AdminAccountClient {
+	return &AdminAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `adminaccount.Hooks(f(g(h())))`.
+func (c *AdminAccountClient) Use(hooks ...Hook) {
+	c.hooks.AdminAccount = append(c.hooks.AdminAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `adminaccount.Intercept(f(g(h())))`.
+func (c *AdminAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AdminAccount = append(c.inters.AdminAccount, interceptors...)
+}
+
+// Create returns a builder for creating a AdminAccount entity.
+func (c *AdminAccountClient) Create() *AdminAccountCreate {
+	mutation := newAdminAccountMutation(c.config, OpCreate)
+	return &AdminAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AdminAccount entities.
+func (c *AdminAccountClient) CreateBulk(builders ...*AdminAccountCreate) *AdminAccountCreateBulk {
+	return &AdminAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AdminAccountClient) MapCreateBulk(slice any, setFunc func(*AdminAccountCreate, int)) *AdminAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AdminAccountCreateBulk{err: fmt.Errorf("calling to AdminAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AdminAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AdminAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AdminAccount.
+func (c *AdminAccountClient) Update() *AdminAccountUpdate {
+	mutation := newAdminAccountMutation(c.config, OpUpdate)
+	return &AdminAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AdminAccountClient) UpdateOne(_m *AdminAccount) *AdminAccountUpdateOne {
+	mutation := newAdminAccountMutation(c.config, OpUpdateOne, withAdminAccount(_m))
+	return &AdminAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AdminAccountClient) UpdateOneID(id int) *AdminAccountUpdateOne {
+	mutation := newAdminAccountMutation(c.config, OpUpdateOne, withAdminAccountID(id))
+	return &AdminAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AdminAccount.
+func (c *AdminAccountClient) Delete() *AdminAccountDelete {
+	mutation := newAdminAccountMutation(c.config, OpDelete)
+	return &AdminAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AdminAccountClient) DeleteOne(_m *AdminAccount) *AdminAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AdminAccountClient) DeleteOneID(id int) *AdminAccountDeleteOne {
+	builder := c.Delete().Where(adminaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AdminAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for AdminAccount.
+func (c *AdminAccountClient) Query() *AdminAccountQuery {
+	return &AdminAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAdminAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AdminAccount entity by its id.
+func (c *AdminAccountClient) Get(ctx context.Context, id int) (*AdminAccount, error) {
+	return c.Query().Where(adminaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AdminAccountClient) GetX(ctx context.Context, id int) *AdminAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApps queries the apps edge of a AdminAccount.
+func (c *AdminAccountClient) QueryApps(_m *AdminAccount) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(adminaccount.Table, adminaccount.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, false, adminaccount.AppsTable, adminaccount.AppsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *AdminAccountClient) Hooks() []Hook {
+	return c.hooks.AdminAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *AdminAccountClient) Interceptors() []Interceptor {
+	return c.inters.AdminAccount
+}
+
+func (c *AdminAccountClient) mutate(ctx context.Context, m *AdminAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AdminAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AdminAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AdminAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AdminAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AdminAccount mutation op: %q", m.Op())
+	}
+}
+
 // AppClient is a client for the App schema.
 type AppClient struct {
 	config
@@ -878,6 +1147,118 @@ func (c *AppClient) QueryShenbiSettings(_m *App) *ShenbiSettingsQuery {
 	return query
 }
 
+// QueryAuthSessions queries the auth_sessions edge of a App.
+func (c *AppClient) QueryAuthSessions(_m *App) *AuthSessionQuery {
+	query := (&AuthSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.AuthSessionsTable, app.AuthSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVerificationTokens queries the verification_tokens edge of a App.
+func (c *AppClient) QueryVerificationTokens(_m *App) *VerificationTokenQuery {
+	query := (&VerificationTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(verificationtoken.Table, verificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.VerificationTokensTable, app.VerificationTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthConsents queries the oauth_consents edge of a App.
+func (c *AppClient) QueryOauthConsents(_m *App) *OAuthConsentQuery {
+	query := (&OAuthConsentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(oauthconsent.Table, oauthconsent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.OauthConsentsTable, app.OauthConsentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthAuthorizationCodes queries the oauth_authorization_codes edge of a App.
+func (c *AppClient) QueryOauthAuthorizationCodes(_m *App) *OAuthAuthorizationCodeQuery {
+	query := (&OAuthAuthorizationCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.OauthAuthorizationCodesTable, app.OauthAuthorizationCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuditEvents queries the audit_events edge of a App.
+func (c *AppClient) QueryAuditEvents(_m *App) *AuditEventQuery {
+	query := (&AuditEventClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(auditevent.Table, auditevent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.AuditEventsTable, app.AuditEventsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWebhookDeliveries queries the webhook_deliveries edge of a App.
+func (c *AppClient) QueryWebhookDeliveries(_m *App) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, app.WebhookDeliveriesTable, app.WebhookDeliveriesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAdmins queries the admins edge of a App.
+func (c *AppClient) QueryAdmins(_m *App) *AdminAccountQuery {
+	query := (&AdminAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(app.Table, app.FieldID, id),
+			sqlgraph.To(adminaccount.Table, adminaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2M, true, app.AdminsTable, app.AdminsPrimaryKey...),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *AppClient) Hooks() []Hook {
 	return c.hooks.App
@@ -1233,107 +1614,107 @@ func (c *AssignmentSubmissionClient) mutate(ctx context.Context, m *AssignmentSu
 	}
 }
 
-// BattleRoomClient is a client for the BattleRoom schema.
-type BattleRoomClient struct {
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
 	config
 }
 
-// NewBattleRoomClient returns a client for the BattleRoom from the given config.
-func NewBattleRoomClient(c config) *BattleRoomClient {
-	return &BattleRoomClient{config: c}
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `battleroom.Hooks(f(g(h())))`.
-func (c *BattleRoomClient) Use(hooks ...Hook) {
-	c.hooks.BattleRoom = append(c.hooks.BattleRoom, hooks...)
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `battleroom.Intercept(f(g(h())))`.
-func (c *BattleRoomClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BattleRoom = append(c.inters.BattleRoom, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
 }
 
-// Create returns a builder for creating a BattleRoom entity.
-func (c *BattleRoomClient) Create() *BattleRoomCreate {
-	mutation := newBattleRoomMutation(c.config, OpCreate)
-	return &BattleRoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BattleRoom entities.
-func (c *BattleRoomClient) CreateBulk(builders ...*BattleRoomCreate) *BattleRoomCreateBulk {
-	return &BattleRoomCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BattleRoomClient) MapCreateBulk(slice any, setFunc func(*BattleRoomCreate, int)) *BattleRoomCreateBulk {
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BattleRoomCreateBulk{err: fmt.Errorf("calling to BattleRoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BattleRoomCreate, rv.Len())
+	builders := make([]*AuditEventCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BattleRoomCreateBulk{config: c.config, builders: builders}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BattleRoom.
-func (c *BattleRoomClient) Update() *BattleRoomUpdate {
-	mutation := newBattleRoomMutation(c.config, OpUpdate)
-	return &BattleRoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BattleRoomClient) UpdateOne(_m *BattleRoom) *BattleRoomUpdateOne {
-	mutation := newBattleRoomMutation(c.config, OpUpdateOne, withBattleRoom(_m))
-	return &BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditEventClient) UpdateOne(_m *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(_m))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BattleRoomClient) UpdateOneID(id int) *BattleRoomUpdateOne {
-	mutation := newBattleRoomMutation(c.config, OpUpdateOne, withBattleRoomID(id))
-	return &BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuditEventClient) UpdateOneID(id int) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BattleRoom.
-func (c *BattleRoomClient) Delete() *BattleRoomDelete {
-	mutation := newBattleRoomMutation(c.config, OpDelete)
-	return &BattleRoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BattleRoomClient) DeleteOne(_m *BattleRoom) *BattleRoomDeleteOne {
+func (c *AuditEventClient) DeleteOne(_m *AuditEvent) *AuditEventDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BattleRoomClient) DeleteOneID(id int) *BattleRoomDeleteOne {
-	builder := c.Delete().Where(battleroom.ID(id))
+func (c *AuditEventClient) DeleteOneID(id int) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BattleRoomDeleteOne{builder}
+	return &AuditEventDeleteOne{builder}
 }
 
-// Query returns a query builder for BattleRoom.
-func (c *BattleRoomClient) Query() *BattleRoomQuery {
-	return &BattleRoomQuery{
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBattleRoom},
+		ctx:    &QueryContext{Type: TypeAuditEvent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BattleRoom entity by its id.
-func (c *BattleRoomClient) Get(ctx context.Context, id int) (*BattleRoom, error) {
-	return c.Query().Where(battleroom.ID(id)).Only(ctx)
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id int) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BattleRoomClient) GetX(ctx context.Context, id int) *BattleRoom {
+func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1341,31 +1722,15 @@ func (c *BattleRoomClient) GetX(ctx context.Context, id int) *BattleRoom {
 	return obj
 }
 
-// QueryApp queries the app edge of a BattleRoom.
-func (c *BattleRoomClient) QueryApp(_m *BattleRoom) *AppQuery {
+// QueryApp queries the app edge of a AuditEvent.
+func (c *AuditEventClient) QueryApp(_m *AuditEvent) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(battleroom.Table, battleroom.FieldID, id),
+			sqlgraph.From(auditevent.Table, auditevent.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, battleroom.AppTable, battleroom.AppColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryHost queries the host edge of a BattleRoom.
-func (c *BattleRoomClient) QueryHost(_m *BattleRoom) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(battleroom.Table, battleroom.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, battleroom.HostTable, battleroom.HostColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, auditevent.AppTable, auditevent.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1374,131 +1739,131 @@ func (c *BattleRoomClient) QueryHost(_m *BattleRoom) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BattleRoomClient) Hooks() []Hook {
-	return c.hooks.BattleRoom
+func (c *AuditEventClient) Hooks() []Hook {
+	return c.hooks.AuditEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *BattleRoomClient) Interceptors() []Interceptor {
-	return c.inters.BattleRoom
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
 }
 
-func (c *BattleRoomClient) mutate(ctx context.Context, m *BattleRoomMutation) (Value, error) {
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BattleRoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BattleRoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BattleRoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown BattleRoom mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
 	}
 }
 
-// BattleSessionClient is a client for the BattleSession schema.
-type BattleSessionClient struct {
+// AuthSessionClient is a client for the AuthSession schema.
+type AuthSessionClient struct {
 	config
 }
 
-// NewBattleSessionClient returns a client for the BattleSession from the given config.
-func NewBattleSessionClient(c config) *BattleSessionClient {
-	return &BattleSessionClient{config: c}
+// NewAuthSessionClient returns a client for the AuthSession from the given config.
+func NewAuthSessionClient(c config) *AuthSessionClient {
+	return &AuthSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `battlesession.Hooks(f(g(h())))`.
-func (c *BattleSessionClient) Use(hooks ...Hook) {
-	c.hooks.BattleSession = append(c.hooks.BattleSession, hooks...)
+// A call to `Use(f, g, h)` equals to `authsession.Hooks(f(g(h())))`.
+func (c *AuthSessionClient) Use(hooks ...Hook) {
+	c.hooks.AuthSession = append(c.hooks.AuthSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `battlesession.Intercept(f(g(h())))`.
-func (c *BattleSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.BattleSession = append(c.inters.BattleSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `authsession.Intercept(f(g(h())))`.
+func (c *AuthSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuthSession = append(c.inters.AuthSession, interceptors...)
 }
 
-// Create returns a builder for creating a BattleSession entity.
-func (c *BattleSessionClient) Create() *BattleSessionCreate {
-	mutation := newBattleSessionMutation(c.config, OpCreate)
-	return &BattleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a AuthSession entity.
+func (c *AuthSessionClient) Create() *AuthSessionCreate {
+	mutation := newAuthSessionMutation(c.config, OpCreate)
+	return &AuthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of BattleSession entities.
-func (c *BattleSessionClient) CreateBulk(builders ...*BattleSessionCreate) *BattleSessionCreateBulk {
-	return &BattleSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of AuthSession entities.
+func (c *AuthSessionClient) CreateBulk(builders ...*AuthSessionCreate) *AuthSessionCreateBulk {
+	return &AuthSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *BattleSessionClient) MapCreateBulk(slice any, setFunc func(*BattleSessionCreate, int)) *BattleSessionCreateBulk {
+func (c *AuthSessionClient) MapCreateBulk(slice any, setFunc func(*AuthSessionCreate, int)) *AuthSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &BattleSessionCreateBulk{err: fmt.Errorf("calling to BattleSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &AuthSessionCreateBulk{err: fmt.Errorf("calling to AuthSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*BattleSessionCreate, rv.Len())
+	builders := make([]*AuthSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &BattleSessionCreateBulk{config: c.config, builders: builders}
+	return &AuthSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for BattleSession.
-func (c *BattleSessionClient) Update() *BattleSessionUpdate {
-	mutation := newBattleSessionMutation(c.config, OpUpdate)
-	return &BattleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for AuthSession.
+func (c *AuthSessionClient) Update() *AuthSessionUpdate {
+	mutation := newAuthSessionMutation(c.config, OpUpdate)
+	return &AuthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *BattleSessionClient) UpdateOne(_m *BattleSession) *BattleSessionUpdateOne {
-	mutation := newBattleSessionMutation(c.config, OpUpdateOne, withBattleSession(_m))
-	return &BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuthSessionClient) UpdateOne(_m *AuthSession) *AuthSessionUpdateOne {
+	mutation := newAuthSessionMutation(c.config, OpUpdateOne, withAuthSession(_m))
+	return &AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *BattleSessionClient) UpdateOneID(id int) *BattleSessionUpdateOne {
-	mutation := newBattleSessionMutation(c.config, OpUpdateOne, withBattleSessionID(id))
-	return &BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *AuthSessionClient) UpdateOneID(id int) *AuthSessionUpdateOne {
+	mutation := newAuthSessionMutation(c.config, OpUpdateOne, withAuthSessionID(id))
+	return &AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for BattleSession.
-func (c *BattleSessionClient) Delete() *BattleSessionDelete {
-	mutation := newBattleSessionMutation(c.config, OpDelete)
-	return &BattleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for AuthSession.
+func (c *AuthSessionClient) Delete() *AuthSessionDelete {
+	mutation := newAuthSessionMutation(c.config, OpDelete)
+	return &AuthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *BattleSessionClient) DeleteOne(_m *BattleSession) *BattleSessionDeleteOne {
+func (c *AuthSessionClient) DeleteOne(_m *AuthSession) *AuthSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *BattleSessionClient) DeleteOneID(id int) *BattleSessionDeleteOne {
-	builder := c.Delete().Where(battlesession.ID(id))
+func (c *AuthSessionClient) DeleteOneID(id int) *AuthSessionDeleteOne {
+	builder := c.Delete().Where(authsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &BattleSessionDeleteOne{builder}
+	return &AuthSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for BattleSession.
-func (c *BattleSessionClient) Query() *BattleSessionQuery {
-	return &BattleSessionQuery{
+// Query returns a query builder for AuthSession.
+func (c *AuthSessionClient) Query() *AuthSessionQuery {
+	return &AuthSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeBattleSession},
+		ctx:    &QueryContext{Type: TypeAuthSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a BattleSession entity by its id.
-func (c *BattleSessionClient) Get(ctx context.Context, id int) (*BattleSession, error) {
-	return c.Query().Where(battlesession.ID(id)).Only(ctx)
+// Get returns a AuthSession entity by its id.
+func (c *AuthSessionClient) Get(ctx context.Context, id int) (*AuthSession, error) {
+	return c.Query().Where(authsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *BattleSessionClient) GetX(ctx context.Context, id int) *BattleSession {
+func (c *AuthSessionClient) GetX(ctx context.Context, id int) *AuthSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1506,15 +1871,31 @@ func (c *BattleSessionClient) GetX(ctx context.Context, id int) *BattleSession {
 	return obj
 }
 
-// QueryApp queries the app edge of a BattleSession.
-func (c *BattleSessionClient) QueryApp(_m *BattleSession) *AppQuery {
+// QueryUser queries the user edge of a AuthSession.
+func (c *AuthSessionClient) QueryUser(_m *AuthSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(authsession.Table, authsession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, authsession.UserTable, authsession.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApp queries the app edge of a AuthSession.
+func (c *AuthSessionClient) QueryApp(_m *AuthSession) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(battlesession.Table, battlesession.FieldID, id),
+			sqlgraph.From(authsession.Table, authsession.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, battlesession.AppTable, battlesession.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, authsession.AppTable, authsession.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1522,15 +1903,15 @@ func (c *BattleSessionClient) QueryApp(_m *BattleSession) *AppQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a BattleSession.
-func (c *BattleSessionClient) QueryUser(_m *BattleSession) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryRefreshTokens queries the refresh_tokens edge of a AuthSession.
+func (c *AuthSessionClient) QueryRefreshTokens(_m *AuthSession) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(battlesession.Table, battlesession.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, battlesession.UserTable, battlesession.UserColumn),
+			sqlgraph.From(authsession.Table, authsession.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, authsession.RefreshTokensTable, authsession.RefreshTokensColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1539,131 +1920,131 @@ func (c *BattleSessionClient) QueryUser(_m *BattleSession) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *BattleSessionClient) Hooks() []Hook {
-	return c.hooks.BattleSession
+func (c *AuthSessionClient) Hooks() []Hook {
+	return c.hooks.AuthSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *BattleSessionClient) Interceptors() []Interceptor {
-	return c.inters.BattleSession
+func (c *AuthSessionClient) Interceptors() []Interceptor {
+	return c.inters.AuthSession
 }
 
-func (c *BattleSessionClient) mutate(ctx context.Context, m *BattleSessionMutation) (Value, error) {
+func (c *AuthSessionClient) mutate(ctx context.Context, m *AuthSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&BattleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&BattleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&AuthSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&BattleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&AuthSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown BattleSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown AuthSession mutation op: %q", m.Op())
 	}
 }
 
-// ClassroomClient is a client for the Classroom schema.
-type ClassroomClient struct {
+// BattleRoomClient is a client for the BattleRoom schema.
+type BattleRoomClient struct {
 	config
 }
 
-// NewClassroomClient returns a client for the Classroom from the given config.
-func NewClassroomClient(c config) *ClassroomClient {
-	return &ClassroomClient{config: c}
+// NewBattleRoomClient returns a client for the BattleRoom from the given config.
+func NewBattleRoomClient(c config) *BattleRoomClient {
+	return &BattleRoomClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `classroom.Hooks(f(g(h())))`.
-func (c *ClassroomClient) Use(hooks ...Hook) {
-	c.hooks.Classroom = append(c.hooks.Classroom, hooks...)
+// A call to `Use(f, g, h)` equals to `battleroom.Hooks(f(g(h())))`.
+func (c *BattleRoomClient) Use(hooks ...Hook) {
+	c.hooks.BattleRoom = append(c.hooks.BattleRoom, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `classroom.Intercept(f(g(h())))`.
-func (c *ClassroomClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Classroom = append(c.inters.Classroom, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `battleroom.Intercept(f(g(h())))`.
+func (c *BattleRoomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BattleRoom = append(c.inters.BattleRoom, interceptors...)
 }
 
-// Create returns a builder for creating a Classroom entity.
-func (c *ClassroomClient) Create() *ClassroomCreate {
-	mutation := newClassroomMutation(c.config, OpCreate)
-	return &ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BattleRoom entity.
+func (c *BattleRoomClient) Create() *BattleRoomCreate {
+	mutation := newBattleRoomMutation(c.config, OpCreate)
+	return &BattleRoomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Classroom entities.
-func (c *ClassroomClient) CreateBulk(builders ...*ClassroomCreate) *ClassroomCreateBulk {
-	return &ClassroomCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BattleRoom entities.
+func (c *BattleRoomClient) CreateBulk(builders ...*BattleRoomCreate) *BattleRoomCreateBulk {
+	return &BattleRoomCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ClassroomClient) MapCreateBulk(slice any, setFunc func(*ClassroomCreate, int)) *ClassroomCreateBulk {
+func (c *BattleRoomClient) MapCreateBulk(slice any, setFunc func(*BattleRoomCreate, int)) *BattleRoomCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ClassroomCreateBulk{err: fmt.Errorf("calling to ClassroomClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BattleRoomCreateBulk{err: fmt.Errorf("calling to BattleRoomClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ClassroomCreate, rv.Len())
+	builders := make([]*BattleRoomCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ClassroomCreateBulk{config: c.config, builders: builders}
+	return &BattleRoomCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Classroom.
-func (c *ClassroomClient) Update() *ClassroomUpdate {
-	mutation := newClassroomMutation(c.config, OpUpdate)
-	return &ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BattleRoom.
+func (c *BattleRoomClient) Update() *BattleRoomUpdate {
+	mutation := newBattleRoomMutation(c.config, OpUpdate)
+	return &BattleRoomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ClassroomClient) UpdateOne(_m *Classroom) *ClassroomUpdateOne {
-	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroom(_m))
-	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BattleRoomClient) UpdateOne(_m *BattleRoom) *BattleRoomUpdateOne {
+	mutation := newBattleRoomMutation(c.config, OpUpdateOne, withBattleRoom(_m))
+	return &BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ClassroomClient) UpdateOneID(id int) *ClassroomUpdateOne {
-	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroomID(id))
-	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BattleRoomClient) UpdateOneID(id int) *BattleRoomUpdateOne {
+	mutation := newBattleRoomMutation(c.config, OpUpdateOne, withBattleRoomID(id))
+	return &BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Classroom.
-func (c *ClassroomClient) Delete() *ClassroomDelete {
-	mutation := newClassroomMutation(c.config, OpDelete)
-	return &ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BattleRoom.
+func (c *BattleRoomClient) Delete() *BattleRoomDelete {
+	mutation := newBattleRoomMutation(c.config, OpDelete)
+	return &BattleRoomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ClassroomClient) DeleteOne(_m *Classroom) *ClassroomDeleteOne {
+func (c *BattleRoomClient) DeleteOne(_m *BattleRoom) *BattleRoomDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ClassroomClient) DeleteOneID(id int) *ClassroomDeleteOne {
-	builder := c.Delete().Where(classroom.ID(id))
+func (c *BattleRoomClient) DeleteOneID(id int) *BattleRoomDeleteOne {
+	builder := c.Delete().Where(battleroom.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ClassroomDeleteOne{builder}
+	return &BattleRoomDeleteOne{builder}
 }
 
-// Query returns a query builder for Classroom.
-func (c *ClassroomClient) Query() *ClassroomQuery {
-	return &ClassroomQuery{
+// Query returns a query builder for BattleRoom.
+func (c *BattleRoomClient) Query() *BattleRoomQuery {
+	return &BattleRoomQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeClassroom},
+		ctx:    &QueryContext{Type: TypeBattleRoom},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Classroom entity by its id.
-func (c *ClassroomClient) Get(ctx context.Context, id int) (*Classroom, error) {
-	return c.Query().Where(classroom.ID(id)).Only(ctx)
+// Get returns a BattleRoom entity by its id.
+func (c *BattleRoomClient) Get(ctx context.Context, id int) (*BattleRoom, error) {
+	return c.Query().Where(battleroom.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ClassroomClient) GetX(ctx context.Context, id int) *Classroom {
+func (c *BattleRoomClient) GetX(ctx context.Context, id int) *BattleRoom {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1671,15 +2052,15 @@ func (c *ClassroomClient) GetX(ctx context.Context, id int) *Classroom {
 	return obj
 }
 
-// QueryApp queries the app edge of a Classroom.
-func (c *ClassroomClient) QueryApp(_m *Classroom) *AppQuery {
+// QueryApp queries the app edge of a BattleRoom.
+func (c *BattleRoomClient) QueryApp(_m *BattleRoom) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.From(battleroom.Table, battleroom.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroom.AppTable, classroom.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, battleroom.AppTable, battleroom.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1687,79 +2068,15 @@ func (c *ClassroomClient) QueryApp(_m *Classroom) *AppQuery {
 	return query
 }
 
-// QueryTeacher queries the teacher edge of a Classroom.
-func (c *ClassroomClient) QueryTeacher(_m *Classroom) *UserQuery {
+// QueryHost queries the host edge of a BattleRoom.
+func (c *BattleRoomClient) QueryHost(_m *BattleRoom) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.From(battleroom.Table, battleroom.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroom.TeacherTable, classroom.TeacherColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryMemberships queries the memberships edge of a Classroom.
-func (c *ClassroomClient) QueryMemberships(_m *Classroom) *ClassroomMembershipQuery {
-	query := (&ClassroomMembershipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
-			sqlgraph.To(classroommembership.Table, classroommembership.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, classroom.MembershipsTable, classroom.MembershipsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryAssignments queries the assignments edge of a Classroom.
-func (c *ClassroomClient) QueryAssignments(_m *Classroom) *AssignmentQuery {
-	query := (&AssignmentClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
-			sqlgraph.To(assignment.Table, assignment.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, classroom.AssignmentsTable, classroom.AssignmentsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryLiveSessions queries the live_sessions edge of a Classroom.
-func (c *ClassroomClient) QueryLiveSessions(_m *Classroom) *LiveSessionQuery {
-	query := (&LiveSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
-			sqlgraph.To(livesession.Table, livesession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, classroom.LiveSessionsTable, classroom.LiveSessionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryClassroomSessions queries the classroom_sessions edge of a Classroom.
-func (c *ClassroomClient) QueryClassroomSessions(_m *Classroom) *ClassroomSessionQuery {
-	query := (&ClassroomSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(classroom.Table, classroom.FieldID, id),
-			sqlgraph.To(classroomsession.Table, classroomsession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, classroom.ClassroomSessionsTable, classroom.ClassroomSessionsColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, battleroom.HostTable, battleroom.HostColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1768,131 +2085,131 @@ func (c *ClassroomClient) QueryClassroomSessions(_m *Classroom) *ClassroomSessio
 }
 
 // Hooks returns the client hooks.
-func (c *ClassroomClient) Hooks() []Hook {
-	return c.hooks.Classroom
+func (c *BattleRoomClient) Hooks() []Hook {
+	return c.hooks.BattleRoom
 }
 
 // Interceptors returns the client interceptors.
-func (c *ClassroomClient) Interceptors() []Interceptor {
-	return c.inters.Classroom
+func (c *BattleRoomClient) Interceptors() []Interceptor {
+	return c.inters.BattleRoom
 }
 
-func (c *ClassroomClient) mutate(ctx context.Context, m *ClassroomMutation) (Value, error) {
+func (c *BattleRoomClient) mutate(ctx context.Context, m *BattleRoomMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleRoomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleRoomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleRoomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BattleRoomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Classroom mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BattleRoom mutation op: %q", m.Op())
 	}
 }
 
-// ClassroomMembershipClient is a client for the ClassroomMembership schema.
-type ClassroomMembershipClient struct {
+// BattleSessionClient is a client for the BattleSession schema.
+type BattleSessionClient struct {
 	config
 }
 
-// NewClassroomMembershipClient returns a client for the ClassroomMembership from the given config.
-func NewClassroomMembershipClient(c config) *ClassroomMembershipClient {
-	return &ClassroomMembershipClient{config: c}
+// NewBattleSessionClient returns a client for the BattleSession from the given config.
+func NewBattleSessionClient(c config) *BattleSessionClient {
+	return &BattleSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `classroommembership.Hooks(f(g(h())))`.
-func (c *ClassroomMembershipClient) Use(hooks ...Hook) {
-	c.hooks.ClassroomMembership = append(c.hooks.ClassroomMembership, hooks...)
+// A call to `Use(f, g, h)` equals to `battlesession.Hooks(f(g(h())))`.
+func (c *BattleSessionClient) Use(hooks ...Hook) {
+	c.hooks.BattleSession = append(c.hooks.BattleSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `classroommembership.Intercept(f(g(h())))`.
-func (c *ClassroomMembershipClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ClassroomMembership = append(c.inters.ClassroomMembership, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `battlesession.Intercept(f(g(h())))`.
+func (c *BattleSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BattleSession = append(c.inters.BattleSession, interceptors...)
 }
 
-// Create returns a builder for creating a ClassroomMembership entity.
-func (c *ClassroomMembershipClient) Create() *ClassroomMembershipCreate {
-	mutation := newClassroomMembershipMutation(c.config, OpCreate)
-	return &ClassroomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a BattleSession entity.
+func (c *BattleSessionClient) Create() *BattleSessionCreate {
+	mutation := newBattleSessionMutation(c.config, OpCreate)
+	return &BattleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ClassroomMembership entities.
-func (c *ClassroomMembershipClient) CreateBulk(builders ...*ClassroomMembershipCreate) *ClassroomMembershipCreateBulk {
-	return &ClassroomMembershipCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of BattleSession entities.
+func (c *BattleSessionClient) CreateBulk(builders ...*BattleSessionCreate) *BattleSessionCreateBulk {
+	return &BattleSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ClassroomMembershipClient) MapCreateBulk(slice any, setFunc func(*ClassroomMembershipCreate, int)) *ClassroomMembershipCreateBulk {
+func (c *BattleSessionClient) MapCreateBulk(slice any, setFunc func(*BattleSessionCreate, int)) *BattleSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ClassroomMembershipCreateBulk{err: fmt.Errorf("calling to ClassroomMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &BattleSessionCreateBulk{err: fmt.Errorf("calling to BattleSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ClassroomMembershipCreate, rv.Len())
+	builders := make([]*BattleSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ClassroomMembershipCreateBulk{config: c.config, builders: builders}
+	return &BattleSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ClassroomMembership.
-func (c *ClassroomMembershipClient) Update() *ClassroomMembershipUpdate {
-	mutation := newClassroomMembershipMutation(c.config, OpUpdate)
-	return &ClassroomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for BattleSession.
+func (c *BattleSessionClient) Update() *BattleSessionUpdate {
+	mutation := newBattleSessionMutation(c.config, OpUpdate)
+	return &BattleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ClassroomMembershipClient) UpdateOne(_m *ClassroomMembership) *ClassroomMembershipUpdateOne {
-	mutation := newClassroomMembershipMutation(c.config, OpUpdateOne, withClassroomMembership(_m))
-	return &ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BattleSessionClient) UpdateOne(_m *BattleSession) *BattleSessionUpdateOne {
+	mutation := newBattleSessionMutation(c.config, OpUpdateOne, withBattleSession(_m))
+	return &BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ClassroomMembershipClient) UpdateOneID(id int) *ClassroomMembershipUpdateOne {
-	mutation := newClassroomMembershipMutation(c.config, OpUpdateOne, withClassroomMembershipID(id))
-	return &ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *BattleSessionClient) UpdateOneID(id int) *BattleSessionUpdateOne {
+	mutation := newBattleSessionMutation(c.config, OpUpdateOne, withBattleSessionID(id))
+	return &BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ClassroomMembership.
-func (c *ClassroomMembershipClient) Delete() *ClassroomMembershipDelete {
-	mutation := newClassroomMembershipMutation(c.config, OpDelete)
-	return &ClassroomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for BattleSession.
+func (c *BattleSessionClient) Delete() *BattleSessionDelete {
+	mutation := newBattleSessionMutation(c.config, OpDelete)
+	return &BattleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ClassroomMembershipClient) DeleteOne(_m *ClassroomMembership) *ClassroomMembershipDeleteOne {
+func (c *BattleSessionClient) DeleteOne(_m *BattleSession) *BattleSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ClassroomMembershipClient) DeleteOneID(id int) *ClassroomMembershipDeleteOne {
-	builder := c.Delete().Where(classroommembership.ID(id))
+func (c *BattleSessionClient) DeleteOneID(id int) *BattleSessionDeleteOne {
+	builder := c.Delete().Where(battlesession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ClassroomMembershipDeleteOne{builder}
+	return &BattleSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for ClassroomMembership.
-func (c *ClassroomMembershipClient) Query() *ClassroomMembershipQuery {
-	return &ClassroomMembershipQuery{
+// Query returns a query builder for BattleSession.
+func (c *BattleSessionClient) Query() *BattleSessionQuery {
+	return &BattleSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeClassroomMembership},
+		ctx:    &QueryContext{Type: TypeBattleSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ClassroomMembership entity by its id.
-func (c *ClassroomMembershipClient) Get(ctx context.Context, id int) (*ClassroomMembership, error) {
-	return c.Query().Where(classroommembership.ID(id)).Only(ctx)
+// Get returns a BattleSession entity by its id.
+func (c *BattleSessionClient) Get(ctx context.Context, id int) (*BattleSession, error) {
+	return c.Query().Where(battlesession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ClassroomMembershipClient) GetX(ctx context.Context, id int) *ClassroomMembership {
+func (c *BattleSessionClient) GetX(ctx context.Context, id int) *BattleSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -1900,15 +2217,15 @@ func (c *ClassroomMembershipClient) GetX(ctx context.Context, id int) *Classroom
 	return obj
 }
 
-// QueryClassroom queries the classroom edge of a ClassroomMembership.
-func (c *ClassroomMembershipClient) QueryClassroom(_m *ClassroomMembership) *ClassroomQuery {
-	query := (&ClassroomClient{config: c.config}).Query()
+// QueryApp queries the app edge of a BattleSession.
+func (c *BattleSessionClient) QueryApp(_m *BattleSession) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroommembership.Table, classroommembership.FieldID, id),
-			sqlgraph.To(classroom.Table, classroom.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroommembership.ClassroomTable, classroommembership.ClassroomColumn),
+			sqlgraph.From(battlesession.Table, battlesession.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, battlesession.AppTable, battlesession.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1916,15 +2233,15 @@ func (c *ClassroomMembershipClient) QueryClassroom(_m *ClassroomMembership) *Cla
 	return query
 }
 
-// QueryStudent queries the student edge of a ClassroomMembership.
-func (c *ClassroomMembershipClient) QueryStudent(_m *ClassroomMembership) *UserQuery {
+// QueryUser queries the user edge of a BattleSession.
+func (c *BattleSessionClient) QueryUser(_m *BattleSession) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroommembership.Table, classroommembership.FieldID, id),
+			sqlgraph.From(battlesession.Table, battlesession.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroommembership.StudentTable, classroommembership.StudentColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, battlesession.UserTable, battlesession.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1933,131 +2250,131 @@ func (c *ClassroomMembershipClient) QueryStudent(_m *ClassroomMembership) *UserQ
 }
 
 // Hooks returns the client hooks.
-func (c *ClassroomMembershipClient) Hooks() []Hook {
-	return c.hooks.ClassroomMembership
+func (c *BattleSessionClient) Hooks() []Hook {
+	return c.hooks.BattleSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *ClassroomMembershipClient) Interceptors() []Interceptor {
-	return c.inters.ClassroomMembership
+func (c *BattleSessionClient) Interceptors() []Interceptor {
+	return c.inters.BattleSession
 }
 
-func (c *ClassroomMembershipClient) mutate(ctx context.Context, m *ClassroomMembershipMutation) (Value, error) {
+func (c *BattleSessionClient) mutate(ctx context.Context, m *BattleSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ClassroomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ClassroomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&BattleSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ClassroomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&BattleSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ClassroomMembership mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown BattleSession mutation op: %q", m.Op())
 	}
 }
 
-// ClassroomSessionClient is a client for the ClassroomSession schema.
-type ClassroomSessionClient struct {
+// ClassroomClient is a client for the Classroom schema.
+type ClassroomClient struct {
 	config
 }
 
-// NewClassroomSessionClient returns a client for the ClassroomSession from the given config.
-func NewClassroomSessionClient(c config) *ClassroomSessionClient {
-	return &ClassroomSessionClient{config: c}
+// NewClassroomClient returns a client for the Classroom from the given config.
+func NewClassroomClient(c config) *ClassroomClient {
+	return &ClassroomClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `classroomsession.Hooks(f(g(h())))`.
-func (c *ClassroomSessionClient) Use(hooks ...Hook) {
-	c.hooks.ClassroomSession = append(c.hooks.ClassroomSession, hooks...)
+// A call to `Use(f, g, h)` equals to `classroom.Hooks(f(g(h())))`.
+func (c *ClassroomClient) Use(hooks ...Hook) {
+	c.hooks.Classroom = append(c.hooks.Classroom, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `classroomsession.Intercept(f(g(h())))`.
-func (c *ClassroomSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ClassroomSession = append(c.inters.ClassroomSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `classroom.Intercept(f(g(h())))`.
+func (c *ClassroomClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Classroom = append(c.inters.Classroom, interceptors...)
 }
 
-// Create returns a builder for creating a ClassroomSession entity.
-func (c *ClassroomSessionClient) Create() *ClassroomSessionCreate {
-	mutation := newClassroomSessionMutation(c.config, OpCreate)
-	return &ClassroomSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Classroom entity.
+func (c *ClassroomClient) Create() *ClassroomCreate {
+	mutation := newClassroomMutation(c.config, OpCreate)
+	return &ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ClassroomSession entities.
-func (c *ClassroomSessionClient) CreateBulk(builders ...*ClassroomSessionCreate) *ClassroomSessionCreateBulk {
-	return &ClassroomSessionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Classroom entities.
+func (c *ClassroomClient) CreateBulk(builders ...*ClassroomCreate) *ClassroomCreateBulk {
+	return &ClassroomCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ClassroomSessionClient) MapCreateBulk(slice any, setFunc func(*ClassroomSessionCreate, int)) *ClassroomSessionCreateBulk {
+func (c *ClassroomClient) MapCreateBulk(slice any, setFunc func(*ClassroomCreate, int)) *ClassroomCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ClassroomSessionCreateBulk{err: fmt.Errorf("calling to ClassroomSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClassroomCreateBulk{err: fmt.Errorf("calling to ClassroomClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ClassroomSessionCreate, rv.Len())
+	builders := make([]*ClassroomCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ClassroomSessionCreateBulk{config: c.config, builders: builders}
+	return &ClassroomCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ClassroomSession.
-func (c *ClassroomSessionClient) Update() *ClassroomSessionUpdate {
-	mutation := newClassroomSessionMutation(c.config, OpUpdate)
-	return &ClassroomSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Classroom.
+func (c *ClassroomClient) Update() *ClassroomUpdate {
+	mutation := newClassroomMutation(c.config, OpUpdate)
+	return &ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ClassroomSessionClient) UpdateOne(_m *ClassroomSession) *ClassroomSessionUpdateOne {
-	mutation := newClassroomSessionMutation(c.config, OpUpdateOne, withClassroomSession(_m))
-	return &ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomClient) UpdateOne(_m *Classroom) *ClassroomUpdateOne {
+	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroom(_m))
+	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ClassroomSessionClient) UpdateOneID(id int) *ClassroomSessionUpdateOne {
-	mutation := newClassroomSessionMutation(c.config, OpUpdateOne, withClassroomSessionID(id))
-	return &ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomClient) UpdateOneID(id int) *ClassroomUpdateOne {
+	mutation := newClassroomMutation(c.config, OpUpdateOne, withClassroomID(id))
+	return &ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ClassroomSession.
-func (c *ClassroomSessionClient) Delete() *ClassroomSessionDelete {
-	mutation := newClassroomSessionMutation(c.config, OpDelete)
-	return &ClassroomSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Classroom.
+func (c *ClassroomClient) Delete() *ClassroomDelete {
+	mutation := newClassroomMutation(c.config, OpDelete)
+	return &ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ClassroomSessionClient) DeleteOne(_m *ClassroomSession) *ClassroomSessionDeleteOne {
+func (c *ClassroomClient) DeleteOne(_m *Classroom) *ClassroomDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ClassroomSessionClient) DeleteOneID(id int) *ClassroomSessionDeleteOne {
-	builder := c.Delete().Where(classroomsession.ID(id))
+func (c *ClassroomClient) DeleteOneID(id int) *ClassroomDeleteOne {
+	builder := c.Delete().Where(classroom.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ClassroomSessionDeleteOne{builder}
+	return &ClassroomDeleteOne{builder}
 }
 
-// Query returns a query builder for ClassroomSession.
-func (c *ClassroomSessionClient) Query() *ClassroomSessionQuery {
-	return &ClassroomSessionQuery{
+// Query returns a query builder for Classroom.
+func (c *ClassroomClient) Query() *ClassroomQuery {
+	return &ClassroomQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeClassroomSession},
+		ctx:    &QueryContext{Type: TypeClassroom},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ClassroomSession entity by its id.
-func (c *ClassroomSessionClient) Get(ctx context.Context, id int) (*ClassroomSession, error) {
-	return c.Query().Where(classroomsession.ID(id)).Only(ctx)
+// Get returns a Classroom entity by its id.
+func (c *ClassroomClient) Get(ctx context.Context, id int) (*Classroom, error) {
+	return c.Query().Where(classroom.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ClassroomSessionClient) GetX(ctx context.Context, id int) *ClassroomSession {
+func (c *ClassroomClient) GetX(ctx context.Context, id int) *Classroom {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2065,15 +2382,15 @@ func (c *ClassroomSessionClient) GetX(ctx context.Context, id int) *ClassroomSes
 	return obj
 }
 
-// QueryApp queries the app edge of a ClassroomSession.
-func (c *ClassroomSessionClient) QueryApp(_m *ClassroomSession) *AppQuery {
+// QueryApp queries the app edge of a Classroom.
+func (c *ClassroomClient) QueryApp(_m *Classroom) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.AppTable, classroomsession.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroom.AppTable, classroom.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2081,15 +2398,15 @@ func (c *ClassroomSessionClient) QueryApp(_m *ClassroomSession) *AppQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a ClassroomSession.
-func (c *ClassroomSessionClient) QueryUser(_m *ClassroomSession) *UserQuery {
+// QueryTeacher queries the teacher edge of a Classroom.
+func (c *ClassroomClient) QueryTeacher(_m *Classroom) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.UserTable, classroomsession.UserColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroom.TeacherTable, classroom.TeacherColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2097,15 +2414,63 @@ func (c *ClassroomSessionClient) QueryUser(_m *ClassroomSession) *UserQuery {
 	return query
 }
 
-// QueryClassroom queries the classroom edge of a ClassroomSession.
-func (c *ClassroomSessionClient) QueryClassroom(_m *ClassroomSession) *ClassroomQuery {
-	query := (&ClassroomClient{config: c.config}).Query()
+// QueryMemberships queries the memberships edge of a Classroom.
+func (c *ClassroomClient) QueryMemberships(_m *Classroom) *ClassroomMembershipQuery {
+	query := (&ClassroomMembershipClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
-			sqlgraph.To(classroom.Table, classroom.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.ClassroomTable, classroomsession.ClassroomColumn),
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(classroommembership.Table, classroommembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.MembershipsTable, classroom.MembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignments queries the assignments edge of a Classroom.
+func (c *ClassroomClient) QueryAssignments(_m *Classroom) *AssignmentQuery {
+	query := (&AssignmentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(assignment.Table, assignment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.AssignmentsTable, classroom.AssignmentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiveSessions queries the live_sessions edge of a Classroom.
+func (c *ClassroomClient) QueryLiveSessions(_m *Classroom) *LiveSessionQuery {
+	query := (&LiveSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(livesession.Table, livesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.LiveSessionsTable, classroom.LiveSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClassroomSessions queries the classroom_sessions edge of a Classroom.
+func (c *ClassroomClient) QueryClassroomSessions(_m *Classroom) *ClassroomSessionQuery {
+	query := (&ClassroomSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroom.Table, classroom.FieldID, id),
+			sqlgraph.To(classroomsession.Table, classroomsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, classroom.ClassroomSessionsTable, classroom.ClassroomSessionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2114,131 +2479,131 @@ func (c *ClassroomSessionClient) QueryClassroom(_m *ClassroomSession) *Classroom
 }
 
 // Hooks returns the client hooks.
-func (c *ClassroomSessionClient) Hooks() []Hook {
-	return c.hooks.ClassroomSession
+func (c *ClassroomClient) Hooks() []Hook {
+	return c.hooks.Classroom
 }
 
 // Interceptors returns the client interceptors.
-func (c *ClassroomSessionClient) Interceptors() []Interceptor {
-	return c.inters.ClassroomSession
+func (c *ClassroomClient) Interceptors() []Interceptor {
+	return c.inters.Classroom
 }
 
-func (c *ClassroomSessionClient) mutate(ctx context.Context, m *ClassroomSessionMutation) (Value, error) {
+func (c *ClassroomClient) mutate(ctx context.Context, m *ClassroomMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ClassroomSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ClassroomSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ClassroomSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClassroomDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ClassroomSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Classroom mutation op: %q", m.Op())
 	}
 }
 
-// EmailTemplateClient is a client for the EmailTemplate schema.
-type EmailTemplateClient struct {
+// ClassroomMembershipClient is a client for the ClassroomMembership schema.
+type ClassroomMembershipClient struct {
 	config
 }
 
-// NewEmailTemplateClient returns a client for the EmailTemplate from the given config.
-func NewEmailTemplateClient(c config) *EmailTemplateClient {
-	return &EmailTemplateClient{config: c}
+// NewClassroomMembershipClient returns a client for the ClassroomMembership from the given config.
+func NewClassroomMembershipClient(c config) *ClassroomMembershipClient {
+	return &ClassroomMembershipClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `emailtemplate.Hooks(f(g(h())))`.
-func (c *EmailTemplateClient) Use(hooks ...Hook) {
-	c.hooks.EmailTemplate = append(c.hooks.EmailTemplate, hooks...)
+// A call to `Use(f, g, h)` equals to `classroommembership.Hooks(f(g(h())))`.
+func (c *ClassroomMembershipClient) Use(hooks ...Hook) {
+	c.hooks.ClassroomMembership = append(c.hooks.ClassroomMembership, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `emailtemplate.Intercept(f(g(h())))`.
-func (c *EmailTemplateClient) Intercept(interceptors ...Interceptor) {
-	c.inters.EmailTemplate = append(c.inters.EmailTemplate, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `classroommembership.Intercept(f(g(h())))`.
+func (c *ClassroomMembershipClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClassroomMembership = append(c.inters.ClassroomMembership, interceptors...)
 }
 
-// Create returns a builder for creating a EmailTemplate entity.
-func (c *EmailTemplateClient) Create() *EmailTemplateCreate {
-	mutation := newEmailTemplateMutation(c.config, OpCreate)
-	return &EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ClassroomMembership entity.
+func (c *ClassroomMembershipClient) Create() *ClassroomMembershipCreate {
+	mutation := newClassroomMembershipMutation(c.config, OpCreate)
+	return &ClassroomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of EmailTemplate entities.
-func (c *EmailTemplateClient) CreateBulk(builders ...*EmailTemplateCreate) *EmailTemplateCreateBulk {
-	return &EmailTemplateCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ClassroomMembership entities.
+func (c *ClassroomMembershipClient) CreateBulk(builders ...*ClassroomMembershipCreate) *ClassroomMembershipCreateBulk {
+	return &ClassroomMembershipCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *EmailTemplateClient) MapCreateBulk(slice any, setFunc func(*EmailTemplateCreate, int)) *EmailTemplateCreateBulk {
+func (c *ClassroomMembershipClient) MapCreateBulk(slice any, setFunc func(*ClassroomMembershipCreate, int)) *ClassroomMembershipCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &EmailTemplateCreateBulk{err: fmt.Errorf("calling to EmailTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClassroomMembershipCreateBulk{err: fmt.Errorf("calling to ClassroomMembershipClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*EmailTemplateCreate, rv.Len())
+	builders := make([]*ClassroomMembershipCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &EmailTemplateCreateBulk{config: c.config, builders: builders}
+	return &ClassroomMembershipCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for EmailTemplate.
-func (c *EmailTemplateClient) Update() *EmailTemplateUpdate {
-	mutation := newEmailTemplateMutation(c.config, OpUpdate)
-	return &EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ClassroomMembership.
+func (c *ClassroomMembershipClient) Update() *ClassroomMembershipUpdate {
+	mutation := newClassroomMembershipMutation(c.config, OpUpdate)
+	return &ClassroomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *EmailTemplateClient) UpdateOne(_m *EmailTemplate) *EmailTemplateUpdateOne {
-	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplate(_m))
-	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomMembershipClient) UpdateOne(_m *ClassroomMembership) *ClassroomMembershipUpdateOne {
+	mutation := newClassroomMembershipMutation(c.config, OpUpdateOne, withClassroomMembership(_m))
+	return &ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *EmailTemplateClient) UpdateOneID(id int) *EmailTemplateUpdateOne {
-	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplateID(id))
-	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomMembershipClient) UpdateOneID(id int) *ClassroomMembershipUpdateOne {
+	mutation := newClassroomMembershipMutation(c.config, OpUpdateOne, withClassroomMembershipID(id))
+	return &ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for EmailTemplate.
-func (c *EmailTemplateClient) Delete() *EmailTemplateDelete {
-	mutation := newEmailTemplateMutation(c.config, OpDelete)
-	return &EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClassroomMembership.
+func (c *ClassroomMembershipClient) Delete() *ClassroomMembershipDelete {
+	mutation := newClassroomMembershipMutation(c.config, OpDelete)
+	return &ClassroomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *EmailTemplateClient) DeleteOne(_m *EmailTemplate) *EmailTemplateDeleteOne {
+func (c *ClassroomMembershipClient) DeleteOne(_m *ClassroomMembership) *ClassroomMembershipDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *EmailTemplateClient) DeleteOneID(id int) *EmailTemplateDeleteOne {
-	builder := c.Delete().Where(emailtemplate.ID(id))
+func (c *ClassroomMembershipClient) DeleteOneID(id int) *ClassroomMembershipDeleteOne {
+	builder := c.Delete().Where(classroommembership.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &EmailTemplateDeleteOne{builder}
+	return &ClassroomMembershipDeleteOne{builder}
 }
 
-// Query returns a query builder for EmailTemplate.
-func (c *EmailTemplateClient) Query() *EmailTemplateQuery {
-	return &EmailTemplateQuery{
+// Query returns a query builder for ClassroomMembership.
+func (c *ClassroomMembershipClient) Query() *ClassroomMembershipQuery {
+	return &ClassroomMembershipQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeEmailTemplate},
+		ctx:    &QueryContext{Type: TypeClassroomMembership},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a EmailTemplate entity by its id.
-func (c *EmailTemplateClient) Get(ctx context.Context, id int) (*EmailTemplate, error) {
-	return c.Query().Where(emailtemplate.ID(id)).Only(ctx)
+// Get returns a ClassroomMembership entity by its id.
+func (c *ClassroomMembershipClient) Get(ctx context.Context, id int) (*ClassroomMembership, error) {
+	return c.Query().Where(classroommembership.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *EmailTemplateClient) GetX(ctx context.Context, id int) *EmailTemplate {
+func (c *ClassroomMembershipClient) GetX(ctx context.Context, id int) *ClassroomMembership {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2246,15 +2611,31 @@ func (c *EmailTemplateClient) GetX(ctx context.Context, id int) *EmailTemplate {
 	return obj
 }
 
-// QueryApp queries the app edge of a EmailTemplate.
-func (c *EmailTemplateClient) QueryApp(_m *EmailTemplate) *AppQuery {
-	query := (&AppClient{config: c.config}).Query()
+// QueryClassroom queries the classroom edge of a ClassroomMembership.
+func (c *ClassroomMembershipClient) QueryClassroom(_m *ClassroomMembership) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(emailtemplate.Table, emailtemplate.FieldID, id),
-			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, emailtemplate.AppTable, emailtemplate.AppColumn),
+			sqlgraph.From(classroommembership.Table, classroommembership.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroommembership.ClassroomTable, classroommembership.ClassroomColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStudent queries the student edge of a ClassroomMembership.
+func (c *ClassroomMembershipClient) QueryStudent(_m *ClassroomMembership) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(classroommembership.Table, classroommembership.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroommembership.StudentTable, classroommembership.StudentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2263,131 +2644,131 @@ func (c *EmailTemplateClient) QueryApp(_m *EmailTemplate) *AppQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *EmailTemplateClient) Hooks() []Hook {
-	return c.hooks.EmailTemplate
+func (c *ClassroomMembershipClient) Hooks() []Hook {
+	return c.hooks.ClassroomMembership
 }
 
 // Interceptors returns the client interceptors.
-func (c *EmailTemplateClient) Interceptors() []Interceptor {
-	return c.inters.EmailTemplate
+func (c *ClassroomMembershipClient) Interceptors() []Interceptor {
+	return c.inters.ClassroomMembership
 }
 
-func (c *EmailTemplateClient) mutate(ctx context.Context, m *EmailTemplateMutation) (Value, error) {
+func (c *ClassroomMembershipClient) mutate(ctx context.Context, m *ClassroomMembershipMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomMembershipCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomMembershipUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomMembershipUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClassroomMembershipDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown EmailTemplate mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClassroomMembership mutation op: %q", m.Op())
 	}
 }
 
-// LiveSessionClient is a client for the LiveSession schema.
-type LiveSessionClient struct {
+// ClassroomSessionClient is a client for the ClassroomSession schema.
+type ClassroomSessionClient struct {
 	config
 }
 
-// NewLiveSessionClient returns a client for the LiveSession from the given config.
-func NewLiveSessionClient(c config) *LiveSessionClient {
-	return &LiveSessionClient{config: c}
+// NewClassroomSessionClient returns a client for the ClassroomSession from the given config.
+func NewClassroomSessionClient(c config) *ClassroomSessionClient {
+	return &ClassroomSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `livesession.Hooks(f(g(h())))`.
-func (c *LiveSessionClient) Use(hooks ...Hook) {
-	c.hooks.LiveSession = append(c.hooks.LiveSession, hooks...)
+// A call to `Use(f, g, h)` equals to `classroomsession.Hooks(f(g(h())))`.
+func (c *ClassroomSessionClient) Use(hooks ...Hook) {
+	c.hooks.ClassroomSession = append(c.hooks.ClassroomSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `livesession.Intercept(f(g(h())))`.
-func (c *LiveSessionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LiveSession = append(c.inters.LiveSession, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `classroomsession.Intercept(f(g(h())))`.
+func (c *ClassroomSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ClassroomSession = append(c.inters.ClassroomSession, interceptors...)
 }
 
-// Create returns a builder for creating a LiveSession entity.
-func (c *LiveSessionClient) Create() *LiveSessionCreate {
-	mutation := newLiveSessionMutation(c.config, OpCreate)
-	return &LiveSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ClassroomSession entity.
+func (c *ClassroomSessionClient) Create() *ClassroomSessionCreate {
+	mutation := newClassroomSessionMutation(c.config, OpCreate)
+	return &ClassroomSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LiveSession entities.
-func (c *LiveSessionClient) CreateBulk(builders ...*LiveSessionCreate) *LiveSessionCreateBulk {
-	return &LiveSessionCreateBulk{config: c.config, builders: builders}
-}
+// CreateBulk returns a builder for creating a bulk of ClassroomSession entities.
+func (c *ClassroomSessionClient) CreateBulk(builders ...*ClassroomSessionCreate) *ClassroomSessionCreateBulk {
+	return &ClassroomSessionCreateBulk{config: c.config, builders: builders}
+}
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LiveSessionClient) MapCreateBulk(slice any, setFunc func(*LiveSessionCreate, int)) *LiveSessionCreateBulk {
+func (c *ClassroomSessionClient) MapCreateBulk(slice any, setFunc func(*ClassroomSessionCreate, int)) *ClassroomSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LiveSessionCreateBulk{err: fmt.Errorf("calling to LiveSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ClassroomSessionCreateBulk{err: fmt.Errorf("calling to ClassroomSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LiveSessionCreate, rv.Len())
+	builders := make([]*ClassroomSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LiveSessionCreateBulk{config: c.config, builders: builders}
+	return &ClassroomSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LiveSession.
-func (c *LiveSessionClient) Update() *LiveSessionUpdate {
-	mutation := newLiveSessionMutation(c.config, OpUpdate)
-	return &LiveSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ClassroomSession.
+func (c *ClassroomSessionClient) Update() *ClassroomSessionUpdate {
+	mutation := newClassroomSessionMutation(c.config, OpUpdate)
+	return &ClassroomSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LiveSessionClient) UpdateOne(_m *LiveSession) *LiveSessionUpdateOne {
-	mutation := newLiveSessionMutation(c.config, OpUpdateOne, withLiveSession(_m))
-	return &LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomSessionClient) UpdateOne(_m *ClassroomSession) *ClassroomSessionUpdateOne {
+	mutation := newClassroomSessionMutation(c.config, OpUpdateOne, withClassroomSession(_m))
+	return &ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LiveSessionClient) UpdateOneID(id int) *LiveSessionUpdateOne {
-	mutation := newLiveSessionMutation(c.config, OpUpdateOne, withLiveSessionID(id))
-	return &LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ClassroomSessionClient) UpdateOneID(id int) *ClassroomSessionUpdateOne {
+	mutation := newClassroomSessionMutation(c.config, OpUpdateOne, withClassroomSessionID(id))
+	return &ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LiveSession.
-func (c *LiveSessionClient) Delete() *LiveSessionDelete {
-	mutation := newLiveSessionMutation(c.config, OpDelete)
-	return &LiveSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ClassroomSession.
+func (c *ClassroomSessionClient) Delete() *ClassroomSessionDelete {
+	mutation := newClassroomSessionMutation(c.config, OpDelete)
+	return &ClassroomSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LiveSessionClient) DeleteOne(_m *LiveSession) *LiveSessionDeleteOne {
+func (c *ClassroomSessionClient) DeleteOne(_m *ClassroomSession) *ClassroomSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LiveSessionClient) DeleteOneID(id int) *LiveSessionDeleteOne {
-	builder := c.Delete().Where(livesession.ID(id))
+func (c *ClassroomSessionClient) DeleteOneID(id int) *ClassroomSessionDeleteOne {
+	builder := c.Delete().Where(classroomsession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LiveSessionDeleteOne{builder}
+	return &ClassroomSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for LiveSession.
-func (c *LiveSessionClient) Query() *LiveSessionQuery {
-	return &LiveSessionQuery{
+// Query returns a query builder for ClassroomSession.
+func (c *ClassroomSessionClient) Query() *ClassroomSessionQuery {
+	return &ClassroomSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLiveSession},
+		ctx:    &QueryContext{Type: TypeClassroomSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LiveSession entity by its id.
-func (c *LiveSessionClient) Get(ctx context.Context, id int) (*LiveSession, error) {
-	return c.Query().Where(livesession.ID(id)).Only(ctx)
+// Get returns a ClassroomSession entity by its id.
+func (c *ClassroomSessionClient) Get(ctx context.Context, id int) (*ClassroomSession, error) {
+	return c.Query().Where(classroomsession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LiveSessionClient) GetX(ctx context.Context, id int) *LiveSession {
+func (c *ClassroomSessionClient) GetX(ctx context.Context, id int) *ClassroomSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2395,31 +2776,15 @@ func (c *LiveSessionClient) GetX(ctx context.Context, id int) *LiveSession {
 	return obj
 }
 
-// QueryApp queries the app edge of a LiveSession.
-func (c *LiveSessionClient) QueryApp(_m *LiveSession) *AppQuery {
+// QueryApp queries the app edge of a ClassroomSession.
+func (c *ClassroomSessionClient) QueryApp(_m *ClassroomSession) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(livesession.Table, livesession.FieldID, id),
+			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livesession.AppTable, livesession.AppColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryClassroom queries the classroom edge of a LiveSession.
-func (c *LiveSessionClient) QueryClassroom(_m *LiveSession) *ClassroomQuery {
-	query := (&ClassroomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(livesession.Table, livesession.FieldID, id),
-			sqlgraph.To(classroom.Table, classroom.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livesession.ClassroomTable, livesession.ClassroomColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.AppTable, classroomsession.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2427,15 +2792,15 @@ func (c *LiveSessionClient) QueryClassroom(_m *LiveSession) *ClassroomQuery {
 	return query
 }
 
-// QueryTeacher queries the teacher edge of a LiveSession.
-func (c *LiveSessionClient) QueryTeacher(_m *LiveSession) *UserQuery {
+// QueryUser queries the user edge of a ClassroomSession.
+func (c *ClassroomSessionClient) QueryUser(_m *ClassroomSession) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(livesession.Table, livesession.FieldID, id),
+			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livesession.TeacherTable, livesession.TeacherColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.UserTable, classroomsession.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2443,15 +2808,15 @@ func (c *LiveSessionClient) QueryTeacher(_m *LiveSession) *UserQuery {
 	return query
 }
 
-// QueryStudents queries the students edge of a LiveSession.
-func (c *LiveSessionClient) QueryStudents(_m *LiveSession) *LiveSessionStudentQuery {
-	query := (&LiveSessionStudentClient{config: c.config}).Query()
+// QueryClassroom queries the classroom edge of a ClassroomSession.
+func (c *ClassroomSessionClient) QueryClassroom(_m *ClassroomSession) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(livesession.Table, livesession.FieldID, id),
-			sqlgraph.To(livesessionstudent.Table, livesessionstudent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, livesession.StudentsTable, livesession.StudentsColumn),
+			sqlgraph.From(classroomsession.Table, classroomsession.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, classroomsession.ClassroomTable, classroomsession.ClassroomColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2460,131 +2825,131 @@ func (c *LiveSessionClient) QueryStudents(_m *LiveSession) *LiveSessionStudentQu
 }
 
 // Hooks returns the client hooks.
-func (c *LiveSessionClient) Hooks() []Hook {
-	return c.hooks.LiveSession
+func (c *ClassroomSessionClient) Hooks() []Hook {
+	return c.hooks.ClassroomSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *LiveSessionClient) Interceptors() []Interceptor {
-	return c.inters.LiveSession
+func (c *ClassroomSessionClient) Interceptors() []Interceptor {
+	return c.inters.ClassroomSession
 }
 
-func (c *LiveSessionClient) mutate(ctx context.Context, m *LiveSessionMutation) (Value, error) {
+func (c *ClassroomSessionClient) mutate(ctx context.Context, m *ClassroomSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LiveSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LiveSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&ClassroomSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LiveSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&ClassroomSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LiveSession mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown ClassroomSession mutation op: %q", m.Op())
 	}
 }
 
-// LiveSessionStudentClient is a client for the LiveSessionStudent schema.
-type LiveSessionStudentClient struct {
+// EmailTemplateClient is a client for the EmailTemplate schema.
+type EmailTemplateClient struct {
 	config
 }
 
-// NewLiveSessionStudentClient returns a client for the LiveSessionStudent from the given config.
-func NewLiveSessionStudentClient(c config) *LiveSessionStudentClient {
-	return &LiveSessionStudentClient{config: c}
+// NewEmailTemplateClient returns a client for the EmailTemplate from the given config.
+func NewEmailTemplateClient(c config) *EmailTemplateClient {
+	return &EmailTemplateClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `livesessionstudent.Hooks(f(g(h())))`.
-func (c *LiveSessionStudentClient) Use(hooks ...Hook) {
-	c.hooks.LiveSessionStudent = append(c.hooks.LiveSessionStudent, hooks...)
+// A call to `Use(f, g, h)` equals to `emailtemplate.Hooks(f(g(h())))`.
+func (c *EmailTemplateClient) Use(hooks ...Hook) {
+	c.hooks.EmailTemplate = append(c.hooks.EmailTemplate, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `livesessionstudent.Intercept(f(g(h())))`.
-func (c *LiveSessionStudentClient) Intercept(interceptors ...Interceptor) {
-	c.inters.LiveSessionStudent = append(c.inters.LiveSessionStudent, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `emailtemplate.Intercept(f(g(h())))`.
+func (c *EmailTemplateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EmailTemplate = append(c.inters.EmailTemplate, interceptors...)
 }
 
-// Create returns a builder for creating a LiveSessionStudent entity.
-func (c *LiveSessionStudentClient) Create() *LiveSessionStudentCreate {
-	mutation := newLiveSessionStudentMutation(c.config, OpCreate)
-	return &LiveSessionStudentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a EmailTemplate entity.
+func (c *EmailTemplateClient) Create() *EmailTemplateCreate {
+	mutation := newEmailTemplateMutation(c.config, OpCreate)
+	return &EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of LiveSessionStudent entities.
-func (c *LiveSessionStudentClient) CreateBulk(builders ...*LiveSessionStudentCreate) *LiveSessionStudentCreateBulk {
-	return &LiveSessionStudentCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of EmailTemplate entities.
+func (c *EmailTemplateClient) CreateBulk(builders ...*EmailTemplateCreate) *EmailTemplateCreateBulk {
+	return &EmailTemplateCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *LiveSessionStudentClient) MapCreateBulk(slice any, setFunc func(*LiveSessionStudentCreate, int)) *LiveSessionStudentCreateBulk {
+func (c *EmailTemplateClient) MapCreateBulk(slice any, setFunc func(*EmailTemplateCreate, int)) *EmailTemplateCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &LiveSessionStudentCreateBulk{err: fmt.Errorf("calling to LiveSessionStudentClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &EmailTemplateCreateBulk{err: fmt.Errorf("calling to EmailTemplateClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*LiveSessionStudentCreate, rv.Len())
+	builders := make([]*EmailTemplateCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &LiveSessionStudentCreateBulk{config: c.config, builders: builders}
+	return &EmailTemplateCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for LiveSessionStudent.
-func (c *LiveSessionStudentClient) Update() *LiveSessionStudentUpdate {
-	mutation := newLiveSessionStudentMutation(c.config, OpUpdate)
-	return &LiveSessionStudentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for EmailTemplate.
+func (c *EmailTemplateClient) Update() *EmailTemplateUpdate {
+	mutation := newEmailTemplateMutation(c.config, OpUpdate)
+	return &EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *LiveSessionStudentClient) UpdateOne(_m *LiveSessionStudent) *LiveSessionStudentUpdateOne {
-	mutation := newLiveSessionStudentMutation(c.config, OpUpdateOne, withLiveSessionStudent(_m))
-	return &LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailTemplateClient) UpdateOne(_m *EmailTemplate) *EmailTemplateUpdateOne {
+	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplate(_m))
+	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *LiveSessionStudentClient) UpdateOneID(id int) *LiveSessionStudentUpdateOne {
-	mutation := newLiveSessionStudentMutation(c.config, OpUpdateOne, withLiveSessionStudentID(id))
-	return &LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *EmailTemplateClient) UpdateOneID(id int) *EmailTemplateUpdateOne {
+	mutation := newEmailTemplateMutation(c.config, OpUpdateOne, withEmailTemplateID(id))
+	return &EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for LiveSessionStudent.
-func (c *LiveSessionStudentClient) Delete() *LiveSessionStudentDelete {
-	mutation := newLiveSessionStudentMutation(c.config, OpDelete)
-	return &LiveSessionStudentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for EmailTemplate.
+func (c *EmailTemplateClient) Delete() *EmailTemplateDelete {
+	mutation := newEmailTemplateMutation(c.config, OpDelete)
+	return &EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *LiveSessionStudentClient) DeleteOne(_m *LiveSessionStudent) *LiveSessionStudentDeleteOne {
+func (c *EmailTemplateClient) DeleteOne(_m *EmailTemplate) *EmailTemplateDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *LiveSessionStudentClient) DeleteOneID(id int) *LiveSessionStudentDeleteOne {
-	builder := c.Delete().Where(livesessionstudent.ID(id))
+func (c *EmailTemplateClient) DeleteOneID(id int) *EmailTemplateDeleteOne {
+	builder := c.Delete().Where(emailtemplate.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &LiveSessionStudentDeleteOne{builder}
+	return &EmailTemplateDeleteOne{builder}
 }
 
-// Query returns a query builder for LiveSessionStudent.
-func (c *LiveSessionStudentClient) Query() *LiveSessionStudentQuery {
-	return &LiveSessionStudentQuery{
+// Query returns a query builder for EmailTemplate.
+func (c *EmailTemplateClient) Query() *EmailTemplateQuery {
+	return &EmailTemplateQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeLiveSessionStudent},
+		ctx:    &QueryContext{Type: TypeEmailTemplate},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a LiveSessionStudent entity by its id.
-func (c *LiveSessionStudentClient) Get(ctx context.Context, id int) (*LiveSessionStudent, error) {
-	return c.Query().Where(livesessionstudent.ID(id)).Only(ctx)
+// Get returns a EmailTemplate entity by its id.
+func (c *EmailTemplateClient) Get(ctx context.Context, id int) (*EmailTemplate, error) {
+	return c.Query().Where(emailtemplate.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *LiveSessionStudentClient) GetX(ctx context.Context, id int) *LiveSessionStudent {
+func (c *EmailTemplateClient) GetX(ctx context.Context, id int) *EmailTemplate {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2592,31 +2957,15 @@ func (c *LiveSessionStudentClient) GetX(ctx context.Context, id int) *LiveSessio
 	return obj
 }
 
-// QuerySession queries the session edge of a LiveSessionStudent.
-func (c *LiveSessionStudentClient) QuerySession(_m *LiveSessionStudent) *LiveSessionQuery {
-	query := (&LiveSessionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(livesessionstudent.Table, livesessionstudent.FieldID, id),
-			sqlgraph.To(livesession.Table, livesession.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livesessionstudent.SessionTable, livesessionstudent.SessionColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryStudent queries the student edge of a LiveSessionStudent.
-func (c *LiveSessionStudentClient) QueryStudent(_m *LiveSessionStudent) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryApp queries the app edge of a EmailTemplate.
+func (c *EmailTemplateClient) QueryApp(_m *EmailTemplate) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(livesessionstudent.Table, livesessionstudent.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, livesessionstudent.StudentTable, livesessionstudent.StudentColumn),
+			sqlgraph.From(emailtemplate.Table, emailtemplate.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, emailtemplate.AppTable, emailtemplate.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2625,131 +2974,131 @@ func (c *LiveSessionStudentClient) QueryStudent(_m *LiveSessionStudent) *UserQue
 }
 
 // Hooks returns the client hooks.
-func (c *LiveSessionStudentClient) Hooks() []Hook {
-	return c.hooks.LiveSessionStudent
+func (c *EmailTemplateClient) Hooks() []Hook {
+	return c.hooks.EmailTemplate
 }
 
 // Interceptors returns the client interceptors.
-func (c *LiveSessionStudentClient) Interceptors() []Interceptor {
-	return c.inters.LiveSessionStudent
+func (c *EmailTemplateClient) Interceptors() []Interceptor {
+	return c.inters.EmailTemplate
 }
 
-func (c *LiveSessionStudentClient) mutate(ctx context.Context, m *LiveSessionStudentMutation) (Value, error) {
+func (c *EmailTemplateClient) mutate(ctx context.Context, m *EmailTemplateMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&LiveSessionStudentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailTemplateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&LiveSessionStudentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailTemplateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&EmailTemplateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&LiveSessionStudentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&EmailTemplateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown LiveSessionStudent mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown EmailTemplate mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationClient is a client for the Organization schema.
-type OrganizationClient struct {
+// LiveSessionClient is a client for the LiveSession schema.
+type LiveSessionClient struct {
 	config
 }
 
-// NewOrganizationClient returns a client for the Organization from the given config.
-func NewOrganizationClient(c config) *OrganizationClient {
-	return &OrganizationClient{config: c}
+// NewLiveSessionClient returns a client for the LiveSession from the given config.
+func NewLiveSessionClient(c config) *LiveSessionClient {
+	return &LiveSessionClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
-func (c *OrganizationClient) Use(hooks ...Hook) {
-	c.hooks.Organization = append(c.hooks.Organization, hooks...)
+// A call to `Use(f, g, h)` equals to `livesession.Hooks(f(g(h())))`.
+func (c *LiveSessionClient) Use(hooks ...Hook) {
+	c.hooks.LiveSession = append(c.hooks.LiveSession, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
-func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Organization = append(c.inters.Organization, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `livesession.Intercept(f(g(h())))`.
+func (c *LiveSessionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveSession = append(c.inters.LiveSession, interceptors...)
 }
 
-// Create returns a builder for creating a Organization entity.
-func (c *OrganizationClient) Create() *OrganizationCreate {
-	mutation := newOrganizationMutation(c.config, OpCreate)
-	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LiveSession entity.
+func (c *LiveSessionClient) Create() *LiveSessionCreate {
+	mutation := newLiveSessionMutation(c.config, OpCreate)
+	return &LiveSessionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Organization entities.
-func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LiveSession entities.
+func (c *LiveSessionClient) CreateBulk(builders ...*LiveSessionCreate) *LiveSessionCreateBulk {
+	return &LiveSessionCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
+func (c *LiveSessionClient) MapCreateBulk(slice any, setFunc func(*LiveSessionCreate, int)) *LiveSessionCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LiveSessionCreateBulk{err: fmt.Errorf("calling to LiveSessionClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationCreate, rv.Len())
+	builders := make([]*LiveSessionCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationCreateBulk{config: c.config, builders: builders}
+	return &LiveSessionCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Organization.
-func (c *OrganizationClient) Update() *OrganizationUpdate {
-	mutation := newOrganizationMutation(c.config, OpUpdate)
-	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LiveSession.
+func (c *LiveSessionClient) Update() *LiveSessionUpdate {
+	mutation := newLiveSessionMutation(c.config, OpUpdate)
+	return &LiveSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveSessionClient) UpdateOne(_m *LiveSession) *LiveSessionUpdateOne {
+	mutation := newLiveSessionMutation(c.config, OpUpdateOne, withLiveSession(_m))
+	return &LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
-	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
-	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveSessionClient) UpdateOneID(id int) *LiveSessionUpdateOne {
+	mutation := newLiveSessionMutation(c.config, OpUpdateOne, withLiveSessionID(id))
+	return &LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Organization.
-func (c *OrganizationClient) Delete() *OrganizationDelete {
-	mutation := newOrganizationMutation(c.config, OpDelete)
-	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LiveSession.
+func (c *LiveSessionClient) Delete() *LiveSessionDelete {
+	mutation := newLiveSessionMutation(c.config, OpDelete)
+	return &LiveSessionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
+func (c *LiveSessionClient) DeleteOne(_m *LiveSession) *LiveSessionDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
-	builder := c.Delete().Where(organization.ID(id))
+func (c *LiveSessionClient) DeleteOneID(id int) *LiveSessionDeleteOne {
+	builder := c.Delete().Where(livesession.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationDeleteOne{builder}
+	return &LiveSessionDeleteOne{builder}
 }
 
-// Query returns a query builder for Organization.
-func (c *OrganizationClient) Query() *OrganizationQuery {
-	return &OrganizationQuery{
+// Query returns a query builder for LiveSession.
+func (c *LiveSessionClient) Query() *LiveSessionQuery {
+	return &LiveSessionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganization},
+		ctx:    &QueryContext{Type: TypeLiveSession},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Organization entity by its id.
-func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
-	return c.Query().Where(organization.ID(id)).Only(ctx)
+// Get returns a LiveSession entity by its id.
+func (c *LiveSessionClient) Get(ctx context.Context, id int) (*LiveSession, error) {
+	return c.Query().Where(livesession.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
+func (c *LiveSessionClient) GetX(ctx context.Context, id int) *LiveSession {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2757,15 +3106,15 @@ func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	return obj
 }
 
-// QueryApp queries the app edge of a Organization.
-func (c *OrganizationClient) QueryApp(_m *Organization) *AppQuery {
+// QueryApp queries the app edge of a LiveSession.
+func (c *LiveSessionClient) QueryApp(_m *LiveSession) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.From(livesession.Table, livesession.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organization.AppTable, organization.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, livesession.AppTable, livesession.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2773,15 +3122,15 @@ func (c *OrganizationClient) QueryApp(_m *Organization) *AppQuery {
 	return query
 }
 
-// QueryMembers queries the members edge of a Organization.
-func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQuery {
-	query := (&OrganizationMemberClient{config: c.config}).Query()
+// QueryClassroom queries the classroom edge of a LiveSession.
+func (c *LiveSessionClient) QueryClassroom(_m *LiveSession) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembersTable, organization.MembersColumn),
+			sqlgraph.From(livesession.Table, livesession.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, livesession.ClassroomTable, livesession.ClassroomColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2789,15 +3138,15 @@ func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQ
 	return query
 }
 
-// QueryInvitations queries the invitations edge of a Organization.
-func (c *OrganizationClient) QueryInvitations(_m *Organization) *OrganizationInvitationQuery {
-	query := (&OrganizationInvitationClient{config: c.config}).Query()
+// QueryTeacher queries the teacher edge of a LiveSession.
+func (c *LiveSessionClient) QueryTeacher(_m *LiveSession) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(organizationinvitation.Table, organizationinvitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvitationsTable, organization.InvitationsColumn),
+			sqlgraph.From(livesession.Table, livesession.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, livesession.TeacherTable, livesession.TeacherColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2805,15 +3154,15 @@ func (c *OrganizationClient) QueryInvitations(_m *Organization) *OrganizationInv
 	return query
 }
 
-// QuerySubscriptions queries the subscriptions edge of a Organization.
-func (c *OrganizationClient) QuerySubscriptions(_m *Organization) *SubscriptionQuery {
-	query := (&SubscriptionClient{config: c.config}).Query()
+// QueryStudents queries the students edge of a LiveSession.
+func (c *LiveSessionClient) QueryStudents(_m *LiveSession) *LiveSessionStudentQuery {
+	query := (&LiveSessionStudentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organization.Table, organization.FieldID, id),
-			sqlgraph.To(subscription.Table, subscription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, organization.SubscriptionsTable, organization.SubscriptionsColumn),
+			sqlgraph.From(livesession.Table, livesession.FieldID, id),
+			sqlgraph.To(livesessionstudent.Table, livesessionstudent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, livesession.StudentsTable, livesession.StudentsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2822,131 +3171,131 @@ func (c *OrganizationClient) QuerySubscriptions(_m *Organization) *SubscriptionQ
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationClient) Hooks() []Hook {
-	return c.hooks.Organization
+func (c *LiveSessionClient) Hooks() []Hook {
+	return c.hooks.LiveSession
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationClient) Interceptors() []Interceptor {
-	return c.inters.Organization
+func (c *LiveSessionClient) Interceptors() []Interceptor {
+	return c.inters.LiveSession
 }
 
-func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
+func (c *LiveSessionClient) mutate(ctx context.Context, m *LiveSessionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LiveSessionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LiveSession mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationInvitationClient is a client for the OrganizationInvitation schema.
-type OrganizationInvitationClient struct {
+// LiveSessionStudentClient is a client for the LiveSessionStudent schema.
+type LiveSessionStudentClient struct {
 	config
 }
 
-// NewOrganizationInvitationClient returns a client for the OrganizationInvitation from the given config.
-func NewOrganizationInvitationClient(c config) *OrganizationInvitationClient {
-	return &OrganizationInvitationClient{config: c}
+// NewLiveSessionStudentClient returns a client for the LiveSessionStudent from the given config.
+func NewLiveSessionStudentClient(c config) *LiveSessionStudentClient {
+	return &LiveSessionStudentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationinvitation.Hooks(f(g(h())))`.
-func (c *OrganizationInvitationClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationInvitation = append(c.hooks.OrganizationInvitation, hooks...)
+// A call to `Use(f, g, h)` equals to `livesessionstudent.Hooks(f(g(h())))`.
+func (c *LiveSessionStudentClient) Use(hooks ...Hook) {
+	c.hooks.LiveSessionStudent = append(c.hooks.LiveSessionStudent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationinvitation.Intercept(f(g(h())))`.
-func (c *OrganizationInvitationClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationInvitation = append(c.inters.OrganizationInvitation, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `livesessionstudent.Intercept(f(g(h())))`.
+func (c *LiveSessionStudentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LiveSessionStudent = append(c.inters.LiveSessionStudent, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationInvitation entity.
-func (c *OrganizationInvitationClient) Create() *OrganizationInvitationCreate {
-	mutation := newOrganizationInvitationMutation(c.config, OpCreate)
-	return &OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a LiveSessionStudent entity.
+func (c *LiveSessionStudentClient) Create() *LiveSessionStudentCreate {
+	mutation := newLiveSessionStudentMutation(c.config, OpCreate)
+	return &LiveSessionStudentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationInvitation entities.
-func (c *OrganizationInvitationClient) CreateBulk(builders ...*OrganizationInvitationCreate) *OrganizationInvitationCreateBulk {
-	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of LiveSessionStudent entities.
+func (c *LiveSessionStudentClient) CreateBulk(builders ...*LiveSessionStudentCreate) *LiveSessionStudentCreateBulk {
+	return &LiveSessionStudentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationInvitationClient) MapCreateBulk(slice any, setFunc func(*OrganizationInvitationCreate, int)) *OrganizationInvitationCreateBulk {
+func (c *LiveSessionStudentClient) MapCreateBulk(slice any, setFunc func(*LiveSessionStudentCreate, int)) *LiveSessionStudentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationInvitationCreateBulk{err: fmt.Errorf("calling to OrganizationInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &LiveSessionStudentCreateBulk{err: fmt.Errorf("calling to LiveSessionStudentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationInvitationCreate, rv.Len())
+	builders := make([]*LiveSessionStudentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
+	return &LiveSessionStudentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationInvitation.
-func (c *OrganizationInvitationClient) Update() *OrganizationInvitationUpdate {
-	mutation := newOrganizationInvitationMutation(c.config, OpUpdate)
-	return &OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for LiveSessionStudent.
+func (c *LiveSessionStudentClient) Update() *LiveSessionStudentUpdate {
+	mutation := newLiveSessionStudentMutation(c.config, OpUpdate)
+	return &LiveSessionStudentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationInvitationClient) UpdateOne(_m *OrganizationInvitation) *OrganizationInvitationUpdateOne {
-	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitation(_m))
-	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveSessionStudentClient) UpdateOne(_m *LiveSessionStudent) *LiveSessionStudentUpdateOne {
+	mutation := newLiveSessionStudentMutation(c.config, OpUpdateOne, withLiveSessionStudent(_m))
+	return &LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationInvitationClient) UpdateOneID(id int) *OrganizationInvitationUpdateOne {
-	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitationID(id))
-	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *LiveSessionStudentClient) UpdateOneID(id int) *LiveSessionStudentUpdateOne {
+	mutation := newLiveSessionStudentMutation(c.config, OpUpdateOne, withLiveSessionStudentID(id))
+	return &LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationInvitation.
-func (c *OrganizationInvitationClient) Delete() *OrganizationInvitationDelete {
-	mutation := newOrganizationInvitationMutation(c.config, OpDelete)
-	return &OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for LiveSessionStudent.
+func (c *LiveSessionStudentClient) Delete() *LiveSessionStudentDelete {
+	mutation := newLiveSessionStudentMutation(c.config, OpDelete)
+	return &LiveSessionStudentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationInvitationClient) DeleteOne(_m *OrganizationInvitation) *OrganizationInvitationDeleteOne {
+func (c *LiveSessionStudentClient) DeleteOne(_m *LiveSessionStudent) *LiveSessionStudentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationInvitationClient) DeleteOneID(id int) *OrganizationInvitationDeleteOne {
-	builder := c.Delete().Where(organizationinvitation.ID(id))
+func (c *LiveSessionStudentClient) DeleteOneID(id int) *LiveSessionStudentDeleteOne {
+	builder := c.Delete().Where(livesessionstudent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationInvitationDeleteOne{builder}
+	return &LiveSessionStudentDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationInvitation.
-func (c *OrganizationInvitationClient) Query() *OrganizationInvitationQuery {
-	return &OrganizationInvitationQuery{
+// Query returns a query builder for LiveSessionStudent.
+func (c *LiveSessionStudentClient) Query() *LiveSessionStudentQuery {
+	return &LiveSessionStudentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationInvitation},
+		ctx:    &QueryContext{Type: TypeLiveSessionStudent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationInvitation entity by its id.
-func (c *OrganizationInvitationClient) Get(ctx context.Context, id int) (*OrganizationInvitation, error) {
-	return c.Query().Where(organizationinvitation.ID(id)).Only(ctx)
+// Get returns a LiveSessionStudent entity by its id.
+func (c *LiveSessionStudentClient) Get(ctx context.Context, id int) (*LiveSessionStudent, error) {
+	return c.Query().Where(livesessionstudent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationInvitationClient) GetX(ctx context.Context, id int) *OrganizationInvitation {
+func (c *LiveSessionStudentClient) GetX(ctx context.Context, id int) *LiveSessionStudent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -2954,15 +3303,15 @@ func (c *OrganizationInvitationClient) GetX(ctx context.Context, id int) *Organi
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a OrganizationInvitation.
-func (c *OrganizationInvitationClient) QueryOrganization(_m *OrganizationInvitation) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QuerySession queries the session edge of a LiveSessionStudent.
+func (c *LiveSessionStudentClient) QuerySession(_m *LiveSessionStudent) *LiveSessionQuery {
+	query := (&LiveSessionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationinvitation.OrganizationTable, organizationinvitation.OrganizationColumn),
+			sqlgraph.From(livesessionstudent.Table, livesessionstudent.FieldID, id),
+			sqlgraph.To(livesession.Table, livesession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, livesessionstudent.SessionTable, livesessionstudent.SessionColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2970,15 +3319,15 @@ func (c *OrganizationInvitationClient) QueryOrganization(_m *OrganizationInvitat
 	return query
 }
 
-// QueryInvitedBy queries the invited_by edge of a OrganizationInvitation.
-func (c *OrganizationInvitationClient) QueryInvitedBy(_m *OrganizationInvitation) *UserQuery {
+// QueryStudent queries the student edge of a LiveSessionStudent.
+func (c *LiveSessionStudentClient) QueryStudent(_m *LiveSessionStudent) *UserQuery {
 	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
+			sqlgraph.From(livesessionstudent.Table, livesessionstudent.FieldID, id),
 			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationinvitation.InvitedByTable, organizationinvitation.InvitedByColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, livesessionstudent.StudentTable, livesessionstudent.StudentColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -2987,131 +3336,131 @@ func (c *OrganizationInvitationClient) QueryInvitedBy(_m *OrganizationInvitation
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationInvitationClient) Hooks() []Hook {
-	return c.hooks.OrganizationInvitation
+func (c *LiveSessionStudentClient) Hooks() []Hook {
+	return c.hooks.LiveSessionStudent
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationInvitationClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationInvitation
+func (c *LiveSessionStudentClient) Interceptors() []Interceptor {
+	return c.inters.LiveSessionStudent
 }
 
-func (c *OrganizationInvitationClient) mutate(ctx context.Context, m *OrganizationInvitationMutation) (Value, error) {
+func (c *LiveSessionStudentClient) mutate(ctx context.Context, m *LiveSessionStudentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionStudentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionStudentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&LiveSessionStudentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&LiveSessionStudentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationInvitation mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown LiveSessionStudent mutation op: %q", m.Op())
 	}
 }
 
-// OrganizationMemberClient is a client for the OrganizationMember schema.
-type OrganizationMemberClient struct {
+// OAuthAuthorizationCodeClient is a client for the OAuthAuthorizationCode schema.
+type OAuthAuthorizationCodeClient struct {
 	config
 }
 
-// NewOrganizationMemberClient returns a client for the OrganizationMember from the given config.
-func NewOrganizationMemberClient(c config) *OrganizationMemberClient {
-	return &OrganizationMemberClient{config: c}
+// NewOAuthAuthorizationCodeClient returns a client for the OAuthAuthorizationCode from the given config.
+func NewOAuthAuthorizationCodeClient(c config) *OAuthAuthorizationCodeClient {
+	return &OAuthAuthorizationCodeClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `organizationmember.Hooks(f(g(h())))`.
-func (c *OrganizationMemberClient) Use(hooks ...Hook) {
-	c.hooks.OrganizationMember = append(c.hooks.OrganizationMember, hooks...)
+// A call to `Use(f, g, h)` equals to `oauthauthorizationcode.Hooks(f(g(h())))`.
+func (c *OAuthAuthorizationCodeClient) Use(hooks ...Hook) {
+	c.hooks.OAuthAuthorizationCode = append(c.hooks.OAuthAuthorizationCode, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `organizationmember.Intercept(f(g(h())))`.
-func (c *OrganizationMemberClient) Intercept(interceptors ...Interceptor) {
-	c.inters.OrganizationMember = append(c.inters.OrganizationMember, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `oauthauthorizationcode.Intercept(f(g(h())))`.
+func (c *OAuthAuthorizationCodeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthAuthorizationCode = append(c.inters.OAuthAuthorizationCode, interceptors...)
 }
 
-// Create returns a builder for creating a OrganizationMember entity.
-func (c *OrganizationMemberClient) Create() *OrganizationMemberCreate {
-	mutation := newOrganizationMemberMutation(c.config, OpCreate)
-	return &OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OAuthAuthorizationCode entity.
+func (c *OAuthAuthorizationCodeClient) Create() *OAuthAuthorizationCodeCreate {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpCreate)
+	return &OAuthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of OrganizationMember entities.
-func (c *OrganizationMemberClient) CreateBulk(builders ...*OrganizationMemberCreate) *OrganizationMemberCreateBulk {
-	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OAuthAuthorizationCode entities.
+func (c *OAuthAuthorizationCodeClient) CreateBulk(builders ...*OAuthAuthorizationCodeCreate) *OAuthAuthorizationCodeCreateBulk {
+	return &OAuthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *OrganizationMemberClient) MapCreateBulk(slice any, setFunc func(*OrganizationMemberCreate, int)) *OrganizationMemberCreateBulk {
+func (c *OAuthAuthorizationCodeClient) MapCreateBulk(slice any, setFunc func(*OAuthAuthorizationCodeCreate, int)) *OAuthAuthorizationCodeCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &OrganizationMemberCreateBulk{err: fmt.Errorf("calling to OrganizationMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OAuthAuthorizationCodeCreateBulk{err: fmt.Errorf("calling to OAuthAuthorizationCodeClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*OrganizationMemberCreate, rv.Len())
+	builders := make([]*OAuthAuthorizationCodeCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
+	return &OAuthAuthorizationCodeCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for OrganizationMember.
-func (c *OrganizationMemberClient) Update() *OrganizationMemberUpdate {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdate)
-	return &OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Update() *OAuthAuthorizationCodeUpdate {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdate)
+	return &OAuthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *OrganizationMemberClient) UpdateOne(_m *OrganizationMember) *OrganizationMemberUpdateOne {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMember(_m))
-	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OAuthAuthorizationCodeClient) UpdateOne(_m *OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdateOne {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdateOne, withOAuthAuthorizationCode(_m))
+	return &OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *OrganizationMemberClient) UpdateOneID(id int) *OrganizationMemberUpdateOne {
-	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMemberID(id))
-	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OAuthAuthorizationCodeClient) UpdateOneID(id int) *OAuthAuthorizationCodeUpdateOne {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpUpdateOne, withOAuthAuthorizationCodeID(id))
+	return &OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for OrganizationMember.
-func (c *OrganizationMemberClient) Delete() *OrganizationMemberDelete {
-	mutation := newOrganizationMemberMutation(c.config, OpDelete)
-	return &OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Delete() *OAuthAuthorizationCodeDelete {
+	mutation := newOAuthAuthorizationCodeMutation(c.config, OpDelete)
+	return &OAuthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *OrganizationMemberClient) DeleteOne(_m *OrganizationMember) *OrganizationMemberDeleteOne {
+func (c *OAuthAuthorizationCodeClient) DeleteOne(_m *OAuthAuthorizationCode) *OAuthAuthorizationCodeDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *OrganizationMemberClient) DeleteOneID(id int) *OrganizationMemberDeleteOne {
-	builder := c.Delete().Where(organizationmember.ID(id))
+func (c *OAuthAuthorizationCodeClient) DeleteOneID(id int) *OAuthAuthorizationCodeDeleteOne {
+	builder := c.Delete().Where(oauthauthorizationcode.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &OrganizationMemberDeleteOne{builder}
+	return &OAuthAuthorizationCodeDeleteOne{builder}
 }
 
-// Query returns a query builder for OrganizationMember.
-func (c *OrganizationMemberClient) Query() *OrganizationMemberQuery {
-	return &OrganizationMemberQuery{
+// Query returns a query builder for OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) Query() *OAuthAuthorizationCodeQuery {
+	return &OAuthAuthorizationCodeQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeOrganizationMember},
+		ctx:    &QueryContext{Type: TypeOAuthAuthorizationCode},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a OrganizationMember entity by its id.
-func (c *OrganizationMemberClient) Get(ctx context.Context, id int) (*OrganizationMember, error) {
-	return c.Query().Where(organizationmember.ID(id)).Only(ctx)
+// Get returns a OAuthAuthorizationCode entity by its id.
+func (c *OAuthAuthorizationCodeClient) Get(ctx context.Context, id int) (*OAuthAuthorizationCode, error) {
+	return c.Query().Where(oauthauthorizationcode.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *OrganizationMember {
+func (c *OAuthAuthorizationCodeClient) GetX(ctx context.Context, id int) *OAuthAuthorizationCode {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3119,15 +3468,15 @@ func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *Organizati
 	return obj
 }
 
-// QueryOrganization queries the organization edge of a OrganizationMember.
-func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *OrganizationQuery {
-	query := (&OrganizationClient{config: c.config}).Query()
+// QueryUser queries the user edge of a OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) QueryUser(_m *OAuthAuthorizationCode) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
-			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.OrganizationTable, organizationmember.OrganizationColumn),
+			sqlgraph.From(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthauthorizationcode.UserTable, oauthauthorizationcode.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3135,15 +3484,15 @@ func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *Or
 	return query
 }
 
-// QueryUser queries the user edge of a OrganizationMember.
-func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryApp queries the app edge of a OAuthAuthorizationCode.
+func (c *OAuthAuthorizationCodeClient) QueryApp(_m *OAuthAuthorizationCode) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.UserTable, organizationmember.UserColumn),
+			sqlgraph.From(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthauthorizationcode.AppTable, oauthauthorizationcode.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3152,131 +3501,131 @@ func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery
 }
 
 // Hooks returns the client hooks.
-func (c *OrganizationMemberClient) Hooks() []Hook {
-	return c.hooks.OrganizationMember
+func (c *OAuthAuthorizationCodeClient) Hooks() []Hook {
+	return c.hooks.OAuthAuthorizationCode
 }
 
 // Interceptors returns the client interceptors.
-func (c *OrganizationMemberClient) Interceptors() []Interceptor {
-	return c.inters.OrganizationMember
+func (c *OAuthAuthorizationCodeClient) Interceptors() []Interceptor {
+	return c.inters.OAuthAuthorizationCode
 }
 
-func (c *OrganizationMemberClient) mutate(ctx context.Context, m *OrganizationMemberMutation) (Value, error) {
+func (c *OAuthAuthorizationCodeClient) mutate(ctx context.Context, m *OAuthAuthorizationCodeMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthAuthorizationCodeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthAuthorizationCodeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthAuthorizationCodeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OAuthAuthorizationCodeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown OrganizationMember mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown OAuthAuthorizationCode mutation op: %q", m.Op())
 	}
 }
 
-// PlanClient is a client for the Plan schema.
-type PlanClient struct {
+// OAuthConsentClient is a client for the OAuthConsent schema.
+type OAuthConsentClient struct {
 	config
 }
 
-// NewPlanClient returns a client for the Plan from the given config.
-func NewPlanClient(c config) *PlanClient {
-	return &PlanClient{config: c}
+// NewOAuthConsentClient returns a client for the OAuthConsent from the given config.
+func NewOAuthConsentClient(c config) *OAuthConsentClient {
+	return &OAuthConsentClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
-func (c *PlanClient) Use(hooks ...Hook) {
-	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+// A call to `Use(f, g, h)` equals to `oauthconsent.Hooks(f(g(h())))`.
+func (c *OAuthConsentClient) Use(hooks ...Hook) {
+	c.hooks.OAuthConsent = append(c.hooks.OAuthConsent, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
-func (c *PlanClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Plan = append(c.inters.Plan, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `oauthconsent.Intercept(f(g(h())))`.
+func (c *OAuthConsentClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OAuthConsent = append(c.inters.OAuthConsent, interceptors...)
 }
 
-// Create returns a builder for creating a Plan entity.
-func (c *PlanClient) Create() *PlanCreate {
-	mutation := newPlanMutation(c.config, OpCreate)
-	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OAuthConsent entity.
+func (c *OAuthConsentClient) Create() *OAuthConsentCreate {
+	mutation := newOAuthConsentMutation(c.config, OpCreate)
+	return &OAuthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Plan entities.
-func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
-	return &PlanCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OAuthConsent entities.
+func (c *OAuthConsentClient) CreateBulk(builders ...*OAuthConsentCreate) *OAuthConsentCreateBulk {
+	return &OAuthConsentCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+func (c *OAuthConsentClient) MapCreateBulk(slice any, setFunc func(*OAuthConsentCreate, int)) *OAuthConsentCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OAuthConsentCreateBulk{err: fmt.Errorf("calling to OAuthConsentClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PlanCreate, rv.Len())
+	builders := make([]*OAuthConsentCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PlanCreateBulk{config: c.config, builders: builders}
+	return &OAuthConsentCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Plan.
-func (c *PlanClient) Update() *PlanUpdate {
-	mutation := newPlanMutation(c.config, OpUpdate)
-	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OAuthConsent.
+func (c *OAuthConsentClient) Update() *OAuthConsentUpdate {
+	mutation := newOAuthConsentMutation(c.config, OpUpdate)
+	return &OAuthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PlanClient) UpdateOne(_m *Plan) *PlanUpdateOne {
-	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(_m))
-	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OAuthConsentClient) UpdateOne(_m *OAuthConsent) *OAuthConsentUpdateOne {
+	mutation := newOAuthConsentMutation(c.config, OpUpdateOne, withOAuthConsent(_m))
+	return &OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PlanClient) UpdateOneID(id int) *PlanUpdateOne {
-	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
-	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OAuthConsentClient) UpdateOneID(id int) *OAuthConsentUpdateOne {
+	mutation := newOAuthConsentMutation(c.config, OpUpdateOne, withOAuthConsentID(id))
+	return &OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Plan.
-func (c *PlanClient) Delete() *PlanDelete {
-	mutation := newPlanMutation(c.config, OpDelete)
-	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OAuthConsent.
+func (c *OAuthConsentClient) Delete() *OAuthConsentDelete {
+	mutation := newOAuthConsentMutation(c.config, OpDelete)
+	return &OAuthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PlanClient) DeleteOne(_m *Plan) *PlanDeleteOne {
+func (c *OAuthConsentClient) DeleteOne(_m *OAuthConsent) *OAuthConsentDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PlanClient) DeleteOneID(id int) *PlanDeleteOne {
-	builder := c.Delete().Where(plan.ID(id))
+func (c *OAuthConsentClient) DeleteOneID(id int) *OAuthConsentDeleteOne {
+	builder := c.Delete().Where(oauthconsent.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PlanDeleteOne{builder}
+	return &OAuthConsentDeleteOne{builder}
 }
 
-// Query returns a query builder for Plan.
-func (c *PlanClient) Query() *PlanQuery {
-	return &PlanQuery{
+// Query returns a query builder for OAuthConsent.
+func (c *OAuthConsentClient) Query() *OAuthConsentQuery {
+	return &OAuthConsentQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePlan},
+		ctx:    &QueryContext{Type: TypeOAuthConsent},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Plan entity by its id.
-func (c *PlanClient) Get(ctx context.Context, id int) (*Plan, error) {
-	return c.Query().Where(plan.ID(id)).Only(ctx)
+// Get returns a OAuthConsent entity by its id.
+func (c *OAuthConsentClient) Get(ctx context.Context, id int) (*OAuthConsent, error) {
+	return c.Query().Where(oauthconsent.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PlanClient) GetX(ctx context.Context, id int) *Plan {
+func (c *OAuthConsentClient) GetX(ctx context.Context, id int) *OAuthConsent {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3284,15 +3633,15 @@ func (c *PlanClient) GetX(ctx context.Context, id int) *Plan {
 	return obj
 }
 
-// QueryApp queries the app edge of a Plan.
-func (c *PlanClient) QueryApp(_m *Plan) *AppQuery {
-	query := (&AppClient{config: c.config}).Query()
+// QueryUser queries the user edge of a OAuthConsent.
+func (c *OAuthConsentClient) QueryUser(_m *OAuthConsent) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(plan.Table, plan.FieldID, id),
-			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, plan.AppTable, plan.AppColumn),
+			sqlgraph.From(oauthconsent.Table, oauthconsent.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthconsent.UserTable, oauthconsent.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3300,15 +3649,15 @@ func (c *PlanClient) QueryApp(_m *Plan) *AppQuery {
 	return query
 }
 
-// QuerySubscriptions queries the subscriptions edge of a Plan.
-func (c *PlanClient) QuerySubscriptions(_m *Plan) *SubscriptionQuery {
-	query := (&SubscriptionClient{config: c.config}).Query()
+// QueryApp queries the app edge of a OAuthConsent.
+func (c *OAuthConsentClient) QueryApp(_m *OAuthConsent) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(plan.Table, plan.FieldID, id),
-			sqlgraph.To(subscription.Table, subscription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, plan.SubscriptionsTable, plan.SubscriptionsColumn),
+			sqlgraph.From(oauthconsent.Table, oauthconsent.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, oauthconsent.AppTable, oauthconsent.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3317,131 +3666,131 @@ func (c *PlanClient) QuerySubscriptions(_m *Plan) *SubscriptionQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *PlanClient) Hooks() []Hook {
-	return c.hooks.Plan
+func (c *OAuthConsentClient) Hooks() []Hook {
+	return c.hooks.OAuthConsent
 }
 
 // Interceptors returns the client interceptors.
-func (c *PlanClient) Interceptors() []Interceptor {
-	return c.inters.Plan
+func (c *OAuthConsentClient) Interceptors() []Interceptor {
+	return c.inters.OAuthConsent
 }
 
-func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+func (c *OAuthConsentClient) mutate(ctx context.Context, m *OAuthConsentMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthConsentCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthConsentUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OAuthConsentUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OAuthConsentDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Plan mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown OAuthConsent mutation op: %q", m.Op())
 	}
 }
 
-// ShenbiProfileClient is a client for the ShenbiProfile schema.
-type ShenbiProfileClient struct {
+// OrganizationClient is a client for the Organization schema.
+type OrganizationClient struct {
 	config
 }
 
-// NewShenbiProfileClient returns a client for the ShenbiProfile from the given config.
-func NewShenbiProfileClient(c config) *ShenbiProfileClient {
-	return &ShenbiProfileClient{config: c}
+// NewOrganizationClient returns a client for the Organization from the given config.
+func NewOrganizationClient(c config) *OrganizationClient {
+	return &OrganizationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `shenbiprofile.Hooks(f(g(h())))`.
-func (c *ShenbiProfileClient) Use(hooks ...Hook) {
-	c.hooks.ShenbiProfile = append(c.hooks.ShenbiProfile, hooks...)
+// A call to `Use(f, g, h)` equals to `organization.Hooks(f(g(h())))`.
+func (c *OrganizationClient) Use(hooks ...Hook) {
+	c.hooks.Organization = append(c.hooks.Organization, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `shenbiprofile.Intercept(f(g(h())))`.
-func (c *ShenbiProfileClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ShenbiProfile = append(c.inters.ShenbiProfile, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organization.Intercept(f(g(h())))`.
+func (c *OrganizationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Organization = append(c.inters.Organization, interceptors...)
 }
 
-// Create returns a builder for creating a ShenbiProfile entity.
-func (c *ShenbiProfileClient) Create() *ShenbiProfileCreate {
-	mutation := newShenbiProfileMutation(c.config, OpCreate)
-	return &ShenbiProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a Organization entity.
+func (c *OrganizationClient) Create() *OrganizationCreate {
+	mutation := newOrganizationMutation(c.config, OpCreate)
+	return &OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ShenbiProfile entities.
-func (c *ShenbiProfileClient) CreateBulk(builders ...*ShenbiProfileCreate) *ShenbiProfileCreateBulk {
-	return &ShenbiProfileCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of Organization entities.
+func (c *OrganizationClient) CreateBulk(builders ...*OrganizationCreate) *OrganizationCreateBulk {
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ShenbiProfileClient) MapCreateBulk(slice any, setFunc func(*ShenbiProfileCreate, int)) *ShenbiProfileCreateBulk {
+func (c *OrganizationClient) MapCreateBulk(slice any, setFunc func(*OrganizationCreate, int)) *OrganizationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ShenbiProfileCreateBulk{err: fmt.Errorf("calling to ShenbiProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationCreateBulk{err: fmt.Errorf("calling to OrganizationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ShenbiProfileCreate, rv.Len())
+	builders := make([]*OrganizationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ShenbiProfileCreateBulk{config: c.config, builders: builders}
+	return &OrganizationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ShenbiProfile.
-func (c *ShenbiProfileClient) Update() *ShenbiProfileUpdate {
-	mutation := newShenbiProfileMutation(c.config, OpUpdate)
-	return &ShenbiProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for Organization.
+func (c *OrganizationClient) Update() *OrganizationUpdate {
+	mutation := newOrganizationMutation(c.config, OpUpdate)
+	return &OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ShenbiProfileClient) UpdateOne(_m *ShenbiProfile) *ShenbiProfileUpdateOne {
-	mutation := newShenbiProfileMutation(c.config, OpUpdateOne, withShenbiProfile(_m))
-	return &ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOne(_m *Organization) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganization(_m))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ShenbiProfileClient) UpdateOneID(id int) *ShenbiProfileUpdateOne {
-	mutation := newShenbiProfileMutation(c.config, OpUpdateOne, withShenbiProfileID(id))
-	return &ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationClient) UpdateOneID(id int) *OrganizationUpdateOne {
+	mutation := newOrganizationMutation(c.config, OpUpdateOne, withOrganizationID(id))
+	return &OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ShenbiProfile.
-func (c *ShenbiProfileClient) Delete() *ShenbiProfileDelete {
-	mutation := newShenbiProfileMutation(c.config, OpDelete)
-	return &ShenbiProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for Organization.
+func (c *OrganizationClient) Delete() *OrganizationDelete {
+	mutation := newOrganizationMutation(c.config, OpDelete)
+	return &OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ShenbiProfileClient) DeleteOne(_m *ShenbiProfile) *ShenbiProfileDeleteOne {
+func (c *OrganizationClient) DeleteOne(_m *Organization) *OrganizationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ShenbiProfileClient) DeleteOneID(id int) *ShenbiProfileDeleteOne {
-	builder := c.Delete().Where(shenbiprofile.ID(id))
+func (c *OrganizationClient) DeleteOneID(id int) *OrganizationDeleteOne {
+	builder := c.Delete().Where(organization.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ShenbiProfileDeleteOne{builder}
+	return &OrganizationDeleteOne{builder}
 }
 
-// Query returns a query builder for ShenbiProfile.
-func (c *ShenbiProfileClient) Query() *ShenbiProfileQuery {
-	return &ShenbiProfileQuery{
+// Query returns a query builder for Organization.
+func (c *OrganizationClient) Query() *OrganizationQuery {
+	return &OrganizationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeShenbiProfile},
+		ctx:    &QueryContext{Type: TypeOrganization},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ShenbiProfile entity by its id.
-func (c *ShenbiProfileClient) Get(ctx context.Context, id int) (*ShenbiProfile, error) {
-	return c.Query().Where(shenbiprofile.ID(id)).Only(ctx)
+// Get returns a Organization entity by its id.
+func (c *OrganizationClient) Get(ctx context.Context, id int) (*Organization, error) {
+	return c.Query().Where(organization.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ShenbiProfileClient) GetX(ctx context.Context, id int) *ShenbiProfile {
+func (c *OrganizationClient) GetX(ctx context.Context, id int) *Organization {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3449,15 +3798,15 @@ func (c *ShenbiProfileClient) GetX(ctx context.Context, id int) *ShenbiProfile {
 	return obj
 }
 
-// QueryApp queries the app edge of a ShenbiProfile.
-func (c *ShenbiProfileClient) QueryApp(_m *ShenbiProfile) *AppQuery {
+// QueryApp queries the app edge of a Organization.
+func (c *OrganizationClient) QueryApp(_m *Organization) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(shenbiprofile.Table, shenbiprofile.FieldID, id),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, shenbiprofile.AppTable, shenbiprofile.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, organization.AppTable, organization.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3465,15 +3814,95 @@ func (c *ShenbiProfileClient) QueryApp(_m *ShenbiProfile) *AppQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a ShenbiProfile.
-func (c *ShenbiProfileClient) QueryUser(_m *ShenbiProfile) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryMembers queries the members edge of a Organization.
+func (c *OrganizationClient) QueryMembers(_m *Organization) *OrganizationMemberQuery {
+	query := (&OrganizationMemberClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(shenbiprofile.Table, shenbiprofile.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, shenbiprofile.UserTable, shenbiprofile.UserColumn),
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.MembersTable, organization.MembersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryInvitations queries the invitations edge of a Organization.
+func (c *OrganizationClient) QueryInvitations(_m *Organization) *OrganizationInvitationQuery {
+	query := (&OrganizationInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationinvitation.Table, organizationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.InvitationsTable, organization.InvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscriptions queries the subscriptions edge of a Organization.
+func (c *OrganizationClient) QuerySubscriptions(_m *Organization) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SubscriptionsTable, organization.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySSOConnection queries the sso_connection edge of a Organization.
+func (c *OrganizationClient) QuerySSOConnection(_m *Organization) *SSOConnectionQuery {
+	query := (&SSOConnectionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(ssoconnection.Table, ssoconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, organization.SSOConnectionTable, organization.SSOConnectionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDomains queries the domains edge of a Organization.
+func (c *OrganizationClient) QueryDomains(_m *Organization) *OrganizationDomainQuery {
+	query := (&OrganizationDomainClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(organizationdomain.Table, organizationdomain.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.DomainsTable, organization.DomainsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySSOLogins queries the sso_logins edge of a Organization.
+func (c *OrganizationClient) QuerySSOLogins(_m *Organization) *SSOLoginQuery {
+	query := (&SSOLoginClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(ssologin.Table, ssologin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SSOLoginsTable, organization.SSOLoginsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3482,131 +3911,131 @@ func (c *ShenbiProfileClient) QueryUser(_m *ShenbiProfile) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ShenbiProfileClient) Hooks() []Hook {
-	return c.hooks.ShenbiProfile
+func (c *OrganizationClient) Hooks() []Hook {
+	return c.hooks.Organization
 }
 
 // Interceptors returns the client interceptors.
-func (c *ShenbiProfileClient) Interceptors() []Interceptor {
-	return c.inters.ShenbiProfile
+func (c *OrganizationClient) Interceptors() []Interceptor {
+	return c.inters.Organization
 }
 
-func (c *ShenbiProfileClient) mutate(ctx context.Context, m *ShenbiProfileMutation) (Value, error) {
+func (c *OrganizationClient) mutate(ctx context.Context, m *OrganizationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ShenbiProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ShenbiProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ShenbiProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ShenbiProfile mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown Organization mutation op: %q", m.Op())
 	}
 }
 
-// ShenbiSettingsClient is a client for the ShenbiSettings schema.
-type ShenbiSettingsClient struct {
+// OrganizationDomainClient is a client for the OrganizationDomain schema.
+type OrganizationDomainClient struct {
 	config
 }
 
-// NewShenbiSettingsClient returns a client for the ShenbiSettings from the given config.
-func NewShenbiSettingsClient(c config) *ShenbiSettingsClient {
-	return &ShenbiSettingsClient{config: c}
+// NewOrganizationDomainClient returns a client for the OrganizationDomain from the given config.
+func NewOrganizationDomainClient(c config) *OrganizationDomainClient {
+	return &OrganizationDomainClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `shenbisettings.Hooks(f(g(h())))`.
-func (c *ShenbiSettingsClient) Use(hooks ...Hook) {
-	c.hooks.ShenbiSettings = append(c.hooks.ShenbiSettings, hooks...)
+// A call to `Use(f, g, h)` equals to `organizationdomain.Hooks(f(g(h())))`.
+func (c *OrganizationDomainClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationDomain = append(c.hooks.OrganizationDomain, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `shenbisettings.Intercept(f(g(h())))`.
-func (c *ShenbiSettingsClient) Intercept(interceptors ...Interceptor) {
-	c.inters.ShenbiSettings = append(c.inters.ShenbiSettings, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organizationdomain.Intercept(f(g(h())))`.
+func (c *OrganizationDomainClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationDomain = append(c.inters.OrganizationDomain, interceptors...)
 }
 
-// Create returns a builder for creating a ShenbiSettings entity.
-func (c *ShenbiSettingsClient) Create() *ShenbiSettingsCreate {
-	mutation := newShenbiSettingsMutation(c.config, OpCreate)
-	return &ShenbiSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OrganizationDomain entity.
+func (c *OrganizationDomainClient) Create() *OrganizationDomainCreate {
+	mutation := newOrganizationDomainMutation(c.config, OpCreate)
+	return &OrganizationDomainCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of ShenbiSettings entities.
-func (c *ShenbiSettingsClient) CreateBulk(builders ...*ShenbiSettingsCreate) *ShenbiSettingsCreateBulk {
-	return &ShenbiSettingsCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OrganizationDomain entities.
+func (c *OrganizationDomainClient) CreateBulk(builders ...*OrganizationDomainCreate) *OrganizationDomainCreateBulk {
+	return &OrganizationDomainCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *ShenbiSettingsClient) MapCreateBulk(slice any, setFunc func(*ShenbiSettingsCreate, int)) *ShenbiSettingsCreateBulk {
+func (c *OrganizationDomainClient) MapCreateBulk(slice any, setFunc func(*OrganizationDomainCreate, int)) *OrganizationDomainCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &ShenbiSettingsCreateBulk{err: fmt.Errorf("calling to ShenbiSettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationDomainCreateBulk{err: fmt.Errorf("calling to OrganizationDomainClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*ShenbiSettingsCreate, rv.Len())
+	builders := make([]*OrganizationDomainCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &ShenbiSettingsCreateBulk{config: c.config, builders: builders}
+	return &OrganizationDomainCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for ShenbiSettings.
-func (c *ShenbiSettingsClient) Update() *ShenbiSettingsUpdate {
-	mutation := newShenbiSettingsMutation(c.config, OpUpdate)
-	return &ShenbiSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OrganizationDomain.
+func (c *OrganizationDomainClient) Update() *OrganizationDomainUpdate {
+	mutation := newOrganizationDomainMutation(c.config, OpUpdate)
+	return &OrganizationDomainUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *ShenbiSettingsClient) UpdateOne(_m *ShenbiSettings) *ShenbiSettingsUpdateOne {
-	mutation := newShenbiSettingsMutation(c.config, OpUpdateOne, withShenbiSettings(_m))
-	return &ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationDomainClient) UpdateOne(_m *OrganizationDomain) *OrganizationDomainUpdateOne {
+	mutation := newOrganizationDomainMutation(c.config, OpUpdateOne, withOrganizationDomain(_m))
+	return &OrganizationDomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *ShenbiSettingsClient) UpdateOneID(id int) *ShenbiSettingsUpdateOne {
-	mutation := newShenbiSettingsMutation(c.config, OpUpdateOne, withShenbiSettingsID(id))
-	return &ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationDomainClient) UpdateOneID(id int) *OrganizationDomainUpdateOne {
+	mutation := newOrganizationDomainMutation(c.config, OpUpdateOne, withOrganizationDomainID(id))
+	return &OrganizationDomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for ShenbiSettings.
-func (c *ShenbiSettingsClient) Delete() *ShenbiSettingsDelete {
-	mutation := newShenbiSettingsMutation(c.config, OpDelete)
-	return &ShenbiSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OrganizationDomain.
+func (c *OrganizationDomainClient) Delete() *OrganizationDomainDelete {
+	mutation := newOrganizationDomainMutation(c.config, OpDelete)
+	return &OrganizationDomainDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *ShenbiSettingsClient) DeleteOne(_m *ShenbiSettings) *ShenbiSettingsDeleteOne {
+func (c *OrganizationDomainClient) DeleteOne(_m *OrganizationDomain) *OrganizationDomainDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *ShenbiSettingsClient) DeleteOneID(id int) *ShenbiSettingsDeleteOne {
-	builder := c.Delete().Where(shenbisettings.ID(id))
+func (c *OrganizationDomainClient) DeleteOneID(id int) *OrganizationDomainDeleteOne {
+	builder := c.Delete().Where(organizationdomain.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &ShenbiSettingsDeleteOne{builder}
+	return &OrganizationDomainDeleteOne{builder}
 }
 
-// Query returns a query builder for ShenbiSettings.
-func (c *ShenbiSettingsClient) Query() *ShenbiSettingsQuery {
-	return &ShenbiSettingsQuery{
+// Query returns a query builder for OrganizationDomain.
+func (c *OrganizationDomainClient) Query() *OrganizationDomainQuery {
+	return &OrganizationDomainQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeShenbiSettings},
+		ctx:    &QueryContext{Type: TypeOrganizationDomain},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a ShenbiSettings entity by its id.
-func (c *ShenbiSettingsClient) Get(ctx context.Context, id int) (*ShenbiSettings, error) {
-	return c.Query().Where(shenbisettings.ID(id)).Only(ctx)
+// Get returns a OrganizationDomain entity by its id.
+func (c *OrganizationDomainClient) Get(ctx context.Context, id int) (*OrganizationDomain, error) {
+	return c.Query().Where(organizationdomain.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *ShenbiSettingsClient) GetX(ctx context.Context, id int) *ShenbiSettings {
+func (c *OrganizationDomainClient) GetX(ctx context.Context, id int) *OrganizationDomain {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3614,31 +4043,15 @@ func (c *ShenbiSettingsClient) GetX(ctx context.Context, id int) *ShenbiSettings
 	return obj
 }
 
-// QueryApp queries the app edge of a ShenbiSettings.
-func (c *ShenbiSettingsClient) QueryApp(_m *ShenbiSettings) *AppQuery {
-	query := (&AppClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(shenbisettings.Table, shenbisettings.FieldID, id),
-			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, shenbisettings.AppTable, shenbisettings.AppColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryUser queries the user edge of a ShenbiSettings.
-func (c *ShenbiSettingsClient) QueryUser(_m *ShenbiSettings) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a OrganizationDomain.
+func (c *OrganizationDomainClient) QueryOrganization(_m *OrganizationDomain) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(shenbisettings.Table, shenbisettings.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, true, shenbisettings.UserTable, shenbisettings.UserColumn),
+			sqlgraph.From(organizationdomain.Table, organizationdomain.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationdomain.OrganizationTable, organizationdomain.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3647,131 +4060,131 @@ func (c *ShenbiSettingsClient) QueryUser(_m *ShenbiSettings) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *ShenbiSettingsClient) Hooks() []Hook {
-	return c.hooks.ShenbiSettings
+func (c *OrganizationDomainClient) Hooks() []Hook {
+	return c.hooks.OrganizationDomain
 }
 
 // Interceptors returns the client interceptors.
-func (c *ShenbiSettingsClient) Interceptors() []Interceptor {
-	return c.inters.ShenbiSettings
+func (c *OrganizationDomainClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationDomain
 }
 
-func (c *ShenbiSettingsClient) mutate(ctx context.Context, m *ShenbiSettingsMutation) (Value, error) {
+func (c *OrganizationDomainClient) mutate(ctx context.Context, m *OrganizationDomainMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&ShenbiSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationDomainCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&ShenbiSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationDomainUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationDomainUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&ShenbiSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationDomainDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown ShenbiSettings mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown OrganizationDomain mutation op: %q", m.Op())
 	}
 }
 
-// SubscriptionClient is a client for the Subscription schema.
-type SubscriptionClient struct {
+// OrganizationInvitationClient is a client for the OrganizationInvitation schema.
+type OrganizationInvitationClient struct {
 	config
 }
 
-// NewSubscriptionClient returns a client for the Subscription from the given config.
-func NewSubscriptionClient(c config) *SubscriptionClient {
-	return &SubscriptionClient{config: c}
+// NewOrganizationInvitationClient returns a client for the OrganizationInvitation from the given config.
+func NewOrganizationInvitationClient(c config) *OrganizationInvitationClient {
+	return &OrganizationInvitationClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `subscription.Hooks(f(g(h())))`.
-func (c *SubscriptionClient) Use(hooks ...Hook) {
-	c.hooks.Subscription = append(c.hooks.Subscription, hooks...)
+// A call to `Use(f, g, h)` equals to `organizationinvitation.Hooks(f(g(h())))`.
+func (c *OrganizationInvitationClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationInvitation = append(c.hooks.OrganizationInvitation, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `subscription.Intercept(f(g(h())))`.
-func (c *SubscriptionClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Subscription = append(c.inters.Subscription, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organizationinvitation.Intercept(f(g(h())))`.
+func (c *OrganizationInvitationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationInvitation = append(c.inters.OrganizationInvitation, interceptors...)
 }
 
-// Create returns a builder for creating a Subscription entity.
-func (c *SubscriptionClient) Create() *SubscriptionCreate {
-	mutation := newSubscriptionMutation(c.config, OpCreate)
-	return &SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
-}
+// Create returns a builder for creating a OrganizationInvitation entity.
+func (c *OrganizationInvitationClient) Create() *OrganizationInvitationCreate {
+	mutation := newOrganizationInvitationMutation(c.config, OpCreate)
+	return &OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
 
-// CreateBulk returns a builder for creating a bulk of Subscription entities.
-func (c *SubscriptionClient) CreateBulk(builders ...*SubscriptionCreate) *SubscriptionCreateBulk {
-	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OrganizationInvitation entities.
+func (c *OrganizationInvitationClient) CreateBulk(builders ...*OrganizationInvitationCreate) *OrganizationInvitationCreateBulk {
+	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *SubscriptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionCreate, int)) *SubscriptionCreateBulk {
+func (c *OrganizationInvitationClient) MapCreateBulk(slice any, setFunc func(*OrganizationInvitationCreate, int)) *OrganizationInvitationCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &SubscriptionCreateBulk{err: fmt.Errorf("calling to SubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationInvitationCreateBulk{err: fmt.Errorf("calling to OrganizationInvitationClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*SubscriptionCreate, rv.Len())
+	builders := make([]*OrganizationInvitationCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+	return &OrganizationInvitationCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Subscription.
-func (c *SubscriptionClient) Update() *SubscriptionUpdate {
-	mutation := newSubscriptionMutation(c.config, OpUpdate)
-	return &SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Update() *OrganizationInvitationUpdate {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdate)
+	return &OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *SubscriptionClient) UpdateOne(_m *Subscription) *SubscriptionUpdateOne {
-	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscription(_m))
-	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationInvitationClient) UpdateOne(_m *OrganizationInvitation) *OrganizationInvitationUpdateOne {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitation(_m))
+	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *SubscriptionClient) UpdateOneID(id int) *SubscriptionUpdateOne {
-	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
-	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationInvitationClient) UpdateOneID(id int) *OrganizationInvitationUpdateOne {
+	mutation := newOrganizationInvitationMutation(c.config, OpUpdateOne, withOrganizationInvitationID(id))
+	return &OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Subscription.
-func (c *SubscriptionClient) Delete() *SubscriptionDelete {
-	mutation := newSubscriptionMutation(c.config, OpDelete)
-	return &SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Delete() *OrganizationInvitationDelete {
+	mutation := newOrganizationInvitationMutation(c.config, OpDelete)
+	return &OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *SubscriptionClient) DeleteOne(_m *Subscription) *SubscriptionDeleteOne {
+func (c *OrganizationInvitationClient) DeleteOne(_m *OrganizationInvitation) *OrganizationInvitationDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *SubscriptionClient) DeleteOneID(id int) *SubscriptionDeleteOne {
-	builder := c.Delete().Where(subscription.ID(id))
+func (c *OrganizationInvitationClient) DeleteOneID(id int) *OrganizationInvitationDeleteOne {
+	builder := c.Delete().Where(organizationinvitation.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &SubscriptionDeleteOne{builder}
+	return &OrganizationInvitationDeleteOne{builder}
 }
 
-// Query returns a query builder for Subscription.
-func (c *SubscriptionClient) Query() *SubscriptionQuery {
-	return &SubscriptionQuery{
+// Query returns a query builder for OrganizationInvitation.
+func (c *OrganizationInvitationClient) Query() *OrganizationInvitationQuery {
+	return &OrganizationInvitationQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeSubscription},
+		ctx:    &QueryContext{Type: TypeOrganizationInvitation},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Subscription entity by its id.
-func (c *SubscriptionClient) Get(ctx context.Context, id int) (*Subscription, error) {
-	return c.Query().Where(subscription.ID(id)).Only(ctx)
+// Get returns a OrganizationInvitation entity by its id.
+func (c *OrganizationInvitationClient) Get(ctx context.Context, id int) (*OrganizationInvitation, error) {
+	return c.Query().Where(organizationinvitation.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *SubscriptionClient) GetX(ctx context.Context, id int) *Subscription {
+func (c *OrganizationInvitationClient) GetX(ctx context.Context, id int) *OrganizationInvitation {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3779,47 +4192,15 @@ func (c *SubscriptionClient) GetX(ctx context.Context, id int) *Subscription {
 	return obj
 }
 
-// QueryUser queries the user edge of a Subscription.
-func (c *SubscriptionClient) QueryUser(_m *Subscription) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(subscription.Table, subscription.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subscription.UserTable, subscription.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryOrganization queries the organization edge of a Subscription.
-func (c *SubscriptionClient) QueryOrganization(_m *Subscription) *OrganizationQuery {
+// QueryOrganization queries the organization edge of a OrganizationInvitation.
+func (c *OrganizationInvitationClient) QueryOrganization(_m *OrganizationInvitation) *OrganizationQuery {
 	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
 			sqlgraph.To(organization.Table, organization.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subscription.OrganizationTable, subscription.OrganizationColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryApp queries the app edge of a Subscription.
-func (c *SubscriptionClient) QueryApp(_m *Subscription) *AppQuery {
-	query := (&AppClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(subscription.Table, subscription.FieldID, id),
-			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subscription.AppTable, subscription.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationinvitation.OrganizationTable, organizationinvitation.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3827,15 +4208,15 @@ func (c *SubscriptionClient) QueryApp(_m *Subscription) *AppQuery {
 	return query
 }
 
-// QueryPlan queries the plan edge of a Subscription.
-func (c *SubscriptionClient) QueryPlan(_m *Subscription) *PlanQuery {
-	query := (&PlanClient{config: c.config}).Query()
+// QueryInvitedBy queries the invited_by edge of a OrganizationInvitation.
+func (c *OrganizationInvitationClient) QueryInvitedBy(_m *OrganizationInvitation) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(subscription.Table, subscription.FieldID, id),
-			sqlgraph.To(plan.Table, plan.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, subscription.PlanTable, subscription.PlanColumn),
+			sqlgraph.From(organizationinvitation.Table, organizationinvitation.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationinvitation.InvitedByTable, organizationinvitation.InvitedByColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3844,131 +4225,131 @@ func (c *SubscriptionClient) QueryPlan(_m *Subscription) *PlanQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *SubscriptionClient) Hooks() []Hook {
-	return c.hooks.Subscription
+func (c *OrganizationInvitationClient) Hooks() []Hook {
+	return c.hooks.OrganizationInvitation
 }
 
 // Interceptors returns the client interceptors.
-func (c *SubscriptionClient) Interceptors() []Interceptor {
-	return c.inters.Subscription
+func (c *OrganizationInvitationClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationInvitation
 }
 
-func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation) (Value, error) {
+func (c *OrganizationInvitationClient) mutate(ctx context.Context, m *OrganizationInvitationMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationInvitationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationInvitationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&OrganizationInvitationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&OrganizationInvitationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Subscription mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown OrganizationInvitation mutation op: %q", m.Op())
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// OrganizationMemberClient is a client for the OrganizationMember schema.
+type OrganizationMemberClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewOrganizationMemberClient returns a client for the OrganizationMember from the given config.
+func NewOrganizationMemberClient(c config) *OrganizationMemberClient {
+	return &OrganizationMemberClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `organizationmember.Hooks(f(g(h())))`.
+func (c *OrganizationMemberClient) Use(hooks ...Hook) {
+	c.hooks.OrganizationMember = append(c.hooks.OrganizationMember, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `organizationmember.Intercept(f(g(h())))`.
+func (c *OrganizationMemberClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OrganizationMember = append(c.inters.OrganizationMember, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a OrganizationMember entity.
+func (c *OrganizationMemberClient) Create() *OrganizationMemberCreate {
+	mutation := newOrganizationMemberMutation(c.config, OpCreate)
+	return &OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
-	return &UserCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of OrganizationMember entities.
+func (c *OrganizationMemberClient) CreateBulk(builders ...*OrganizationMemberCreate) *OrganizationMemberCreateBulk {
+	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+func (c *OrganizationMemberClient) MapCreateBulk(slice any, setFunc func(*OrganizationMemberCreate, int)) *OrganizationMemberCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &OrganizationMemberCreateBulk{err: fmt.Errorf("calling to OrganizationMemberClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserCreate, rv.Len())
+	builders := make([]*OrganizationMemberCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserCreateBulk{config: c.config, builders: builders}
+	return &OrganizationMemberCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for User.
-func (c *UserClient) Update() *UserUpdate {
-	mutation := newUserMutation(c.config, OpUpdate)
-	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for OrganizationMember.
+func (c *OrganizationMemberClient) Update() *OrganizationMemberUpdate {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdate)
+	return &OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationMemberClient) UpdateOne(_m *OrganizationMember) *OrganizationMemberUpdateOne {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMember(_m))
+	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
-	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
-	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *OrganizationMemberClient) UpdateOneID(id int) *OrganizationMemberUpdateOne {
+	mutation := newOrganizationMemberMutation(c.config, OpUpdateOne, withOrganizationMemberID(id))
+	return &OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for User.
-func (c *UserClient) Delete() *UserDelete {
-	mutation := newUserMutation(c.config, OpDelete)
-	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for OrganizationMember.
+func (c *OrganizationMemberClient) Delete() *OrganizationMemberDelete {
+	mutation := newOrganizationMemberMutation(c.config, OpDelete)
+	return &OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+func (c *OrganizationMemberClient) DeleteOne(_m *OrganizationMember) *OrganizationMemberDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
-	builder := c.Delete().Where(user.ID(id))
+func (c *OrganizationMemberClient) DeleteOneID(id int) *OrganizationMemberDeleteOne {
+	builder := c.Delete().Where(organizationmember.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserDeleteOne{builder}
+	return &OrganizationMemberDeleteOne{builder}
 }
 
-// Query returns a query builder for User.
-func (c *UserClient) Query() *UserQuery {
-	return &UserQuery{
+// Query returns a query builder for OrganizationMember.
+func (c *OrganizationMemberClient) Query() *OrganizationMemberQuery {
+	return &OrganizationMemberQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUser},
+		ctx:    &QueryContext{Type: TypeOrganizationMember},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a User entity by its id.
-func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
-	return c.Query().Where(user.ID(id)).Only(ctx)
+// Get returns a OrganizationMember entity by its id.
+func (c *OrganizationMemberClient) Get(ctx context.Context, id int) (*OrganizationMember, error) {
+	return c.Query().Where(organizationmember.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserClient) GetX(ctx context.Context, id int) *User {
+func (c *OrganizationMemberClient) GetX(ctx context.Context, id int) *OrganizationMember {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -3976,15 +4357,15 @@ func (c *UserClient) GetX(ctx context.Context, id int) *User {
 	return obj
 }
 
-// QueryUserApps queries the user_apps edge of a User.
-func (c *UserClient) QueryUserApps(_m *User) *UserAppQuery {
-	query := (&UserAppClient{config: c.config}).Query()
+// QueryOrganization queries the organization edge of a OrganizationMember.
+func (c *OrganizationMemberClient) QueryOrganization(_m *OrganizationMember) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(userapp.Table, userapp.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.UserAppsTable, user.UserAppsColumn),
+			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.OrganizationTable, organizationmember.OrganizationColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -3992,31 +4373,15 @@ func (c *UserClient) QueryUserApps(_m *User) *UserAppQuery {
 	return query
 }
 
-// QueryOrganizationMemberships queries the organization_memberships edge of a User.
-func (c *UserClient) QueryOrganizationMemberships(_m *User) *OrganizationMemberQuery {
-	query := (&OrganizationMemberClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.OrganizationMembershipsTable, user.OrganizationMembershipsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QuerySubscriptions queries the subscriptions edge of a User.
-func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
-	query := (&SubscriptionClient{config: c.config}).Query()
+// QueryUser queries the user edge of a OrganizationMember.
+func (c *OrganizationMemberClient) QueryUser(_m *OrganizationMember) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(subscription.Table, subscription.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+			sqlgraph.From(organizationmember.Table, organizationmember.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, organizationmember.UserTable, organizationmember.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4024,127 +4389,148 @@ func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
 	return query
 }
 
-// QueryShenbiProfile queries the shenbi_profile edge of a User.
-func (c *UserClient) QueryShenbiProfile(_m *User) *ShenbiProfileQuery {
-	query := (&ShenbiProfileClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(shenbiprofile.Table, shenbiprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ShenbiProfileTable, user.ShenbiProfileColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Hooks returns the client hooks.
+func (c *OrganizationMemberClient) Hooks() []Hook {
+	return c.hooks.OrganizationMember
 }
 
-// QueryClassroomsTeaching queries the classrooms_teaching edge of a User.
-func (c *UserClient) QueryClassroomsTeaching(_m *User) *ClassroomQuery {
-	query := (&ClassroomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(classroom.Table, classroom.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomsTeachingTable, user.ClassroomsTeachingColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// Interceptors returns the client interceptors.
+func (c *OrganizationMemberClient) Interceptors() []Interceptor {
+	return c.inters.OrganizationMember
 }
 
-// QueryClassroomMemberships queries the classroom_memberships edge of a User.
-func (c *UserClient) QueryClassroomMemberships(_m *User) *ClassroomMembershipQuery {
-	query := (&ClassroomMembershipClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(classroommembership.Table, classroommembership.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomMembershipsTable, user.ClassroomMembershipsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+func (c *OrganizationMemberClient) mutate(ctx context.Context, m *OrganizationMemberMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrganizationMemberCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrganizationMemberUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrganizationMemberUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrganizationMemberDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OrganizationMember mutation op: %q", m.Op())
 	}
-	return query
 }
 
-// QueryAssignmentSubmissions queries the assignment_submissions edge of a User.
-func (c *UserClient) QueryAssignmentSubmissions(_m *User) *AssignmentSubmissionQuery {
-	query := (&AssignmentSubmissionClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(assignmentsubmission.Table, assignmentsubmission.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AssignmentSubmissionsTable, user.AssignmentSubmissionsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
+// PlanClient is a client for the Plan schema.
+type PlanClient struct {
+	config
 }
 
-// QueryUserProgress queries the user_progress edge of a User.
-func (c *UserClient) QueryUserProgress(_m *User) *UserProgressQuery {
-	query := (&UserProgressClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(userprogress.Table, userprogress.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.UserProgressTable, user.UserProgressColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// NewPlanClient returns a client for the Plan from the given config.
+func NewPlanClient(c config) *PlanClient {
+	return &PlanClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `plan.Hooks(f(g(h())))`.
+func (c *PlanClient) Use(hooks ...Hook) {
+	c.hooks.Plan = append(c.hooks.Plan, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `plan.Intercept(f(g(h())))`.
+func (c *PlanClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Plan = append(c.inters.Plan, interceptors...)
+}
+
+// Create returns a builder for creating a Plan entity.
+func (c *PlanClient) Create() *PlanCreate {
+	mutation := newPlanMutation(c.config, OpCreate)
+	return &PlanCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Plan entities.
+func (c *PlanClient) CreateBulk(builders ...*PlanCreate) *PlanCreateBulk {
+	return &PlanCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PlanClient) MapCreateBulk(slice any, setFunc func(*PlanCreate, int)) *PlanCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PlanCreateBulk{err: fmt.Errorf("calling to PlanClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	return query
+	builders := make([]*PlanCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PlanCreateBulk{config: c.config, builders: builders}
 }
 
-// QueryAchievements queries the achievements edge of a User.
-func (c *UserClient) QueryAchievements(_m *User) *AchievementQuery {
-	query := (&AchievementClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(achievement.Table, achievement.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.AchievementsTable, user.AchievementsColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Update returns an update builder for Plan.
+func (c *PlanClient) Update() *PlanUpdate {
+	mutation := newPlanMutation(c.config, OpUpdate)
+	return &PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PlanClient) UpdateOne(_m *Plan) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlan(_m))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PlanClient) UpdateOneID(id int) *PlanUpdateOne {
+	mutation := newPlanMutation(c.config, OpUpdateOne, withPlanID(id))
+	return &PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Plan.
+func (c *PlanClient) Delete() *PlanDelete {
+	mutation := newPlanMutation(c.config, OpDelete)
+	return &PlanDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PlanClient) DeleteOne(_m *Plan) *PlanDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PlanClient) DeleteOneID(id int) *PlanDeleteOne {
+	builder := c.Delete().Where(plan.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PlanDeleteOne{builder}
+}
+
+// Query returns a query builder for Plan.
+func (c *PlanClient) Query() *PlanQuery {
+	return &PlanQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePlan},
+		inters: c.Interceptors(),
 	}
-	return query
 }
 
-// QueryBattleRoomsHosted queries the battle_rooms_hosted edge of a User.
-func (c *UserClient) QueryBattleRoomsHosted(_m *User) *BattleRoomQuery {
-	query := (&BattleRoomClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(battleroom.Table, battleroom.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.BattleRoomsHostedTable, user.BattleRoomsHostedColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Get returns a Plan entity by its id.
+func (c *PlanClient) Get(ctx context.Context, id int) (*Plan, error) {
+	return c.Query().Where(plan.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PlanClient) GetX(ctx context.Context, id int) *Plan {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
 	}
-	return query
+	return obj
 }
 
-// QueryBattleSessions queries the battle_sessions edge of a User.
-func (c *UserClient) QueryBattleSessions(_m *User) *BattleSessionQuery {
-	query := (&BattleSessionClient{config: c.config}).Query()
+// QueryApp queries the app edge of a Plan.
+func (c *PlanClient) QueryApp(_m *Plan) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(battlesession.Table, battlesession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.BattleSessionsTable, user.BattleSessionsColumn),
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, plan.AppTable, plan.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4152,15 +4538,15 @@ func (c *UserClient) QueryBattleSessions(_m *User) *BattleSessionQuery {
 	return query
 }
 
-// QueryLiveSessionsTeaching queries the live_sessions_teaching edge of a User.
-func (c *UserClient) QueryLiveSessionsTeaching(_m *User) *LiveSessionQuery {
-	query := (&LiveSessionClient{config: c.config}).Query()
+// QuerySubscriptions queries the subscriptions edge of a Plan.
+func (c *PlanClient) QuerySubscriptions(_m *Plan) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(livesession.Table, livesession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LiveSessionsTeachingTable, user.LiveSessionsTeachingColumn),
+			sqlgraph.From(plan.Table, plan.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, plan.SubscriptionsTable, plan.SubscriptionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4168,15 +4554,1756 @@ func (c *UserClient) QueryLiveSessionsTeaching(_m *User) *LiveSessionQuery {
 	return query
 }
 
-// QueryLiveSessionParticipations queries the live_session_participations edge of a User.
+// Hooks returns the client hooks.
+func (c *PlanClient) Hooks() []Hook {
+	return c.hooks.Plan
+}
+
+// Interceptors returns the client interceptors.
+func (c *PlanClient) Interceptors() []Interceptor {
+	return c.inters.Plan
+}
+
+func (c *PlanClient) mutate(ctx context.Context, m *PlanMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PlanCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PlanUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PlanUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PlanDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Plan mutation op: %q", m.Op())
+	}
+}
+
+// RateLimitBucketClient is a client for the RateLimitBucket schema.
+type RateLimitBucketClient struct {
+	config
+}
+
+// NewRateLimitBucketClient returns a client for the RateLimitBucket from the given config.
+func NewRateLimitBucketClient(c config) *RateLimitBucketClient {
+	return &RateLimitBucketClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ratelimitbucket.Hooks(f(g(h())))`.
+func (c *RateLimitBucketClient) Use(hooks ...Hook) {
+	c.hooks.RateLimitBucket = append(c.hooks.RateLimitBucket, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ratelimitbucket.Intercept(f(g(h())))`.
+func (c *RateLimitBucketClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RateLimitBucket = append(c.inters.RateLimitBucket, interceptors...)
+}
+
+// Create returns a builder for creating a RateLimitBucket entity.
+func (c *RateLimitBucketClient) Create() *RateLimitBucketCreate {
+	mutation := newRateLimitBucketMutation(c.config, OpCreate)
+	return &RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RateLimitBucket entities.
+func (c *RateLimitBucketClient) CreateBulk(builders ...*RateLimitBucketCreate) *RateLimitBucketCreateBulk {
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RateLimitBucketClient) MapCreateBulk(slice any, setFunc func(*RateLimitBucketCreate, int)) *RateLimitBucketCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RateLimitBucketCreateBulk{err: fmt.Errorf("calling to RateLimitBucketClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RateLimitBucketCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RateLimitBucketCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Update() *RateLimitBucketUpdate {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdate)
+	return &RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RateLimitBucketClient) UpdateOne(_m *RateLimitBucket) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucket(_m))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RateLimitBucketClient) UpdateOneID(id int) *RateLimitBucketUpdateOne {
+	mutation := newRateLimitBucketMutation(c.config, OpUpdateOne, withRateLimitBucketID(id))
+	return &RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Delete() *RateLimitBucketDelete {
+	mutation := newRateLimitBucketMutation(c.config, OpDelete)
+	return &RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RateLimitBucketClient) DeleteOne(_m *RateLimitBucket) *RateLimitBucketDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RateLimitBucketClient) DeleteOneID(id int) *RateLimitBucketDeleteOne {
+	builder := c.Delete().Where(ratelimitbucket.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RateLimitBucketDeleteOne{builder}
+}
+
+// Query returns a query builder for RateLimitBucket.
+func (c *RateLimitBucketClient) Query() *RateLimitBucketQuery {
+	return &RateLimitBucketQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRateLimitBucket},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RateLimitBucket entity by its id.
+func (c *RateLimitBucketClient) Get(ctx context.Context, id int) (*RateLimitBucket, error) {
+	return c.Query().Where(ratelimitbucket.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RateLimitBucketClient) GetX(ctx context.Context, id int) *RateLimitBucket {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RateLimitBucketClient) Hooks() []Hook {
+	return c.hooks.RateLimitBucket
+}
+
+// Interceptors returns the client interceptors.
+func (c *RateLimitBucketClient) Interceptors() []Interceptor {
+	return c.inters.RateLimitBucket
+}
+
+func (c *RateLimitBucketClient) mutate(ctx context.Context, m *RateLimitBucketMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RateLimitBucketCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RateLimitBucketUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RateLimitBucketUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RateLimitBucketDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RateLimitBucket mutation op: %q", m.Op())
+	}
+}
+
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(_m *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(_m))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(_m *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySession queries the session edge of a RefreshToken.
+func (c *RefreshTokenClient) QuerySession(_m *RefreshToken) *AuthSessionQuery {
+	query := (&AuthSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.SessionTable, refreshtoken.SessionColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
+// SSOConnectionClient is a client for the SSOConnection schema.
+type SSOConnectionClient struct {
+	config
+}
+
+// NewSSOConnectionClient returns a client for the SSOConnection from the given config.
+func NewSSOConnectionClient(c config) *SSOConnectionClient {
+	return &SSOConnectionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ssoconnection.Hooks(f(g(h())))`.
+func (c *SSOConnectionClient) Use(hooks ...Hook) {
+	c.hooks.SSOConnection = append(c.hooks.SSOConnection, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ssoconnection.Intercept(f(g(h())))`.
+func (c *SSOConnectionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SSOConnection = append(c.inters.SSOConnection, interceptors...)
+}
+
+// Create returns a builder for creating a SSOConnection entity.
+func (c *SSOConnectionClient) Create() *SSOConnectionCreate {
+	mutation := newSSOConnectionMutation(c.config, OpCreate)
+	return &SSOConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SSOConnection entities.
+func (c *SSOConnectionClient) CreateBulk(builders ...*SSOConnectionCreate) *SSOConnectionCreateBulk {
+	return &SSOConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SSOConnectionClient) MapCreateBulk(slice any, setFunc func(*SSOConnectionCreate, int)) *SSOConnectionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SSOConnectionCreateBulk{err: fmt.Errorf("calling to SSOConnectionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SSOConnectionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SSOConnectionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SSOConnection.
+func (c *SSOConnectionClient) Update() *SSOConnectionUpdate {
+	mutation := newSSOConnectionMutation(c.config, OpUpdate)
+	return &SSOConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SSOConnectionClient) UpdateOne(_m *SSOConnection) *SSOConnectionUpdateOne {
+	mutation := newSSOConnectionMutation(c.config, OpUpdateOne, withSSOConnection(_m))
+	return &SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SSOConnectionClient) UpdateOneID(id int) *SSOConnectionUpdateOne {
+	mutation := newSSOConnectionMutation(c.config, OpUpdateOne, withSSOConnectionID(id))
+	return &SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SSOConnection.
+func (c *SSOConnectionClient) Delete() *SSOConnectionDelete {
+	mutation := newSSOConnectionMutation(c.config, OpDelete)
+	return &SSOConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SSOConnectionClient) DeleteOne(_m *SSOConnection) *SSOConnectionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SSOConnectionClient) DeleteOneID(id int) *SSOConnectionDeleteOne {
+	builder := c.Delete().Where(ssoconnection.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SSOConnectionDeleteOne{builder}
+}
+
+// Query returns a query builder for SSOConnection.
+func (c *SSOConnectionClient) Query() *SSOConnectionQuery {
+	return &SSOConnectionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSSOConnection},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SSOConnection entity by its id.
+func (c *SSOConnectionClient) Get(ctx context.Context, id int) (*SSOConnection, error) {
+	return c.Query().Where(ssoconnection.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SSOConnectionClient) GetX(ctx context.Context, id int) *SSOConnection {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a SSOConnection.
+func (c *SSOConnectionClient) QueryOrganization(_m *SSOConnection) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ssoconnection.Table, ssoconnection.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, ssoconnection.OrganizationTable, ssoconnection.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SSOConnectionClient) Hooks() []Hook {
+	return c.hooks.SSOConnection
+}
+
+// Interceptors returns the client interceptors.
+func (c *SSOConnectionClient) Interceptors() []Interceptor {
+	return c.inters.SSOConnection
+}
+
+func (c *SSOConnectionClient) mutate(ctx context.Context, m *SSOConnectionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SSOConnectionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SSOConnectionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SSOConnectionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SSOConnectionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SSOConnection mutation op: %q", m.Op())
+	}
+}
+
+// SSOLoginClient is a client for the SSOLogin schema.
+type SSOLoginClient struct {
+	config
+}
+
+// NewSSOLoginClient returns a client for the SSOLogin from the given config.
+func NewSSOLoginClient(c config) *SSOLoginClient {
+	return &SSOLoginClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `ssologin.Hooks(f(g(h())))`.
+func (c *SSOLoginClient) Use(hooks ...Hook) {
+	c.hooks.SSOLogin = append(c.hooks.SSOLogin, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `ssologin.Intercept(f(g(h())))`.
+func (c *SSOLoginClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SSOLogin = append(c.inters.SSOLogin, interceptors...)
+}
+
+// Create returns a builder for creating a SSOLogin entity.
+func (c *SSOLoginClient) Create() *SSOLoginCreate {
+	mutation := newSSOLoginMutation(c.config, OpCreate)
+	return &SSOLoginCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SSOLogin entities.
+func (c *SSOLoginClient) CreateBulk(builders ...*SSOLoginCreate) *SSOLoginCreateBulk {
+	return &SSOLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SSOLoginClient) MapCreateBulk(slice any, setFunc func(*SSOLoginCreate, int)) *SSOLoginCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SSOLoginCreateBulk{err: fmt.Errorf("calling to SSOLoginClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SSOLoginCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SSOLoginCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SSOLogin.
+func (c *SSOLoginClient) Update() *SSOLoginUpdate {
+	mutation := newSSOLoginMutation(c.config, OpUpdate)
+	return &SSOLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SSOLoginClient) UpdateOne(_m *SSOLogin) *SSOLoginUpdateOne {
+	mutation := newSSOLoginMutation(c.config, OpUpdateOne, withSSOLogin(_m))
+	return &SSOLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SSOLoginClient) UpdateOneID(id int) *SSOLoginUpdateOne {
+	mutation := newSSOLoginMutation(c.config, OpUpdateOne, withSSOLoginID(id))
+	return &SSOLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SSOLogin.
+func (c *SSOLoginClient) Delete() *SSOLoginDelete {
+	mutation := newSSOLoginMutation(c.config, OpDelete)
+	return &SSOLoginDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SSOLoginClient) DeleteOne(_m *SSOLogin) *SSOLoginDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SSOLoginClient) DeleteOneID(id int) *SSOLoginDeleteOne {
+	builder := c.Delete().Where(ssologin.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SSOLoginDeleteOne{builder}
+}
+
+// Query returns a query builder for SSOLogin.
+func (c *SSOLoginClient) Query() *SSOLoginQuery {
+	return &SSOLoginQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSSOLogin},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SSOLogin entity by its id.
+func (c *SSOLoginClient) Get(ctx context.Context, id int) (*SSOLogin, error) {
+	return c.Query().Where(ssologin.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SSOLoginClient) GetX(ctx context.Context, id int) *SSOLogin {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOrganization queries the organization edge of a SSOLogin.
+func (c *SSOLoginClient) QueryOrganization(_m *SSOLogin) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ssologin.Table, ssologin.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ssologin.OrganizationTable, ssologin.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a SSOLogin.
+func (c *SSOLoginClient) QueryUser(_m *SSOLogin) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(ssologin.Table, ssologin.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ssologin.UserTable, ssologin.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SSOLoginClient) Hooks() []Hook {
+	return c.hooks.SSOLogin
+}
+
+// Interceptors returns the client interceptors.
+func (c *SSOLoginClient) Interceptors() []Interceptor {
+	return c.inters.SSOLogin
+}
+
+func (c *SSOLoginClient) mutate(ctx context.Context, m *SSOLoginMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SSOLoginCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SSOLoginUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SSOLoginUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SSOLoginDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SSOLogin mutation op: %q", m.Op())
+	}
+}
+
+// ShenbiProfileClient is a client for the ShenbiProfile schema.
+type ShenbiProfileClient struct {
+	config
+}
+
+// NewShenbiProfileClient returns a client for the ShenbiProfile from the given config.
+func NewShenbiProfileClient(c config) *ShenbiProfileClient {
+	return &ShenbiProfileClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shenbiprofile.Hooks(f(g(h())))`.
+func (c *ShenbiProfileClient) Use(hooks ...Hook) {
+	c.hooks.ShenbiProfile = append(c.hooks.ShenbiProfile, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shenbiprofile.Intercept(f(g(h())))`.
+func (c *ShenbiProfileClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShenbiProfile = append(c.inters.ShenbiProfile, interceptors...)
+}
+
+// Create returns a builder for creating a ShenbiProfile entity.
+func (c *ShenbiProfileClient) Create() *ShenbiProfileCreate {
+	mutation := newShenbiProfileMutation(c.config, OpCreate)
+	return &ShenbiProfileCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShenbiProfile entities.
+func (c *ShenbiProfileClient) CreateBulk(builders ...*ShenbiProfileCreate) *ShenbiProfileCreateBulk {
+	return &ShenbiProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShenbiProfileClient) MapCreateBulk(slice any, setFunc func(*ShenbiProfileCreate, int)) *ShenbiProfileCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShenbiProfileCreateBulk{err: fmt.Errorf("calling to ShenbiProfileClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShenbiProfileCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShenbiProfileCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShenbiProfile.
+func (c *ShenbiProfileClient) Update() *ShenbiProfileUpdate {
+	mutation := newShenbiProfileMutation(c.config, OpUpdate)
+	return &ShenbiProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShenbiProfileClient) UpdateOne(_m *ShenbiProfile) *ShenbiProfileUpdateOne {
+	mutation := newShenbiProfileMutation(c.config, OpUpdateOne, withShenbiProfile(_m))
+	return &ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShenbiProfileClient) UpdateOneID(id int) *ShenbiProfileUpdateOne {
+	mutation := newShenbiProfileMutation(c.config, OpUpdateOne, withShenbiProfileID(id))
+	return &ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShenbiProfile.
+func (c *ShenbiProfileClient) Delete() *ShenbiProfileDelete {
+	mutation := newShenbiProfileMutation(c.config, OpDelete)
+	return &ShenbiProfileDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShenbiProfileClient) DeleteOne(_m *ShenbiProfile) *ShenbiProfileDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShenbiProfileClient) DeleteOneID(id int) *ShenbiProfileDeleteOne {
+	builder := c.Delete().Where(shenbiprofile.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShenbiProfileDeleteOne{builder}
+}
+
+// Query returns a query builder for ShenbiProfile.
+func (c *ShenbiProfileClient) Query() *ShenbiProfileQuery {
+	return &ShenbiProfileQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShenbiProfile},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShenbiProfile entity by its id.
+func (c *ShenbiProfileClient) Get(ctx context.Context, id int) (*ShenbiProfile, error) {
+	return c.Query().Where(shenbiprofile.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShenbiProfileClient) GetX(ctx context.Context, id int) *ShenbiProfile {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApp queries the app edge of a ShenbiProfile.
+func (c *ShenbiProfileClient) QueryApp(_m *ShenbiProfile) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shenbiprofile.Table, shenbiprofile.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shenbiprofile.AppTable, shenbiprofile.AppColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ShenbiProfile.
+func (c *ShenbiProfileClient) QueryUser(_m *ShenbiProfile) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shenbiprofile.Table, shenbiprofile.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shenbiprofile.UserTable, shenbiprofile.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShenbiProfileClient) Hooks() []Hook {
+	return c.hooks.ShenbiProfile
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShenbiProfileClient) Interceptors() []Interceptor {
+	return c.inters.ShenbiProfile
+}
+
+func (c *ShenbiProfileClient) mutate(ctx context.Context, m *ShenbiProfileMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShenbiProfileCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShenbiProfileUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShenbiProfileUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShenbiProfileDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShenbiProfile mutation op: %q", m.Op())
+	}
+}
+
+// ShenbiSettingsClient is a client for the ShenbiSettings schema.
+type ShenbiSettingsClient struct {
+	config
+}
+
+// NewShenbiSettingsClient returns a client for the ShenbiSettings from the given config.
+func NewShenbiSettingsClient(c config) *ShenbiSettingsClient {
+	return &ShenbiSettingsClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `shenbisettings.Hooks(f(g(h())))`.
+func (c *ShenbiSettingsClient) Use(hooks ...Hook) {
+	c.hooks.ShenbiSettings = append(c.hooks.ShenbiSettings, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `shenbisettings.Intercept(f(g(h())))`.
+func (c *ShenbiSettingsClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ShenbiSettings = append(c.inters.ShenbiSettings, interceptors...)
+}
+
+// Create returns a builder for creating a ShenbiSettings entity.
+func (c *ShenbiSettingsClient) Create() *ShenbiSettingsCreate {
+	mutation := newShenbiSettingsMutation(c.config, OpCreate)
+	return &ShenbiSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ShenbiSettings entities.
+func (c *ShenbiSettingsClient) CreateBulk(builders ...*ShenbiSettingsCreate) *ShenbiSettingsCreateBulk {
+	return &ShenbiSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ShenbiSettingsClient) MapCreateBulk(slice any, setFunc func(*ShenbiSettingsCreate, int)) *ShenbiSettingsCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ShenbiSettingsCreateBulk{err: fmt.Errorf("calling to ShenbiSettingsClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ShenbiSettingsCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ShenbiSettingsCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ShenbiSettings.
+func (c *ShenbiSettingsClient) Update() *ShenbiSettingsUpdate {
+	mutation := newShenbiSettingsMutation(c.config, OpUpdate)
+	return &ShenbiSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ShenbiSettingsClient) UpdateOne(_m *ShenbiSettings) *ShenbiSettingsUpdateOne {
+	mutation := newShenbiSettingsMutation(c.config, OpUpdateOne, withShenbiSettings(_m))
+	return &ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ShenbiSettingsClient) UpdateOneID(id int) *ShenbiSettingsUpdateOne {
+	mutation := newShenbiSettingsMutation(c.config, OpUpdateOne, withShenbiSettingsID(id))
+	return &ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ShenbiSettings.
+func (c *ShenbiSettingsClient) Delete() *ShenbiSettingsDelete {
+	mutation := newShenbiSettingsMutation(c.config, OpDelete)
+	return &ShenbiSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ShenbiSettingsClient) DeleteOne(_m *ShenbiSettings) *ShenbiSettingsDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ShenbiSettingsClient) DeleteOneID(id int) *ShenbiSettingsDeleteOne {
+	builder := c.Delete().Where(shenbisettings.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ShenbiSettingsDeleteOne{builder}
+}
+
+// Query returns a query builder for ShenbiSettings.
+func (c *ShenbiSettingsClient) Query() *ShenbiSettingsQuery {
+	return &ShenbiSettingsQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeShenbiSettings},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ShenbiSettings entity by its id.
+func (c *ShenbiSettingsClient) Get(ctx context.Context, id int) (*ShenbiSettings, error) {
+	return c.Query().Where(shenbisettings.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ShenbiSettingsClient) GetX(ctx context.Context, id int) *ShenbiSettings {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApp queries the app edge of a ShenbiSettings.
+func (c *ShenbiSettingsClient) QueryApp(_m *ShenbiSettings) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shenbisettings.Table, shenbisettings.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shenbisettings.AppTable, shenbisettings.AppColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a ShenbiSettings.
+func (c *ShenbiSettingsClient) QueryUser(_m *ShenbiSettings) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(shenbisettings.Table, shenbisettings.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, shenbisettings.UserTable, shenbisettings.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ShenbiSettingsClient) Hooks() []Hook {
+	return c.hooks.ShenbiSettings
+}
+
+// Interceptors returns the client interceptors.
+func (c *ShenbiSettingsClient) Interceptors() []Interceptor {
+	return c.inters.ShenbiSettings
+}
+
+func (c *ShenbiSettingsClient) mutate(ctx context.Context, m *ShenbiSettingsMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ShenbiSettingsCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ShenbiSettingsUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ShenbiSettingsUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ShenbiSettingsDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ShenbiSettings mutation op: %q", m.Op())
+	}
+}
+
+// SubscriptionClient is a client for the Subscription schema.
+type SubscriptionClient struct {
+	config
+}
+
+// NewSubscriptionClient returns a client for the Subscription from the given config.
+func NewSubscriptionClient(c config) *SubscriptionClient {
+	return &SubscriptionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `subscription.Hooks(f(g(h())))`.
+func (c *SubscriptionClient) Use(hooks ...Hook) {
+	c.hooks.Subscription = append(c.hooks.Subscription, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `subscription.Intercept(f(g(h())))`.
+func (c *SubscriptionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Subscription = append(c.inters.Subscription, interceptors...)
+}
+
+// Create returns a builder for creating a Subscription entity.
+func (c *SubscriptionClient) Create() *SubscriptionCreate {
+	mutation := newSubscriptionMutation(c.config, OpCreate)
+	return &SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Subscription entities.
+func (c *SubscriptionClient) CreateBulk(builders ...*SubscriptionCreate) *SubscriptionCreateBulk {
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SubscriptionClient) MapCreateBulk(slice any, setFunc func(*SubscriptionCreate, int)) *SubscriptionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SubscriptionCreateBulk{err: fmt.Errorf("calling to SubscriptionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SubscriptionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SubscriptionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Subscription.
+func (c *SubscriptionClient) Update() *SubscriptionUpdate {
+	mutation := newSubscriptionMutation(c.config, OpUpdate)
+	return &SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SubscriptionClient) UpdateOne(_m *Subscription) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscription(_m))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SubscriptionClient) UpdateOneID(id int) *SubscriptionUpdateOne {
+	mutation := newSubscriptionMutation(c.config, OpUpdateOne, withSubscriptionID(id))
+	return &SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Subscription.
+func (c *SubscriptionClient) Delete() *SubscriptionDelete {
+	mutation := newSubscriptionMutation(c.config, OpDelete)
+	return &SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SubscriptionClient) DeleteOne(_m *Subscription) *SubscriptionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SubscriptionClient) DeleteOneID(id int) *SubscriptionDeleteOne {
+	builder := c.Delete().Where(subscription.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SubscriptionDeleteOne{builder}
+}
+
+// Query returns a query builder for Subscription.
+func (c *SubscriptionClient) Query() *SubscriptionQuery {
+	return &SubscriptionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSubscription},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Subscription entity by its id.
+func (c *SubscriptionClient) Get(ctx context.Context, id int) (*Subscription, error) {
+	return c.Query().Where(subscription.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SubscriptionClient) GetX(ctx context.Context, id int) *Subscription {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Subscription.
+func (c *SubscriptionClient) QueryUser(_m *Subscription) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.UserTable, subscription.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganization queries the organization edge of a Subscription.
+func (c *SubscriptionClient) QueryOrganization(_m *Subscription) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.OrganizationTable, subscription.OrganizationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApp queries the app edge of a Subscription.
+func (c *SubscriptionClient) QueryApp(_m *Subscription) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.AppTable, subscription.AppColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPlan queries the plan edge of a Subscription.
+func (c *SubscriptionClient) QueryPlan(_m *Subscription) *PlanQuery {
+	query := (&PlanClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(subscription.Table, subscription.FieldID, id),
+			sqlgraph.To(plan.Table, plan.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, subscription.PlanTable, subscription.PlanColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *SubscriptionClient) Hooks() []Hook {
+	return c.hooks.Subscription
+}
+
+// Interceptors returns the client interceptors.
+func (c *SubscriptionClient) Interceptors() []Interceptor {
+	return c.inters.Subscription
+}
+
+func (c *SubscriptionClient) mutate(ctx context.Context, m *SubscriptionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SubscriptionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SubscriptionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SubscriptionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SubscriptionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Subscription mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserClient) MapCreateBulk(slice any, setFunc func(*UserCreate, int)) *UserCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserCreateBulk{err: fmt.Errorf("calling to UserClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for User.
+func (c *UserClient) Update() *UserUpdate {
+	mutation := newUserMutation(c.config, OpUpdate)
+	return &UserUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserClient) UpdateOne(_m *User) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUser(_m))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserClient) UpdateOneID(id int) *UserUpdateOne {
+	mutation := newUserMutation(c.config, OpUpdateOne, withUserID(id))
+	return &UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for User.
+func (c *UserClient) Delete() *UserDelete {
+	mutation := newUserMutation(c.config, OpDelete)
+	return &UserDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserClient) DeleteOne(_m *User) *UserDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserClient) DeleteOneID(id int) *UserDeleteOne {
+	builder := c.Delete().Where(user.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserDeleteOne{builder}
+}
+
+// Query returns a query builder for User.
+func (c *UserClient) Query() *UserQuery {
+	return &UserQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUser},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a User entity by its id.
+func (c *UserClient) Get(ctx context.Context, id int) (*User, error) {
+	return c.Query().Where(user.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserClient) GetX(ctx context.Context, id int) *User {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUserApps queries the user_apps edge of a User.
+func (c *UserClient) QueryUserApps(_m *User) *UserAppQuery {
+	query := (&UserAppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userapp.Table, userapp.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserAppsTable, user.UserAppsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOrganizationMemberships queries the organization_memberships edge of a User.
+func (c *UserClient) QueryOrganizationMemberships(_m *User) *OrganizationMemberQuery {
+	query := (&OrganizationMemberClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organizationmember.Table, organizationmember.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrganizationMembershipsTable, user.OrganizationMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySubscriptions queries the subscriptions edge of a User.
+func (c *UserClient) QuerySubscriptions(_m *User) *SubscriptionQuery {
+	query := (&SubscriptionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(subscription.Table, subscription.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SubscriptionsTable, user.SubscriptionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShenbiProfile queries the shenbi_profile edge of a User.
+func (c *UserClient) QueryShenbiProfile(_m *User) *ShenbiProfileQuery {
+	query := (&ShenbiProfileClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(shenbiprofile.Table, shenbiprofile.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShenbiProfileTable, user.ShenbiProfileColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClassroomsTeaching queries the classrooms_teaching edge of a User.
+func (c *UserClient) QueryClassroomsTeaching(_m *User) *ClassroomQuery {
+	query := (&ClassroomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(classroom.Table, classroom.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomsTeachingTable, user.ClassroomsTeachingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClassroomMemberships queries the classroom_memberships edge of a User.
+func (c *UserClient) QueryClassroomMemberships(_m *User) *ClassroomMembershipQuery {
+	query := (&ClassroomMembershipClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(classroommembership.Table, classroommembership.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomMembershipsTable, user.ClassroomMembershipsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAssignmentSubmissions queries the assignment_submissions edge of a User.
+func (c *UserClient) QueryAssignmentSubmissions(_m *User) *AssignmentSubmissionQuery {
+	query := (&AssignmentSubmissionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(assignmentsubmission.Table, assignmentsubmission.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AssignmentSubmissionsTable, user.AssignmentSubmissionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUserProgress queries the user_progress edge of a User.
+func (c *UserClient) QueryUserProgress(_m *User) *UserProgressQuery {
+	query := (&UserProgressClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(userprogress.Table, userprogress.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.UserProgressTable, user.UserProgressColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAchievements queries the achievements edge of a User.
+func (c *UserClient) QueryAchievements(_m *User) *AchievementQuery {
+	query := (&AchievementClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(achievement.Table, achievement.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AchievementsTable, user.AchievementsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBattleRoomsHosted queries the battle_rooms_hosted edge of a User.
+func (c *UserClient) QueryBattleRoomsHosted(_m *User) *BattleRoomQuery {
+	query := (&BattleRoomClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(battleroom.Table, battleroom.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BattleRoomsHostedTable, user.BattleRoomsHostedColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBattleSessions queries the battle_sessions edge of a User.
+func (c *UserClient) QueryBattleSessions(_m *User) *BattleSessionQuery {
+	query := (&BattleSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(battlesession.Table, battlesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.BattleSessionsTable, user.BattleSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiveSessionsTeaching queries the live_sessions_teaching edge of a User.
+func (c *UserClient) QueryLiveSessionsTeaching(_m *User) *LiveSessionQuery {
+	query := (&LiveSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(livesession.Table, livesession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LiveSessionsTeachingTable, user.LiveSessionsTeachingColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryLiveSessionParticipations queries the live_session_participations edge of a User.
 func (c *UserClient) QueryLiveSessionParticipations(_m *User) *LiveSessionStudentQuery {
 	query := (&LiveSessionStudentClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(livesessionstudent.Table, livesessionstudent.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.LiveSessionParticipationsTable, user.LiveSessionParticipationsColumn),
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(livesessionstudent.Table, livesessionstudent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.LiveSessionParticipationsTable, user.LiveSessionParticipationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryClassroomSessions queries the classroom_sessions edge of a User.
+func (c *UserClient) QueryClassroomSessions(_m *User) *ClassroomSessionQuery {
+	query := (&ClassroomSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(classroomsession.Table, classroomsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomSessionsTable, user.ClassroomSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryShenbiSettings queries the shenbi_settings edge of a User.
+func (c *UserClient) QueryShenbiSettings(_m *User) *ShenbiSettingsQuery {
+	query := (&ShenbiSettingsClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(shenbisettings.Table, shenbisettings.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShenbiSettingsTable, user.ShenbiSettingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySentInvitations queries the sent_invitations edge of a User.
+func (c *UserClient) QuerySentInvitations(_m *User) *OrganizationInvitationQuery {
+	query := (&OrganizationInvitationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(organizationinvitation.Table, organizationinvitation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SentInvitationsTable, user.SentInvitationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryAuthSessions queries the auth_sessions edge of a User.
+func (c *UserClient) QueryAuthSessions(_m *User) *AuthSessionQuery {
+	query := (&AuthSessionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthSessionsTable, user.AuthSessionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryVerificationTokens queries the verification_tokens edge of a User.
+func (c *UserClient) QueryVerificationTokens(_m *User) *VerificationTokenQuery {
+	query := (&VerificationTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(verificationtoken.Table, verificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VerificationTokensTable, user.VerificationTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthConsents queries the oauth_consents edge of a User.
+func (c *UserClient) QueryOauthConsents(_m *User) *OAuthConsentQuery {
+	query := (&OAuthConsentClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(oauthconsent.Table, oauthconsent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthConsentsTable, user.OauthConsentsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOauthAuthorizationCodes queries the oauth_authorization_codes edge of a User.
+func (c *UserClient) QueryOauthAuthorizationCodes(_m *User) *OAuthAuthorizationCodeQuery {
+	query := (&OAuthAuthorizationCodeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthAuthorizationCodesTable, user.OauthAuthorizationCodesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QuerySSOLogins queries the sso_logins edge of a User.
+func (c *UserClient) QuerySSOLogins(_m *User) *SSOLoginQuery {
+	query := (&SSOLoginClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(ssologin.Table, ssologin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SSOLoginsTable, user.SSOLoginsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserClient) Hooks() []Hook {
+	return c.hooks.User
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserClient) Interceptors() []Interceptor {
+	return c.inters.User
+}
+
+func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+	}
+}
+
+// UserAppClient is a client for the UserApp schema.
+type UserAppClient struct {
+	config
+}
+
+// NewUserAppClient returns a client for the UserApp from the given config.
+func NewUserAppClient(c config) *UserAppClient {
+	return &UserAppClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userapp.Hooks(f(g(h())))`.
+func (c *UserAppClient) Use(hooks ...Hook) {
+	c.hooks.UserApp = append(c.hooks.UserApp, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userapp.Intercept(f(g(h())))`.
+func (c *UserAppClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserApp = append(c.inters.UserApp, interceptors...)
+}
+
+// Create returns a builder for creating a UserApp entity.
+func (c *UserAppClient) Create() *UserAppCreate {
+	mutation := newUserAppMutation(c.config, OpCreate)
+	return &UserAppCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserApp entities.
+func (c *UserAppClient) CreateBulk(builders ...*UserAppCreate) *UserAppCreateBulk {
+	return &UserAppCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserAppClient) MapCreateBulk(slice any, setFunc func(*UserAppCreate, int)) *UserAppCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserAppCreateBulk{err: fmt.Errorf("calling to UserAppClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserAppCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserAppCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserApp.
+func (c *UserAppClient) Update() *UserAppUpdate {
+	mutation := newUserAppMutation(c.config, OpUpdate)
+	return &UserAppUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserAppClient) UpdateOne(_m *UserApp) *UserAppUpdateOne {
+	mutation := newUserAppMutation(c.config, OpUpdateOne, withUserApp(_m))
+	return &UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserAppClient) UpdateOneID(id int) *UserAppUpdateOne {
+	mutation := newUserAppMutation(c.config, OpUpdateOne, withUserAppID(id))
+	return &UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserApp.
+func (c *UserAppClient) Delete() *UserAppDelete {
+	mutation := newUserAppMutation(c.config, OpDelete)
+	return &UserAppDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserAppClient) DeleteOne(_m *UserApp) *UserAppDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserAppClient) DeleteOneID(id int) *UserAppDeleteOne {
+	builder := c.Delete().Where(userapp.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserAppDeleteOne{builder}
+}
+
+// Query returns a query builder for UserApp.
+func (c *UserAppClient) Query() *UserAppQuery {
+	return &UserAppQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserApp},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserApp entity by its id.
+func (c *UserAppClient) Get(ctx context.Context, id int) (*UserApp, error) {
+	return c.Query().Where(userapp.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserAppClient) GetX(ctx context.Context, id int) *UserApp {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a UserApp.
+func (c *UserAppClient) QueryUser(_m *UserApp) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userapp.Table, userapp.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userapp.UserTable, userapp.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryApp queries the app edge of a UserApp.
+func (c *UserAppClient) QueryApp(_m *UserApp) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userapp.Table, userapp.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userapp.AppTable, userapp.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4184,31 +6311,313 @@ func (c *UserClient) QueryLiveSessionParticipations(_m *User) *LiveSessionStuden
 	return query
 }
 
-// QueryClassroomSessions queries the classroom_sessions edge of a User.
-func (c *UserClient) QueryClassroomSessions(_m *User) *ClassroomSessionQuery {
-	query := (&ClassroomSessionClient{config: c.config}).Query()
+// Hooks returns the client hooks.
+func (c *UserAppClient) Hooks() []Hook {
+	return c.hooks.UserApp
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserAppClient) Interceptors() []Interceptor {
+	return c.inters.UserApp
+}
+
+func (c *UserAppClient) mutate(ctx context.Context, m *UserAppMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserAppCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserAppUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserAppDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserApp mutation op: %q", m.Op())
+	}
+}
+
+// UserProgressClient is a client for the UserProgress schema.
+type UserProgressClient struct {
+	config
+}
+
+// NewUserProgressClient returns a client for the UserProgress from the given config.
+func NewUserProgressClient(c config) *UserProgressClient {
+	return &UserProgressClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `userprogress.Hooks(f(g(h())))`.
+func (c *UserProgressClient) Use(hooks ...Hook) {
+	c.hooks.UserProgress = append(c.hooks.UserProgress, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `userprogress.Intercept(f(g(h())))`.
+func (c *UserProgressClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserProgress = append(c.inters.UserProgress, interceptors...)
+}
+
+// Create returns a builder for creating a UserProgress entity.
+func (c *UserProgressClient) Create() *UserProgressCreate {
+	mutation := newUserProgressMutation(c.config, OpCreate)
+	return &UserProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserProgress entities.
+func (c *UserProgressClient) CreateBulk(builders ...*UserProgressCreate) *UserProgressCreateBulk {
+	return &UserProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserProgressClient) MapCreateBulk(slice any, setFunc func(*UserProgressCreate, int)) *UserProgressCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserProgressCreateBulk{err: fmt.Errorf("calling to UserProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserProgressCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserProgressCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserProgress.
+func (c *UserProgressClient) Update() *UserProgressUpdate {
+	mutation := newUserProgressMutation(c.config, OpUpdate)
+	return &UserProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserProgressClient) UpdateOne(_m *UserProgress) *UserProgressUpdateOne {
+	mutation := newUserProgressMutation(c.config, OpUpdateOne, withUserProgress(_m))
+	return &UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserProgressClient) UpdateOneID(id int) *UserProgressUpdateOne {
+	mutation := newUserProgressMutation(c.config, OpUpdateOne, withUserProgressID(id))
+	return &UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserProgress.
+func (c *UserProgressClient) Delete() *UserProgressDelete {
+	mutation := newUserProgressMutation(c.config, OpDelete)
+	return &UserProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserProgressClient) DeleteOne(_m *UserProgress) *UserProgressDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserProgressClient) DeleteOneID(id int) *UserProgressDeleteOne {
+	builder := c.Delete().Where(userprogress.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserProgressDeleteOne{builder}
+}
+
+// Query returns a query builder for UserProgress.
+func (c *UserProgressClient) Query() *UserProgressQuery {
+	return &UserProgressQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserProgress},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserProgress entity by its id.
+func (c *UserProgressClient) Get(ctx context.Context, id int) (*UserProgress, error) {
+	return c.Query().Where(userprogress.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserProgressClient) GetX(ctx context.Context, id int) *UserProgress {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryApp queries the app edge of a UserProgress.
+func (c *UserProgressClient) QueryApp(_m *UserProgress) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(classroomsession.Table, classroomsession.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.ClassroomSessionsTable, user.ClassroomSessionsColumn),
+			sqlgraph.From(userprogress.Table, userprogress.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userprogress.AppTable, userprogress.AppColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUser queries the user edge of a UserProgress.
+func (c *UserProgressClient) QueryUser(_m *UserProgress) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(userprogress.Table, userprogress.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, userprogress.UserTable, userprogress.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
 	}
-	return query
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *UserProgressClient) Hooks() []Hook {
+	return c.hooks.UserProgress
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserProgressClient) Interceptors() []Interceptor {
+	return c.inters.UserProgress
+}
+
+func (c *UserProgressClient) mutate(ctx context.Context, m *UserProgressMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserProgress mutation op: %q", m.Op())
+	}
+}
+
+// VerificationTokenClient is a client for the VerificationToken schema.
+type VerificationTokenClient struct {
+	config
+}
+
+// NewVerificationTokenClient returns a client for the VerificationToken from the given config.
+func NewVerificationTokenClient(c config) *VerificationTokenClient {
+	return &VerificationTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `verificationtoken.Hooks(f(g(h())))`.
+func (c *VerificationTokenClient) Use(hooks ...Hook) {
+	c.hooks.VerificationToken = append(c.hooks.VerificationToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `verificationtoken.Intercept(f(g(h())))`.
+func (c *VerificationTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.VerificationToken = append(c.inters.VerificationToken, interceptors...)
+}
+
+// Create returns a builder for creating a VerificationToken entity.
+func (c *VerificationTokenClient) Create() *VerificationTokenCreate {
+	mutation := newVerificationTokenMutation(c.config, OpCreate)
+	return &VerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of VerificationToken entities.
+func (c *VerificationTokenClient) CreateBulk(builders ...*VerificationTokenCreate) *VerificationTokenCreateBulk {
+	return &VerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *VerificationTokenClient) MapCreateBulk(slice any, setFunc func(*VerificationTokenCreate, int)) *VerificationTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &VerificationTokenCreateBulk{err: fmt.Errorf("calling to VerificationTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*VerificationTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &VerificationTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for VerificationToken.
+func (c *VerificationTokenClient) Update() *VerificationTokenUpdate {
+	mutation := newVerificationTokenMutation(c.config, OpUpdate)
+	return &VerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *VerificationTokenClient) UpdateOne(_m *VerificationToken) *VerificationTokenUpdateOne {
+	mutation := newVerificationTokenMutation(c.config, OpUpdateOne, withVerificationToken(_m))
+	return &VerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *VerificationTokenClient) UpdateOneID(id int) *VerificationTokenUpdateOne {
+	mutation := newVerificationTokenMutation(c.config, OpUpdateOne, withVerificationTokenID(id))
+	return &VerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for VerificationToken.
+func (c *VerificationTokenClient) Delete() *VerificationTokenDelete {
+	mutation := newVerificationTokenMutation(c.config, OpDelete)
+	return &VerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *VerificationTokenClient) DeleteOne(_m *VerificationToken) *VerificationTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *VerificationTokenClient) DeleteOneID(id int) *VerificationTokenDeleteOne {
+	builder := c.Delete().Where(verificationtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &VerificationTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for VerificationToken.
+func (c *VerificationTokenClient) Query() *VerificationTokenQuery {
+	return &VerificationTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeVerificationToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a VerificationToken entity by its id.
+func (c *VerificationTokenClient) Get(ctx context.Context, id int) (*VerificationToken, error) {
+	return c.Query().Where(verificationtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *VerificationTokenClient) GetX(ctx context.Context, id int) *VerificationToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
-// QueryShenbiSettings queries the shenbi_settings edge of a User.
-func (c *UserClient) QueryShenbiSettings(_m *User) *ShenbiSettingsQuery {
-	query := (&ShenbiSettingsClient{config: c.config}).Query()
+// QueryUser queries the user edge of a VerificationToken.
+func (c *VerificationTokenClient) QueryUser(_m *VerificationToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(shenbisettings.Table, shenbisettings.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ShenbiSettingsTable, user.ShenbiSettingsColumn),
+			sqlgraph.From(verificationtoken.Table, verificationtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, verificationtoken.UserTable, verificationtoken.UserColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4216,15 +6625,15 @@ func (c *UserClient) QueryShenbiSettings(_m *User) *ShenbiSettingsQuery {
 	return query
 }
 
-// QuerySentInvitations queries the sent_invitations edge of a User.
-func (c *UserClient) QuerySentInvitations(_m *User) *OrganizationInvitationQuery {
-	query := (&OrganizationInvitationClient{config: c.config}).Query()
+// QueryApp queries the app edge of a VerificationToken.
+func (c *VerificationTokenClient) QueryApp(_m *VerificationToken) *AppQuery {
+	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(user.Table, user.FieldID, id),
-			sqlgraph.To(organizationinvitation.Table, organizationinvitation.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, user.SentInvitationsTable, user.SentInvitationsColumn),
+			sqlgraph.From(verificationtoken.Table, verificationtoken.FieldID, id),
+			sqlgraph.To(app.Table, app.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, verificationtoken.AppTable, verificationtoken.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4233,131 +6642,131 @@ func (c *UserClient) QuerySentInvitations(_m *User) *OrganizationInvitationQuery
 }
 
 // Hooks returns the client hooks.
-func (c *UserClient) Hooks() []Hook {
-	return c.hooks.User
+func (c *VerificationTokenClient) Hooks() []Hook {
+	return c.hooks.VerificationToken
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserClient) Interceptors() []Interceptor {
-	return c.inters.User
+func (c *VerificationTokenClient) Interceptors() []Interceptor {
+	return c.inters.VerificationToken
 }
 
-func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error) {
+func (c *VerificationTokenClient) mutate(ctx context.Context, m *VerificationTokenMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&VerificationTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&VerificationTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown User mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown VerificationToken mutation op: %q", m.Op())
 	}
 }
 
-// UserAppClient is a client for the UserApp schema.
-type UserAppClient struct {
+// WebhookAttemptClient is a client for the WebhookAttempt schema.
+type WebhookAttemptClient struct {
 	config
 }
 
-// NewUserAppClient returns a client for the UserApp from the given config.
-func NewUserAppClient(c config) *UserAppClient {
-	return &UserAppClient{config: c}
+// NewWebhookAttemptClient returns a client for the WebhookAttempt from the given config.
+func NewWebhookAttemptClient(c config) *WebhookAttemptClient {
+	return &WebhookAttemptClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userapp.Hooks(f(g(h())))`.
-func (c *UserAppClient) Use(hooks ...Hook) {
-	c.hooks.UserApp = append(c.hooks.UserApp, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookattempt.Hooks(f(g(h())))`.
+func (c *WebhookAttemptClient) Use(hooks ...Hook) {
+	c.hooks.WebhookAttempt = append(c.hooks.WebhookAttempt, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userapp.Intercept(f(g(h())))`.
-func (c *UserAppClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserApp = append(c.inters.UserApp, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookattempt.Intercept(f(g(h())))`.
+func (c *WebhookAttemptClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookAttempt = append(c.inters.WebhookAttempt, interceptors...)
 }
 
-// Create returns a builder for creating a UserApp entity.
-func (c *UserAppClient) Create() *UserAppCreate {
-	mutation := newUserAppMutation(c.config, OpCreate)
-	return &UserAppCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookAttempt entity.
+func (c *WebhookAttemptClient) Create() *WebhookAttemptCreate {
+	mutation := newWebhookAttemptMutation(c.config, OpCreate)
+	return &WebhookAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserApp entities.
-func (c *UserAppClient) CreateBulk(builders ...*UserAppCreate) *UserAppCreateBulk {
-	return &UserAppCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookAttempt entities.
+func (c *WebhookAttemptClient) CreateBulk(builders ...*WebhookAttemptCreate) *WebhookAttemptCreateBulk {
+	return &WebhookAttemptCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserAppClient) MapCreateBulk(slice any, setFunc func(*UserAppCreate, int)) *UserAppCreateBulk {
+func (c *WebhookAttemptClient) MapCreateBulk(slice any, setFunc func(*WebhookAttemptCreate, int)) *WebhookAttemptCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserAppCreateBulk{err: fmt.Errorf("calling to UserAppClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookAttemptCreateBulk{err: fmt.Errorf("calling to WebhookAttemptClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserAppCreate, rv.Len())
+	builders := make([]*WebhookAttemptCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserAppCreateBulk{config: c.config, builders: builders}
+	return &WebhookAttemptCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserApp.
-func (c *UserAppClient) Update() *UserAppUpdate {
-	mutation := newUserAppMutation(c.config, OpUpdate)
-	return &UserAppUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookAttempt.
+func (c *WebhookAttemptClient) Update() *WebhookAttemptUpdate {
+	mutation := newWebhookAttemptMutation(c.config, OpUpdate)
+	return &WebhookAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserAppClient) UpdateOne(_m *UserApp) *UserAppUpdateOne {
-	mutation := newUserAppMutation(c.config, OpUpdateOne, withUserApp(_m))
-	return &UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookAttemptClient) UpdateOne(_m *WebhookAttempt) *WebhookAttemptUpdateOne {
+	mutation := newWebhookAttemptMutation(c.config, OpUpdateOne, withWebhookAttempt(_m))
+	return &WebhookAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserAppClient) UpdateOneID(id int) *UserAppUpdateOne {
-	mutation := newUserAppMutation(c.config, OpUpdateOne, withUserAppID(id))
-	return &UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookAttemptClient) UpdateOneID(id int) *WebhookAttemptUpdateOne {
+	mutation := newWebhookAttemptMutation(c.config, OpUpdateOne, withWebhookAttemptID(id))
+	return &WebhookAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserApp.
-func (c *UserAppClient) Delete() *UserAppDelete {
-	mutation := newUserAppMutation(c.config, OpDelete)
-	return &UserAppDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookAttempt.
+func (c *WebhookAttemptClient) Delete() *WebhookAttemptDelete {
+	mutation := newWebhookAttemptMutation(c.config, OpDelete)
+	return &WebhookAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserAppClient) DeleteOne(_m *UserApp) *UserAppDeleteOne {
+func (c *WebhookAttemptClient) DeleteOne(_m *WebhookAttempt) *WebhookAttemptDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserAppClient) DeleteOneID(id int) *UserAppDeleteOne {
-	builder := c.Delete().Where(userapp.ID(id))
+func (c *WebhookAttemptClient) DeleteOneID(id int) *WebhookAttemptDeleteOne {
+	builder := c.Delete().Where(webhookattempt.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserAppDeleteOne{builder}
+	return &WebhookAttemptDeleteOne{builder}
 }
 
-// Query returns a query builder for UserApp.
-func (c *UserAppClient) Query() *UserAppQuery {
-	return &UserAppQuery{
+// Query returns a query builder for WebhookAttempt.
+func (c *WebhookAttemptClient) Query() *WebhookAttemptQuery {
+	return &WebhookAttemptQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserApp},
+		ctx:    &QueryContext{Type: TypeWebhookAttempt},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserApp entity by its id.
-func (c *UserAppClient) Get(ctx context.Context, id int) (*UserApp, error) {
-	return c.Query().Where(userapp.ID(id)).Only(ctx)
+// Get returns a WebhookAttempt entity by its id.
+func (c *WebhookAttemptClient) Get(ctx context.Context, id int) (*WebhookAttempt, error) {
+	return c.Query().Where(webhookattempt.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserAppClient) GetX(ctx context.Context, id int) *UserApp {
+func (c *WebhookAttemptClient) GetX(ctx context.Context, id int) *WebhookAttempt {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4365,31 +6774,15 @@ func (c *UserAppClient) GetX(ctx context.Context, id int) *UserApp {
 	return obj
 }
 
-// QueryUser queries the user edge of a UserApp.
-func (c *UserAppClient) QueryUser(_m *UserApp) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(userapp.Table, userapp.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userapp.UserTable, userapp.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
-	}
-	return query
-}
-
-// QueryApp queries the app edge of a UserApp.
-func (c *UserAppClient) QueryApp(_m *UserApp) *AppQuery {
-	query := (&AppClient{config: c.config}).Query()
+// QueryDelivery queries the delivery edge of a WebhookAttempt.
+func (c *WebhookAttemptClient) QueryDelivery(_m *WebhookAttempt) *WebhookDeliveryQuery {
+	query := (&WebhookDeliveryClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(userapp.Table, userapp.FieldID, id),
-			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userapp.AppTable, userapp.AppColumn),
+			sqlgraph.From(webhookattempt.Table, webhookattempt.FieldID, id),
+			sqlgraph.To(webhookdelivery.Table, webhookdelivery.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookattempt.DeliveryTable, webhookattempt.DeliveryColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4398,131 +6791,131 @@ func (c *UserAppClient) QueryApp(_m *UserApp) *AppQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserAppClient) Hooks() []Hook {
-	return c.hooks.UserApp
+func (c *WebhookAttemptClient) Hooks() []Hook {
+	return c.hooks.WebhookAttempt
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserAppClient) Interceptors() []Interceptor {
-	return c.inters.UserApp
+func (c *WebhookAttemptClient) Interceptors() []Interceptor {
+	return c.inters.WebhookAttempt
 }
 
-func (c *UserAppClient) mutate(ctx context.Context, m *UserAppMutation) (Value, error) {
+func (c *WebhookAttemptClient) mutate(ctx context.Context, m *WebhookAttemptMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserAppCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookAttemptCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserAppUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookAttemptUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserAppUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookAttemptUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserAppDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookAttemptDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserApp mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookAttempt mutation op: %q", m.Op())
 	}
 }
 
-// UserProgressClient is a client for the UserProgress schema.
-type UserProgressClient struct {
+// WebhookDeliveryClient is a client for the WebhookDelivery schema.
+type WebhookDeliveryClient struct {
 	config
 }
 
-// NewUserProgressClient returns a client for the UserProgress from the given config.
-func NewUserProgressClient(c config) *UserProgressClient {
-	return &UserProgressClient{config: c}
+// NewWebhookDeliveryClient returns a client for the WebhookDelivery from the given config.
+func NewWebhookDeliveryClient(c config) *WebhookDeliveryClient {
+	return &WebhookDeliveryClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `userprogress.Hooks(f(g(h())))`.
-func (c *UserProgressClient) Use(hooks ...Hook) {
-	c.hooks.UserProgress = append(c.hooks.UserProgress, hooks...)
+// A call to `Use(f, g, h)` equals to `webhookdelivery.Hooks(f(g(h())))`.
+func (c *WebhookDeliveryClient) Use(hooks ...Hook) {
+	c.hooks.WebhookDelivery = append(c.hooks.WebhookDelivery, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `userprogress.Intercept(f(g(h())))`.
-func (c *UserProgressClient) Intercept(interceptors ...Interceptor) {
-	c.inters.UserProgress = append(c.inters.UserProgress, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `webhookdelivery.Intercept(f(g(h())))`.
+func (c *WebhookDeliveryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookDelivery = append(c.inters.WebhookDelivery, interceptors...)
 }
 
-// Create returns a builder for creating a UserProgress entity.
-func (c *UserProgressClient) Create() *UserProgressCreate {
-	mutation := newUserProgressMutation(c.config, OpCreate)
-	return &UserProgressCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a WebhookDelivery entity.
+func (c *WebhookDeliveryClient) Create() *WebhookDeliveryCreate {
+	mutation := newWebhookDeliveryMutation(c.config, OpCreate)
+	return &WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of UserProgress entities.
-func (c *UserProgressClient) CreateBulk(builders ...*UserProgressCreate) *UserProgressCreateBulk {
-	return &UserProgressCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of WebhookDelivery entities.
+func (c *WebhookDeliveryClient) CreateBulk(builders ...*WebhookDeliveryCreate) *WebhookDeliveryCreateBulk {
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *UserProgressClient) MapCreateBulk(slice any, setFunc func(*UserProgressCreate, int)) *UserProgressCreateBulk {
+func (c *WebhookDeliveryClient) MapCreateBulk(slice any, setFunc func(*WebhookDeliveryCreate, int)) *WebhookDeliveryCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &UserProgressCreateBulk{err: fmt.Errorf("calling to UserProgressClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &WebhookDeliveryCreateBulk{err: fmt.Errorf("calling to WebhookDeliveryClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*UserProgressCreate, rv.Len())
+	builders := make([]*WebhookDeliveryCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &UserProgressCreateBulk{config: c.config, builders: builders}
+	return &WebhookDeliveryCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for UserProgress.
-func (c *UserProgressClient) Update() *UserProgressUpdate {
-	mutation := newUserProgressMutation(c.config, OpUpdate)
-	return &UserProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Update() *WebhookDeliveryUpdate {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdate)
+	return &WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *UserProgressClient) UpdateOne(_m *UserProgress) *UserProgressUpdateOne {
-	mutation := newUserProgressMutation(c.config, OpUpdateOne, withUserProgress(_m))
-	return &UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOne(_m *WebhookDelivery) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDelivery(_m))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *UserProgressClient) UpdateOneID(id int) *UserProgressUpdateOne {
-	mutation := newUserProgressMutation(c.config, OpUpdateOne, withUserProgressID(id))
-	return &UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *WebhookDeliveryClient) UpdateOneID(id int) *WebhookDeliveryUpdateOne {
+	mutation := newWebhookDeliveryMutation(c.config, OpUpdateOne, withWebhookDeliveryID(id))
+	return &WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for UserProgress.
-func (c *UserProgressClient) Delete() *UserProgressDelete {
-	mutation := newUserProgressMutation(c.config, OpDelete)
-	return &UserProgressDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Delete() *WebhookDeliveryDelete {
+	mutation := newWebhookDeliveryMutation(c.config, OpDelete)
+	return &WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *UserProgressClient) DeleteOne(_m *UserProgress) *UserProgressDeleteOne {
+func (c *WebhookDeliveryClient) DeleteOne(_m *WebhookDelivery) *WebhookDeliveryDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *UserProgressClient) DeleteOneID(id int) *UserProgressDeleteOne {
-	builder := c.Delete().Where(userprogress.ID(id))
+func (c *WebhookDeliveryClient) DeleteOneID(id int) *WebhookDeliveryDeleteOne {
+	builder := c.Delete().Where(webhookdelivery.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &UserProgressDeleteOne{builder}
+	return &WebhookDeliveryDeleteOne{builder}
 }
 
-// Query returns a query builder for UserProgress.
-func (c *UserProgressClient) Query() *UserProgressQuery {
-	return &UserProgressQuery{
+// Query returns a query builder for WebhookDelivery.
+func (c *WebhookDeliveryClient) Query() *WebhookDeliveryQuery {
+	return &WebhookDeliveryQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypeUserProgress},
+		ctx:    &QueryContext{Type: TypeWebhookDelivery},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a UserProgress entity by its id.
-func (c *UserProgressClient) Get(ctx context.Context, id int) (*UserProgress, error) {
-	return c.Query().Where(userprogress.ID(id)).Only(ctx)
+// Get returns a WebhookDelivery entity by its id.
+func (c *WebhookDeliveryClient) Get(ctx context.Context, id int) (*WebhookDelivery, error) {
+	return c.Query().Where(webhookdelivery.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *UserProgressClient) GetX(ctx context.Context, id int) *UserProgress {
+func (c *WebhookDeliveryClient) GetX(ctx context.Context, id int) *WebhookDelivery {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -4530,15 +6923,15 @@ func (c *UserProgressClient) GetX(ctx context.Context, id int) *UserProgress {
 	return obj
 }
 
-// QueryApp queries the app edge of a UserProgress.
-func (c *UserProgressClient) QueryApp(_m *UserProgress) *AppQuery {
+// QueryApp queries the app edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryApp(_m *WebhookDelivery) *AppQuery {
 	query := (&AppClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(userprogress.Table, userprogress.FieldID, id),
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
 			sqlgraph.To(app.Table, app.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userprogress.AppTable, userprogress.AppColumn),
+			sqlgraph.Edge(sqlgraph.M2O, true, webhookdelivery.AppTable, webhookdelivery.AppColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4546,15 +6939,15 @@ func (c *UserProgressClient) QueryApp(_m *UserProgress) *AppQuery {
 	return query
 }
 
-// QueryUser queries the user edge of a UserProgress.
-func (c *UserProgressClient) QueryUser(_m *UserProgress) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryAttemptLogs queries the attempt_logs edge of a WebhookDelivery.
+func (c *WebhookDeliveryClient) QueryAttemptLogs(_m *WebhookDelivery) *WebhookAttemptQuery {
+	query := (&WebhookAttemptClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(userprogress.Table, userprogress.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, userprogress.UserTable, userprogress.UserColumn),
+			sqlgraph.From(webhookdelivery.Table, webhookdelivery.FieldID, id),
+			sqlgraph.To(webhookattempt.Table, webhookattempt.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, webhookdelivery.AttemptLogsTable, webhookdelivery.AttemptLogsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -4563,44 +6956,183 @@ func (c *UserProgressClient) QueryUser(_m *UserProgress) *UserQuery {
 }
 
 // Hooks returns the client hooks.
-func (c *UserProgressClient) Hooks() []Hook {
-	return c.hooks.UserProgress
+func (c *WebhookDeliveryClient) Hooks() []Hook {
+	return c.hooks.WebhookDelivery
 }
 
 // Interceptors returns the client interceptors.
-func (c *UserProgressClient) Interceptors() []Interceptor {
-	return c.inters.UserProgress
+func (c *WebhookDeliveryClient) Interceptors() []Interceptor {
+	return c.inters.WebhookDelivery
 }
 
-func (c *UserProgressClient) mutate(ctx context.Context, m *UserProgressMutation) (Value, error) {
+func (c *WebhookDeliveryClient) mutate(ctx context.Context, m *WebhookDeliveryMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&UserProgressCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&UserProgressUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&UserProgressUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&WebhookDeliveryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&UserProgressDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&WebhookDeliveryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown UserProgress mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown WebhookDelivery mutation op: %q", m.Op())
+	}
+}
+
+// WebhookEventClient is a client for the WebhookEvent schema.
+type WebhookEventClient struct {
+	config
+}
+
+// NewWebhookEventClient returns a client for the WebhookEvent from the given config.
+func NewWebhookEventClient(c config) *WebhookEventClient {
+	return &WebhookEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `webhookevent.Hooks(f(g(h())))`.
+func (c *WebhookEventClient) Use(hooks ...Hook) {
+	c.hooks.WebhookEvent = append(c.hooks.WebhookEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `webhookevent.Intercept(f(g(h())))`.
+func (c *WebhookEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WebhookEvent = append(c.inters.WebhookEvent, interceptors...)
+}
+
+// Create returns a builder for creating a WebhookEvent entity.
+func (c *WebhookEventClient) Create() *WebhookEventCreate {
+	mutation := newWebhookEventMutation(c.config, OpCreate)
+	return &WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WebhookEvent entities.
+func (c *WebhookEventClient) CreateBulk(builders ...*WebhookEventCreate) *WebhookEventCreateBulk {
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WebhookEventClient) MapCreateBulk(slice any, setFunc func(*WebhookEventCreate, int)) *WebhookEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WebhookEventCreateBulk{err: fmt.Errorf("calling to WebhookEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WebhookEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WebhookEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WebhookEvent.
+func (c *WebhookEventClient) Update() *WebhookEventUpdate {
+	mutation := newWebhookEventMutation(c.config, OpUpdate)
+	return &WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WebhookEventClient) UpdateOne(_m *WebhookEvent) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEvent(_m))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WebhookEventClient) UpdateOneID(id int) *WebhookEventUpdateOne {
+	mutation := newWebhookEventMutation(c.config, OpUpdateOne, withWebhookEventID(id))
+	return &WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WebhookEvent.
+func (c *WebhookEventClient) Delete() *WebhookEventDelete {
+	mutation := newWebhookEventMutation(c.config, OpDelete)
+	return &WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WebhookEventClient) DeleteOne(_m *WebhookEvent) *WebhookEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WebhookEventClient) DeleteOneID(id int) *WebhookEventDeleteOne {
+	builder := c.Delete().Where(webhookevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WebhookEventDeleteOne{builder}
+}
+
+// Query returns a query builder for WebhookEvent.
+func (c *WebhookEventClient) Query() *WebhookEventQuery {
+	return &WebhookEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWebhookEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WebhookEvent entity by its id.
+func (c *WebhookEventClient) Get(ctx context.Context, id int) (*WebhookEvent, error) {
+	return c.Query().Where(webhookevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WebhookEventClient) GetX(ctx context.Context, id int) *WebhookEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *WebhookEventClient) Hooks() []Hook {
+	return c.hooks.WebhookEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *WebhookEventClient) Interceptors() []Interceptor {
+	return c.inters.WebhookEvent
+}
+
+func (c *WebhookEventClient) mutate(ctx context.Context, m *WebhookEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WebhookEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WebhookEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WebhookEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WebhookEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WebhookEvent mutation op: %q", m.Op())
 	}
 }
 
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Achievement, App, Assignment, AssignmentSubmission, BattleRoom, BattleSession,
-		Classroom, ClassroomMembership, ClassroomSession, EmailTemplate, LiveSession,
-		LiveSessionStudent, Organization, OrganizationInvitation, OrganizationMember,
-		Plan, ShenbiProfile, ShenbiSettings, Subscription, User, UserApp,
-		UserProgress []ent.Hook
+		Achievement, AdminAccount, App, Assignment, AssignmentSubmission, AuditEvent,
+		AuthSession, BattleRoom, BattleSession, Classroom, ClassroomMembership,
+		ClassroomSession, EmailTemplate, LiveSession, LiveSessionStudent,
+		OAuthAuthorizationCode, OAuthConsent, Organization, OrganizationDomain,
+		OrganizationInvitation, OrganizationMember, Plan, RateLimitBucket,
+		RefreshToken, SSOConnection, SSOLogin, ShenbiProfile, ShenbiSettings,
+		Subscription, User, UserApp, UserProgress, VerificationToken, WebhookAttempt,
+		WebhookDelivery, WebhookEvent []ent.Hook
 	}
 	inters struct {
-		Achievement, App, Assignment, AssignmentSubmission, BattleRoom, BattleSession,
-		Classroom, ClassroomMembership, ClassroomSession, EmailTemplate, LiveSession,
-		LiveSessionStudent, Organization, OrganizationInvitation, OrganizationMember,
-		Plan, ShenbiProfile, ShenbiSettings, Subscription, User, UserApp,
-		UserProgress []ent.Interceptor
+		Achievement, AdminAccount, App, Assignment, AssignmentSubmission, AuditEvent,
+		AuthSession, BattleRoom, BattleSession, Classroom, ClassroomMembership,
+		ClassroomSession, EmailTemplate, LiveSession, LiveSessionStudent,
+		OAuthAuthorizationCode, OAuthConsent, Organization, OrganizationDomain,
+		OrganizationInvitation, OrganizationMember, Plan, RateLimitBucket,
+		RefreshToken, SSOConnection, SSOLogin, ShenbiProfile, ShenbiSettings,
+		Subscription, User, UserApp, UserProgress, VerificationToken, WebhookAttempt,
+		WebhookDelivery, WebhookEvent []ent.Interceptor
 	}
 )
@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/assignment"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -24,16 +27,27 @@ import (
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/ratelimitbucket"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/ent/webhookevent"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -95,9 +109,12 @@ func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
 			achievement.Table:            achievement.ValidColumn,
+			adminaccount.Table:           adminaccount.ValidColumn,
 			app.Table:                    app.ValidColumn,
 			assignment.Table:             assignment.ValidColumn,
 			assignmentsubmission.Table:   assignmentsubmission.ValidColumn,
+			auditevent.Table:             auditevent.ValidColumn,
+			authsession.Table:            authsession.ValidColumn,
 			battleroom.Table:             battleroom.ValidColumn,
 			battlesession.Table:          battlesession.ValidColumn,
 			classroom.Table:              classroom.ValidColumn,
@@ -106,16 +123,27 @@ func checkColumn(t, c string) error {
 			emailtemplate.Table:          emailtemplate.ValidColumn,
 			livesession.Table:            livesession.ValidColumn,
 			livesessionstudent.Table:     livesessionstudent.ValidColumn,
+			oauthauthorizationcode.Table: oauthauthorizationcode.ValidColumn,
+			oauthconsent.Table:           oauthconsent.ValidColumn,
 			organization.Table:           organization.ValidColumn,
+			organizationdomain.Table:     organizationdomain.ValidColumn,
 			organizationinvitation.Table: organizationinvitation.ValidColumn,
 			organizationmember.Table:     organizationmember.ValidColumn,
 			plan.Table:                   plan.ValidColumn,
+			ratelimitbucket.Table:        ratelimitbucket.ValidColumn,
+			refreshtoken.Table:           refreshtoken.ValidColumn,
+			ssoconnection.Table:          ssoconnection.ValidColumn,
+			ssologin.Table:               ssologin.ValidColumn,
 			shenbiprofile.Table:          shenbiprofile.ValidColumn,
 			shenbisettings.Table:         shenbisettings.ValidColumn,
 			subscription.Table:           subscription.ValidColumn,
 			user.Table:                   user.ValidColumn,
 			userapp.Table:                userapp.ValidColumn,
 			userprogress.Table:           userprogress.ValidColumn,
+			verificationtoken.Table:      verificationtoken.ValidColumn,
+			webhookattempt.Table:         webhookattempt.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhookevent.Table:           webhookevent.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -0,0 +1,1199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package intercept
+
+import (
+	"context"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/assignment"
+	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
+	"gigaboo.io/lem/internal/ent/battleroom"
+	"gigaboo.io/lem/internal/ent/battlesession"
+	"gigaboo.io/lem/internal/ent/classroom"
+	"gigaboo.io/lem/internal/ent/classroommembership"
+	"gigaboo.io/lem/internal/ent/classroomsession"
+	"gigaboo.io/lem/internal/ent/emailtemplate"
+	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+	"gigaboo.io/lem/internal/ent/organizationinvitation"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/ratelimitbucket"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/ent/userapp"
+	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/ent/webhookevent"
+)
+
+// The Query interface represents an operation that queries a graph.
+// By using this interface, users can write generic code that manipulates
+// query builders of different types.
+type Query interface {
+	// Type returns the string representation of the query type.
+	Type() string
+	// Limit the number of records to be returned by this query.
+	Limit(int)
+	// Offset to start from.
+	Offset(int)
+	// Unique configures the query builder to filter duplicate records.
+	Unique(bool)
+	// Order specifies how the records should be ordered.
+	Order(...func(*sql.Selector))
+	// WhereP appends storage-level predicates to the query builder. Using this method, users
+	// can use type-assertion to append predicates that do not depend on any generated package.
+	WhereP(...func(*sql.Selector))
+}
+
+// The Func type is an adapter that allows ordinary functions to be used as interceptors.
+// Unlike traversal functions, interceptors are skipped during graph traversals. Note that the
+// implementation of Func is different from the one defined in entgo.io/ent.InterceptFunc.
+type Func func(context.Context, Query) error
+
+// Intercept calls f(ctx, q) and then applied the next Querier.
+func (f Func) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		query, err := NewQuery(q)
+		if err != nil {
+			return nil, err
+		}
+		if err := f(ctx, query); err != nil {
+			return nil, err
+		}
+		return next.Query(ctx, q)
+	})
+}
+
+// The TraverseFunc type is an adapter to allow the use of ordinary function as Traverser.
+// If f is a function with the appropriate signature, TraverseFunc(f) is a Traverser that calls f.
+type TraverseFunc func(context.Context, Query) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseFunc) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseFunc) Traverse(ctx context.Context, q ent.Query) error {
+	query, err := NewQuery(q)
+	if err != nil {
+		return err
+	}
+	return f(ctx, query)
+}
+
+// The AchievementFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AchievementFunc func(context.Context, *ent.AchievementQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AchievementFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AchievementQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AchievementQuery", q)
+}
+
+// The TraverseAchievement type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAchievement func(context.Context, *ent.AchievementQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAchievement) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAchievement) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AchievementQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AchievementQuery", q)
+}
+
+// The AdminAccountFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AdminAccountFunc func(context.Context, *ent.AdminAccountQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AdminAccountFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AdminAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AdminAccountQuery", q)
+}
+
+// The TraverseAdminAccount type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAdminAccount func(context.Context, *ent.AdminAccountQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAdminAccount) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAdminAccount) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AdminAccountQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AdminAccountQuery", q)
+}
+
+// The AppFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AppFunc func(context.Context, *ent.AppQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AppFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AppQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AppQuery", q)
+}
+
+// The TraverseApp type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseApp func(context.Context, *ent.AppQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseApp) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseApp) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AppQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AppQuery", q)
+}
+
+// The AssignmentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AssignmentFunc func(context.Context, *ent.AssignmentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AssignmentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AssignmentQuery", q)
+}
+
+// The TraverseAssignment type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAssignment func(context.Context, *ent.AssignmentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAssignment) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAssignment) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AssignmentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AssignmentQuery", q)
+}
+
+// The AssignmentSubmissionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AssignmentSubmissionFunc func(context.Context, *ent.AssignmentSubmissionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AssignmentSubmissionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AssignmentSubmissionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AssignmentSubmissionQuery", q)
+}
+
+// The TraverseAssignmentSubmission type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAssignmentSubmission func(context.Context, *ent.AssignmentSubmissionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAssignmentSubmission) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAssignmentSubmission) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AssignmentSubmissionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AssignmentSubmissionQuery", q)
+}
+
+// The AuditEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuditEventFunc func(context.Context, *ent.AuditEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuditEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuditEventQuery", q)
+}
+
+// The TraverseAuditEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuditEvent func(context.Context, *ent.AuditEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuditEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuditEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuditEventQuery", q)
+}
+
+// The AuthSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type AuthSessionFunc func(context.Context, *ent.AuthSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f AuthSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.AuthSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.AuthSessionQuery", q)
+}
+
+// The TraverseAuthSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseAuthSession func(context.Context, *ent.AuthSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseAuthSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseAuthSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuthSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.AuthSessionQuery", q)
+}
+
+// The BattleRoomFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BattleRoomFunc func(context.Context, *ent.BattleRoomQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BattleRoomFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BattleRoomQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BattleRoomQuery", q)
+}
+
+// The TraverseBattleRoom type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBattleRoom func(context.Context, *ent.BattleRoomQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBattleRoom) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBattleRoom) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BattleRoomQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BattleRoomQuery", q)
+}
+
+// The BattleSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type BattleSessionFunc func(context.Context, *ent.BattleSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f BattleSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.BattleSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.BattleSessionQuery", q)
+}
+
+// The TraverseBattleSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseBattleSession func(context.Context, *ent.BattleSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseBattleSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseBattleSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.BattleSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.BattleSessionQuery", q)
+}
+
+// The ClassroomFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomFunc func(context.Context, *ent.ClassroomQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ClassroomQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ClassroomQuery", q)
+}
+
+// The TraverseClassroom type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroom func(context.Context, *ent.ClassroomQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroom) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroom) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ClassroomQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ClassroomQuery", q)
+}
+
+// The ClassroomMembershipFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomMembershipFunc func(context.Context, *ent.ClassroomMembershipQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomMembershipFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ClassroomMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ClassroomMembershipQuery", q)
+}
+
+// The TraverseClassroomMembership type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroomMembership func(context.Context, *ent.ClassroomMembershipQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroomMembership) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroomMembership) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ClassroomMembershipQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ClassroomMembershipQuery", q)
+}
+
+// The ClassroomSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ClassroomSessionFunc func(context.Context, *ent.ClassroomSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ClassroomSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ClassroomSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ClassroomSessionQuery", q)
+}
+
+// The TraverseClassroomSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseClassroomSession func(context.Context, *ent.ClassroomSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseClassroomSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseClassroomSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ClassroomSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ClassroomSessionQuery", q)
+}
+
+// The EmailTemplateFunc type is an adapter to allow the use of ordinary function as a Querier.
+type EmailTemplateFunc func(context.Context, *ent.EmailTemplateQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f EmailTemplateFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.EmailTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.EmailTemplateQuery", q)
+}
+
+// The TraverseEmailTemplate type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseEmailTemplate func(context.Context, *ent.EmailTemplateQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseEmailTemplate) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseEmailTemplate) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EmailTemplateQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.EmailTemplateQuery", q)
+}
+
+// The LiveSessionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LiveSessionFunc func(context.Context, *ent.LiveSessionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LiveSessionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LiveSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LiveSessionQuery", q)
+}
+
+// The TraverseLiveSession type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLiveSession func(context.Context, *ent.LiveSessionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLiveSession) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLiveSession) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LiveSessionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LiveSessionQuery", q)
+}
+
+// The LiveSessionStudentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type LiveSessionStudentFunc func(context.Context, *ent.LiveSessionStudentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f LiveSessionStudentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.LiveSessionStudentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.LiveSessionStudentQuery", q)
+}
+
+// The TraverseLiveSessionStudent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseLiveSessionStudent func(context.Context, *ent.LiveSessionStudentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseLiveSessionStudent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseLiveSessionStudent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LiveSessionStudentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.LiveSessionStudentQuery", q)
+}
+
+// The OAuthAuthorizationCodeFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OAuthAuthorizationCodeFunc func(context.Context, *ent.OAuthAuthorizationCodeQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OAuthAuthorizationCodeFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OAuthAuthorizationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OAuthAuthorizationCodeQuery", q)
+}
+
+// The TraverseOAuthAuthorizationCode type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOAuthAuthorizationCode func(context.Context, *ent.OAuthAuthorizationCodeQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOAuthAuthorizationCode) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOAuthAuthorizationCode) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OAuthAuthorizationCodeQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OAuthAuthorizationCodeQuery", q)
+}
+
+// The OAuthConsentFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OAuthConsentFunc func(context.Context, *ent.OAuthConsentQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OAuthConsentFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OAuthConsentQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OAuthConsentQuery", q)
+}
+
+// The TraverseOAuthConsent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOAuthConsent func(context.Context, *ent.OAuthConsentQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOAuthConsent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOAuthConsent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OAuthConsentQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OAuthConsentQuery", q)
+}
+
+// The OrganizationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationFunc func(context.Context, *ent.OrganizationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OrganizationQuery", q)
+}
+
+// The TraverseOrganization type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganization func(context.Context, *ent.OrganizationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganization) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganization) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OrganizationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OrganizationQuery", q)
+}
+
+// The OrganizationDomainFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationDomainFunc func(context.Context, *ent.OrganizationDomainQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationDomainFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OrganizationDomainQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OrganizationDomainQuery", q)
+}
+
+// The TraverseOrganizationDomain type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganizationDomain func(context.Context, *ent.OrganizationDomainQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganizationDomain) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganizationDomain) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OrganizationDomainQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OrganizationDomainQuery", q)
+}
+
+// The OrganizationInvitationFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationInvitationFunc func(context.Context, *ent.OrganizationInvitationQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationInvitationFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OrganizationInvitationQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OrganizationInvitationQuery", q)
+}
+
+// The TraverseOrganizationInvitation type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganizationInvitation func(context.Context, *ent.OrganizationInvitationQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganizationInvitation) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganizationInvitation) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OrganizationInvitationQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OrganizationInvitationQuery", q)
+}
+
+// The OrganizationMemberFunc type is an adapter to allow the use of ordinary function as a Querier.
+type OrganizationMemberFunc func(context.Context, *ent.OrganizationMemberQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f OrganizationMemberFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.OrganizationMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.OrganizationMemberQuery", q)
+}
+
+// The TraverseOrganizationMember type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseOrganizationMember func(context.Context, *ent.OrganizationMemberQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseOrganizationMember) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseOrganizationMember) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OrganizationMemberQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.OrganizationMemberQuery", q)
+}
+
+// The PlanFunc type is an adapter to allow the use of ordinary function as a Querier.
+type PlanFunc func(context.Context, *ent.PlanQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f PlanFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.PlanQuery", q)
+}
+
+// The TraversePlan type is an adapter to allow the use of ordinary function as Traverser.
+type TraversePlan func(context.Context, *ent.PlanQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraversePlan) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraversePlan) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PlanQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.PlanQuery", q)
+}
+
+// The RateLimitBucketFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RateLimitBucketFunc func(context.Context, *ent.RateLimitBucketQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RateLimitBucketFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RateLimitBucketQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RateLimitBucketQuery", q)
+}
+
+// The TraverseRateLimitBucket type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRateLimitBucket func(context.Context, *ent.RateLimitBucketQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRateLimitBucket) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRateLimitBucket) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RateLimitBucketQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RateLimitBucketQuery", q)
+}
+
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f RefreshTokenFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.RefreshTokenQuery", q)
+}
+
+// The TraverseRefreshToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRefreshToken func(context.Context, *ent.RefreshTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRefreshToken) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRefreshToken) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.RefreshTokenQuery", q)
+}
+
+// The SSOConnectionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SSOConnectionFunc func(context.Context, *ent.SSOConnectionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SSOConnectionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SSOConnectionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SSOConnectionQuery", q)
+}
+
+// The TraverseSSOConnection type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSSOConnection func(context.Context, *ent.SSOConnectionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSSOConnection) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSSOConnection) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SSOConnectionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SSOConnectionQuery", q)
+}
+
+// The SSOLoginFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SSOLoginFunc func(context.Context, *ent.SSOLoginQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SSOLoginFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SSOLoginQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SSOLoginQuery", q)
+}
+
+// The TraverseSSOLogin type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSSOLogin func(context.Context, *ent.SSOLoginQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSSOLogin) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSSOLogin) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SSOLoginQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SSOLoginQuery", q)
+}
+
+// The ShenbiProfileFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ShenbiProfileFunc func(context.Context, *ent.ShenbiProfileQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ShenbiProfileFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ShenbiProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ShenbiProfileQuery", q)
+}
+
+// The TraverseShenbiProfile type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseShenbiProfile func(context.Context, *ent.ShenbiProfileQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseShenbiProfile) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseShenbiProfile) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShenbiProfileQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ShenbiProfileQuery", q)
+}
+
+// The ShenbiSettingsFunc type is an adapter to allow the use of ordinary function as a Querier.
+type ShenbiSettingsFunc func(context.Context, *ent.ShenbiSettingsQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f ShenbiSettingsFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.ShenbiSettingsQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.ShenbiSettingsQuery", q)
+}
+
+// The TraverseShenbiSettings type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseShenbiSettings func(context.Context, *ent.ShenbiSettingsQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseShenbiSettings) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseShenbiSettings) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.ShenbiSettingsQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.ShenbiSettingsQuery", q)
+}
+
+// The SubscriptionFunc type is an adapter to allow the use of ordinary function as a Querier.
+type SubscriptionFunc func(context.Context, *ent.SubscriptionQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f SubscriptionFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.SubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.SubscriptionQuery", q)
+}
+
+// The TraverseSubscription type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseSubscription func(context.Context, *ent.SubscriptionQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseSubscription) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseSubscription) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.SubscriptionQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.SubscriptionQuery", q)
+}
+
+// The UserFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserFunc func(context.Context, *ent.UserQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
+}
+
+// The TraverseUser type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUser func(context.Context, *ent.UserQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUser) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUser) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserQuery", q)
+}
+
+// The UserAppFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserAppFunc func(context.Context, *ent.UserAppQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserAppFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserAppQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserAppQuery", q)
+}
+
+// The TraverseUserApp type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserApp func(context.Context, *ent.UserAppQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserApp) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserApp) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserAppQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserAppQuery", q)
+}
+
+// The UserProgressFunc type is an adapter to allow the use of ordinary function as a Querier.
+type UserProgressFunc func(context.Context, *ent.UserProgressQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f UserProgressFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.UserProgressQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.UserProgressQuery", q)
+}
+
+// The TraverseUserProgress type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseUserProgress func(context.Context, *ent.UserProgressQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseUserProgress) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseUserProgress) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.UserProgressQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.UserProgressQuery", q)
+}
+
+// The VerificationTokenFunc type is an adapter to allow the use of ordinary function as a Querier.
+type VerificationTokenFunc func(context.Context, *ent.VerificationTokenQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f VerificationTokenFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.VerificationTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.VerificationTokenQuery", q)
+}
+
+// The TraverseVerificationToken type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseVerificationToken func(context.Context, *ent.VerificationTokenQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseVerificationToken) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseVerificationToken) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.VerificationTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.VerificationTokenQuery", q)
+}
+
+// The WebhookAttemptFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookAttemptFunc func(context.Context, *ent.WebhookAttemptQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookAttemptFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WebhookAttemptQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WebhookAttemptQuery", q)
+}
+
+// The TraverseWebhookAttempt type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookAttempt func(context.Context, *ent.WebhookAttemptQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookAttempt) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookAttempt) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebhookAttemptQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WebhookAttemptQuery", q)
+}
+
+// The WebhookDeliveryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookDeliveryFunc func(context.Context, *ent.WebhookDeliveryQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookDeliveryFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WebhookDeliveryQuery", q)
+}
+
+// The TraverseWebhookDelivery type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookDelivery func(context.Context, *ent.WebhookDeliveryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookDelivery) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookDelivery) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebhookDeliveryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WebhookDeliveryQuery", q)
+}
+
+// The WebhookEventFunc type is an adapter to allow the use of ordinary function as a Querier.
+type WebhookEventFunc func(context.Context, *ent.WebhookEventQuery) (ent.Value, error)
+
+// Query calls f(ctx, q).
+func (f WebhookEventFunc) Query(ctx context.Context, q ent.Query) (ent.Value, error) {
+	if q, ok := q.(*ent.WebhookEventQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *ent.WebhookEventQuery", q)
+}
+
+// The TraverseWebhookEvent type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseWebhookEvent func(context.Context, *ent.WebhookEventQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseWebhookEvent) Intercept(next ent.Querier) ent.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseWebhookEvent) Traverse(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WebhookEventQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *ent.WebhookEventQuery", q)
+}
+
+// NewQuery returns the generic Query interface for the given typed query.
+func NewQuery(q ent.Query) (Query, error) {
+	switch q := q.(type) {
+	case *ent.AchievementQuery:
+		return &query[*ent.AchievementQuery, predicate.Achievement, achievement.OrderOption]{typ: ent.TypeAchievement, tq: q}, nil
+	case *ent.AdminAccountQuery:
+		return &query[*ent.AdminAccountQuery, predicate.AdminAccount, adminaccount.OrderOption]{typ: ent.TypeAdminAccount, tq: q}, nil
+	case *ent.AppQuery:
+		return &query[*ent.AppQuery, predicate.App, app.OrderOption]{typ: ent.TypeApp, tq: q}, nil
+	case *ent.AssignmentQuery:
+		return &query[*ent.AssignmentQuery, predicate.Assignment, assignment.OrderOption]{typ: ent.TypeAssignment, tq: q}, nil
+	case *ent.AssignmentSubmissionQuery:
+		return &query[*ent.AssignmentSubmissionQuery, predicate.AssignmentSubmission, assignmentsubmission.OrderOption]{typ: ent.TypeAssignmentSubmission, tq: q}, nil
+	case *ent.AuditEventQuery:
+		return &query[*ent.AuditEventQuery, predicate.AuditEvent, auditevent.OrderOption]{typ: ent.TypeAuditEvent, tq: q}, nil
+	case *ent.AuthSessionQuery:
+		return &query[*ent.AuthSessionQuery, predicate.AuthSession, authsession.OrderOption]{typ: ent.TypeAuthSession, tq: q}, nil
+	case *ent.BattleRoomQuery:
+		return &query[*ent.BattleRoomQuery, predicate.BattleRoom, battleroom.OrderOption]{typ: ent.TypeBattleRoom, tq: q}, nil
+	case *ent.BattleSessionQuery:
+		return &query[*ent.BattleSessionQuery, predicate.BattleSession, battlesession.OrderOption]{typ: ent.TypeBattleSession, tq: q}, nil
+	case *ent.ClassroomQuery:
+		return &query[*ent.ClassroomQuery, predicate.Classroom, classroom.OrderOption]{typ: ent.TypeClassroom, tq: q}, nil
+	case *ent.ClassroomMembershipQuery:
+		return &query[*ent.ClassroomMembershipQuery, predicate.ClassroomMembership, classroommembership.OrderOption]{typ: ent.TypeClassroomMembership, tq: q}, nil
+	case *ent.ClassroomSessionQuery:
+		return &query[*ent.ClassroomSessionQuery, predicate.ClassroomSession, classroomsession.OrderOption]{typ: ent.TypeClassroomSession, tq: q}, nil
+	case *ent.EmailTemplateQuery:
+		return &query[*ent.EmailTemplateQuery, predicate.EmailTemplate, emailtemplate.OrderOption]{typ: ent.TypeEmailTemplate, tq: q}, nil
+	case *ent.LiveSessionQuery:
+		return &query[*ent.LiveSessionQuery, predicate.LiveSession, livesession.OrderOption]{typ: ent.TypeLiveSession, tq: q}, nil
+	case *ent.LiveSessionStudentQuery:
+		return &query[*ent.LiveSessionStudentQuery, predicate.LiveSessionStudent, livesessionstudent.OrderOption]{typ: ent.TypeLiveSessionStudent, tq: q}, nil
+	case *ent.OAuthAuthorizationCodeQuery:
+		return &query[*ent.OAuthAuthorizationCodeQuery, predicate.OAuthAuthorizationCode, oauthauthorizationcode.OrderOption]{typ: ent.TypeOAuthAuthorizationCode, tq: q}, nil
+	case *ent.OAuthConsentQuery:
+		return &query[*ent.OAuthConsentQuery, predicate.OAuthConsent, oauthconsent.OrderOption]{typ: ent.TypeOAuthConsent, tq: q}, nil
+	case *ent.OrganizationQuery:
+		return &query[*ent.OrganizationQuery, predicate.Organization, organization.OrderOption]{typ: ent.TypeOrganization, tq: q}, nil
+	case *ent.OrganizationDomainQuery:
+		return &query[*ent.OrganizationDomainQuery, predicate.OrganizationDomain, organizationdomain.OrderOption]{typ: ent.TypeOrganizationDomain, tq: q}, nil
+	case *ent.OrganizationInvitationQuery:
+		return &query[*ent.OrganizationInvitationQuery, predicate.OrganizationInvitation, organizationinvitation.OrderOption]{typ: ent.TypeOrganizationInvitation, tq: q}, nil
+	case *ent.OrganizationMemberQuery:
+		return &query[*ent.OrganizationMemberQuery, predicate.OrganizationMember, organizationmember.OrderOption]{typ: ent.TypeOrganizationMember, tq: q}, nil
+	case *ent.PlanQuery:
+		return &query[*ent.PlanQuery, predicate.Plan, plan.OrderOption]{typ: ent.TypePlan, tq: q}, nil
+	case *ent.RateLimitBucketQuery:
+		return &query[*ent.RateLimitBucketQuery, predicate.RateLimitBucket, ratelimitbucket.OrderOption]{typ: ent.TypeRateLimitBucket, tq: q}, nil
+	case *ent.RefreshTokenQuery:
+		return &query[*ent.RefreshTokenQuery, predicate.RefreshToken, refreshtoken.OrderOption]{typ: ent.TypeRefreshToken, tq: q}, nil
+	case *ent.SSOConnectionQuery:
+		return &query[*ent.SSOConnectionQuery, predicate.SSOConnection, ssoconnection.OrderOption]{typ: ent.TypeSSOConnection, tq: q}, nil
+	case *ent.SSOLoginQuery:
+		return &query[*ent.SSOLoginQuery, predicate.SSOLogin, ssologin.OrderOption]{typ: ent.TypeSSOLogin, tq: q}, nil
+	case *ent.ShenbiProfileQuery:
+		return &query[*ent.ShenbiProfileQuery, predicate.ShenbiProfile, shenbiprofile.OrderOption]{typ: ent.TypeShenbiProfile, tq: q}, nil
+	case *ent.ShenbiSettingsQuery:
+		return &query[*ent.ShenbiSettingsQuery, predicate.ShenbiSettings, shenbisettings.OrderOption]{typ: ent.TypeShenbiSettings, tq: q}, nil
+	case *ent.SubscriptionQuery:
+		return &query[*ent.SubscriptionQuery, predicate.Subscription, subscription.OrderOption]{typ: ent.TypeSubscription, tq: q}, nil
+	case *ent.UserQuery:
+		return &query[*ent.UserQuery, predicate.User, user.OrderOption]{typ: ent.TypeUser, tq: q}, nil
+	case *ent.UserAppQuery:
+		return &query[*ent.UserAppQuery, predicate.UserApp, userapp.OrderOption]{typ: ent.TypeUserApp, tq: q}, nil
+	case *ent.UserProgressQuery:
+		return &query[*ent.UserProgressQuery, predicate.UserProgress, userprogress.OrderOption]{typ: ent.TypeUserProgress, tq: q}, nil
+	case *ent.VerificationTokenQuery:
+		return &query[*ent.VerificationTokenQuery, predicate.VerificationToken, verificationtoken.OrderOption]{typ: ent.TypeVerificationToken, tq: q}, nil
+	case *ent.WebhookAttemptQuery:
+		return &query[*ent.WebhookAttemptQuery, predicate.WebhookAttempt, webhookattempt.OrderOption]{typ: ent.TypeWebhookAttempt, tq: q}, nil
+	case *ent.WebhookDeliveryQuery:
+		return &query[*ent.WebhookDeliveryQuery, predicate.WebhookDelivery, webhookdelivery.OrderOption]{typ: ent.TypeWebhookDelivery, tq: q}, nil
+	case *ent.WebhookEventQuery:
+		return &query[*ent.WebhookEventQuery, predicate.WebhookEvent, webhookevent.OrderOption]{typ: ent.TypeWebhookEvent, tq: q}, nil
+	default:
+		return nil, fmt.Errorf("unknown query type %T", q)
+	}
+}
+
+type query[T any, P ~func(*sql.Selector), R ~func(*sql.Selector)] struct {
+	typ string
+	tq  interface {
+		Limit(int) T
+		Offset(int) T
+		Unique(bool) T
+		Order(...R) T
+		Where(...P) T
+	}
+}
+
+func (q query[T, P, R]) Type() string {
+	return q.typ
+}
+
+func (q query[T, P, R]) Limit(limit int) {
+	q.tq.Limit(limit)
+}
+
+func (q query[T, P, R]) Offset(offset int) {
+	q.tq.Offset(offset)
+}
+
+func (q query[T, P, R]) Unique(unique bool) {
+	q.tq.Unique(unique)
+}
+
+func (q query[T, P, R]) Order(orders ...func(*sql.Selector)) {
+	rs := make([]R, len(orders))
+	for i := range orders {
+		rs[i] = orders[i]
+	}
+	q.tq.Order(rs...)
+}
+
+func (q query[T, P, R]) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]P, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	q.tq.Where(p...)
+}
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -43,16 +44,46 @@ var (
 			},
 		},
 	}
+	// AdminAccountsColumns holds the columns for the "admin_accounts" table.
+	AdminAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "email", Type: field.TypeString, Unique: true},
+		{Name: "name", Type: field.TypeString, Nullable: true},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"SUPER_ADMIN", "APP_ADMIN", "SUPPORT", "BILLING"}, Default: "SUPPORT"},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "last_login_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// AdminAccountsTable holds the schema information for the "admin_accounts" table.
+	AdminAccountsTable = &schema.Table{
+		Name:       "admin_accounts",
+		Columns:    AdminAccountsColumns,
+		PrimaryKey: []*schema.Column{AdminAccountsColumns[0]},
+	}
 	// AppsColumns holds the columns for the "apps" table.
 	AppsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "slug", Type: field.TypeString, Unique: true},
-		{Name: "api_key", Type: field.TypeString, Unique: true},
+		{Name: "api_key", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "api_secret", Type: field.TypeString, Nullable: true},
+		{Name: "api_key_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "api_key_prefix", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_key_hash", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_key_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "api_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_secret_hash", Type: field.TypeString, Nullable: true},
+		{Name: "api_secret_encrypted", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_secret_encrypted", Type: field.TypeString, Nullable: true},
+		{Name: "previous_api_secret_expires_at", Type: field.TypeTime, Nullable: true},
 		{Name: "allowed_origins", Type: field.TypeJSON, Nullable: true},
+		{Name: "oauth_redirect_uris", Type: field.TypeJSON, Nullable: true},
 		{Name: "webhook_url", Type: field.TypeString, Nullable: true},
 		{Name: "stripe_product_id", Type: field.TypeString, Nullable: true},
+		{Name: "rate_limit_per_minute", Type: field.TypeInt, Nullable: true},
+		{Name: "rate_limit_burst", Type: field.TypeInt, Nullable: true},
+		{Name: "magic_link_signup", Type: field.TypeBool, Default: false},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -69,9 +100,9 @@ var (
 				Columns: []*schema.Column{AppsColumns[2]},
 			},
 			{
-				Name:    "app_api_key",
+				Name:    "app_previous_api_key_hash",
 				Unique:  false,
-				Columns: []*schema.Column{AppsColumns[3]},
+				Columns: []*schema.Column{AppsColumns[7]},
 			},
 		},
 	}
@@ -157,6 +188,100 @@ var (
 			},
 		},
 	}
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "actor_type", Type: field.TypeEnum, Enums: []string{"ADMIN", "USER"}, Default: "ADMIN"},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString, Nullable: true},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_audit_events", Type: field.TypeInt, Nullable: true},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "audit_events_apps_audit_events",
+				Columns:    []*schema.Column{AuditEventsColumns[12]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[11]},
+			},
+			{
+				Name:    "auditevent_actor",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[3]},
+			},
+			{
+				Name:    "auditevent_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[4], AuditEventsColumns[5]},
+			},
+		},
+	}
+	// AuthSessionsColumns holds the columns for the "auth_sessions" table.
+	AuthSessionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "ip_address", Type: field.TypeString, Nullable: true},
+		{Name: "mfa_verified", Type: field.TypeBool, Default: false},
+		{Name: "impersonator", Type: field.TypeString, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_reason", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_auth_sessions", Type: field.TypeInt},
+		{Name: "user_auth_sessions", Type: field.TypeInt},
+	}
+	// AuthSessionsTable holds the schema information for the "auth_sessions" table.
+	AuthSessionsTable = &schema.Table{
+		Name:       "auth_sessions",
+		Columns:    AuthSessionsColumns,
+		PrimaryKey: []*schema.Column{AuthSessionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "auth_sessions_apps_auth_sessions",
+				Columns:    []*schema.Column{AuthSessionsColumns[10]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "auth_sessions_users_auth_sessions",
+				Columns:    []*schema.Column{AuthSessionsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "authsession_user_auth_sessions_app_auth_sessions",
+				Unique:  false,
+				Columns: []*schema.Column{AuthSessionsColumns[11], AuthSessionsColumns[10]},
+			},
+		},
+	}
 	// BattleRoomsColumns holds the columns for the "battle_rooms" table.
 	BattleRoomsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -513,6 +638,79 @@ var (
 			},
 		},
 	}
+	// OauthAuthorizationCodesColumns holds the columns for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code_hash", Type: field.TypeString, Unique: true},
+		{Name: "redirect_uri", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "nonce", Type: field.TypeString, Nullable: true},
+		{Name: "code_challenge", Type: field.TypeString},
+		{Name: "code_challenge_method", Type: field.TypeString, Default: "S256"},
+		{Name: "auth_time", Type: field.TypeTime},
+		{Name: "mfa_verified", Type: field.TypeBool, Default: false},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_oauth_authorization_codes", Type: field.TypeInt},
+		{Name: "user_oauth_authorization_codes", Type: field.TypeInt},
+	}
+	// OauthAuthorizationCodesTable holds the schema information for the "oauth_authorization_codes" table.
+	OauthAuthorizationCodesTable = &schema.Table{
+		Name:       "oauth_authorization_codes",
+		Columns:    OauthAuthorizationCodesColumns,
+		PrimaryKey: []*schema.Column{OauthAuthorizationCodesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_authorization_codes_apps_oauth_authorization_codes",
+				Columns:    []*schema.Column{OauthAuthorizationCodesColumns[12]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "oauth_authorization_codes_users_oauth_authorization_codes",
+				Columns:    []*schema.Column{OauthAuthorizationCodesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// OauthConsentsColumns holds the columns for the "oauth_consents" table.
+	OauthConsentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "app_oauth_consents", Type: field.TypeInt},
+		{Name: "user_oauth_consents", Type: field.TypeInt},
+	}
+	// OauthConsentsTable holds the schema information for the "oauth_consents" table.
+	OauthConsentsTable = &schema.Table{
+		Name:       "oauth_consents",
+		Columns:    OauthConsentsColumns,
+		PrimaryKey: []*schema.Column{OauthConsentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "oauth_consents_apps_oauth_consents",
+				Columns:    []*schema.Column{OauthConsentsColumns[4]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "oauth_consents_users_oauth_consents",
+				Columns:    []*schema.Column{OauthConsentsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "oauthconsent_user_oauth_consents_app_oauth_consents",
+				Unique:  true,
+				Columns: []*schema.Column{OauthConsentsColumns[5], OauthConsentsColumns[4]},
+			},
+		},
+	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -548,6 +746,43 @@ var (
 			},
 		},
 	}
+	// OrganizationDomainsColumns holds the columns for the "organization_domains" table.
+	OrganizationDomainsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "domain", Type: field.TypeString},
+		{Name: "verification_token", Type: field.TypeString},
+		{Name: "verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "default_role", Type: field.TypeEnum, Enums: []string{"ADMIN", "MEMBER"}, Default: "MEMBER"},
+		{Name: "auto_join", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_domains", Type: field.TypeInt},
+	}
+	// OrganizationDomainsTable holds the schema information for the "organization_domains" table.
+	OrganizationDomainsTable = &schema.Table{
+		Name:       "organization_domains",
+		Columns:    OrganizationDomainsColumns,
+		PrimaryKey: []*schema.Column{OrganizationDomainsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_domains_organizations_domains",
+				Columns:    []*schema.Column{OrganizationDomainsColumns[7]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationdomain_domain_organization_domains",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationDomainsColumns[1], OrganizationDomainsColumns[7]},
+			},
+			{
+				Name:    "organizationdomain_domain",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationDomainsColumns[1]},
+			},
+		},
+	}
 	// OrganizationInvitationsColumns holds the columns for the "organization_invitations" table.
 	OrganizationInvitationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -597,6 +832,7 @@ var (
 	OrganizationMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "role", Type: field.TypeEnum, Enums: []string{"OWNER", "ADMIN", "MEMBER"}, Default: "MEMBER"},
+		{Name: "has_seat", Type: field.TypeBool, Default: false},
 		{Name: "joined_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "organization_members", Type: field.TypeInt},
@@ -610,13 +846,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organization_members_organizations_members",
-				Columns:    []*schema.Column{OrganizationMembersColumns[4]},
+				Columns:    []*schema.Column{OrganizationMembersColumns[5]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "organization_members_users_organization_memberships",
-				Columns:    []*schema.Column{OrganizationMembersColumns[5]},
+				Columns:    []*schema.Column{OrganizationMembersColumns[6]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -625,7 +861,7 @@ var (
 			{
 				Name:    "organizationmember_organization_members_user_organization_memberships",
 				Unique:  true,
-				Columns: []*schema.Column{OrganizationMembersColumns[4], OrganizationMembersColumns[5]},
+				Columns: []*schema.Column{OrganizationMembersColumns[5], OrganizationMembersColumns[6]},
 			},
 		},
 	}
@@ -672,6 +908,130 @@ var (
 			},
 		},
 	}
+	// RateLimitBucketsColumns holds the columns for the "rate_limit_buckets" table.
+	RateLimitBucketsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "key", Type: field.TypeString, Unique: true},
+		{Name: "tokens", Type: field.TypeFloat64, Default: 0},
+		{Name: "failures", Type: field.TypeInt, Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+	}
+	// RateLimitBucketsTable holds the schema information for the "rate_limit_buckets" table.
+	RateLimitBucketsTable = &schema.Table{
+		Name:       "rate_limit_buckets",
+		Columns:    RateLimitBucketsColumns,
+		PrimaryKey: []*schema.Column{RateLimitBucketsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ratelimitbucket_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{RateLimitBucketsColumns[6]},
+			},
+		},
+	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "auth_session_refresh_tokens", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_auth_sessions_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[6]},
+				RefColumns: []*schema.Column{AuthSessionsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[1]},
+			},
+		},
+	}
+	// SSOConnectionsColumns holds the columns for the "sso_connections" table.
+	SSOConnectionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "protocol", Type: field.TypeEnum, Enums: []string{"SAML", "OIDC"}},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "saml_idp_metadata", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "saml_idp_metadata_url", Type: field.TypeString, Nullable: true},
+		{Name: "oidc_issuer", Type: field.TypeString, Nullable: true},
+		{Name: "oidc_client_id", Type: field.TypeString, Nullable: true},
+		{Name: "oidc_client_secret", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_sso_connection", Type: field.TypeInt, Unique: true},
+	}
+	// SSOConnectionsTable holds the schema information for the "sso_connections" table.
+	SSOConnectionsTable = &schema.Table{
+		Name:       "sso_connections",
+		Columns:    SSOConnectionsColumns,
+		PrimaryKey: []*schema.Column{SSOConnectionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sso_connections_organizations_sso_connection",
+				Columns:    []*schema.Column{SSOConnectionsColumns[10]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ssoconnection_organization_sso_connection",
+				Unique:  true,
+				Columns: []*schema.Column{SSOConnectionsColumns[10]},
+			},
+		},
+	}
+	// SSOLoginsColumns holds the columns for the "sso_logins" table.
+	SSOLoginsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "state_hash", Type: field.TypeString, Unique: true},
+		{Name: "request_id", Type: field.TypeString},
+		{Name: "code_verifier", Type: field.TypeString, Nullable: true},
+		{Name: "redirect_url", Type: field.TypeString},
+		{Name: "code_hash", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "organization_sso_logins", Type: field.TypeInt},
+		{Name: "user_sso_logins", Type: field.TypeInt, Nullable: true},
+	}
+	// SSOLoginsTable holds the schema information for the "sso_logins" table.
+	SSOLoginsTable = &schema.Table{
+		Name:       "sso_logins",
+		Columns:    SSOLoginsColumns,
+		PrimaryKey: []*schema.Column{SSOLoginsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "sso_logins_organizations_sso_logins",
+				Columns:    []*schema.Column{SSOLoginsColumns[10]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "sso_logins_users_sso_logins",
+				Columns:    []*schema.Column{SSOLoginsColumns[11]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+	}
 	// ShenbiProfilesColumns holds the columns for the "shenbi_profiles" table.
 	ShenbiProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -683,7 +1043,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "app_shenbi_profiles", Type: field.TypeInt},
-		{Name: "user_shenbi_profile", Type: field.TypeInt, Unique: true},
+		{Name: "user_shenbi_profile", Type: field.TypeInt},
 	}
 	// ShenbiProfilesTable holds the schema information for the "shenbi_profiles" table.
 	ShenbiProfilesTable = &schema.Table{
@@ -721,7 +1081,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "app_shenbi_settings", Type: field.TypeInt},
-		{Name: "user_shenbi_settings", Type: field.TypeInt, Unique: true},
+		{Name: "user_shenbi_settings", Type: field.TypeInt},
 	}
 	// ShenbiSettingsTable holds the schema information for the "shenbi_settings" table.
 	ShenbiSettingsTable = &schema.Table{
@@ -758,7 +1118,9 @@ var (
 		{Name: "current_period_start", Type: field.TypeTime, Nullable: true},
 		{Name: "current_period_end", Type: field.TypeTime, Nullable: true},
 		{Name: "canceled_at", Type: field.TypeTime, Nullable: true},
+		{Name: "cancel_at_period_end", Type: field.TypeBool, Default: false},
 		{Name: "trial_end", Type: field.TypeTime, Nullable: true},
+		{Name: "quantity", Type: field.TypeInt, Default: 1},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "app_subscriptions", Type: field.TypeInt},
@@ -774,25 +1136,25 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "subscriptions_apps_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[9]},
+				Columns:    []*schema.Column{SubscriptionsColumns[11]},
 				RefColumns: []*schema.Column{AppsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "subscriptions_organizations_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[10]},
+				Columns:    []*schema.Column{SubscriptionsColumns[12]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "subscriptions_plans_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[11]},
+				Columns:    []*schema.Column{SubscriptionsColumns[13]},
 				RefColumns: []*schema.Column{PlansColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "subscriptions_users_subscriptions",
-				Columns:    []*schema.Column{SubscriptionsColumns[12]},
+				Columns:    []*schema.Column{SubscriptionsColumns[14]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -800,18 +1162,21 @@ var (
 		Indexes: []*schema.Index{
 			{
 				Name:    "subscription_stripe_subscription_id",
-				Unique:  false,
+				Unique:  true,
 				Columns: []*schema.Column{SubscriptionsColumns[2]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "stripe_subscription_id <> ''",
+				},
 			},
 			{
 				Name:    "subscription_user_subscriptions_app_subscriptions",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[12], SubscriptionsColumns[9]},
+				Columns: []*schema.Column{SubscriptionsColumns[14], SubscriptionsColumns[11]},
 			},
 			{
 				Name:    "subscription_organization_subscriptions_app_subscriptions",
 				Unique:  false,
-				Columns: []*schema.Column{SubscriptionsColumns[10], SubscriptionsColumns[9]},
+				Columns: []*schema.Column{SubscriptionsColumns[12], SubscriptionsColumns[11]},
 			},
 		},
 	}
@@ -828,6 +1193,10 @@ var (
 		{Name: "google_access_token", Type: field.TypeString, Nullable: true},
 		{Name: "google_refresh_token", Type: field.TypeString, Nullable: true},
 		{Name: "google_token_expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "totp_secret_encrypted", Type: field.TypeString, Nullable: true},
+		{Name: "totp_last_counter", Type: field.TypeInt64, Default: 0},
+		{Name: "mfa_enabled", Type: field.TypeBool, Default: false},
+		{Name: "mfa_recovery_codes", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
 		{Name: "is_verified", Type: field.TypeBool, Default: false},
 		{Name: "extra_data", Type: field.TypeJSON, Nullable: true},
@@ -941,12 +1310,182 @@ var (
 			},
 		},
 	}
+	// VerificationTokensColumns holds the columns for the "verification_tokens" table.
+	VerificationTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "purpose", Type: field.TypeEnum, Enums: []string{"PASSWORD_RESET", "EMAIL_VERIFICATION", "MAGIC_LINK"}},
+		{Name: "email", Type: field.TypeString},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_verification_tokens", Type: field.TypeInt},
+		{Name: "user_verification_tokens", Type: field.TypeInt, Nullable: true},
+	}
+	// VerificationTokensTable holds the schema information for the "verification_tokens" table.
+	VerificationTokensTable = &schema.Table{
+		Name:       "verification_tokens",
+		Columns:    VerificationTokensColumns,
+		PrimaryKey: []*schema.Column{VerificationTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "verification_tokens_apps_verification_tokens",
+				Columns:    []*schema.Column{VerificationTokensColumns[7]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "verification_tokens_users_verification_tokens",
+				Columns:    []*schema.Column{VerificationTokensColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "verificationtoken_token_hash",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationTokensColumns[1]},
+			},
+			{
+				Name:    "verificationtoken_purpose_user_verification_tokens",
+				Unique:  false,
+				Columns: []*schema.Column{VerificationTokensColumns[2], VerificationTokensColumns[8]},
+			},
+		},
+	}
+	// WebhookAttemptsColumns holds the columns for the "webhook_attempts" table.
+	WebhookAttemptsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "response_body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "duration_ms", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "webhook_delivery_attempt_logs", Type: field.TypeInt},
+	}
+	// WebhookAttemptsTable holds the schema information for the "webhook_attempts" table.
+	WebhookAttemptsTable = &schema.Table{
+		Name:       "webhook_attempts",
+		Columns:    WebhookAttemptsColumns,
+		PrimaryKey: []*schema.Column{WebhookAttemptsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_attempts_webhook_deliveries_attempt_logs",
+				Columns:    []*schema.Column{WebhookAttemptsColumns[6]},
+				RefColumns: []*schema.Column{WebhookDeliveriesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeString, Unique: true},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeJSON},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "DELIVERED", "DEAD"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "app_webhook_deliveries", Type: field.TypeInt},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_apps_webhook_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[12]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_status_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[4], WebhookDeliveriesColumns[6]},
+			},
+			{
+				Name:    "webhookdelivery_created_at_app_webhook_deliveries",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[11], WebhookDeliveriesColumns[12]},
+			},
+		},
+	}
+	// WebhookEventsColumns holds the columns for the "webhook_events" table.
+	WebhookEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "event_id", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "event_created_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"PENDING", "PROCESSED", "FAILED"}, Default: "PENDING"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "processed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// WebhookEventsTable holds the schema information for the "webhook_events" table.
+	WebhookEventsTable = &schema.Table{
+		Name:       "webhook_events",
+		Columns:    WebhookEventsColumns,
+		PrimaryKey: []*schema.Column{WebhookEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookevent_status_event_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[5], WebhookEventsColumns[4]},
+			},
+			{
+				Name:    "webhookevent_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEventsColumns[10]},
+			},
+		},
+	}
+	// AdminAccountAppsColumns holds the columns for the "admin_account_apps" table.
+	AdminAccountAppsColumns = []*schema.Column{
+		{Name: "admin_account_id", Type: field.TypeInt},
+		{Name: "app_id", Type: field.TypeInt},
+	}
+	// AdminAccountAppsTable holds the schema information for the "admin_account_apps" table.
+	AdminAccountAppsTable = &schema.Table{
+		Name:       "admin_account_apps",
+		Columns:    AdminAccountAppsColumns,
+		PrimaryKey: []*schema.Column{AdminAccountAppsColumns[0], AdminAccountAppsColumns[1]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "admin_account_apps_admin_account_id",
+				Columns:    []*schema.Column{AdminAccountAppsColumns[0]},
+				RefColumns: []*schema.Column{AdminAccountsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "admin_account_apps_app_id",
+				Columns:    []*schema.Column{AdminAccountAppsColumns[1]},
+				RefColumns: []*schema.Column{AppsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AchievementsTable,
+		AdminAccountsTable,
 		AppsTable,
 		AssignmentsTable,
 		AssignmentSubmissionsTable,
+		AuditEventsTable,
+		AuthSessionsTable,
 		BattleRoomsTable,
 		BattleSessionsTable,
 		ClassroomsTable,
@@ -955,16 +1494,28 @@ var (
 		EmailTemplatesTable,
 		LiveSessionsTable,
 		LiveSessionStudentsTable,
+		OauthAuthorizationCodesTable,
+		OauthConsentsTable,
 		OrganizationsTable,
+		OrganizationDomainsTable,
 		OrganizationInvitationsTable,
 		OrganizationMembersTable,
 		PlansTable,
+		RateLimitBucketsTable,
+		RefreshTokensTable,
+		SSOConnectionsTable,
+		SSOLoginsTable,
 		ShenbiProfilesTable,
 		ShenbiSettingsTable,
 		SubscriptionsTable,
 		UsersTable,
 		UserAppsTable,
 		UserProgressesTable,
+		VerificationTokensTable,
+		WebhookAttemptsTable,
+		WebhookDeliveriesTable,
+		WebhookEventsTable,
+		AdminAccountAppsTable,
 	}
 )
 
@@ -974,6 +1525,9 @@ func init() {
 	AssignmentsTable.ForeignKeys[0].RefTable = ClassroomsTable
 	AssignmentSubmissionsTable.ForeignKeys[0].RefTable = AssignmentsTable
 	AssignmentSubmissionsTable.ForeignKeys[1].RefTable = UsersTable
+	AuditEventsTable.ForeignKeys[0].RefTable = AppsTable
+	AuthSessionsTable.ForeignKeys[0].RefTable = AppsTable
+	AuthSessionsTable.ForeignKeys[1].RefTable = UsersTable
 	BattleRoomsTable.ForeignKeys[0].RefTable = AppsTable
 	BattleRoomsTable.ForeignKeys[1].RefTable = UsersTable
 	BattleSessionsTable.ForeignKeys[0].RefTable = AppsTable
@@ -991,12 +1545,21 @@ func init() {
 	LiveSessionsTable.ForeignKeys[2].RefTable = UsersTable
 	LiveSessionStudentsTable.ForeignKeys[0].RefTable = LiveSessionsTable
 	LiveSessionStudentsTable.ForeignKeys[1].RefTable = UsersTable
+	OauthAuthorizationCodesTable.ForeignKeys[0].RefTable = AppsTable
+	OauthAuthorizationCodesTable.ForeignKeys[1].RefTable = UsersTable
+	OauthConsentsTable.ForeignKeys[0].RefTable = AppsTable
+	OauthConsentsTable.ForeignKeys[1].RefTable = UsersTable
 	OrganizationsTable.ForeignKeys[0].RefTable = AppsTable
+	OrganizationDomainsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationInvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationInvitationsTable.ForeignKeys[1].RefTable = UsersTable
 	OrganizationMembersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationMembersTable.ForeignKeys[1].RefTable = UsersTable
 	PlansTable.ForeignKeys[0].RefTable = AppsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = AuthSessionsTable
+	SSOConnectionsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	SSOLoginsTable.ForeignKeys[0].RefTable = OrganizationsTable
+	SSOLoginsTable.ForeignKeys[1].RefTable = UsersTable
 	ShenbiProfilesTable.ForeignKeys[0].RefTable = AppsTable
 	ShenbiProfilesTable.ForeignKeys[1].RefTable = UsersTable
 	ShenbiSettingsTable.ForeignKeys[0].RefTable = AppsTable
@@ -1009,4 +1572,10 @@ func init() {
 	UserAppsTable.ForeignKeys[1].RefTable = UsersTable
 	UserProgressesTable.ForeignKeys[0].RefTable = AppsTable
 	UserProgressesTable.ForeignKeys[1].RefTable = UsersTable
+	VerificationTokensTable.ForeignKeys[0].RefTable = AppsTable
+	VerificationTokensTable.ForeignKeys[1].RefTable = UsersTable
+	WebhookAttemptsTable.ForeignKeys[0].RefTable = WebhookDeliveriesTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = AppsTable
+	AdminAccountAppsTable.ForeignKeys[0].RefTable = AdminAccountsTable
+	AdminAccountAppsTable.ForeignKeys[1].RefTable = AppsTable
 }
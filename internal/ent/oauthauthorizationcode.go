@@ -0,0 +1,293 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// OAuthAuthorizationCode is the model entity for the OAuthAuthorizationCode schema.
+type OAuthAuthorizationCode struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash string `json:"-"`
+	// RedirectURI holds the value of the "redirect_uri" field.
+	RedirectURI string `json:"redirect_uri,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// Nonce holds the value of the "nonce" field.
+	Nonce string `json:"nonce,omitempty"`
+	// CodeChallenge holds the value of the "code_challenge" field.
+	CodeChallenge string `json:"code_challenge,omitempty"`
+	// CodeChallengeMethod holds the value of the "code_challenge_method" field.
+	CodeChallengeMethod string `json:"code_challenge_method,omitempty"`
+	// AuthTime holds the value of the "auth_time" field.
+	AuthTime time.Time `json:"auth_time,omitempty"`
+	// MfaVerified holds the value of the "mfa_verified" field.
+	MfaVerified bool `json:"mfa_verified,omitempty"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthAuthorizationCodeQuery when eager-loading is set.
+	Edges                          OAuthAuthorizationCodeEdges `json:"edges"`
+	app_oauth_authorization_codes  *int
+	user_oauth_authorization_codes *int
+	selectValues                   sql.SelectValues
+}
+
+// OAuthAuthorizationCodeEdges holds the relations/edges for other nodes in the graph.
+type OAuthAuthorizationCodeEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// App holds the value of the app edge.
+	App *App `json:"app,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthAuthorizationCodeEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AppOrErr returns the App value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthAuthorizationCodeEdges) AppOrErr() (*App, error) {
+	if e.App != nil {
+		return e.App, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: app.Label}
+	}
+	return nil, &NotLoadedError{edge: "app"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthAuthorizationCode) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldScopes:
+			values[i] = new([]byte)
+		case oauthauthorizationcode.FieldMfaVerified:
+			values[i] = new(sql.NullBool)
+		case oauthauthorizationcode.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oauthauthorizationcode.FieldCodeHash, oauthauthorizationcode.FieldRedirectURI, oauthauthorizationcode.FieldNonce, oauthauthorizationcode.FieldCodeChallenge, oauthauthorizationcode.FieldCodeChallengeMethod:
+			values[i] = new(sql.NullString)
+		case oauthauthorizationcode.FieldAuthTime, oauthauthorizationcode.FieldExpiresAt, oauthauthorizationcode.FieldUsedAt, oauthauthorizationcode.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case oauthauthorizationcode.ForeignKeys[0]: // app_oauth_authorization_codes
+			values[i] = new(sql.NullInt64)
+		case oauthauthorizationcode.ForeignKeys[1]: // user_oauth_authorization_codes
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthAuthorizationCode fields.
+func (_m *OAuthAuthorizationCode) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthauthorizationcode.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case oauthauthorizationcode.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				_m.CodeHash = value.String
+			}
+		case oauthauthorizationcode.FieldRedirectURI:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_uri", values[i])
+			} else if value.Valid {
+				_m.RedirectURI = value.String
+			}
+		case oauthauthorizationcode.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthauthorizationcode.FieldNonce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field nonce", values[i])
+			} else if value.Valid {
+				_m.Nonce = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallenge:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge", values[i])
+			} else if value.Valid {
+				_m.CodeChallenge = value.String
+			}
+		case oauthauthorizationcode.FieldCodeChallengeMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_challenge_method", values[i])
+			} else if value.Valid {
+				_m.CodeChallengeMethod = value.String
+			}
+		case oauthauthorizationcode.FieldAuthTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field auth_time", values[i])
+			} else if value.Valid {
+				_m.AuthTime = value.Time
+			}
+		case oauthauthorizationcode.FieldMfaVerified:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_verified", values[i])
+			} else if value.Valid {
+				_m.MfaVerified = value.Bool
+			}
+		case oauthauthorizationcode.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case oauthauthorizationcode.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case oauthauthorizationcode.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oauthauthorizationcode.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field app_oauth_authorization_codes", value)
+			} else if value.Valid {
+				_m.app_oauth_authorization_codes = new(int)
+				*_m.app_oauth_authorization_codes = int(value.Int64)
+			}
+		case oauthauthorizationcode.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_oauth_authorization_codes", value)
+			} else if value.Valid {
+				_m.user_oauth_authorization_codes = new(int)
+				*_m.user_oauth_authorization_codes = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthAuthorizationCode.
+// This includes values selected through modifiers, order, etc.
+func (_m *OAuthAuthorizationCode) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OAuthAuthorizationCode entity.
+func (_m *OAuthAuthorizationCode) QueryUser() *UserQuery {
+	return NewOAuthAuthorizationCodeClient(_m.config).QueryUser(_m)
+}
+
+// QueryApp queries the "app" edge of the OAuthAuthorizationCode entity.
+func (_m *OAuthAuthorizationCode) QueryApp() *AppQuery {
+	return NewOAuthAuthorizationCodeClient(_m.config).QueryApp(_m)
+}
+
+// Update returns a builder for updating this OAuthAuthorizationCode.
+// Note that you need to call OAuthAuthorizationCode.Unwrap() before calling this method if this OAuthAuthorizationCode
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OAuthAuthorizationCode) Update() *OAuthAuthorizationCodeUpdateOne {
+	return NewOAuthAuthorizationCodeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OAuthAuthorizationCode entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OAuthAuthorizationCode) Unwrap() *OAuthAuthorizationCode {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthAuthorizationCode is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OAuthAuthorizationCode) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthAuthorizationCode(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_uri=")
+	builder.WriteString(_m.RedirectURI)
+	builder.WriteString(", ")
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("nonce=")
+	builder.WriteString(_m.Nonce)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge=")
+	builder.WriteString(_m.CodeChallenge)
+	builder.WriteString(", ")
+	builder.WriteString("code_challenge_method=")
+	builder.WriteString(_m.CodeChallengeMethod)
+	builder.WriteString(", ")
+	builder.WriteString("auth_time=")
+	builder.WriteString(_m.AuthTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_verified=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaVerified))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthAuthorizationCodes is a parsable slice of OAuthAuthorizationCode.
+type OAuthAuthorizationCodes []*OAuthAuthorizationCode
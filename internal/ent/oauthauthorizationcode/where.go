@@ -0,0 +1,682 @@
+// Code generated by ent, DO NOT EDIT.
+
+package oauthauthorizationcode
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldID, id))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// RedirectURI applies equality check predicate on the "redirect_uri" field. It's identical to RedirectURIEQ.
+func RedirectURI(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// Nonce applies equality check predicate on the "nonce" field. It's identical to NonceEQ.
+func Nonce(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldNonce, v))
+}
+
+// CodeChallenge applies equality check predicate on the "code_challenge" field. It's identical to CodeChallengeEQ.
+func CodeChallenge(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethod applies equality check predicate on the "code_challenge_method" field. It's identical to CodeChallengeMethodEQ.
+func CodeChallengeMethod(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// AuthTime applies equality check predicate on the "auth_time" field. It's identical to AuthTimeEQ.
+func AuthTime(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// MfaVerified applies equality check predicate on the "mfa_verified" field. It's identical to MfaVerifiedEQ.
+func MfaVerified(v bool) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldMfaVerified, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// RedirectURIEQ applies the EQ predicate on the "redirect_uri" field.
+func RedirectURIEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldRedirectURI, v))
+}
+
+// RedirectURINEQ applies the NEQ predicate on the "redirect_uri" field.
+func RedirectURINEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldRedirectURI, v))
+}
+
+// RedirectURIIn applies the In predicate on the "redirect_uri" field.
+func RedirectURIIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURINotIn applies the NotIn predicate on the "redirect_uri" field.
+func RedirectURINotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldRedirectURI, vs...))
+}
+
+// RedirectURIGT applies the GT predicate on the "redirect_uri" field.
+func RedirectURIGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldRedirectURI, v))
+}
+
+// RedirectURIGTE applies the GTE predicate on the "redirect_uri" field.
+func RedirectURIGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldRedirectURI, v))
+}
+
+// RedirectURILT applies the LT predicate on the "redirect_uri" field.
+func RedirectURILT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldRedirectURI, v))
+}
+
+// RedirectURILTE applies the LTE predicate on the "redirect_uri" field.
+func RedirectURILTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldRedirectURI, v))
+}
+
+// RedirectURIContains applies the Contains predicate on the "redirect_uri" field.
+func RedirectURIContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldRedirectURI, v))
+}
+
+// RedirectURIHasPrefix applies the HasPrefix predicate on the "redirect_uri" field.
+func RedirectURIHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldRedirectURI, v))
+}
+
+// RedirectURIHasSuffix applies the HasSuffix predicate on the "redirect_uri" field.
+func RedirectURIHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldRedirectURI, v))
+}
+
+// RedirectURIEqualFold applies the EqualFold predicate on the "redirect_uri" field.
+func RedirectURIEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldRedirectURI, v))
+}
+
+// RedirectURIContainsFold applies the ContainsFold predicate on the "redirect_uri" field.
+func RedirectURIContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldRedirectURI, v))
+}
+
+// NonceEQ applies the EQ predicate on the "nonce" field.
+func NonceEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldNonce, v))
+}
+
+// NonceNEQ applies the NEQ predicate on the "nonce" field.
+func NonceNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldNonce, v))
+}
+
+// NonceIn applies the In predicate on the "nonce" field.
+func NonceIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldNonce, vs...))
+}
+
+// NonceNotIn applies the NotIn predicate on the "nonce" field.
+func NonceNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldNonce, vs...))
+}
+
+// NonceGT applies the GT predicate on the "nonce" field.
+func NonceGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldNonce, v))
+}
+
+// NonceGTE applies the GTE predicate on the "nonce" field.
+func NonceGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldNonce, v))
+}
+
+// NonceLT applies the LT predicate on the "nonce" field.
+func NonceLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldNonce, v))
+}
+
+// NonceLTE applies the LTE predicate on the "nonce" field.
+func NonceLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldNonce, v))
+}
+
+// NonceContains applies the Contains predicate on the "nonce" field.
+func NonceContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldNonce, v))
+}
+
+// NonceHasPrefix applies the HasPrefix predicate on the "nonce" field.
+func NonceHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldNonce, v))
+}
+
+// NonceHasSuffix applies the HasSuffix predicate on the "nonce" field.
+func NonceHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldNonce, v))
+}
+
+// NonceIsNil applies the IsNil predicate on the "nonce" field.
+func NonceIsNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIsNull(FieldNonce))
+}
+
+// NonceNotNil applies the NotNil predicate on the "nonce" field.
+func NonceNotNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotNull(FieldNonce))
+}
+
+// NonceEqualFold applies the EqualFold predicate on the "nonce" field.
+func NonceEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldNonce, v))
+}
+
+// NonceContainsFold applies the ContainsFold predicate on the "nonce" field.
+func NonceContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldNonce, v))
+}
+
+// CodeChallengeEQ applies the EQ predicate on the "code_challenge" field.
+func CodeChallengeEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeNEQ applies the NEQ predicate on the "code_challenge" field.
+func CodeChallengeNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeChallenge, v))
+}
+
+// CodeChallengeIn applies the In predicate on the "code_challenge" field.
+func CodeChallengeIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeNotIn applies the NotIn predicate on the "code_challenge" field.
+func CodeChallengeNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeChallenge, vs...))
+}
+
+// CodeChallengeGT applies the GT predicate on the "code_challenge" field.
+func CodeChallengeGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeGTE applies the GTE predicate on the "code_challenge" field.
+func CodeChallengeGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLT applies the LT predicate on the "code_challenge" field.
+func CodeChallengeLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeChallenge, v))
+}
+
+// CodeChallengeLTE applies the LTE predicate on the "code_challenge" field.
+func CodeChallengeLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContains applies the Contains predicate on the "code_challenge" field.
+func CodeChallengeContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasPrefix applies the HasPrefix predicate on the "code_challenge" field.
+func CodeChallengeHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeHasSuffix applies the HasSuffix predicate on the "code_challenge" field.
+func CodeChallengeHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeChallenge, v))
+}
+
+// CodeChallengeEqualFold applies the EqualFold predicate on the "code_challenge" field.
+func CodeChallengeEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeContainsFold applies the ContainsFold predicate on the "code_challenge" field.
+func CodeChallengeContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeChallenge, v))
+}
+
+// CodeChallengeMethodEQ applies the EQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodNEQ applies the NEQ predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNEQ(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodIn applies the In predicate on the "code_challenge_method" field.
+func CodeChallengeMethodIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodNotIn applies the NotIn predicate on the "code_challenge_method" field.
+func CodeChallengeMethodNotIn(vs ...string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCodeChallengeMethod, vs...))
+}
+
+// CodeChallengeMethodGT applies the GT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodGTE applies the GTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodGTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLT applies the LT predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLT(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodLTE applies the LTE predicate on the "code_challenge_method" field.
+func CodeChallengeMethodLTE(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContains applies the Contains predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContains(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContains(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasPrefix applies the HasPrefix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasPrefix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasPrefix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodHasSuffix applies the HasSuffix predicate on the "code_challenge_method" field.
+func CodeChallengeMethodHasSuffix(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldHasSuffix(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodEqualFold applies the EqualFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodEqualFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEqualFold(FieldCodeChallengeMethod, v))
+}
+
+// CodeChallengeMethodContainsFold applies the ContainsFold predicate on the "code_challenge_method" field.
+func CodeChallengeMethodContainsFold(v string) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldContainsFold(FieldCodeChallengeMethod, v))
+}
+
+// AuthTimeEQ applies the EQ predicate on the "auth_time" field.
+func AuthTimeEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldAuthTime, v))
+}
+
+// AuthTimeNEQ applies the NEQ predicate on the "auth_time" field.
+func AuthTimeNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldAuthTime, v))
+}
+
+// AuthTimeIn applies the In predicate on the "auth_time" field.
+func AuthTimeIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeNotIn applies the NotIn predicate on the "auth_time" field.
+func AuthTimeNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldAuthTime, vs...))
+}
+
+// AuthTimeGT applies the GT predicate on the "auth_time" field.
+func AuthTimeGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldAuthTime, v))
+}
+
+// AuthTimeGTE applies the GTE predicate on the "auth_time" field.
+func AuthTimeGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldAuthTime, v))
+}
+
+// AuthTimeLT applies the LT predicate on the "auth_time" field.
+func AuthTimeLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldAuthTime, v))
+}
+
+// AuthTimeLTE applies the LTE predicate on the "auth_time" field.
+func AuthTimeLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldAuthTime, v))
+}
+
+// MfaVerifiedEQ applies the EQ predicate on the "mfa_verified" field.
+func MfaVerifiedEQ(v bool) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldMfaVerified, v))
+}
+
+// MfaVerifiedNEQ applies the NEQ predicate on the "mfa_verified" field.
+func MfaVerifiedNEQ(v bool) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldMfaVerified, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasApp applies the HasEdge predicate on the "app" edge.
+func HasApp() predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AppTable, AppColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAppWith applies the HasEdge predicate on the "app" edge with a given conditions (other predicates).
+func HasAppWith(preds ...predicate.App) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		step := newAppStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OAuthAuthorizationCode) predicate.OAuthAuthorizationCode {
+	return predicate.OAuthAuthorizationCode(sql.NotPredicates(p))
+}
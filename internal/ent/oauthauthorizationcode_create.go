@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// OAuthAuthorizationCodeCreate is the builder for creating a OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeCreate struct {
+	config
+	mutation *OAuthAuthorizationCodeMutation
+	hooks    []Hook
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *OAuthAuthorizationCodeCreate) SetCodeHash(v string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_c *OAuthAuthorizationCodeCreate) SetRedirectURI(v string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetRedirectURI(v)
+	return _c
+}
+
+// SetScopes sets the "scopes" field.
+func (_c *OAuthAuthorizationCodeCreate) SetScopes(v []string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetScopes(v)
+	return _c
+}
+
+// SetNonce sets the "nonce" field.
+func (_c *OAuthAuthorizationCodeCreate) SetNonce(v string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetNonce(v)
+	return _c
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_c *OAuthAuthorizationCodeCreate) SetNillableNonce(v *string) *OAuthAuthorizationCodeCreate {
+	if v != nil {
+		_c.SetNonce(*v)
+	}
+	return _c
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_c *OAuthAuthorizationCodeCreate) SetCodeChallenge(v string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetCodeChallenge(v)
+	return _c
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_c *OAuthAuthorizationCodeCreate) SetCodeChallengeMethod(v string) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetCodeChallengeMethod(v)
+	return _c
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_c *OAuthAuthorizationCodeCreate) SetNillableCodeChallengeMethod(v *string) *OAuthAuthorizationCodeCreate {
+	if v != nil {
+		_c.SetCodeChallengeMethod(*v)
+	}
+	return _c
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_c *OAuthAuthorizationCodeCreate) SetAuthTime(v time.Time) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetAuthTime(v)
+	return _c
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_c *OAuthAuthorizationCodeCreate) SetMfaVerified(v bool) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetMfaVerified(v)
+	return _c
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_c *OAuthAuthorizationCodeCreate) SetNillableMfaVerified(v *bool) *OAuthAuthorizationCodeCreate {
+	if v != nil {
+		_c.SetMfaVerified(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OAuthAuthorizationCodeCreate) SetExpiresAt(v time.Time) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *OAuthAuthorizationCodeCreate) SetUsedAt(v time.Time) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *OAuthAuthorizationCodeCreate) SetNillableUsedAt(v *time.Time) *OAuthAuthorizationCodeCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OAuthAuthorizationCodeCreate) SetCreatedAt(v time.Time) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OAuthAuthorizationCodeCreate) SetNillableCreatedAt(v *time.Time) *OAuthAuthorizationCodeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *OAuthAuthorizationCodeCreate) SetUserID(id int) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OAuthAuthorizationCodeCreate) SetUser(v *User) *OAuthAuthorizationCodeCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetAppID sets the "app" edge to the App entity by ID.
+func (_c *OAuthAuthorizationCodeCreate) SetAppID(id int) *OAuthAuthorizationCodeCreate {
+	_c.mutation.SetAppID(id)
+	return _c
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_c *OAuthAuthorizationCodeCreate) SetApp(v *App) *OAuthAuthorizationCodeCreate {
+	return _c.SetAppID(v.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (_c *OAuthAuthorizationCodeCreate) Mutation() *OAuthAuthorizationCodeMutation {
+	return _c.mutation
+}
+
+// Save creates the OAuthAuthorizationCode in the database.
+func (_c *OAuthAuthorizationCodeCreate) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OAuthAuthorizationCodeCreate) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthAuthorizationCodeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthAuthorizationCodeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OAuthAuthorizationCodeCreate) defaults() {
+	if _, ok := _c.mutation.CodeChallengeMethod(); !ok {
+		v := oauthauthorizationcode.DefaultCodeChallengeMethod
+		_c.mutation.SetCodeChallengeMethod(v)
+	}
+	if _, ok := _c.mutation.MfaVerified(); !ok {
+		v := oauthauthorizationcode.DefaultMfaVerified
+		_c.mutation.SetMfaVerified(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := oauthauthorizationcode.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OAuthAuthorizationCodeCreate) check() error {
+	if _, ok := _c.mutation.CodeHash(); !ok {
+		return &ValidationError{Name: "code_hash", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_hash"`)}
+	}
+	if v, ok := _c.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedirectURI(); !ok {
+		return &ValidationError{Name: "redirect_uri", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.redirect_uri"`)}
+	}
+	if v, ok := _c.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Scopes(); !ok {
+		return &ValidationError{Name: "scopes", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.scopes"`)}
+	}
+	if _, ok := _c.mutation.CodeChallenge(); !ok {
+		return &ValidationError{Name: "code_challenge", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_challenge"`)}
+	}
+	if v, ok := _c.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CodeChallengeMethod(); !ok {
+		return &ValidationError{Name: "code_challenge_method", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.code_challenge_method"`)}
+	}
+	if _, ok := _c.mutation.AuthTime(); !ok {
+		return &ValidationError{Name: "auth_time", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.auth_time"`)}
+	}
+	if _, ok := _c.mutation.MfaVerified(); !ok {
+		return &ValidationError{Name: "mfa_verified", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.mfa_verified"`)}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OAuthAuthorizationCode.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "OAuthAuthorizationCode.user"`)}
+	}
+	if len(_c.mutation.AppIDs()) == 0 {
+		return &ValidationError{Name: "app", err: errors.New(`ent: missing required edge "OAuthAuthorizationCode.app"`)}
+	}
+	return nil
+}
+
+func (_c *OAuthAuthorizationCodeCreate) sqlSave(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OAuthAuthorizationCodeCreate) createSpec() (*OAuthAuthorizationCode, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OAuthAuthorizationCode{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = value
+	}
+	if value, ok := _c.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+		_node.RedirectURI = value
+	}
+	if value, ok := _c.mutation.Scopes(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScopes, field.TypeJSON, value)
+		_node.Scopes = value
+	}
+	if value, ok := _c.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+		_node.Nonce = value
+	}
+	if value, ok := _c.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+		_node.CodeChallenge = value
+	}
+	if value, ok := _c.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+		_node.CodeChallengeMethod = value
+	}
+	if value, ok := _c.mutation.AuthTime(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldAuthTime, field.TypeTime, value)
+		_node.AuthTime = value
+	}
+	if value, ok := _c.mutation.MfaVerified(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldMfaVerified, field.TypeBool, value)
+		_node.MfaVerified = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_oauth_authorization_codes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.AppTable,
+			Columns: []string{oauthauthorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.app_oauth_authorization_codes = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OAuthAuthorizationCodeCreateBulk is the builder for creating many OAuthAuthorizationCode entities in bulk.
+type OAuthAuthorizationCodeCreateBulk struct {
+	config
+	err      error
+	builders []*OAuthAuthorizationCodeCreate
+}
+
+// Save creates the OAuthAuthorizationCode entities in the database.
+func (_c *OAuthAuthorizationCodeCreateBulk) Save(ctx context.Context) ([]*OAuthAuthorizationCode, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OAuthAuthorizationCode, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OAuthAuthorizationCodeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OAuthAuthorizationCodeCreateBulk) SaveX(ctx context.Context) []*OAuthAuthorizationCode {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OAuthAuthorizationCodeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OAuthAuthorizationCodeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// OAuthAuthorizationCodeDelete is the builder for deleting a OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeDelete struct {
+	config
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeDelete builder.
+func (_d *OAuthAuthorizationCodeDelete) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *OAuthAuthorizationCodeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OAuthAuthorizationCodeDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *OAuthAuthorizationCodeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(oauthauthorizationcode.Table, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// OAuthAuthorizationCodeDeleteOne is the builder for deleting a single OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeDeleteOne struct {
+	_d *OAuthAuthorizationCodeDelete
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeDelete builder.
+func (_d *OAuthAuthorizationCodeDeleteOne) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *OAuthAuthorizationCodeDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{oauthauthorizationcode.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OAuthAuthorizationCodeDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
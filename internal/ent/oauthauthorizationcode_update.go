@@ -0,0 +1,803 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// OAuthAuthorizationCodeUpdate is the builder for updating OAuthAuthorizationCode entities.
+type OAuthAuthorizationCodeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (_u *OAuthAuthorizationCodeUpdate) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetCodeHash(v string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableCodeHash(v *string) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetRedirectURI(v string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableRedirectURI(v *string) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetScopes(v []string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthAuthorizationCodeUpdate) AppendScopes(v []string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetNonce(v string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableNonce(v *string) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *OAuthAuthorizationCodeUpdate) ClearNonce() *OAuthAuthorizationCodeUpdate {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetCodeChallenge(v string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableCodeChallenge(v *string) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetCodeChallengeMethod(v string) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableCodeChallengeMethod(v *string) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetAuthTime(v time.Time) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableAuthTime(v *time.Time) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetMfaVerified(v bool) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetMfaVerified(v)
+	return _u
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableMfaVerified(v *bool) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetMfaVerified(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetExpiresAt(v time.Time) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableExpiresAt(v *time.Time) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OAuthAuthorizationCodeUpdate) SetUsedAt(v time.Time) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdate) SetNillableUsedAt(v *time.Time) *OAuthAuthorizationCodeUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OAuthAuthorizationCodeUpdate) ClearUsedAt() *OAuthAuthorizationCodeUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *OAuthAuthorizationCodeUpdate) SetUserID(id int) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OAuthAuthorizationCodeUpdate) SetUser(v *User) *OAuthAuthorizationCodeUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAppID sets the "app" edge to the App entity by ID.
+func (_u *OAuthAuthorizationCodeUpdate) SetAppID(id int) *OAuthAuthorizationCodeUpdate {
+	_u.mutation.SetAppID(id)
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *OAuthAuthorizationCodeUpdate) SetApp(v *App) *OAuthAuthorizationCodeUpdate {
+	return _u.SetAppID(v.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (_u *OAuthAuthorizationCodeUpdate) Mutation() *OAuthAuthorizationCodeMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OAuthAuthorizationCodeUpdate) ClearUser() *OAuthAuthorizationCodeUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *OAuthAuthorizationCodeUpdate) ClearApp() *OAuthAuthorizationCodeUpdate {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OAuthAuthorizationCodeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthAuthorizationCodeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OAuthAuthorizationCodeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthAuthorizationCodeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthAuthorizationCodeUpdate) check() error {
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.user"`)
+	}
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.app"`)
+	}
+	return nil
+}
+
+func (_u *OAuthAuthorizationCodeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthauthorizationcode.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MfaVerified(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldMfaVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.AppTable,
+			Columns: []string{oauthauthorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.AppTable,
+			Columns: []string{oauthauthorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OAuthAuthorizationCodeUpdateOne is the builder for updating a single OAuthAuthorizationCode entity.
+type OAuthAuthorizationCodeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OAuthAuthorizationCodeMutation
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetCodeHash(v string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableCodeHash(v *string) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// SetRedirectURI sets the "redirect_uri" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetRedirectURI(v string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetRedirectURI(v)
+	return _u
+}
+
+// SetNillableRedirectURI sets the "redirect_uri" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableRedirectURI(v *string) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetRedirectURI(*v)
+	}
+	return _u
+}
+
+// SetScopes sets the "scopes" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetScopes(v []string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetScopes(v)
+	return _u
+}
+
+// AppendScopes appends value to the "scopes" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) AppendScopes(v []string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.AppendScopes(v)
+	return _u
+}
+
+// SetNonce sets the "nonce" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNonce(v string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetNonce(v)
+	return _u
+}
+
+// SetNillableNonce sets the "nonce" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableNonce(v *string) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetNonce(*v)
+	}
+	return _u
+}
+
+// ClearNonce clears the value of the "nonce" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) ClearNonce() *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.ClearNonce()
+	return _u
+}
+
+// SetCodeChallenge sets the "code_challenge" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetCodeChallenge(v string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeChallenge(v)
+	return _u
+}
+
+// SetNillableCodeChallenge sets the "code_challenge" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableCodeChallenge(v *string) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallenge(*v)
+	}
+	return _u
+}
+
+// SetCodeChallengeMethod sets the "code_challenge_method" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetCodeChallengeMethod(v string) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetCodeChallengeMethod(v)
+	return _u
+}
+
+// SetNillableCodeChallengeMethod sets the "code_challenge_method" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableCodeChallengeMethod(v *string) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetCodeChallengeMethod(*v)
+	}
+	return _u
+}
+
+// SetAuthTime sets the "auth_time" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetAuthTime(v time.Time) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetAuthTime(v)
+	return _u
+}
+
+// SetNillableAuthTime sets the "auth_time" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableAuthTime(v *time.Time) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetAuthTime(*v)
+	}
+	return _u
+}
+
+// SetMfaVerified sets the "mfa_verified" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetMfaVerified(v bool) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetMfaVerified(v)
+	return _u
+}
+
+// SetNillableMfaVerified sets the "mfa_verified" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableMfaVerified(v *bool) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetMfaVerified(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetExpiresAt(v time.Time) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableExpiresAt(v *time.Time) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetUsedAt(v time.Time) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetNillableUsedAt(v *time.Time) *OAuthAuthorizationCodeUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *OAuthAuthorizationCodeUpdateOne) ClearUsedAt() *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetUserID(id int) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetUser(v *User) *OAuthAuthorizationCodeUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetAppID sets the "app" edge to the App entity by ID.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetAppID(id int) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.SetAppID(id)
+	return _u
+}
+
+// SetApp sets the "app" edge to the App entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) SetApp(v *App) *OAuthAuthorizationCodeUpdateOne {
+	return _u.SetAppID(v.ID)
+}
+
+// Mutation returns the OAuthAuthorizationCodeMutation object of the builder.
+func (_u *OAuthAuthorizationCodeUpdateOne) Mutation() *OAuthAuthorizationCodeMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) ClearUser() *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearApp clears the "app" edge to the App entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) ClearApp() *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.ClearApp()
+	return _u
+}
+
+// Where appends a list predicates to the OAuthAuthorizationCodeUpdate builder.
+func (_u *OAuthAuthorizationCodeUpdateOne) Where(ps ...predicate.OAuthAuthorizationCode) *OAuthAuthorizationCodeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OAuthAuthorizationCodeUpdateOne) Select(field string, fields ...string) *OAuthAuthorizationCodeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OAuthAuthorizationCode entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) Save(ctx context.Context) (*OAuthAuthorizationCode, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OAuthAuthorizationCodeUpdateOne) SaveX(ctx context.Context) *OAuthAuthorizationCode {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OAuthAuthorizationCodeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OAuthAuthorizationCodeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OAuthAuthorizationCodeUpdateOne) check() error {
+	if v, ok := _u.mutation.CodeHash(); ok {
+		if err := oauthauthorizationcode.CodeHashValidator(v); err != nil {
+			return &ValidationError{Name: "code_hash", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURI(); ok {
+		if err := oauthauthorizationcode.RedirectURIValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_uri", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.redirect_uri": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.CodeChallenge(); ok {
+		if err := oauthauthorizationcode.CodeChallengeValidator(v); err != nil {
+			return &ValidationError{Name: "code_challenge", err: fmt.Errorf(`ent: validator failed for field "OAuthAuthorizationCode.code_challenge": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.user"`)
+	}
+	if _u.mutation.AppCleared() && len(_u.mutation.AppIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OAuthAuthorizationCode.app"`)
+	}
+	return nil
+}
+
+func (_u *OAuthAuthorizationCodeUpdateOne) sqlSave(ctx context.Context) (_node *OAuthAuthorizationCode, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(oauthauthorizationcode.Table, oauthauthorizationcode.Columns, sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OAuthAuthorizationCode.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, oauthauthorizationcode.FieldID)
+		for _, f := range fields {
+			if !oauthauthorizationcode.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != oauthauthorizationcode.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RedirectURI(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldRedirectURI, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Scopes(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldScopes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedScopes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, oauthauthorizationcode.FieldScopes, value)
+		})
+	}
+	if value, ok := _u.mutation.Nonce(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldNonce, field.TypeString, value)
+	}
+	if _u.mutation.NonceCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldNonce, field.TypeString)
+	}
+	if value, ok := _u.mutation.CodeChallenge(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallenge, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeChallengeMethod(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldCodeChallengeMethod, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AuthTime(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldAuthTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.MfaVerified(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldMfaVerified, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(oauthauthorizationcode.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(oauthauthorizationcode.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.UserTable,
+			Columns: []string{oauthauthorizationcode.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.AppCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.AppTable,
+			Columns: []string{oauthauthorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AppIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   oauthauthorizationcode.AppTable,
+			Columns: []string{oauthauthorizationcode.AppColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(app.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OAuthAuthorizationCode{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{oauthauthorizationcode.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
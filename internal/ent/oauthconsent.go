@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// OAuthConsent is the model entity for the OAuthConsent schema.
+type OAuthConsent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Scopes holds the value of the "scopes" field.
+	Scopes []string `json:"scopes,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OAuthConsentQuery when eager-loading is set.
+	Edges               OAuthConsentEdges `json:"edges"`
+	app_oauth_consents  *int
+	user_oauth_consents *int
+	selectValues        sql.SelectValues
+}
+
+// OAuthConsentEdges holds the relations/edges for other nodes in the graph.
+type OAuthConsentEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// App holds the value of the app edge.
+	App *App `json:"app,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthConsentEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// AppOrErr returns the App value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OAuthConsentEdges) AppOrErr() (*App, error) {
+	if e.App != nil {
+		return e.App, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: app.Label}
+	}
+	return nil, &NotLoadedError{edge: "app"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OAuthConsent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldScopes:
+			values[i] = new([]byte)
+		case oauthconsent.FieldID:
+			values[i] = new(sql.NullInt64)
+		case oauthconsent.FieldCreatedAt, oauthconsent.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case oauthconsent.ForeignKeys[0]: // app_oauth_consents
+			values[i] = new(sql.NullInt64)
+		case oauthconsent.ForeignKeys[1]: // user_oauth_consents
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OAuthConsent fields.
+func (_m *OAuthConsent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case oauthconsent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case oauthconsent.FieldScopes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field scopes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Scopes); err != nil {
+					return fmt.Errorf("unmarshal field scopes: %w", err)
+				}
+			}
+		case oauthconsent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case oauthconsent.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case oauthconsent.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field app_oauth_consents", value)
+			} else if value.Valid {
+				_m.app_oauth_consents = new(int)
+				*_m.app_oauth_consents = int(value.Int64)
+			}
+		case oauthconsent.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_oauth_consents", value)
+			} else if value.Valid {
+				_m.user_oauth_consents = new(int)
+				*_m.user_oauth_consents = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OAuthConsent.
+// This includes values selected through modifiers, order, etc.
+func (_m *OAuthConsent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the OAuthConsent entity.
+func (_m *OAuthConsent) QueryUser() *UserQuery {
+	return NewOAuthConsentClient(_m.config).QueryUser(_m)
+}
+
+// QueryApp queries the "app" edge of the OAuthConsent entity.
+func (_m *OAuthConsent) QueryApp() *AppQuery {
+	return NewOAuthConsentClient(_m.config).QueryApp(_m)
+}
+
+// Update returns a builder for updating this OAuthConsent.
+// Note that you need to call OAuthConsent.Unwrap() before calling this method if this OAuthConsent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OAuthConsent) Update() *OAuthConsentUpdateOne {
+	return NewOAuthConsentClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OAuthConsent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OAuthConsent) Unwrap() *OAuthConsent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OAuthConsent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OAuthConsent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OAuthConsent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("scopes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Scopes))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OAuthConsents is a parsable slice of OAuthConsent.
+type OAuthConsents []*OAuthConsent
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
 )
 
 // Organization is the model entity for the Organization schema.
@@ -54,9 +55,15 @@ type OrganizationEdges struct {
 	Invitations []*OrganizationInvitation `json:"invitations,omitempty"`
 	// Subscriptions holds the value of the subscriptions edge.
 	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
+	// SSOConnection holds the value of the sso_connection edge.
+	SSOConnection *SSOConnection `json:"sso_connection,omitempty"`
+	// Domains holds the value of the domains edge.
+	Domains []*OrganizationDomain `json:"domains,omitempty"`
+	// SSOLogins holds the value of the sso_logins edge.
+	SSOLogins []*SSOLogin `json:"sso_logins,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [4]bool
+	loadedTypes [7]bool
 }
 
 // AppOrErr returns the App value or an error if the edge
@@ -97,6 +104,35 @@ func (e OrganizationEdges) SubscriptionsOrErr() ([]*Subscription, error) {
 	return nil, &NotLoadedError{edge: "subscriptions"}
 }
 
+// SSOConnectionOrErr returns the SSOConnection value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrganizationEdges) SSOConnectionOrErr() (*SSOConnection, error) {
+	if e.SSOConnection != nil {
+		return e.SSOConnection, nil
+	} else if e.loadedTypes[4] {
+		return nil, &NotFoundError{label: ssoconnection.Label}
+	}
+	return nil, &NotLoadedError{edge: "sso_connection"}
+}
+
+// DomainsOrErr returns the Domains value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) DomainsOrErr() ([]*OrganizationDomain, error) {
+	if e.loadedTypes[5] {
+		return e.Domains, nil
+	}
+	return nil, &NotLoadedError{edge: "domains"}
+}
+
+// SSOLoginsOrErr returns the SSOLogins value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) SSOLoginsOrErr() ([]*SSOLogin, error) {
+	if e.loadedTypes[6] {
+		return e.SSOLogins, nil
+	}
+	return nil, &NotLoadedError{edge: "sso_logins"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -231,6 +267,21 @@ func (_m *Organization) QuerySubscriptions() *SubscriptionQuery {
 	return NewOrganizationClient(_m.config).QuerySubscriptions(_m)
 }
 
+// QuerySSOConnection queries the "sso_connection" edge of the Organization entity.
+func (_m *Organization) QuerySSOConnection() *SSOConnectionQuery {
+	return NewOrganizationClient(_m.config).QuerySSOConnection(_m)
+}
+
+// QueryDomains queries the "domains" edge of the Organization entity.
+func (_m *Organization) QueryDomains() *OrganizationDomainQuery {
+	return NewOrganizationClient(_m.config).QueryDomains(_m)
+}
+
+// QuerySSOLogins queries the "sso_logins" edge of the Organization entity.
+func (_m *Organization) QuerySSOLogins() *SSOLoginQuery {
+	return NewOrganizationClient(_m.config).QuerySSOLogins(_m)
+}
+
 // Update returns a builder for updating this Organization.
 // Note that you need to call Organization.Unwrap() before calling this method if this Organization
 // was returned from a transaction, and the transaction was committed or rolled back.
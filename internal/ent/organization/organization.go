@@ -40,6 +40,12 @@ const (
 	EdgeInvitations = "invitations"
 	// EdgeSubscriptions holds the string denoting the subscriptions edge name in mutations.
 	EdgeSubscriptions = "subscriptions"
+	// EdgeSSOConnection holds the string denoting the sso_connection edge name in mutations.
+	EdgeSSOConnection = "sso_connection"
+	// EdgeDomains holds the string denoting the domains edge name in mutations.
+	EdgeDomains = "domains"
+	// EdgeSSOLogins holds the string denoting the sso_logins edge name in mutations.
+	EdgeSSOLogins = "sso_logins"
 	// Table holds the table name of the organization in the database.
 	Table = "organizations"
 	// AppTable is the table that holds the app relation/edge.
@@ -70,6 +76,27 @@ const (
 	SubscriptionsInverseTable = "subscriptions"
 	// SubscriptionsColumn is the table column denoting the subscriptions relation/edge.
 	SubscriptionsColumn = "organization_subscriptions"
+	// SSOConnectionTable is the table that holds the sso_connection relation/edge.
+	SSOConnectionTable = "sso_connections"
+	// SSOConnectionInverseTable is the table name for the SSOConnection entity.
+	// It exists in this package in order to avoid circular dependency with the "ssoconnection" package.
+	SSOConnectionInverseTable = "sso_connections"
+	// SSOConnectionColumn is the table column denoting the sso_connection relation/edge.
+	SSOConnectionColumn = "organization_sso_connection"
+	// DomainsTable is the table that holds the domains relation/edge.
+	DomainsTable = "organization_domains"
+	// DomainsInverseTable is the table name for the OrganizationDomain entity.
+	// It exists in this package in order to avoid circular dependency with the "organizationdomain" package.
+	DomainsInverseTable = "organization_domains"
+	// DomainsColumn is the table column denoting the domains relation/edge.
+	DomainsColumn = "organization_domains"
+	// SSOLoginsTable is the table that holds the sso_logins relation/edge.
+	SSOLoginsTable = "sso_logins"
+	// SSOLoginsInverseTable is the table name for the SSOLogin entity.
+	// It exists in this package in order to avoid circular dependency with the "ssologin" package.
+	SSOLoginsInverseTable = "sso_logins"
+	// SSOLoginsColumn is the table column denoting the sso_logins relation/edge.
+	SSOLoginsColumn = "organization_sso_logins"
 )
 
 // Columns holds all SQL columns for organization fields.
@@ -218,6 +245,41 @@ func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSubscriptionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// BySSOConnectionField orders the results by sso_connection field.
+func BySSOConnectionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSSOConnectionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByDomainsCount orders the results by domains count.
+func ByDomainsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDomainsStep(), opts...)
+	}
+}
+
+// ByDomains orders the results by domains terms.
+func ByDomains(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDomainsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySSOLoginsCount orders the results by sso_logins count.
+func BySSOLoginsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSSOLoginsStep(), opts...)
+	}
+}
+
+// BySSOLogins orders the results by sso_logins terms.
+func BySSOLogins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSSOLoginsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newAppStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -246,3 +308,24 @@ func newSubscriptionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SubscriptionsTable, SubscriptionsColumn),
 	)
 }
+func newSSOConnectionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SSOConnectionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, false, SSOConnectionTable, SSOConnectionColumn),
+	)
+}
+func newDomainsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DomainsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DomainsTable, DomainsColumn),
+	)
+}
+func newSSOLoginsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SSOLoginsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SSOLoginsTable, SSOLoginsColumn),
+	)
+}
@@ -642,6 +642,75 @@ func HasSubscriptionsWith(preds ...predicate.Subscription) predicate.Organizatio
 	})
 }
 
+// HasSSOConnection applies the HasEdge predicate on the "sso_connection" edge.
+func HasSSOConnection() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, SSOConnectionTable, SSOConnectionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSSOConnectionWith applies the HasEdge predicate on the "sso_connection" edge with a given conditions (other predicates).
+func HasSSOConnectionWith(preds ...predicate.SSOConnection) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newSSOConnectionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDomains applies the HasEdge predicate on the "domains" edge.
+func HasDomains() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DomainsTable, DomainsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDomainsWith applies the HasEdge predicate on the "domains" edge with a given conditions (other predicates).
+func HasDomainsWith(preds ...predicate.OrganizationDomain) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newDomainsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSSOLogins applies the HasEdge predicate on the "sso_logins" edge.
+func HasSSOLogins() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SSOLoginsTable, SSOLoginsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSSOLoginsWith applies the HasEdge predicate on the "sso_logins" edge with a given conditions (other predicates).
+func HasSSOLoginsWith(preds ...predicate.SSOLogin) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newSSOLoginsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Organization) predicate.Organization {
 	return predicate.Organization(sql.AndPredicates(predicates...))
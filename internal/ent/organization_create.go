@@ -12,8 +12,11 @@ import (
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 )
 
@@ -182,6 +185,55 @@ func (_c *OrganizationCreate) AddSubscriptions(v ...*Subscription) *Organization
 	return _c.AddSubscriptionIDs(ids...)
 }
 
+// SetSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID.
+func (_c *OrganizationCreate) SetSSOConnectionID(id int) *OrganizationCreate {
+	_c.mutation.SetSSOConnectionID(id)
+	return _c
+}
+
+// SetNillableSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableSSOConnectionID(id *int) *OrganizationCreate {
+	if id != nil {
+		_c = _c.SetSSOConnectionID(*id)
+	}
+	return _c
+}
+
+// SetSSOConnection sets the "sso_connection" edge to the SSOConnection entity.
+func (_c *OrganizationCreate) SetSSOConnection(v *SSOConnection) *OrganizationCreate {
+	return _c.SetSSOConnectionID(v.ID)
+}
+
+// AddDomainIDs adds the "domains" edge to the OrganizationDomain entity by IDs.
+func (_c *OrganizationCreate) AddDomainIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddDomainIDs(ids...)
+	return _c
+}
+
+// AddDomains adds the "domains" edges to the OrganizationDomain entity.
+func (_c *OrganizationCreate) AddDomains(v ...*OrganizationDomain) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDomainIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_c *OrganizationCreate) AddSSOLoginIDs(ids ...int) *OrganizationCreate {
+	_c.mutation.AddSSOLoginIDs(ids...)
+	return _c
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_c *OrganizationCreate) AddSSOLogins(v ...*SSOLogin) *OrganizationCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (_c *OrganizationCreate) Mutation() *OrganizationMutation {
 	return _c.mutation
@@ -388,6 +440,54 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.SSOConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.SSOConnectionTable,
+			Columns: []string{organization.SSOConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
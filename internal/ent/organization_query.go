@@ -9,14 +9,18 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 )
 
@@ -31,7 +35,11 @@ type OrganizationQuery struct {
 	withMembers       *OrganizationMemberQuery
 	withInvitations   *OrganizationInvitationQuery
 	withSubscriptions *SubscriptionQuery
+	withSSOConnection *SSOConnectionQuery
+	withDomains       *OrganizationDomainQuery
+	withSSOLogins     *SSOLoginQuery
 	withFKs           bool
+	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -156,6 +164,72 @@ func (_q *OrganizationQuery) QuerySubscriptions() *SubscriptionQuery {
 	return query
 }
 
+// QuerySSOConnection chains the current query on the "sso_connection" edge.
+func (_q *OrganizationQuery) QuerySSOConnection() *SSOConnectionQuery {
+	query := (&SSOConnectionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(ssoconnection.Table, ssoconnection.FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, false, organization.SSOConnectionTable, organization.SSOConnectionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryDomains chains the current query on the "domains" edge.
+func (_q *OrganizationQuery) QueryDomains() *OrganizationDomainQuery {
+	query := (&OrganizationDomainClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(organizationdomain.Table, organizationdomain.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.DomainsTable, organization.DomainsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySSOLogins chains the current query on the "sso_logins" edge.
+func (_q *OrganizationQuery) QuerySSOLogins() *SSOLoginQuery {
+	query := (&SSOLoginClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(ssologin.Table, ssologin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.SSOLoginsTable, organization.SSOLoginsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Organization entity from the query.
 // Returns a *NotFoundError when no Organization was found.
 func (_q *OrganizationQuery) First(ctx context.Context) (*Organization, error) {
@@ -352,6 +426,9 @@ func (_q *OrganizationQuery) Clone() *OrganizationQuery {
 		withMembers:       _q.withMembers.Clone(),
 		withInvitations:   _q.withInvitations.Clone(),
 		withSubscriptions: _q.withSubscriptions.Clone(),
+		withSSOConnection: _q.withSSOConnection.Clone(),
+		withDomains:       _q.withDomains.Clone(),
+		withSSOLogins:     _q.withSSOLogins.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -402,6 +479,39 @@ func (_q *OrganizationQuery) WithSubscriptions(opts ...func(*SubscriptionQuery))
 	return _q
 }
 
+// WithSSOConnection tells the query-builder to eager-load the nodes that are connected to
+// the "sso_connection" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithSSOConnection(opts ...func(*SSOConnectionQuery)) *OrganizationQuery {
+	query := (&SSOConnectionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSSOConnection = query
+	return _q
+}
+
+// WithDomains tells the query-builder to eager-load the nodes that are connected to
+// the "domains" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithDomains(opts ...func(*OrganizationDomainQuery)) *OrganizationQuery {
+	query := (&OrganizationDomainClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withDomains = query
+	return _q
+}
+
+// WithSSOLogins tells the query-builder to eager-load the nodes that are connected to
+// the "sso_logins" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithSSOLogins(opts ...func(*SSOLoginQuery)) *OrganizationQuery {
+	query := (&SSOLoginClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSSOLogins = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -481,11 +591,14 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		nodes       = []*Organization{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [4]bool{
+		loadedTypes = [7]bool{
 			_q.withApp != nil,
 			_q.withMembers != nil,
 			_q.withInvitations != nil,
 			_q.withSubscriptions != nil,
+			_q.withSSOConnection != nil,
+			_q.withDomains != nil,
+			_q.withSSOLogins != nil,
 		}
 	)
 	if _q.withApp != nil {
@@ -503,6 +616,9 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -539,6 +655,26 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withSSOConnection; query != nil {
+		if err := _q.loadSSOConnection(ctx, query, nodes, nil,
+			func(n *Organization, e *SSOConnection) { n.Edges.SSOConnection = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withDomains; query != nil {
+		if err := _q.loadDomains(ctx, query, nodes,
+			func(n *Organization) { n.Edges.Domains = []*OrganizationDomain{} },
+			func(n *Organization, e *OrganizationDomain) { n.Edges.Domains = append(n.Edges.Domains, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSSOLogins; query != nil {
+		if err := _q.loadSSOLogins(ctx, query, nodes,
+			func(n *Organization) { n.Edges.SSOLogins = []*SSOLogin{} },
+			func(n *Organization, e *SSOLogin) { n.Edges.SSOLogins = append(n.Edges.SSOLogins, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -667,9 +803,102 @@ func (_q *OrganizationQuery) loadSubscriptions(ctx context.Context, query *Subsc
 	}
 	return nil
 }
+func (_q *OrganizationQuery) loadSSOConnection(ctx context.Context, query *SSOConnectionQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *SSOConnection)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+	}
+	query.withFKs = true
+	query.Where(predicate.SSOConnection(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.SSOConnectionColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.organization_sso_connection
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_sso_connection" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_sso_connection" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadDomains(ctx context.Context, query *OrganizationDomainQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *OrganizationDomain)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OrganizationDomain(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.DomainsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.organization_domains
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_domains" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_domains" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadSSOLogins(ctx context.Context, query *SSOLoginQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *SSOLogin)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SSOLogin(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.SSOLoginsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.organization_sso_logins
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "organization_sso_logins" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "organization_sso_logins" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *OrganizationQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -732,6 +961,9 @@ func (_q *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -749,6 +981,32 @@ func (_q *OrganizationQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *OrganizationQuery) ForUpdate(opts ...sql.LockOption) *OrganizationQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *OrganizationQuery) ForShare(opts ...sql.LockOption) *OrganizationQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // OrganizationGroupBy is the group-by builder for Organization entities.
 type OrganizationGroupBy struct {
 	selector
@@ -13,9 +13,12 @@ import (
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 )
 
@@ -208,6 +211,55 @@ func (_u *OrganizationUpdate) AddSubscriptions(v ...*Subscription) *Organization
 	return _u.AddSubscriptionIDs(ids...)
 }
 
+// SetSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID.
+func (_u *OrganizationUpdate) SetSSOConnectionID(id int) *OrganizationUpdate {
+	_u.mutation.SetSSOConnectionID(id)
+	return _u
+}
+
+// SetNillableSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID if the given value is not nil.
+func (_u *OrganizationUpdate) SetNillableSSOConnectionID(id *int) *OrganizationUpdate {
+	if id != nil {
+		_u = _u.SetSSOConnectionID(*id)
+	}
+	return _u
+}
+
+// SetSSOConnection sets the "sso_connection" edge to the SSOConnection entity.
+func (_u *OrganizationUpdate) SetSSOConnection(v *SSOConnection) *OrganizationUpdate {
+	return _u.SetSSOConnectionID(v.ID)
+}
+
+// AddDomainIDs adds the "domains" edge to the OrganizationDomain entity by IDs.
+func (_u *OrganizationUpdate) AddDomainIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddDomainIDs(ids...)
+	return _u
+}
+
+// AddDomains adds the "domains" edges to the OrganizationDomain entity.
+func (_u *OrganizationUpdate) AddDomains(v ...*OrganizationDomain) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDomainIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_u *OrganizationUpdate) AddSSOLoginIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.AddSSOLoginIDs(ids...)
+	return _u
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_u *OrganizationUpdate) AddSSOLogins(v ...*SSOLogin) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (_u *OrganizationUpdate) Mutation() *OrganizationMutation {
 	return _u.mutation
@@ -282,6 +334,54 @@ func (_u *OrganizationUpdate) RemoveSubscriptions(v ...*Subscription) *Organizat
 	return _u.RemoveSubscriptionIDs(ids...)
 }
 
+// ClearSSOConnection clears the "sso_connection" edge to the SSOConnection entity.
+func (_u *OrganizationUpdate) ClearSSOConnection() *OrganizationUpdate {
+	_u.mutation.ClearSSOConnection()
+	return _u
+}
+
+// ClearDomains clears all "domains" edges to the OrganizationDomain entity.
+func (_u *OrganizationUpdate) ClearDomains() *OrganizationUpdate {
+	_u.mutation.ClearDomains()
+	return _u
+}
+
+// RemoveDomainIDs removes the "domains" edge to OrganizationDomain entities by IDs.
+func (_u *OrganizationUpdate) RemoveDomainIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveDomainIDs(ids...)
+	return _u
+}
+
+// RemoveDomains removes "domains" edges to OrganizationDomain entities.
+func (_u *OrganizationUpdate) RemoveDomains(v ...*OrganizationDomain) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDomainIDs(ids...)
+}
+
+// ClearSSOLogins clears all "sso_logins" edges to the SSOLogin entity.
+func (_u *OrganizationUpdate) ClearSSOLogins() *OrganizationUpdate {
+	_u.mutation.ClearSSOLogins()
+	return _u
+}
+
+// RemoveSSOLoginIDs removes the "sso_logins" edge to SSOLogin entities by IDs.
+func (_u *OrganizationUpdate) RemoveSSOLoginIDs(ids ...int) *OrganizationUpdate {
+	_u.mutation.RemoveSSOLoginIDs(ids...)
+	return _u
+}
+
+// RemoveSSOLogins removes "sso_logins" edges to SSOLogin entities.
+func (_u *OrganizationUpdate) RemoveSSOLogins(v ...*SSOLogin) *OrganizationUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSSOLoginIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *OrganizationUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -548,6 +648,125 @@ func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SSOConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.SSOConnectionTable,
+			Columns: []string{organization.SSOConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.SSOConnectionTable,
+			Columns: []string{organization.SSOConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDomainsIDs(); len(nodes) > 0 && !_u.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSSOLoginsIDs(); len(nodes) > 0 && !_u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{organization.Label}
@@ -744,6 +963,55 @@ func (_u *OrganizationUpdateOne) AddSubscriptions(v ...*Subscription) *Organizat
 	return _u.AddSubscriptionIDs(ids...)
 }
 
+// SetSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID.
+func (_u *OrganizationUpdateOne) SetSSOConnectionID(id int) *OrganizationUpdateOne {
+	_u.mutation.SetSSOConnectionID(id)
+	return _u
+}
+
+// SetNillableSSOConnectionID sets the "sso_connection" edge to the SSOConnection entity by ID if the given value is not nil.
+func (_u *OrganizationUpdateOne) SetNillableSSOConnectionID(id *int) *OrganizationUpdateOne {
+	if id != nil {
+		_u = _u.SetSSOConnectionID(*id)
+	}
+	return _u
+}
+
+// SetSSOConnection sets the "sso_connection" edge to the SSOConnection entity.
+func (_u *OrganizationUpdateOne) SetSSOConnection(v *SSOConnection) *OrganizationUpdateOne {
+	return _u.SetSSOConnectionID(v.ID)
+}
+
+// AddDomainIDs adds the "domains" edge to the OrganizationDomain entity by IDs.
+func (_u *OrganizationUpdateOne) AddDomainIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddDomainIDs(ids...)
+	return _u
+}
+
+// AddDomains adds the "domains" edges to the OrganizationDomain entity.
+func (_u *OrganizationUpdateOne) AddDomains(v ...*OrganizationDomain) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDomainIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_u *OrganizationUpdateOne) AddSSOLoginIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.AddSSOLoginIDs(ids...)
+	return _u
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_u *OrganizationUpdateOne) AddSSOLogins(v ...*SSOLogin) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the OrganizationMutation object of the builder.
 func (_u *OrganizationUpdateOne) Mutation() *OrganizationMutation {
 	return _u.mutation
@@ -818,6 +1086,54 @@ func (_u *OrganizationUpdateOne) RemoveSubscriptions(v ...*Subscription) *Organi
 	return _u.RemoveSubscriptionIDs(ids...)
 }
 
+// ClearSSOConnection clears the "sso_connection" edge to the SSOConnection entity.
+func (_u *OrganizationUpdateOne) ClearSSOConnection() *OrganizationUpdateOne {
+	_u.mutation.ClearSSOConnection()
+	return _u
+}
+
+// ClearDomains clears all "domains" edges to the OrganizationDomain entity.
+func (_u *OrganizationUpdateOne) ClearDomains() *OrganizationUpdateOne {
+	_u.mutation.ClearDomains()
+	return _u
+}
+
+// RemoveDomainIDs removes the "domains" edge to OrganizationDomain entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveDomainIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveDomainIDs(ids...)
+	return _u
+}
+
+// RemoveDomains removes "domains" edges to OrganizationDomain entities.
+func (_u *OrganizationUpdateOne) RemoveDomains(v ...*OrganizationDomain) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDomainIDs(ids...)
+}
+
+// ClearSSOLogins clears all "sso_logins" edges to the SSOLogin entity.
+func (_u *OrganizationUpdateOne) ClearSSOLogins() *OrganizationUpdateOne {
+	_u.mutation.ClearSSOLogins()
+	return _u
+}
+
+// RemoveSSOLoginIDs removes the "sso_logins" edge to SSOLogin entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveSSOLoginIDs(ids ...int) *OrganizationUpdateOne {
+	_u.mutation.RemoveSSOLoginIDs(ids...)
+	return _u
+}
+
+// RemoveSSOLogins removes "sso_logins" edges to SSOLogin entities.
+func (_u *OrganizationUpdateOne) RemoveSSOLogins(v ...*SSOLogin) *OrganizationUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSSOLoginIDs(ids...)
+}
+
 // Where appends a list predicates to the OrganizationUpdate builder.
 func (_u *OrganizationUpdateOne) Where(ps ...predicate.Organization) *OrganizationUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1114,6 +1430,125 @@ func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizati
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.SSOConnectionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.SSOConnectionTable,
+			Columns: []string{organization.SSOConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOConnectionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: false,
+			Table:   organization.SSOConnectionTable,
+			Columns: []string{organization.SSOConnectionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDomainsIDs(); len(nodes) > 0 && !_u.mutation.DomainsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DomainsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.DomainsTable,
+			Columns: []string{organization.DomainsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSSOLoginsIDs(); len(nodes) > 0 && !_u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.SSOLoginsTable,
+			Columns: []string{organization.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Organization{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
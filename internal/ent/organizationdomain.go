@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+)
+
+// OrganizationDomain is the model entity for the OrganizationDomain schema.
+type OrganizationDomain struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Domain holds the value of the "domain" field.
+	Domain string `json:"domain,omitempty"`
+	// VerificationToken holds the value of the "verification_token" field.
+	VerificationToken string `json:"verification_token,omitempty"`
+	// VerifiedAt holds the value of the "verified_at" field.
+	VerifiedAt *time.Time `json:"verified_at,omitempty"`
+	// DefaultRole holds the value of the "default_role" field.
+	DefaultRole organizationdomain.DefaultRole `json:"default_role,omitempty"`
+	// AutoJoin holds the value of the "auto_join" field.
+	AutoJoin bool `json:"auto_join,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrganizationDomainQuery when eager-loading is set.
+	Edges                OrganizationDomainEdges `json:"edges"`
+	organization_domains *int
+	selectValues         sql.SelectValues
+}
+
+// OrganizationDomainEdges holds the relations/edges for other nodes in the graph.
+type OrganizationDomainEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrganizationDomainEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OrganizationDomain) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case organizationdomain.FieldAutoJoin:
+			values[i] = new(sql.NullBool)
+		case organizationdomain.FieldID:
+			values[i] = new(sql.NullInt64)
+		case organizationdomain.FieldDomain, organizationdomain.FieldVerificationToken, organizationdomain.FieldDefaultRole:
+			values[i] = new(sql.NullString)
+		case organizationdomain.FieldVerifiedAt, organizationdomain.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case organizationdomain.ForeignKeys[0]: // organization_domains
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OrganizationDomain fields.
+func (_m *OrganizationDomain) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case organizationdomain.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case organizationdomain.FieldDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field domain", values[i])
+			} else if value.Valid {
+				_m.Domain = value.String
+			}
+		case organizationdomain.FieldVerificationToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field verification_token", values[i])
+			} else if value.Valid {
+				_m.VerificationToken = value.String
+			}
+		case organizationdomain.FieldVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field verified_at", values[i])
+			} else if value.Valid {
+				_m.VerifiedAt = new(time.Time)
+				*_m.VerifiedAt = value.Time
+			}
+		case organizationdomain.FieldDefaultRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field default_role", values[i])
+			} else if value.Valid {
+				_m.DefaultRole = organizationdomain.DefaultRole(value.String)
+			}
+		case organizationdomain.FieldAutoJoin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto_join", values[i])
+			} else if value.Valid {
+				_m.AutoJoin = value.Bool
+			}
+		case organizationdomain.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case organizationdomain.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field organization_domains", value)
+			} else if value.Valid {
+				_m.organization_domains = new(int)
+				*_m.organization_domains = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OrganizationDomain.
+// This includes values selected through modifiers, order, etc.
+func (_m *OrganizationDomain) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the OrganizationDomain entity.
+func (_m *OrganizationDomain) QueryOrganization() *OrganizationQuery {
+	return NewOrganizationDomainClient(_m.config).QueryOrganization(_m)
+}
+
+// Update returns a builder for updating this OrganizationDomain.
+// Note that you need to call OrganizationDomain.Unwrap() before calling this method if this OrganizationDomain
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OrganizationDomain) Update() *OrganizationDomainUpdateOne {
+	return NewOrganizationDomainClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OrganizationDomain entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OrganizationDomain) Unwrap() *OrganizationDomain {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OrganizationDomain is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OrganizationDomain) String() string {
+	var builder strings.Builder
+	builder.WriteString("OrganizationDomain(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("domain=")
+	builder.WriteString(_m.Domain)
+	builder.WriteString(", ")
+	builder.WriteString("verification_token=")
+	builder.WriteString(_m.VerificationToken)
+	builder.WriteString(", ")
+	if v := _m.VerifiedAt; v != nil {
+		builder.WriteString("verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("default_role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DefaultRole))
+	builder.WriteString(", ")
+	builder.WriteString("auto_join=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AutoJoin))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OrganizationDomains is a parsable slice of OrganizationDomain.
+type OrganizationDomains []*OrganizationDomain
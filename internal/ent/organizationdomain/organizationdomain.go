@@ -0,0 +1,162 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organizationdomain
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the organizationdomain type in the database.
+	Label = "organization_domain"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDomain holds the string denoting the domain field in the database.
+	FieldDomain = "domain"
+	// FieldVerificationToken holds the string denoting the verification_token field in the database.
+	FieldVerificationToken = "verification_token"
+	// FieldVerifiedAt holds the string denoting the verified_at field in the database.
+	FieldVerifiedAt = "verified_at"
+	// FieldDefaultRole holds the string denoting the default_role field in the database.
+	FieldDefaultRole = "default_role"
+	// FieldAutoJoin holds the string denoting the auto_join field in the database.
+	FieldAutoJoin = "auto_join"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// Table holds the table name of the organizationdomain in the database.
+	Table = "organization_domains"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "organization_domains"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_domains"
+)
+
+// Columns holds all SQL columns for organizationdomain fields.
+var Columns = []string{
+	FieldID,
+	FieldDomain,
+	FieldVerificationToken,
+	FieldVerifiedAt,
+	FieldDefaultRole,
+	FieldAutoJoin,
+	FieldCreatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "organization_domains"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"organization_domains",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	DomainValidator func(string) error
+	// VerificationTokenValidator is a validator for the "verification_token" field. It is called by the builders before save.
+	VerificationTokenValidator func(string) error
+	// DefaultAutoJoin holds the default value on creation for the "auto_join" field.
+	DefaultAutoJoin bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// DefaultRole defines the type for the "default_role" enum field.
+type DefaultRole string
+
+// DefaultRoleMEMBER is the default value of the DefaultRole enum.
+const DefaultDefaultRole = DefaultRoleMEMBER
+
+// DefaultRole values.
+const (
+	DefaultRoleADMIN  DefaultRole = "ADMIN"
+	DefaultRoleMEMBER DefaultRole = "MEMBER"
+)
+
+func (dr DefaultRole) String() string {
+	return string(dr)
+}
+
+// DefaultRoleValidator is a validator for the "default_role" field enum values. It is called by the builders before save.
+func DefaultRoleValidator(dr DefaultRole) error {
+	switch dr {
+	case DefaultRoleADMIN, DefaultRoleMEMBER:
+		return nil
+	default:
+		return fmt.Errorf("organizationdomain: invalid enum value for default_role field: %q", dr)
+	}
+}
+
+// OrderOption defines the ordering options for the OrganizationDomain queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDomain orders the results by the domain field.
+func ByDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDomain, opts...).ToFunc()
+}
+
+// ByVerificationToken orders the results by the verification_token field.
+func ByVerificationToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerificationToken, opts...).ToFunc()
+}
+
+// ByVerifiedAt orders the results by the verified_at field.
+func ByVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVerifiedAt, opts...).ToFunc()
+}
+
+// ByDefaultRole orders the results by the default_role field.
+func ByDefaultRole(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDefaultRole, opts...).ToFunc()
+}
+
+// ByAutoJoin orders the results by the auto_join field.
+func ByAutoJoin(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAutoJoin, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
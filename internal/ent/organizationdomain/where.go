@@ -0,0 +1,369 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organizationdomain
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLTE(FieldID, id))
+}
+
+// Domain applies equality check predicate on the "domain" field. It's identical to DomainEQ.
+func Domain(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldDomain, v))
+}
+
+// VerificationToken applies equality check predicate on the "verification_token" field. It's identical to VerificationTokenEQ.
+func VerificationToken(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerifiedAt applies equality check predicate on the "verified_at" field. It's identical to VerifiedAtEQ.
+func VerifiedAt(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// AutoJoin applies equality check predicate on the "auto_join" field. It's identical to AutoJoinEQ.
+func AutoJoin(v bool) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldAutoJoin, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DomainEQ applies the EQ predicate on the "domain" field.
+func DomainEQ(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldDomain, v))
+}
+
+// DomainNEQ applies the NEQ predicate on the "domain" field.
+func DomainNEQ(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldDomain, v))
+}
+
+// DomainIn applies the In predicate on the "domain" field.
+func DomainIn(vs ...string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldDomain, vs...))
+}
+
+// DomainNotIn applies the NotIn predicate on the "domain" field.
+func DomainNotIn(vs ...string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldDomain, vs...))
+}
+
+// DomainGT applies the GT predicate on the "domain" field.
+func DomainGT(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGT(FieldDomain, v))
+}
+
+// DomainGTE applies the GTE predicate on the "domain" field.
+func DomainGTE(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGTE(FieldDomain, v))
+}
+
+// DomainLT applies the LT predicate on the "domain" field.
+func DomainLT(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLT(FieldDomain, v))
+}
+
+// DomainLTE applies the LTE predicate on the "domain" field.
+func DomainLTE(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLTE(FieldDomain, v))
+}
+
+// DomainContains applies the Contains predicate on the "domain" field.
+func DomainContains(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldContains(FieldDomain, v))
+}
+
+// DomainHasPrefix applies the HasPrefix predicate on the "domain" field.
+func DomainHasPrefix(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldHasPrefix(FieldDomain, v))
+}
+
+// DomainHasSuffix applies the HasSuffix predicate on the "domain" field.
+func DomainHasSuffix(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldHasSuffix(FieldDomain, v))
+}
+
+// DomainEqualFold applies the EqualFold predicate on the "domain" field.
+func DomainEqualFold(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEqualFold(FieldDomain, v))
+}
+
+// DomainContainsFold applies the ContainsFold predicate on the "domain" field.
+func DomainContainsFold(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldContainsFold(FieldDomain, v))
+}
+
+// VerificationTokenEQ applies the EQ predicate on the "verification_token" field.
+func VerificationTokenEQ(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenNEQ applies the NEQ predicate on the "verification_token" field.
+func VerificationTokenNEQ(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldVerificationToken, v))
+}
+
+// VerificationTokenIn applies the In predicate on the "verification_token" field.
+func VerificationTokenIn(vs ...string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenNotIn applies the NotIn predicate on the "verification_token" field.
+func VerificationTokenNotIn(vs ...string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldVerificationToken, vs...))
+}
+
+// VerificationTokenGT applies the GT predicate on the "verification_token" field.
+func VerificationTokenGT(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGT(FieldVerificationToken, v))
+}
+
+// VerificationTokenGTE applies the GTE predicate on the "verification_token" field.
+func VerificationTokenGTE(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenLT applies the LT predicate on the "verification_token" field.
+func VerificationTokenLT(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLT(FieldVerificationToken, v))
+}
+
+// VerificationTokenLTE applies the LTE predicate on the "verification_token" field.
+func VerificationTokenLTE(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLTE(FieldVerificationToken, v))
+}
+
+// VerificationTokenContains applies the Contains predicate on the "verification_token" field.
+func VerificationTokenContains(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldContains(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasPrefix applies the HasPrefix predicate on the "verification_token" field.
+func VerificationTokenHasPrefix(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldHasPrefix(FieldVerificationToken, v))
+}
+
+// VerificationTokenHasSuffix applies the HasSuffix predicate on the "verification_token" field.
+func VerificationTokenHasSuffix(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldHasSuffix(FieldVerificationToken, v))
+}
+
+// VerificationTokenEqualFold applies the EqualFold predicate on the "verification_token" field.
+func VerificationTokenEqualFold(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEqualFold(FieldVerificationToken, v))
+}
+
+// VerificationTokenContainsFold applies the ContainsFold predicate on the "verification_token" field.
+func VerificationTokenContainsFold(v string) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldContainsFold(FieldVerificationToken, v))
+}
+
+// VerifiedAtEQ applies the EQ predicate on the "verified_at" field.
+func VerifiedAtEQ(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtNEQ applies the NEQ predicate on the "verified_at" field.
+func VerifiedAtNEQ(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIn applies the In predicate on the "verified_at" field.
+func VerifiedAtIn(vs ...time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtNotIn applies the NotIn predicate on the "verified_at" field.
+func VerifiedAtNotIn(vs ...time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldVerifiedAt, vs...))
+}
+
+// VerifiedAtGT applies the GT predicate on the "verified_at" field.
+func VerifiedAtGT(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtGTE applies the GTE predicate on the "verified_at" field.
+func VerifiedAtGTE(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLT applies the LT predicate on the "verified_at" field.
+func VerifiedAtLT(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLT(FieldVerifiedAt, v))
+}
+
+// VerifiedAtLTE applies the LTE predicate on the "verified_at" field.
+func VerifiedAtLTE(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLTE(FieldVerifiedAt, v))
+}
+
+// VerifiedAtIsNil applies the IsNil predicate on the "verified_at" field.
+func VerifiedAtIsNil() predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIsNull(FieldVerifiedAt))
+}
+
+// VerifiedAtNotNil applies the NotNil predicate on the "verified_at" field.
+func VerifiedAtNotNil() predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotNull(FieldVerifiedAt))
+}
+
+// DefaultRoleEQ applies the EQ predicate on the "default_role" field.
+func DefaultRoleEQ(v DefaultRole) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldDefaultRole, v))
+}
+
+// DefaultRoleNEQ applies the NEQ predicate on the "default_role" field.
+func DefaultRoleNEQ(v DefaultRole) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldDefaultRole, v))
+}
+
+// DefaultRoleIn applies the In predicate on the "default_role" field.
+func DefaultRoleIn(vs ...DefaultRole) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldDefaultRole, vs...))
+}
+
+// DefaultRoleNotIn applies the NotIn predicate on the "default_role" field.
+func DefaultRoleNotIn(vs ...DefaultRole) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldDefaultRole, vs...))
+}
+
+// AutoJoinEQ applies the EQ predicate on the "auto_join" field.
+func AutoJoinEQ(v bool) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldAutoJoin, v))
+}
+
+// AutoJoinNEQ applies the NEQ predicate on the "auto_join" field.
+func AutoJoinNEQ(v bool) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldAutoJoin, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrganizationDomain) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrganizationDomain) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrganizationDomain) predicate.OrganizationDomain {
+	return predicate.OrganizationDomain(sql.NotPredicates(p))
+}
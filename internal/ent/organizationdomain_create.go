@@ -0,0 +1,343 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+)
+
+// OrganizationDomainCreate is the builder for creating a OrganizationDomain entity.
+type OrganizationDomainCreate struct {
+	config
+	mutation *OrganizationDomainMutation
+	hooks    []Hook
+}
+
+// SetDomain sets the "domain" field.
+func (_c *OrganizationDomainCreate) SetDomain(v string) *OrganizationDomainCreate {
+	_c.mutation.SetDomain(v)
+	return _c
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_c *OrganizationDomainCreate) SetVerificationToken(v string) *OrganizationDomainCreate {
+	_c.mutation.SetVerificationToken(v)
+	return _c
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_c *OrganizationDomainCreate) SetVerifiedAt(v time.Time) *OrganizationDomainCreate {
+	_c.mutation.SetVerifiedAt(v)
+	return _c
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_c *OrganizationDomainCreate) SetNillableVerifiedAt(v *time.Time) *OrganizationDomainCreate {
+	if v != nil {
+		_c.SetVerifiedAt(*v)
+	}
+	return _c
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_c *OrganizationDomainCreate) SetDefaultRole(v organizationdomain.DefaultRole) *OrganizationDomainCreate {
+	_c.mutation.SetDefaultRole(v)
+	return _c
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_c *OrganizationDomainCreate) SetNillableDefaultRole(v *organizationdomain.DefaultRole) *OrganizationDomainCreate {
+	if v != nil {
+		_c.SetDefaultRole(*v)
+	}
+	return _c
+}
+
+// SetAutoJoin sets the "auto_join" field.
+func (_c *OrganizationDomainCreate) SetAutoJoin(v bool) *OrganizationDomainCreate {
+	_c.mutation.SetAutoJoin(v)
+	return _c
+}
+
+// SetNillableAutoJoin sets the "auto_join" field if the given value is not nil.
+func (_c *OrganizationDomainCreate) SetNillableAutoJoin(v *bool) *OrganizationDomainCreate {
+	if v != nil {
+		_c.SetAutoJoin(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrganizationDomainCreate) SetCreatedAt(v time.Time) *OrganizationDomainCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrganizationDomainCreate) SetNillableCreatedAt(v *time.Time) *OrganizationDomainCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *OrganizationDomainCreate) SetOrganizationID(id int) *OrganizationDomainCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *OrganizationDomainCreate) SetOrganization(v *Organization) *OrganizationDomainCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationDomainMutation object of the builder.
+func (_c *OrganizationDomainCreate) Mutation() *OrganizationDomainMutation {
+	return _c.mutation
+}
+
+// Save creates the OrganizationDomain in the database.
+func (_c *OrganizationDomainCreate) Save(ctx context.Context) (*OrganizationDomain, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrganizationDomainCreate) SaveX(ctx context.Context) *OrganizationDomain {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationDomainCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationDomainCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrganizationDomainCreate) defaults() {
+	if _, ok := _c.mutation.DefaultRole(); !ok {
+		v := organizationdomain.DefaultDefaultRole
+		_c.mutation.SetDefaultRole(v)
+	}
+	if _, ok := _c.mutation.AutoJoin(); !ok {
+		v := organizationdomain.DefaultAutoJoin
+		_c.mutation.SetAutoJoin(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := organizationdomain.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrganizationDomainCreate) check() error {
+	if _, ok := _c.mutation.Domain(); !ok {
+		return &ValidationError{Name: "domain", err: errors.New(`ent: missing required field "OrganizationDomain.domain"`)}
+	}
+	if v, ok := _c.mutation.Domain(); ok {
+		if err := organizationdomain.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.domain": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.VerificationToken(); !ok {
+		return &ValidationError{Name: "verification_token", err: errors.New(`ent: missing required field "OrganizationDomain.verification_token"`)}
+	}
+	if v, ok := _c.mutation.VerificationToken(); ok {
+		if err := organizationdomain.VerificationTokenValidator(v); err != nil {
+			return &ValidationError{Name: "verification_token", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.verification_token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DefaultRole(); !ok {
+		return &ValidationError{Name: "default_role", err: errors.New(`ent: missing required field "OrganizationDomain.default_role"`)}
+	}
+	if v, ok := _c.mutation.DefaultRole(); ok {
+		if err := organizationdomain.DefaultRoleValidator(v); err != nil {
+			return &ValidationError{Name: "default_role", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.default_role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AutoJoin(); !ok {
+		return &ValidationError{Name: "auto_join", err: errors.New(`ent: missing required field "OrganizationDomain.auto_join"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrganizationDomain.created_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "OrganizationDomain.organization"`)}
+	}
+	return nil
+}
+
+func (_c *OrganizationDomainCreate) sqlSave(ctx context.Context) (*OrganizationDomain, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrganizationDomainCreate) createSpec() (*OrganizationDomain, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrganizationDomain{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(organizationdomain.Table, sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Domain(); ok {
+		_spec.SetField(organizationdomain.FieldDomain, field.TypeString, value)
+		_node.Domain = value
+	}
+	if value, ok := _c.mutation.VerificationToken(); ok {
+		_spec.SetField(organizationdomain.FieldVerificationToken, field.TypeString, value)
+		_node.VerificationToken = value
+	}
+	if value, ok := _c.mutation.VerifiedAt(); ok {
+		_spec.SetField(organizationdomain.FieldVerifiedAt, field.TypeTime, value)
+		_node.VerifiedAt = &value
+	}
+	if value, ok := _c.mutation.DefaultRole(); ok {
+		_spec.SetField(organizationdomain.FieldDefaultRole, field.TypeEnum, value)
+		_node.DefaultRole = value
+	}
+	if value, ok := _c.mutation.AutoJoin(); ok {
+		_spec.SetField(organizationdomain.FieldAutoJoin, field.TypeBool, value)
+		_node.AutoJoin = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(organizationdomain.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationdomain.OrganizationTable,
+			Columns: []string{organizationdomain.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.organization_domains = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrganizationDomainCreateBulk is the builder for creating many OrganizationDomain entities in bulk.
+type OrganizationDomainCreateBulk struct {
+	config
+	err      error
+	builders []*OrganizationDomainCreate
+}
+
+// Save creates the OrganizationDomain entities in the database.
+func (_c *OrganizationDomainCreateBulk) Save(ctx context.Context) ([]*OrganizationDomain, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrganizationDomain, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrganizationDomainMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrganizationDomainCreateBulk) SaveX(ctx context.Context) []*OrganizationDomain {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationDomainCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationDomainCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
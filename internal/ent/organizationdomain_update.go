@@ -0,0 +1,509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// OrganizationDomainUpdate is the builder for updating OrganizationDomain entities.
+type OrganizationDomainUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationDomainMutation
+}
+
+// Where appends a list predicates to the OrganizationDomainUpdate builder.
+func (_u *OrganizationDomainUpdate) Where(ps ...predicate.OrganizationDomain) *OrganizationDomainUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetDomain sets the "domain" field.
+func (_u *OrganizationDomainUpdate) SetDomain(v string) *OrganizationDomainUpdate {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *OrganizationDomainUpdate) SetNillableDomain(v *string) *OrganizationDomainUpdate {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *OrganizationDomainUpdate) SetVerificationToken(v string) *OrganizationDomainUpdate {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_u *OrganizationDomainUpdate) SetNillableVerificationToken(v *string) *OrganizationDomainUpdate {
+	if v != nil {
+		_u.SetVerificationToken(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *OrganizationDomainUpdate) SetVerifiedAt(v time.Time) *OrganizationDomainUpdate {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *OrganizationDomainUpdate) SetNillableVerifiedAt(v *time.Time) *OrganizationDomainUpdate {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *OrganizationDomainUpdate) ClearVerifiedAt() *OrganizationDomainUpdate {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_u *OrganizationDomainUpdate) SetDefaultRole(v organizationdomain.DefaultRole) *OrganizationDomainUpdate {
+	_u.mutation.SetDefaultRole(v)
+	return _u
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_u *OrganizationDomainUpdate) SetNillableDefaultRole(v *organizationdomain.DefaultRole) *OrganizationDomainUpdate {
+	if v != nil {
+		_u.SetDefaultRole(*v)
+	}
+	return _u
+}
+
+// SetAutoJoin sets the "auto_join" field.
+func (_u *OrganizationDomainUpdate) SetAutoJoin(v bool) *OrganizationDomainUpdate {
+	_u.mutation.SetAutoJoin(v)
+	return _u
+}
+
+// SetNillableAutoJoin sets the "auto_join" field if the given value is not nil.
+func (_u *OrganizationDomainUpdate) SetNillableAutoJoin(v *bool) *OrganizationDomainUpdate {
+	if v != nil {
+		_u.SetAutoJoin(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *OrganizationDomainUpdate) SetOrganizationID(id int) *OrganizationDomainUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationDomainUpdate) SetOrganization(v *Organization) *OrganizationDomainUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationDomainMutation object of the builder.
+func (_u *OrganizationDomainUpdate) Mutation() *OrganizationDomainMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationDomainUpdate) ClearOrganization() *OrganizationDomainUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationDomainUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationDomainUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationDomainUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationDomainUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationDomainUpdate) check() error {
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := organizationdomain.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.domain": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationToken(); ok {
+		if err := organizationdomain.VerificationTokenValidator(v); err != nil {
+			return &ValidationError{Name: "verification_token", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.verification_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultRole(); ok {
+		if err := organizationdomain.DefaultRoleValidator(v); err != nil {
+			return &ValidationError{Name: "default_role", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.default_role": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationDomain.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationDomainUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationdomain.Table, organizationdomain.Columns, sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(organizationdomain.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(organizationdomain.FieldVerificationToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(organizationdomain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(organizationdomain.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DefaultRole(); ok {
+		_spec.SetField(organizationdomain.FieldDefaultRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AutoJoin(); ok {
+		_spec.SetField(organizationdomain.FieldAutoJoin, field.TypeBool, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationdomain.OrganizationTable,
+			Columns: []string{organizationdomain.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationdomain.OrganizationTable,
+			Columns: []string{organizationdomain.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationdomain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationDomainUpdateOne is the builder for updating a single OrganizationDomain entity.
+type OrganizationDomainUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationDomainMutation
+}
+
+// SetDomain sets the "domain" field.
+func (_u *OrganizationDomainUpdateOne) SetDomain(v string) *OrganizationDomainUpdateOne {
+	_u.mutation.SetDomain(v)
+	return _u
+}
+
+// SetNillableDomain sets the "domain" field if the given value is not nil.
+func (_u *OrganizationDomainUpdateOne) SetNillableDomain(v *string) *OrganizationDomainUpdateOne {
+	if v != nil {
+		_u.SetDomain(*v)
+	}
+	return _u
+}
+
+// SetVerificationToken sets the "verification_token" field.
+func (_u *OrganizationDomainUpdateOne) SetVerificationToken(v string) *OrganizationDomainUpdateOne {
+	_u.mutation.SetVerificationToken(v)
+	return _u
+}
+
+// SetNillableVerificationToken sets the "verification_token" field if the given value is not nil.
+func (_u *OrganizationDomainUpdateOne) SetNillableVerificationToken(v *string) *OrganizationDomainUpdateOne {
+	if v != nil {
+		_u.SetVerificationToken(*v)
+	}
+	return _u
+}
+
+// SetVerifiedAt sets the "verified_at" field.
+func (_u *OrganizationDomainUpdateOne) SetVerifiedAt(v time.Time) *OrganizationDomainUpdateOne {
+	_u.mutation.SetVerifiedAt(v)
+	return _u
+}
+
+// SetNillableVerifiedAt sets the "verified_at" field if the given value is not nil.
+func (_u *OrganizationDomainUpdateOne) SetNillableVerifiedAt(v *time.Time) *OrganizationDomainUpdateOne {
+	if v != nil {
+		_u.SetVerifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearVerifiedAt clears the value of the "verified_at" field.
+func (_u *OrganizationDomainUpdateOne) ClearVerifiedAt() *OrganizationDomainUpdateOne {
+	_u.mutation.ClearVerifiedAt()
+	return _u
+}
+
+// SetDefaultRole sets the "default_role" field.
+func (_u *OrganizationDomainUpdateOne) SetDefaultRole(v organizationdomain.DefaultRole) *OrganizationDomainUpdateOne {
+	_u.mutation.SetDefaultRole(v)
+	return _u
+}
+
+// SetNillableDefaultRole sets the "default_role" field if the given value is not nil.
+func (_u *OrganizationDomainUpdateOne) SetNillableDefaultRole(v *organizationdomain.DefaultRole) *OrganizationDomainUpdateOne {
+	if v != nil {
+		_u.SetDefaultRole(*v)
+	}
+	return _u
+}
+
+// SetAutoJoin sets the "auto_join" field.
+func (_u *OrganizationDomainUpdateOne) SetAutoJoin(v bool) *OrganizationDomainUpdateOne {
+	_u.mutation.SetAutoJoin(v)
+	return _u
+}
+
+// SetNillableAutoJoin sets the "auto_join" field if the given value is not nil.
+func (_u *OrganizationDomainUpdateOne) SetNillableAutoJoin(v *bool) *OrganizationDomainUpdateOne {
+	if v != nil {
+		_u.SetAutoJoin(*v)
+	}
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *OrganizationDomainUpdateOne) SetOrganizationID(id int) *OrganizationDomainUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationDomainUpdateOne) SetOrganization(v *Organization) *OrganizationDomainUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationDomainMutation object of the builder.
+func (_u *OrganizationDomainUpdateOne) Mutation() *OrganizationDomainMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationDomainUpdateOne) ClearOrganization() *OrganizationDomainUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the OrganizationDomainUpdate builder.
+func (_u *OrganizationDomainUpdateOne) Where(ps ...predicate.OrganizationDomain) *OrganizationDomainUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationDomainUpdateOne) Select(field string, fields ...string) *OrganizationDomainUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrganizationDomain entity.
+func (_u *OrganizationDomainUpdateOne) Save(ctx context.Context) (*OrganizationDomain, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationDomainUpdateOne) SaveX(ctx context.Context) *OrganizationDomain {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationDomainUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationDomainUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationDomainUpdateOne) check() error {
+	if v, ok := _u.mutation.Domain(); ok {
+		if err := organizationdomain.DomainValidator(v); err != nil {
+			return &ValidationError{Name: "domain", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.domain": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.VerificationToken(); ok {
+		if err := organizationdomain.VerificationTokenValidator(v); err != nil {
+			return &ValidationError{Name: "verification_token", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.verification_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.DefaultRole(); ok {
+		if err := organizationdomain.DefaultRoleValidator(v); err != nil {
+			return &ValidationError{Name: "default_role", err: fmt.Errorf(`ent: validator failed for field "OrganizationDomain.default_role": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationDomain.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationDomainUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationDomain, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationdomain.Table, organizationdomain.Columns, sqlgraph.NewFieldSpec(organizationdomain.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrganizationDomain.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizationdomain.FieldID)
+		for _, f := range fields {
+			if !organizationdomain.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizationdomain.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Domain(); ok {
+		_spec.SetField(organizationdomain.FieldDomain, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerificationToken(); ok {
+		_spec.SetField(organizationdomain.FieldVerificationToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VerifiedAt(); ok {
+		_spec.SetField(organizationdomain.FieldVerifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.VerifiedAtCleared() {
+		_spec.ClearField(organizationdomain.FieldVerifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DefaultRole(); ok {
+		_spec.SetField(organizationdomain.FieldDefaultRole, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AutoJoin(); ok {
+		_spec.SetField(organizationdomain.FieldAutoJoin, field.TypeBool, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationdomain.OrganizationTable,
+			Columns: []string{organizationdomain.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationdomain.OrganizationTable,
+			Columns: []string{organizationdomain.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrganizationDomain{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationdomain.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -21,6 +21,8 @@ type OrganizationMember struct {
 	ID int `json:"id,omitempty"`
 	// Role holds the value of the "role" field.
 	Role organizationmember.Role `json:"role,omitempty"`
+	// HasSeat holds the value of the "has_seat" field.
+	HasSeat bool `json:"has_seat,omitempty"`
 	// JoinedAt holds the value of the "joined_at" field.
 	JoinedAt time.Time `json:"joined_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -71,6 +73,8 @@ func (*OrganizationMember) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case organizationmember.FieldHasSeat:
+			values[i] = new(sql.NullBool)
 		case organizationmember.FieldID:
 			values[i] = new(sql.NullInt64)
 		case organizationmember.FieldRole:
@@ -108,6 +112,12 @@ func (_m *OrganizationMember) assignValues(columns []string, values []any) error
 			} else if value.Valid {
 				_m.Role = organizationmember.Role(value.String)
 			}
+		case organizationmember.FieldHasSeat:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field has_seat", values[i])
+			} else if value.Valid {
+				_m.HasSeat = value.Bool
+			}
 		case organizationmember.FieldJoinedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field joined_at", values[i])
@@ -183,6 +193,9 @@ func (_m *OrganizationMember) String() string {
 	builder.WriteString("role=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Role))
 	builder.WriteString(", ")
+	builder.WriteString("has_seat=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HasSeat))
+	builder.WriteString(", ")
 	builder.WriteString("joined_at=")
 	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -17,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldRole holds the string denoting the role field in the database.
 	FieldRole = "role"
+	// FieldHasSeat holds the string denoting the has_seat field in the database.
+	FieldHasSeat = "has_seat"
 	// FieldJoinedAt holds the string denoting the joined_at field in the database.
 	FieldJoinedAt = "joined_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -47,6 +49,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldRole,
+	FieldHasSeat,
 	FieldJoinedAt,
 	FieldUpdatedAt,
 }
@@ -74,6 +77,8 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultHasSeat holds the default value on creation for the "has_seat" field.
+	DefaultHasSeat bool
 	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
 	DefaultJoinedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -122,6 +127,11 @@ func ByRole(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRole, opts...).ToFunc()
 }
 
+// ByHasSeat orders the results by the has_seat field.
+func ByHasSeat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHasSeat, opts...).ToFunc()
+}
+
 // ByJoinedAt orders the results by the joined_at field.
 func ByJoinedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldJoinedAt, opts...).ToFunc()
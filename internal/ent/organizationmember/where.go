@@ -55,6 +55,11 @@ func IDLTE(id int) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldLTE(FieldID, id))
 }
 
+// HasSeat applies equality check predicate on the "has_seat" field. It's identical to HasSeatEQ.
+func HasSeat(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldHasSeat, v))
+}
+
 // JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
 func JoinedAt(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldJoinedAt, v))
@@ -85,6 +90,16 @@ func RoleNotIn(vs ...Role) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// HasSeatEQ applies the EQ predicate on the "has_seat" field.
+func HasSeatEQ(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldHasSeat, v))
+}
+
+// HasSeatNEQ applies the NEQ predicate on the "has_seat" field.
+func HasSeatNEQ(v bool) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldNEQ(FieldHasSeat, v))
+}
+
 // JoinedAtEQ applies the EQ predicate on the "joined_at" field.
 func JoinedAtEQ(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldJoinedAt, v))
@@ -36,6 +36,20 @@ func (_c *OrganizationMemberCreate) SetNillableRole(v *organizationmember.Role)
 	return _c
 }
 
+// SetHasSeat sets the "has_seat" field.
+func (_c *OrganizationMemberCreate) SetHasSeat(v bool) *OrganizationMemberCreate {
+	_c.mutation.SetHasSeat(v)
+	return _c
+}
+
+// SetNillableHasSeat sets the "has_seat" field if the given value is not nil.
+func (_c *OrganizationMemberCreate) SetNillableHasSeat(v *bool) *OrganizationMemberCreate {
+	if v != nil {
+		_c.SetHasSeat(*v)
+	}
+	return _c
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (_c *OrganizationMemberCreate) SetJoinedAt(v time.Time) *OrganizationMemberCreate {
 	_c.mutation.SetJoinedAt(v)
@@ -125,6 +139,10 @@ func (_c *OrganizationMemberCreate) defaults() {
 		v := organizationmember.DefaultRole
 		_c.mutation.SetRole(v)
 	}
+	if _, ok := _c.mutation.HasSeat(); !ok {
+		v := organizationmember.DefaultHasSeat
+		_c.mutation.SetHasSeat(v)
+	}
 	if _, ok := _c.mutation.JoinedAt(); !ok {
 		v := organizationmember.DefaultJoinedAt()
 		_c.mutation.SetJoinedAt(v)
@@ -145,6 +163,9 @@ func (_c *OrganizationMemberCreate) check() error {
 			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "OrganizationMember.role": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.HasSeat(); !ok {
+		return &ValidationError{Name: "has_seat", err: errors.New(`ent: missing required field "OrganizationMember.has_seat"`)}
+	}
 	if _, ok := _c.mutation.JoinedAt(); !ok {
 		return &ValidationError{Name: "joined_at", err: errors.New(`ent: missing required field "OrganizationMember.joined_at"`)}
 	}
@@ -187,6 +208,10 @@ func (_c *OrganizationMemberCreate) createSpec() (*OrganizationMember, *sqlgraph
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 		_node.Role = value
 	}
+	if value, ok := _c.mutation.HasSeat(); ok {
+		_spec.SetField(organizationmember.FieldHasSeat, field.TypeBool, value)
+		_node.HasSeat = value
+	}
 	if value, ok := _c.mutation.JoinedAt(); ok {
 		_spec.SetField(organizationmember.FieldJoinedAt, field.TypeTime, value)
 		_node.JoinedAt = value
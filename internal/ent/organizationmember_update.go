@@ -44,6 +44,20 @@ func (_u *OrganizationMemberUpdate) SetNillableRole(v *organizationmember.Role)
 	return _u
 }
 
+// SetHasSeat sets the "has_seat" field.
+func (_u *OrganizationMemberUpdate) SetHasSeat(v bool) *OrganizationMemberUpdate {
+	_u.mutation.SetHasSeat(v)
+	return _u
+}
+
+// SetNillableHasSeat sets the "has_seat" field if the given value is not nil.
+func (_u *OrganizationMemberUpdate) SetNillableHasSeat(v *bool) *OrganizationMemberUpdate {
+	if v != nil {
+		_u.SetHasSeat(*v)
+	}
+	return _u
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (_u *OrganizationMemberUpdate) SetJoinedAt(v time.Time) *OrganizationMemberUpdate {
 	_u.mutation.SetJoinedAt(v)
@@ -170,6 +184,9 @@ func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.HasSeat(); ok {
+		_spec.SetField(organizationmember.FieldHasSeat, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.JoinedAt(); ok {
 		_spec.SetField(organizationmember.FieldJoinedAt, field.TypeTime, value)
 	}
@@ -268,6 +285,20 @@ func (_u *OrganizationMemberUpdateOne) SetNillableRole(v *organizationmember.Rol
 	return _u
 }
 
+// SetHasSeat sets the "has_seat" field.
+func (_u *OrganizationMemberUpdateOne) SetHasSeat(v bool) *OrganizationMemberUpdateOne {
+	_u.mutation.SetHasSeat(v)
+	return _u
+}
+
+// SetNillableHasSeat sets the "has_seat" field if the given value is not nil.
+func (_u *OrganizationMemberUpdateOne) SetNillableHasSeat(v *bool) *OrganizationMemberUpdateOne {
+	if v != nil {
+		_u.SetHasSeat(*v)
+	}
+	return _u
+}
+
 // SetJoinedAt sets the "joined_at" field.
 func (_u *OrganizationMemberUpdateOne) SetJoinedAt(v time.Time) *OrganizationMemberUpdateOne {
 	_u.mutation.SetJoinedAt(v)
@@ -424,6 +455,9 @@ func (_u *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *Orga
 	if value, ok := _u.mutation.Role(); ok {
 		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.HasSeat(); ok {
+		_spec.SetField(organizationmember.FieldHasSeat, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.JoinedAt(); ok {
 		_spec.SetField(organizationmember.FieldJoinedAt, field.TypeTime, value)
 	}
@@ -9,6 +9,9 @@ import (
 // Achievement is the predicate function for achievement builders.
 type Achievement func(*sql.Selector)
 
+// AdminAccount is the predicate function for adminaccount builders.
+type AdminAccount func(*sql.Selector)
+
 // App is the predicate function for app builders.
 type App func(*sql.Selector)
 
@@ -18,6 +21,12 @@ type Assignment func(*sql.Selector)
 // AssignmentSubmission is the predicate function for assignmentsubmission builders.
 type AssignmentSubmission func(*sql.Selector)
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// AuthSession is the predicate function for authsession builders.
+type AuthSession func(*sql.Selector)
+
 // BattleRoom is the predicate function for battleroom builders.
 type BattleRoom func(*sql.Selector)
 
@@ -42,9 +51,18 @@ type LiveSession func(*sql.Selector)
 // LiveSessionStudent is the predicate function for livesessionstudent builders.
 type LiveSessionStudent func(*sql.Selector)
 
+// OAuthAuthorizationCode is the predicate function for oauthauthorizationcode builders.
+type OAuthAuthorizationCode func(*sql.Selector)
+
+// OAuthConsent is the predicate function for oauthconsent builders.
+type OAuthConsent func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
+// OrganizationDomain is the predicate function for organizationdomain builders.
+type OrganizationDomain func(*sql.Selector)
+
 // OrganizationInvitation is the predicate function for organizationinvitation builders.
 type OrganizationInvitation func(*sql.Selector)
 
@@ -54,6 +72,18 @@ type OrganizationMember func(*sql.Selector)
 // Plan is the predicate function for plan builders.
 type Plan func(*sql.Selector)
 
+// RateLimitBucket is the predicate function for ratelimitbucket builders.
+type RateLimitBucket func(*sql.Selector)
+
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
+// SSOConnection is the predicate function for ssoconnection builders.
+type SSOConnection func(*sql.Selector)
+
+// SSOLogin is the predicate function for ssologin builders.
+type SSOLogin func(*sql.Selector)
+
 // ShenbiProfile is the predicate function for shenbiprofile builders.
 type ShenbiProfile func(*sql.Selector)
 
@@ -71,3 +101,15 @@ type UserApp func(*sql.Selector)
 
 // UserProgress is the predicate function for userprogress builders.
 type UserProgress func(*sql.Selector)
+
+// VerificationToken is the predicate function for verificationtoken builders.
+type VerificationToken func(*sql.Selector)
+
+// WebhookAttempt is the predicate function for webhookattempt builders.
+type WebhookAttempt func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEvent is the predicate function for webhookevent builders.
+type WebhookEvent func(*sql.Selector)
@@ -6,9 +6,12 @@ import (
 	"time"
 
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/assignment"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -17,17 +20,28 @@ import (
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/ratelimitbucket"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
 	"gigaboo.io/lem/internal/ent/schema"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/ent/webhookevent"
 )
 
 // The init function reads all schema descriptors with runtime code
@@ -44,6 +58,26 @@ func init() {
 	achievementDescEarnedAt := achievementFields[1].Descriptor()
 	// achievement.DefaultEarnedAt holds the default value on creation for the earned_at field.
 	achievement.DefaultEarnedAt = achievementDescEarnedAt.Default.(func() time.Time)
+	adminaccountFields := schema.AdminAccount{}.Fields()
+	_ = adminaccountFields
+	// adminaccountDescEmail is the schema descriptor for email field.
+	adminaccountDescEmail := adminaccountFields[0].Descriptor()
+	// adminaccount.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	adminaccount.EmailValidator = adminaccountDescEmail.Validators[0].(func(string) error)
+	// adminaccountDescIsActive is the schema descriptor for is_active field.
+	adminaccountDescIsActive := adminaccountFields[3].Descriptor()
+	// adminaccount.DefaultIsActive holds the default value on creation for the is_active field.
+	adminaccount.DefaultIsActive = adminaccountDescIsActive.Default.(bool)
+	// adminaccountDescCreatedAt is the schema descriptor for created_at field.
+	adminaccountDescCreatedAt := adminaccountFields[5].Descriptor()
+	// adminaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	adminaccount.DefaultCreatedAt = adminaccountDescCreatedAt.Default.(func() time.Time)
+	// adminaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	adminaccountDescUpdatedAt := adminaccountFields[6].Descriptor()
+	// adminaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	adminaccount.DefaultUpdatedAt = adminaccountDescUpdatedAt.Default.(func() time.Time)
+	// adminaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	adminaccount.UpdateDefaultUpdatedAt = adminaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
 	appFields := schema.App{}.Fields()
 	_ = appFields
 	// appDescName is the schema descriptor for name field.
@@ -54,20 +88,20 @@ func init() {
 	appDescSlug := appFields[1].Descriptor()
 	// app.SlugValidator is a validator for the "slug" field. It is called by the builders before save.
 	app.SlugValidator = appDescSlug.Validators[0].(func(string) error)
-	// appDescAPIKey is the schema descriptor for api_key field.
-	appDescAPIKey := appFields[2].Descriptor()
-	// app.APIKeyValidator is a validator for the "api_key" field. It is called by the builders before save.
-	app.APIKeyValidator = appDescAPIKey.Validators[0].(func(string) error)
+	// appDescMagicLinkSignup is the schema descriptor for magic_link_signup field.
+	appDescMagicLinkSignup := appFields[19].Descriptor()
+	// app.DefaultMagicLinkSignup holds the default value on creation for the magic_link_signup field.
+	app.DefaultMagicLinkSignup = appDescMagicLinkSignup.Default.(bool)
 	// appDescIsActive is the schema descriptor for is_active field.
-	appDescIsActive := appFields[7].Descriptor()
+	appDescIsActive := appFields[20].Descriptor()
 	// app.DefaultIsActive holds the default value on creation for the is_active field.
 	app.DefaultIsActive = appDescIsActive.Default.(bool)
 	// appDescCreatedAt is the schema descriptor for created_at field.
-	appDescCreatedAt := appFields[8].Descriptor()
+	appDescCreatedAt := appFields[21].Descriptor()
 	// app.DefaultCreatedAt holds the default value on creation for the created_at field.
 	app.DefaultCreatedAt = appDescCreatedAt.Default.(func() time.Time)
 	// appDescUpdatedAt is the schema descriptor for updated_at field.
-	appDescUpdatedAt := appFields[9].Descriptor()
+	appDescUpdatedAt := appFields[22].Descriptor()
 	// app.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	app.DefaultUpdatedAt = appDescUpdatedAt.Default.(func() time.Time)
 	// app.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -116,6 +150,30 @@ func init() {
 	assignmentsubmission.DefaultUpdatedAt = assignmentsubmissionDescUpdatedAt.Default.(func() time.Time)
 	// assignmentsubmission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	assignmentsubmission.UpdateDefaultUpdatedAt = assignmentsubmissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescActor is the schema descriptor for actor field.
+	auditeventDescActor := auditeventFields[0].Descriptor()
+	// auditevent.ActorValidator is a validator for the "actor" field. It is called by the builders before save.
+	auditevent.ActorValidator = auditeventDescActor.Validators[0].(func(string) error)
+	// auditeventDescAction is the schema descriptor for action field.
+	auditeventDescAction := auditeventFields[2].Descriptor()
+	// auditevent.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditevent.ActionValidator = auditeventDescAction.Validators[0].(func(string) error)
+	// auditeventDescCreatedAt is the schema descriptor for created_at field.
+	auditeventDescCreatedAt := auditeventFields[10].Descriptor()
+	// auditevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditevent.DefaultCreatedAt = auditeventDescCreatedAt.Default.(func() time.Time)
+	authsessionFields := schema.AuthSession{}.Fields()
+	_ = authsessionFields
+	// authsessionDescMfaVerified is the schema descriptor for mfa_verified field.
+	authsessionDescMfaVerified := authsessionFields[2].Descriptor()
+	// authsession.DefaultMfaVerified holds the default value on creation for the mfa_verified field.
+	authsession.DefaultMfaVerified = authsessionDescMfaVerified.Default.(bool)
+	// authsessionDescCreatedAt is the schema descriptor for created_at field.
+	authsessionDescCreatedAt := authsessionFields[8].Descriptor()
+	// authsession.DefaultCreatedAt holds the default value on creation for the created_at field.
+	authsession.DefaultCreatedAt = authsessionDescCreatedAt.Default.(func() time.Time)
 	battleroomFields := schema.BattleRoom{}.Fields()
 	_ = battleroomFields
 	// battleroomDescRoomCode is the schema descriptor for room_code field.
@@ -282,6 +340,44 @@ func init() {
 	livesessionstudent.DefaultLastUpdatedAt = livesessionstudentDescLastUpdatedAt.Default.(func() time.Time)
 	// livesessionstudent.UpdateDefaultLastUpdatedAt holds the default value on update for the last_updated_at field.
 	livesessionstudent.UpdateDefaultLastUpdatedAt = livesessionstudentDescLastUpdatedAt.UpdateDefault.(func() time.Time)
+	oauthauthorizationcodeFields := schema.OAuthAuthorizationCode{}.Fields()
+	_ = oauthauthorizationcodeFields
+	// oauthauthorizationcodeDescCodeHash is the schema descriptor for code_hash field.
+	oauthauthorizationcodeDescCodeHash := oauthauthorizationcodeFields[0].Descriptor()
+	// oauthauthorizationcode.CodeHashValidator is a validator for the "code_hash" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeHashValidator = oauthauthorizationcodeDescCodeHash.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescRedirectURI is the schema descriptor for redirect_uri field.
+	oauthauthorizationcodeDescRedirectURI := oauthauthorizationcodeFields[1].Descriptor()
+	// oauthauthorizationcode.RedirectURIValidator is a validator for the "redirect_uri" field. It is called by the builders before save.
+	oauthauthorizationcode.RedirectURIValidator = oauthauthorizationcodeDescRedirectURI.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescCodeChallenge is the schema descriptor for code_challenge field.
+	oauthauthorizationcodeDescCodeChallenge := oauthauthorizationcodeFields[4].Descriptor()
+	// oauthauthorizationcode.CodeChallengeValidator is a validator for the "code_challenge" field. It is called by the builders before save.
+	oauthauthorizationcode.CodeChallengeValidator = oauthauthorizationcodeDescCodeChallenge.Validators[0].(func(string) error)
+	// oauthauthorizationcodeDescCodeChallengeMethod is the schema descriptor for code_challenge_method field.
+	oauthauthorizationcodeDescCodeChallengeMethod := oauthauthorizationcodeFields[5].Descriptor()
+	// oauthauthorizationcode.DefaultCodeChallengeMethod holds the default value on creation for the code_challenge_method field.
+	oauthauthorizationcode.DefaultCodeChallengeMethod = oauthauthorizationcodeDescCodeChallengeMethod.Default.(string)
+	// oauthauthorizationcodeDescMfaVerified is the schema descriptor for mfa_verified field.
+	oauthauthorizationcodeDescMfaVerified := oauthauthorizationcodeFields[7].Descriptor()
+	// oauthauthorizationcode.DefaultMfaVerified holds the default value on creation for the mfa_verified field.
+	oauthauthorizationcode.DefaultMfaVerified = oauthauthorizationcodeDescMfaVerified.Default.(bool)
+	// oauthauthorizationcodeDescCreatedAt is the schema descriptor for created_at field.
+	oauthauthorizationcodeDescCreatedAt := oauthauthorizationcodeFields[10].Descriptor()
+	// oauthauthorizationcode.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthauthorizationcode.DefaultCreatedAt = oauthauthorizationcodeDescCreatedAt.Default.(func() time.Time)
+	oauthconsentFields := schema.OAuthConsent{}.Fields()
+	_ = oauthconsentFields
+	// oauthconsentDescCreatedAt is the schema descriptor for created_at field.
+	oauthconsentDescCreatedAt := oauthconsentFields[1].Descriptor()
+	// oauthconsent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	oauthconsent.DefaultCreatedAt = oauthconsentDescCreatedAt.Default.(func() time.Time)
+	// oauthconsentDescUpdatedAt is the schema descriptor for updated_at field.
+	oauthconsentDescUpdatedAt := oauthconsentFields[2].Descriptor()
+	// oauthconsent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	oauthconsent.DefaultUpdatedAt = oauthconsentDescUpdatedAt.Default.(func() time.Time)
+	// oauthconsent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	oauthconsent.UpdateDefaultUpdatedAt = oauthconsentDescUpdatedAt.UpdateDefault.(func() time.Time)
 	organizationFields := schema.Organization{}.Fields()
 	_ = organizationFields
 	// organizationDescName is the schema descriptor for name field.
@@ -306,6 +402,24 @@ func init() {
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	organization.UpdateDefaultUpdatedAt = organizationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	organizationdomainFields := schema.OrganizationDomain{}.Fields()
+	_ = organizationdomainFields
+	// organizationdomainDescDomain is the schema descriptor for domain field.
+	organizationdomainDescDomain := organizationdomainFields[0].Descriptor()
+	// organizationdomain.DomainValidator is a validator for the "domain" field. It is called by the builders before save.
+	organizationdomain.DomainValidator = organizationdomainDescDomain.Validators[0].(func(string) error)
+	// organizationdomainDescVerificationToken is the schema descriptor for verification_token field.
+	organizationdomainDescVerificationToken := organizationdomainFields[1].Descriptor()
+	// organizationdomain.VerificationTokenValidator is a validator for the "verification_token" field. It is called by the builders before save.
+	organizationdomain.VerificationTokenValidator = organizationdomainDescVerificationToken.Validators[0].(func(string) error)
+	// organizationdomainDescAutoJoin is the schema descriptor for auto_join field.
+	organizationdomainDescAutoJoin := organizationdomainFields[4].Descriptor()
+	// organizationdomain.DefaultAutoJoin holds the default value on creation for the auto_join field.
+	organizationdomain.DefaultAutoJoin = organizationdomainDescAutoJoin.Default.(bool)
+	// organizationdomainDescCreatedAt is the schema descriptor for created_at field.
+	organizationdomainDescCreatedAt := organizationdomainFields[5].Descriptor()
+	// organizationdomain.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationdomain.DefaultCreatedAt = organizationdomainDescCreatedAt.Default.(func() time.Time)
 	organizationinvitationFields := schema.OrganizationInvitation{}.Fields()
 	_ = organizationinvitationFields
 	// organizationinvitationDescEmail is the schema descriptor for email field.
@@ -322,12 +436,16 @@ func init() {
 	organizationinvitation.DefaultCreatedAt = organizationinvitationDescCreatedAt.Default.(func() time.Time)
 	organizationmemberFields := schema.OrganizationMember{}.Fields()
 	_ = organizationmemberFields
+	// organizationmemberDescHasSeat is the schema descriptor for has_seat field.
+	organizationmemberDescHasSeat := organizationmemberFields[1].Descriptor()
+	// organizationmember.DefaultHasSeat holds the default value on creation for the has_seat field.
+	organizationmember.DefaultHasSeat = organizationmemberDescHasSeat.Default.(bool)
 	// organizationmemberDescJoinedAt is the schema descriptor for joined_at field.
-	organizationmemberDescJoinedAt := organizationmemberFields[1].Descriptor()
+	organizationmemberDescJoinedAt := organizationmemberFields[2].Descriptor()
 	// organizationmember.DefaultJoinedAt holds the default value on creation for the joined_at field.
 	organizationmember.DefaultJoinedAt = organizationmemberDescJoinedAt.Default.(func() time.Time)
 	// organizationmemberDescUpdatedAt is the schema descriptor for updated_at field.
-	organizationmemberDescUpdatedAt := organizationmemberFields[2].Descriptor()
+	organizationmemberDescUpdatedAt := organizationmemberFields[3].Descriptor()
 	// organizationmember.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	organizationmember.DefaultUpdatedAt = organizationmemberDescUpdatedAt.Default.(func() time.Time)
 	// organizationmember.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -368,6 +486,68 @@ func init() {
 	plan.DefaultUpdatedAt = planDescUpdatedAt.Default.(func() time.Time)
 	// plan.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	plan.UpdateDefaultUpdatedAt = planDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ratelimitbucketFields := schema.RateLimitBucket{}.Fields()
+	_ = ratelimitbucketFields
+	// ratelimitbucketDescKey is the schema descriptor for key field.
+	ratelimitbucketDescKey := ratelimitbucketFields[0].Descriptor()
+	// ratelimitbucket.KeyValidator is a validator for the "key" field. It is called by the builders before save.
+	ratelimitbucket.KeyValidator = ratelimitbucketDescKey.Validators[0].(func(string) error)
+	// ratelimitbucketDescTokens is the schema descriptor for tokens field.
+	ratelimitbucketDescTokens := ratelimitbucketFields[1].Descriptor()
+	// ratelimitbucket.DefaultTokens holds the default value on creation for the tokens field.
+	ratelimitbucket.DefaultTokens = ratelimitbucketDescTokens.Default.(float64)
+	// ratelimitbucketDescFailures is the schema descriptor for failures field.
+	ratelimitbucketDescFailures := ratelimitbucketFields[2].Descriptor()
+	// ratelimitbucket.DefaultFailures holds the default value on creation for the failures field.
+	ratelimitbucket.DefaultFailures = ratelimitbucketDescFailures.Default.(int)
+	// ratelimitbucketDescUpdatedAt is the schema descriptor for updated_at field.
+	ratelimitbucketDescUpdatedAt := ratelimitbucketFields[4].Descriptor()
+	// ratelimitbucket.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ratelimitbucket.DefaultUpdatedAt = ratelimitbucketDescUpdatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[0].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
+	ssoconnectionFields := schema.SSOConnection{}.Fields()
+	_ = ssoconnectionFields
+	// ssoconnectionDescEnabled is the schema descriptor for enabled field.
+	ssoconnectionDescEnabled := ssoconnectionFields[1].Descriptor()
+	// ssoconnection.DefaultEnabled holds the default value on creation for the enabled field.
+	ssoconnection.DefaultEnabled = ssoconnectionDescEnabled.Default.(bool)
+	// ssoconnectionDescCreatedAt is the schema descriptor for created_at field.
+	ssoconnectionDescCreatedAt := ssoconnectionFields[7].Descriptor()
+	// ssoconnection.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ssoconnection.DefaultCreatedAt = ssoconnectionDescCreatedAt.Default.(func() time.Time)
+	// ssoconnectionDescUpdatedAt is the schema descriptor for updated_at field.
+	ssoconnectionDescUpdatedAt := ssoconnectionFields[8].Descriptor()
+	// ssoconnection.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ssoconnection.DefaultUpdatedAt = ssoconnectionDescUpdatedAt.Default.(func() time.Time)
+	// ssoconnection.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ssoconnection.UpdateDefaultUpdatedAt = ssoconnectionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	ssologinFields := schema.SSOLogin{}.Fields()
+	_ = ssologinFields
+	// ssologinDescStateHash is the schema descriptor for state_hash field.
+	ssologinDescStateHash := ssologinFields[0].Descriptor()
+	// ssologin.StateHashValidator is a validator for the "state_hash" field. It is called by the builders before save.
+	ssologin.StateHashValidator = ssologinDescStateHash.Validators[0].(func(string) error)
+	// ssologinDescRequestID is the schema descriptor for request_id field.
+	ssologinDescRequestID := ssologinFields[1].Descriptor()
+	// ssologin.RequestIDValidator is a validator for the "request_id" field. It is called by the builders before save.
+	ssologin.RequestIDValidator = ssologinDescRequestID.Validators[0].(func(string) error)
+	// ssologinDescRedirectURL is the schema descriptor for redirect_url field.
+	ssologinDescRedirectURL := ssologinFields[3].Descriptor()
+	// ssologin.RedirectURLValidator is a validator for the "redirect_url" field. It is called by the builders before save.
+	ssologin.RedirectURLValidator = ssologinDescRedirectURL.Validators[0].(func(string) error)
+	// ssologinDescCreatedAt is the schema descriptor for created_at field.
+	ssologinDescCreatedAt := ssologinFields[8].Descriptor()
+	// ssologin.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ssologin.DefaultCreatedAt = ssologinDescCreatedAt.Default.(func() time.Time)
 	shenbiprofileFields := schema.ShenbiProfile{}.Fields()
 	_ = shenbiprofileFields
 	// shenbiprofileDescCreatedAt is the schema descriptor for created_at field.
@@ -402,32 +582,48 @@ func init() {
 	shenbisettings.UpdateDefaultUpdatedAt = shenbisettingsDescUpdatedAt.UpdateDefault.(func() time.Time)
 	subscriptionFields := schema.Subscription{}.Fields()
 	_ = subscriptionFields
+	// subscriptionDescCancelAtPeriodEnd is the schema descriptor for cancel_at_period_end field.
+	subscriptionDescCancelAtPeriodEnd := subscriptionFields[5].Descriptor()
+	// subscription.DefaultCancelAtPeriodEnd holds the default value on creation for the cancel_at_period_end field.
+	subscription.DefaultCancelAtPeriodEnd = subscriptionDescCancelAtPeriodEnd.Default.(bool)
+	// subscriptionDescQuantity is the schema descriptor for quantity field.
+	subscriptionDescQuantity := subscriptionFields[7].Descriptor()
+	// subscription.DefaultQuantity holds the default value on creation for the quantity field.
+	subscription.DefaultQuantity = subscriptionDescQuantity.Default.(int)
 	// subscriptionDescCreatedAt is the schema descriptor for created_at field.
-	subscriptionDescCreatedAt := subscriptionFields[6].Descriptor()
+	subscriptionDescCreatedAt := subscriptionFields[8].Descriptor()
 	// subscription.DefaultCreatedAt holds the default value on creation for the created_at field.
 	subscription.DefaultCreatedAt = subscriptionDescCreatedAt.Default.(func() time.Time)
 	// subscriptionDescUpdatedAt is the schema descriptor for updated_at field.
-	subscriptionDescUpdatedAt := subscriptionFields[7].Descriptor()
+	subscriptionDescUpdatedAt := subscriptionFields[9].Descriptor()
 	// subscription.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	subscription.DefaultUpdatedAt = subscriptionDescUpdatedAt.Default.(func() time.Time)
 	// subscription.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	subscription.UpdateDefaultUpdatedAt = subscriptionDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userFields := schema.User{}.Fields()
 	_ = userFields
+	// userDescTotpLastCounter is the schema descriptor for totp_last_counter field.
+	userDescTotpLastCounter := userFields[11].Descriptor()
+	// user.DefaultTotpLastCounter holds the default value on creation for the totp_last_counter field.
+	user.DefaultTotpLastCounter = userDescTotpLastCounter.Default.(int64)
+	// userDescMfaEnabled is the schema descriptor for mfa_enabled field.
+	userDescMfaEnabled := userFields[12].Descriptor()
+	// user.DefaultMfaEnabled holds the default value on creation for the mfa_enabled field.
+	user.DefaultMfaEnabled = userDescMfaEnabled.Default.(bool)
 	// userDescIsActive is the schema descriptor for is_active field.
-	userDescIsActive := userFields[10].Descriptor()
+	userDescIsActive := userFields[14].Descriptor()
 	// user.DefaultIsActive holds the default value on creation for the is_active field.
 	user.DefaultIsActive = userDescIsActive.Default.(bool)
 	// userDescIsVerified is the schema descriptor for is_verified field.
-	userDescIsVerified := userFields[11].Descriptor()
+	userDescIsVerified := userFields[15].Descriptor()
 	// user.DefaultIsVerified holds the default value on creation for the is_verified field.
 	user.DefaultIsVerified = userDescIsVerified.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[13].Descriptor()
+	userDescCreatedAt := userFields[17].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[14].Descriptor()
+	userDescUpdatedAt := userFields[18].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -486,4 +682,72 @@ func init() {
 	userprogress.DefaultUpdatedAt = userprogressDescUpdatedAt.Default.(func() time.Time)
 	// userprogress.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	userprogress.UpdateDefaultUpdatedAt = userprogressDescUpdatedAt.UpdateDefault.(func() time.Time)
+	verificationtokenFields := schema.VerificationToken{}.Fields()
+	_ = verificationtokenFields
+	// verificationtokenDescTokenHash is the schema descriptor for token_hash field.
+	verificationtokenDescTokenHash := verificationtokenFields[0].Descriptor()
+	// verificationtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	verificationtoken.TokenHashValidator = verificationtokenDescTokenHash.Validators[0].(func(string) error)
+	// verificationtokenDescEmail is the schema descriptor for email field.
+	verificationtokenDescEmail := verificationtokenFields[2].Descriptor()
+	// verificationtoken.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	verificationtoken.EmailValidator = verificationtokenDescEmail.Validators[0].(func(string) error)
+	// verificationtokenDescCreatedAt is the schema descriptor for created_at field.
+	verificationtokenDescCreatedAt := verificationtokenFields[5].Descriptor()
+	// verificationtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	verificationtoken.DefaultCreatedAt = verificationtokenDescCreatedAt.Default.(func() time.Time)
+	webhookattemptFields := schema.WebhookAttempt{}.Fields()
+	_ = webhookattemptFields
+	// webhookattemptDescDurationMs is the schema descriptor for duration_ms field.
+	webhookattemptDescDurationMs := webhookattemptFields[3].Descriptor()
+	// webhookattempt.DefaultDurationMs holds the default value on creation for the duration_ms field.
+	webhookattempt.DefaultDurationMs = webhookattemptDescDurationMs.Default.(int)
+	// webhookattemptDescCreatedAt is the schema descriptor for created_at field.
+	webhookattemptDescCreatedAt := webhookattemptFields[4].Descriptor()
+	// webhookattempt.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookattempt.DefaultCreatedAt = webhookattemptDescCreatedAt.Default.(func() time.Time)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEventID is the schema descriptor for event_id field.
+	webhookdeliveryDescEventID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookdelivery.EventIDValidator = webhookdeliveryDescEventID.Validators[0].(func(string) error)
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[1].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdeliveryDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookdeliveryDescNextAttemptAt := webhookdeliveryFields[5].Descriptor()
+	// webhookdelivery.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookdelivery.DefaultNextAttemptAt = webhookdeliveryDescNextAttemptAt.Default.(func() time.Time)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[10].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	webhookeventFields := schema.WebhookEvent{}.Fields()
+	_ = webhookeventFields
+	// webhookeventDescEventID is the schema descriptor for event_id field.
+	webhookeventDescEventID := webhookeventFields[0].Descriptor()
+	// webhookevent.EventIDValidator is a validator for the "event_id" field. It is called by the builders before save.
+	webhookevent.EventIDValidator = webhookeventDescEventID.Validators[0].(func(string) error)
+	// webhookeventDescType is the schema descriptor for type field.
+	webhookeventDescType := webhookeventFields[1].Descriptor()
+	// webhookevent.TypeValidator is a validator for the "type" field. It is called by the builders before save.
+	webhookevent.TypeValidator = webhookeventDescType.Validators[0].(func(string) error)
+	// webhookeventDescAttempts is the schema descriptor for attempts field.
+	webhookeventDescAttempts := webhookeventFields[5].Descriptor()
+	// webhookevent.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookevent.DefaultAttempts = webhookeventDescAttempts.Default.(int)
+	// webhookeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	webhookeventDescNextAttemptAt := webhookeventFields[6].Descriptor()
+	// webhookevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	webhookevent.DefaultNextAttemptAt = webhookeventDescNextAttemptAt.Default.(func() time.Time)
+	// webhookeventDescCreatedAt is the schema descriptor for created_at field.
+	webhookeventDescCreatedAt := webhookeventFields[9].Descriptor()
+	// webhookevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookevent.DefaultCreatedAt = webhookeventDescCreatedAt.Default.(func() time.Time)
 }
@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// AdminAccount holds the schema definition for the AdminAccount entity. It
+// grants an admin console role to a Google account.
+type AdminAccount struct {
+	ent.Schema
+}
+
+// Fields of the AdminAccount.
+func (AdminAccount) Fields() []ent.Field {
+	return []ent.Field{
+		// Stored lowercased
+		field.String("email").
+			Unique().
+			NotEmpty(),
+		field.String("name").
+			Optional(),
+		// SUPER_ADMIN can do anything, including managing admins. APP_ADMIN
+		// manages only the apps it is linked to. SUPPORT can only read, and
+		// BILLING can read and manage plans.
+		field.Enum("role").
+			Values("SUPER_ADMIN", "APP_ADMIN", "SUPPORT", "BILLING").
+			Default("SUPPORT"),
+		field.Bool("is_active").
+			Default(true),
+		field.Time("last_login_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the AdminAccount.
+func (AdminAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Apps an APP_ADMIN manages
+		edge.To("apps", App.Type),
+	}
+}
@@ -22,19 +22,73 @@ func (App) Fields() []ent.Field {
 		field.String("slug").
 			Unique().
 			NotEmpty(),
+		// Plaintext API key and secret key of apps created before keys were
+		// hashed. database.Migrate hashes and clears them.
 		field.String("api_key").
+			Optional().
+			Nillable().
 			Unique().
-			NotEmpty().
 			Sensitive(),
 		field.String("api_secret").
 			Optional().
 			Sensitive(),
+		// SHA-256 hash of the API key; the key itself is only shown when created.
+		field.String("api_key_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive(),
+		// First characters of the API key, to tell keys apart.
+		field.String("api_key_prefix").
+			Optional(),
+		// The key replaced by the last rotation, accepted until it expires.
+		field.String("previous_api_key_hash").
+			Optional().
+			Nillable().
+			Sensitive(),
+		field.Time("previous_api_key_expires_at").
+			Optional().
+			Nillable(),
+		// SHA-256 hash of the secret key, if the app has one. It signs
+		// server-to-server requests and is the OpenID Connect client secret.
+		field.String("api_secret_hash").
+			Optional().
+			Sensitive(),
+		field.String("previous_api_secret_hash").
+			Optional().
+			Sensitive(),
+		// The secret key encrypted with the server's key (see apikey.Sealer),
+		// to check and make signatures with. Secret keys created before it
+		// was stored have to be rotated to sign.
+		field.String("api_secret_encrypted").
+			Optional().
+			Sensitive(),
+		field.String("previous_api_secret_encrypted").
+			Optional().
+			Sensitive(),
+		field.Time("previous_api_secret_expires_at").
+			Optional().
+			Nillable(),
 		field.JSON("allowed_origins", []string{}).
 			Optional(),
+		// Redirect URIs registered for logging in with lem over OpenID Connect;
+		// the app's slug is its client ID and api_secret_hash hashes its secret key.
+		field.JSON("oauth_redirect_uris", []string{}).
+			Optional(),
 		field.String("webhook_url").
 			Optional(),
 		field.String("stripe_product_id").
 			Optional(),
+		// Requests per minute allowed for the app's API key; nil uses the server default.
+		field.Int("rate_limit_per_minute").
+			Optional().
+			Nillable(),
+		field.Int("rate_limit_burst").
+			Optional().
+			Nillable(),
+		// Whether a magic link sent to an unknown email creates an account.
+		field.Bool("magic_link_signup").
+			Default(false),
 		field.Bool("is_active").
 			Default(true),
 		field.Time("created_at").
@@ -63,6 +117,14 @@ func (App) Edges() []ent.Edge {
 		edge.To("live_sessions", LiveSession.Type),
 		edge.To("classroom_sessions", ClassroomSession.Type),
 		edge.To("shenbi_settings", ShenbiSettings.Type),
+		edge.To("auth_sessions", AuthSession.Type),
+		edge.To("verification_tokens", VerificationToken.Type),
+		edge.To("oauth_consents", OAuthConsent.Type),
+		edge.To("oauth_authorization_codes", OAuthAuthorizationCode.Type),
+		edge.To("audit_events", AuditEvent.Type),
+		edge.To("webhook_deliveries", WebhookDelivery.Type),
+		edge.From("admins", AdminAccount.Type).
+			Ref("apps"),
 	}
 }
 
@@ -70,6 +132,6 @@ func (App) Edges() []ent.Edge {
 func (App) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("slug"),
-		index.Fields("api_key"),
+		index.Fields("previous_api_key_hash"),
 	}
 }
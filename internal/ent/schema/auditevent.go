@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuditEvent holds the schema definition for the AuditEvent entity. Each
+// event records who did what to which object, e.g. an admin changing a plan
+// or every request an admin makes while impersonating a user. Events are
+// append-only.
+type AuditEvent struct {
+	ent.Schema
+}
+
+// Fields of the AuditEvent.
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Who acted, e.g. an admin email
+		field.String("actor").
+			NotEmpty().
+			Immutable(),
+		field.Enum("actor_type").
+			Values("ADMIN", "USER").
+			Default("ADMIN").
+			Immutable(),
+		field.String("action").
+			NotEmpty().
+			Immutable(),
+		field.String("target_type").
+			Optional().
+			Immutable(),
+		field.String("target_id").
+			Optional().
+			Immutable(),
+		field.String("ip_address").
+			Optional().
+			Immutable(),
+		field.String("user_agent").
+			Optional().
+			Immutable(),
+		// Values of the changed fields before and after a change
+		field.JSON("before", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.JSON("after", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AuditEvent.
+func (AuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("audit_events").
+			Unique(),
+	}
+}
+
+// Indexes of the AuditEvent.
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at"),
+		index.Fields("actor"),
+		index.Fields("action"),
+		index.Fields("target_type", "target_id"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// AuthSession holds the schema definition for the AuthSession entity.
+// A session is created on every login and owns one family of refresh tokens.
+type AuthSession struct {
+	ent.Schema
+}
+
+// Fields of the AuthSession.
+func (AuthSession) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("user_agent").
+			Optional(),
+		field.String("ip_address").
+			Optional(),
+		field.Bool("mfa_verified").
+			Default(false),
+		// Email of the admin acting as the user in an impersonation session
+		field.String("impersonator").
+			Optional(),
+		field.Time("last_used_at").
+			Optional().
+			Nillable(),
+		field.Time("expires_at"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.String("revoked_reason").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the AuthSession.
+func (AuthSession) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("auth_sessions").
+			Unique().
+			Required(),
+		edge.From("app", App.Type).
+			Ref("auth_sessions").
+			Unique().
+			Required(),
+		edge.To("refresh_tokens", RefreshToken.Type),
+	}
+}
+
+// Indexes of the AuthSession.
+func (AuthSession) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "app"),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// OAuthAuthorizationCode holds the schema definition for the
+// OAuthAuthorizationCode entity. These are the single-use codes of the
+// OpenID Connect authorization code flow. Only a SHA-256 hash of the code
+// is stored.
+type OAuthAuthorizationCode struct {
+	ent.Schema
+}
+
+// Fields of the OAuthAuthorizationCode.
+func (OAuthAuthorizationCode) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("code_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		field.String("redirect_uri").
+			NotEmpty(),
+		field.JSON("scopes", []string{}),
+		field.String("nonce").
+			Optional(),
+		field.String("code_challenge").
+			NotEmpty(),
+		field.String("code_challenge_method").
+			Default("S256"),
+		// When the user authenticated, and whether with two factors
+		field.Time("auth_time"),
+		field.Bool("mfa_verified").
+			Default(false),
+		field.Time("expires_at"),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OAuthAuthorizationCode.
+func (OAuthAuthorizationCode) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_authorization_codes").
+			Unique().
+			Required(),
+		edge.From("app", App.Type).
+			Ref("oauth_authorization_codes").
+			Unique().
+			Required(),
+	}
+}
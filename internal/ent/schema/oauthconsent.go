@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OAuthConsent holds the schema definition for the OAuthConsent entity.
+// It records the scopes a user has allowed an app to access when logging in
+// to it through lem's OpenID Connect provider.
+type OAuthConsent struct {
+	ent.Schema
+}
+
+// Fields of the OAuthConsent.
+func (OAuthConsent) Fields() []ent.Field {
+	return []ent.Field{
+		field.JSON("scopes", []string{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the OAuthConsent.
+func (OAuthConsent) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("oauth_consents").
+			Unique().
+			Required(),
+		edge.From("app", App.Type).
+			Ref("oauth_consents").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OAuthConsent.
+func (OAuthConsent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("user", "app").
+			Unique(),
+	}
+}
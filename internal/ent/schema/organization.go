@@ -50,6 +50,10 @@ func (Organization) Edges() []ent.Edge {
 		edge.To("members", OrganizationMember.Type),
 		edge.To("invitations", OrganizationInvitation.Type),
 		edge.To("subscriptions", Subscription.Type),
+		edge.To("sso_connection", SSOConnection.Type).
+			Unique(),
+		edge.To("domains", OrganizationDomain.Type),
+		edge.To("sso_logins", SSOLogin.Type),
 	}
 }
 
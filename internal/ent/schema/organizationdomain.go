@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OrganizationDomain holds the schema definition for the OrganizationDomain
+// entity. An organization proves it owns an email domain by publishing the
+// verification token in a DNS TXT record; users signing in through the
+// organization's SSO with an address on a verified domain join it
+// automatically.
+type OrganizationDomain struct {
+	ent.Schema
+}
+
+// Fields of the OrganizationDomain.
+func (OrganizationDomain) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("domain").
+			NotEmpty(),
+		field.String("verification_token").
+			NotEmpty(),
+		field.Time("verified_at").
+			Optional().
+			Nillable(),
+		// Role given to members provisioned through this domain
+		field.Enum("default_role").
+			Values("ADMIN", "MEMBER").
+			Default("MEMBER"),
+		field.Bool("auto_join").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the OrganizationDomain.
+func (OrganizationDomain) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("domains").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the OrganizationDomain.
+func (OrganizationDomain) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("domain").
+			Edges("organization").
+			Unique(),
+		index.Fields("domain"),
+	}
+}
@@ -38,6 +38,10 @@ func (OrganizationMember) Fields() []ent.Field {
 		field.Enum("role").
 			Values("OWNER", "ADMIN", "MEMBER").
 			Default("MEMBER"),
+		// Whether the member holds one of the seats the organization's
+		// subscription pays for, which gives them its plan
+		field.Bool("has_seat").
+			Default(false),
 		field.Time("joined_at").
 			Default(time.Now),
 		field.Time("updated_at").
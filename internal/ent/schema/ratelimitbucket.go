@@ -0,0 +1,46 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RateLimitBucket holds the schema definition for the RateLimitBucket entity.
+// It stores rate limiter state shared by all server instances: a token bucket
+// and the failure count and lock of a lockout key. A row is deleted once it
+// expires, when it holds nothing a missing row wouldn't.
+type RateLimitBucket struct {
+	ent.Schema
+}
+
+// Fields of the RateLimitBucket.
+func (RateLimitBucket) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("key").
+			Unique().
+			NotEmpty(),
+		field.Float("tokens").
+			Default(0),
+		field.Int("failures").
+			Default(0),
+		field.Time("locked_until").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now),
+		// Unset on rows written before expiry was recorded
+		field.Time("expires_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the RateLimitBucket.
+func (RateLimitBucket) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
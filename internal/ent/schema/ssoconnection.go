@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SSOConnection holds the schema definition for the SSOConnection entity.
+// It is an organization's own identity provider, either a SAML 2.0 IdP
+// described by its metadata or a generic OpenID Connect provider.
+type SSOConnection struct {
+	ent.Schema
+}
+
+// Fields of the SSOConnection.
+func (SSOConnection) Fields() []ent.Field {
+	return []ent.Field{
+		field.Enum("protocol").
+			Values("SAML", "OIDC"),
+		field.Bool("enabled").
+			Default(true),
+		// SAML: the IdP metadata document, and the URL it was fetched from if any
+		field.Text("saml_idp_metadata").
+			Optional(),
+		field.String("saml_idp_metadata_url").
+			Optional(),
+		// OIDC: the issuer used for discovery, and lem's client credentials
+		field.String("oidc_issuer").
+			Optional(),
+		field.String("oidc_client_id").
+			Optional(),
+		field.String("oidc_client_secret").
+			Optional().
+			Sensitive(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the SSOConnection.
+func (SSOConnection) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("sso_connection").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the SSOConnection.
+func (SSOConnection) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("organization").
+			Unique(),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// SSOLogin holds the schema definition for the SSOLogin entity. It tracks a
+// sign-in through an organization's identity provider: the state sent to the
+// IdP, and once the IdP has answered, the single-use code the app exchanges
+// for tokens. Only SHA-256 hashes of the state and code are stored.
+type SSOLogin struct {
+	ent.Schema
+}
+
+// Fields of the SSOLogin.
+func (SSOLogin) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("state_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		// SAML AuthnRequest ID or OIDC nonce the IdP response must match
+		field.String("request_id").
+			NotEmpty(),
+		field.String("code_verifier").
+			Optional().
+			Sensitive(),
+		field.String("redirect_url").
+			NotEmpty(),
+		field.String("code_hash").
+			Optional().
+			Nillable().
+			Unique().
+			Sensitive(),
+		field.Time("expires_at"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the SSOLogin.
+func (SSOLogin) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("sso_logins").
+			Unique().
+			Required(),
+		edge.From("user", User.Type).
+			Ref("sso_logins").
+			Unique(),
+	}
+}
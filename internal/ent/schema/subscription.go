@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
@@ -40,12 +41,19 @@ func (Subscription) Fields() []ent.Field {
 		field.Time("current_period_end").
 			Optional().
 			Nillable(),
+		// When the subscription was canceled. With cancel_at_period_end it
+		// stays active until current_period_end.
 		field.Time("canceled_at").
 			Optional().
 			Nillable(),
+		field.Bool("cancel_at_period_end").
+			Default(false),
 		field.Time("trial_end").
 			Optional().
 			Nillable(),
+		// Seats paid for by an organization's subscription
+		field.Int("quantity").
+			Default(1),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -78,7 +86,11 @@ func (Subscription) Edges() []ent.Edge {
 // Indexes of the Subscription.
 func (Subscription) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("stripe_subscription_id"),
+		// Stripe events for a new subscription can arrive concurrently, so
+		// only one of them may create it
+		index.Fields("stripe_subscription_id").
+			Unique().
+			Annotations(entsql.IndexWhere("stripe_subscription_id <> ''")),
 		index.Edges("user", "app"),
 		index.Edges("organization", "app"),
 	}
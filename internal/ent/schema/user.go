@@ -46,6 +46,18 @@ func (User) Fields() []ent.Field {
 		field.Time("google_token_expires_at").
 			Optional().
 			Nillable(),
+		// The pending or enabled TOTP secret, encrypted with the server's
+		// key (see apikey.Sealer).
+		field.String("totp_secret_encrypted").
+			Optional().
+			Sensitive(),
+		field.Int64("totp_last_counter").
+			Default(0),
+		field.Bool("mfa_enabled").
+			Default(false),
+		field.JSON("mfa_recovery_codes", []string{}).
+			Optional().
+			Sensitive(),
 		field.Bool("is_active").
 			Default(true),
 		field.Bool("is_verified").
@@ -70,8 +82,9 @@ func (User) Edges() []ent.Edge {
 		edge.To("user_apps", UserApp.Type),
 		edge.To("organization_memberships", OrganizationMember.Type),
 		edge.To("subscriptions", Subscription.Type),
-		edge.To("shenbi_profile", ShenbiProfile.Type).
-			Unique(),
+		// One per app the user has a profile in; the edge keeps its
+		// singular name, which names its column.
+		edge.To("shenbi_profile", ShenbiProfile.Type),
 		edge.To("classrooms_teaching", Classroom.Type),
 		edge.To("classroom_memberships", ClassroomMembership.Type),
 		edge.To("assignment_submissions", AssignmentSubmission.Type),
@@ -83,9 +96,14 @@ func (User) Edges() []ent.Edge {
 		edge.To("live_sessions_teaching", LiveSession.Type),
 		edge.To("live_session_participations", LiveSessionStudent.Type),
 		edge.To("classroom_sessions", ClassroomSession.Type),
-		edge.To("shenbi_settings", ShenbiSettings.Type).
-			Unique(),
+		// One per app, like shenbi_profile.
+		edge.To("shenbi_settings", ShenbiSettings.Type),
 		edge.To("sent_invitations", OrganizationInvitation.Type),
+		edge.To("auth_sessions", AuthSession.Type),
+		edge.To("verification_tokens", VerificationToken.Type),
+		edge.To("oauth_consents", OAuthConsent.Type),
+		edge.To("oauth_authorization_codes", OAuthAuthorizationCode.Type),
+		edge.To("sso_logins", SSOLogin.Type),
 	}
 }
 
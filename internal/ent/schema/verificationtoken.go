@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// VerificationToken holds the schema definition for the VerificationToken entity.
+// These are the single-use tokens emailed to users, e.g. for password resets.
+// Only a SHA-256 hash of the token is stored.
+type VerificationToken struct {
+	ent.Schema
+}
+
+// Fields of the VerificationToken.
+func (VerificationToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("token_hash").
+			Unique().
+			NotEmpty().
+			Sensitive(),
+		field.Enum("purpose").
+			Values("PASSWORD_RESET", "EMAIL_VERIFICATION", "MAGIC_LINK"),
+		field.String("email").
+			NotEmpty(),
+		field.Time("expires_at"),
+		field.Time("used_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the VerificationToken.
+func (VerificationToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		// Magic links sent to an email without an account have no user;
+		// the account is created when the link is used.
+		edge.From("user", User.Type).
+			Ref("verification_tokens").
+			Unique(),
+		edge.From("app", App.Type).
+			Ref("verification_tokens").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the VerificationToken.
+func (VerificationToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("token_hash"),
+		index.Fields("purpose").
+			Edges("user"),
+	}
+}
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// WebhookAttempt holds the schema definition for the WebhookAttempt entity.
+// It logs one attempt to send a webhook delivery and the app's response.
+type WebhookAttempt struct {
+	ent.Schema
+}
+
+// Fields of the WebhookAttempt.
+func (WebhookAttempt) Fields() []ent.Field {
+	return []ent.Field{
+		// Unset when no response was received
+		field.Int("status_code").
+			Optional().
+			Nillable().
+			Immutable(),
+		field.String("error").
+			Optional().
+			Immutable(),
+		// The start of the response body
+		field.Text("response_body").
+			Optional().
+			Immutable(),
+		field.Int("duration_ms").
+			Default(0).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookAttempt.
+func (WebhookAttempt) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("delivery", WebhookDelivery.Type).
+			Ref("attempt_logs").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
@@ -0,0 +1,78 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+// Each delivery is an event queued to be POSTed to an app's webhook URL,
+// retried with backoff until it succeeds or is given up on.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		// Sent to the app so that it can ignore events it has already
+		// processed
+		field.String("event_id").
+			Unique().
+			NotEmpty().
+			Immutable(),
+		field.String("event_type").
+			NotEmpty().
+			Immutable(),
+		// The request body sent to the app
+		field.JSON("payload", map[string]interface{}{}).
+			Immutable(),
+		// DEAD deliveries have used up their attempts and are only sent
+		// again when redelivered by an admin.
+		field.Enum("status").
+			Values("PENDING", "DELIVERED", "DEAD").
+			Default("PENDING"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.Time("last_attempt_at").
+			Optional().
+			Nillable(),
+		field.Int("last_status_code").
+			Optional().
+			Nillable(),
+		field.String("last_error").
+			Optional(),
+		field.Time("delivered_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("app", App.Type).
+			Ref("webhook_deliveries").
+			Unique().
+			Required(),
+		edge.To("attempt_logs", WebhookAttempt.Type),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "next_attempt_at"),
+		index.Fields("created_at").
+			Edges("app"),
+	}
+}
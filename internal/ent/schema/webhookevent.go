@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WebhookEvent holds the schema definition for the WebhookEvent entity. Each
+// event is a Stripe webhook event, stored when it is received and processed
+// later, so that Stripe's retries of an event are only processed once and
+// failures are retried without Stripe.
+type WebhookEvent struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEvent.
+func (WebhookEvent) Fields() []ent.Field {
+	return []ent.Field{
+		// Stripe's event ID, e.g. evt_...
+		field.String("event_id").
+			Unique().
+			NotEmpty().
+			Immutable(),
+		field.String("type").
+			NotEmpty().
+			Immutable(),
+		// The event as received from Stripe
+		field.Text("payload").
+			Immutable(),
+		// When Stripe created the event. Events are processed in this order.
+		field.Time("event_created_at").
+			Immutable(),
+		// FAILED events have used up their attempts and are only processed
+		// again when replayed by an admin.
+		field.Enum("status").
+			Values("PENDING", "PROCESSED", "FAILED").
+			Default("PENDING"),
+		field.Int("attempts").
+			Default(0),
+		field.Time("next_attempt_at").
+			Default(time.Now),
+		field.String("last_error").
+			Optional(),
+		field.Time("processed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the WebhookEvent.
+func (WebhookEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "event_created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -286,7 +286,7 @@ func (_c *ShenbiProfileCreate) createSpec() (*ShenbiProfile, *sqlgraph.CreateSpe
 	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbiprofile.UserTable,
 			Columns: []string{shenbiprofile.UserColumn},
@@ -314,7 +314,7 @@ func (_u *ShenbiProfileUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbiprofile.UserTable,
 			Columns: []string{shenbiprofile.UserColumn},
@@ -327,7 +327,7 @@ func (_u *ShenbiProfileUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	}
 	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbiprofile.UserTable,
 			Columns: []string{shenbiprofile.UserColumn},
@@ -675,7 +675,7 @@ func (_u *ShenbiProfileUpdateOne) sqlSave(ctx context.Context) (_node *ShenbiPro
 	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbiprofile.UserTable,
 			Columns: []string{shenbiprofile.UserColumn},
@@ -688,7 +688,7 @@ func (_u *ShenbiProfileUpdateOne) sqlSave(ctx context.Context) (_node *ShenbiPro
 	}
 	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbiprofile.UserTable,
 			Columns: []string{shenbiprofile.UserColumn},
@@ -244,7 +244,7 @@ func (_c *ShenbiSettingsCreate) createSpec() (*ShenbiSettings, *sqlgraph.CreateS
 	}
 	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbisettings.UserTable,
 			Columns: []string{shenbisettings.UserColumn},
@@ -220,7 +220,7 @@ func (_u *ShenbiSettingsUpdate) sqlSave(ctx context.Context) (_node int, err err
 	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbisettings.UserTable,
 			Columns: []string{shenbisettings.UserColumn},
@@ -233,7 +233,7 @@ func (_u *ShenbiSettingsUpdate) sqlSave(ctx context.Context) (_node int, err err
 	}
 	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbisettings.UserTable,
 			Columns: []string{shenbisettings.UserColumn},
@@ -487,7 +487,7 @@ func (_u *ShenbiSettingsUpdateOne) sqlSave(ctx context.Context) (_node *ShenbiSe
 	}
 	if _u.mutation.UserCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbisettings.UserTable,
 			Columns: []string{shenbisettings.UserColumn},
@@ -500,7 +500,7 @@ func (_u *ShenbiSettingsUpdateOne) sqlSave(ctx context.Context) (_node *ShenbiSe
 	}
 	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.M2O,
 			Inverse: true,
 			Table:   shenbisettings.UserTable,
 			Columns: []string{shenbisettings.UserColumn},
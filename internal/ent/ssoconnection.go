@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+)
+
+// SSOConnection is the model entity for the SSOConnection schema.
+type SSOConnection struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Protocol holds the value of the "protocol" field.
+	Protocol ssoconnection.Protocol `json:"protocol,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// SamlIdpMetadata holds the value of the "saml_idp_metadata" field.
+	SamlIdpMetadata string `json:"saml_idp_metadata,omitempty"`
+	// SamlIdpMetadataURL holds the value of the "saml_idp_metadata_url" field.
+	SamlIdpMetadataURL string `json:"saml_idp_metadata_url,omitempty"`
+	// OidcIssuer holds the value of the "oidc_issuer" field.
+	OidcIssuer string `json:"oidc_issuer,omitempty"`
+	// OidcClientID holds the value of the "oidc_client_id" field.
+	OidcClientID string `json:"oidc_client_id,omitempty"`
+	// OidcClientSecret holds the value of the "oidc_client_secret" field.
+	OidcClientSecret string `json:"-"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SSOConnectionQuery when eager-loading is set.
+	Edges                       SSOConnectionEdges `json:"edges"`
+	organization_sso_connection *int
+	selectValues                sql.SelectValues
+}
+
+// SSOConnectionEdges holds the relations/edges for other nodes in the graph.
+type SSOConnectionEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SSOConnectionEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSOConnection) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ssoconnection.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case ssoconnection.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ssoconnection.FieldProtocol, ssoconnection.FieldSamlIdpMetadata, ssoconnection.FieldSamlIdpMetadataURL, ssoconnection.FieldOidcIssuer, ssoconnection.FieldOidcClientID, ssoconnection.FieldOidcClientSecret:
+			values[i] = new(sql.NullString)
+		case ssoconnection.FieldCreatedAt, ssoconnection.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case ssoconnection.ForeignKeys[0]: // organization_sso_connection
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSOConnection fields.
+func (_m *SSOConnection) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ssoconnection.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ssoconnection.FieldProtocol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field protocol", values[i])
+			} else if value.Valid {
+				_m.Protocol = ssoconnection.Protocol(value.String)
+			}
+		case ssoconnection.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				_m.Enabled = value.Bool
+			}
+		case ssoconnection.FieldSamlIdpMetadata:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_idp_metadata", values[i])
+			} else if value.Valid {
+				_m.SamlIdpMetadata = value.String
+			}
+		case ssoconnection.FieldSamlIdpMetadataURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field saml_idp_metadata_url", values[i])
+			} else if value.Valid {
+				_m.SamlIdpMetadataURL = value.String
+			}
+		case ssoconnection.FieldOidcIssuer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_issuer", values[i])
+			} else if value.Valid {
+				_m.OidcIssuer = value.String
+			}
+		case ssoconnection.FieldOidcClientID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_client_id", values[i])
+			} else if value.Valid {
+				_m.OidcClientID = value.String
+			}
+		case ssoconnection.FieldOidcClientSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field oidc_client_secret", values[i])
+			} else if value.Valid {
+				_m.OidcClientSecret = value.String
+			}
+		case ssoconnection.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ssoconnection.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		case ssoconnection.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field organization_sso_connection", value)
+			} else if value.Valid {
+				_m.organization_sso_connection = new(int)
+				*_m.organization_sso_connection = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSOConnection.
+// This includes values selected through modifiers, order, etc.
+func (_m *SSOConnection) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the SSOConnection entity.
+func (_m *SSOConnection) QueryOrganization() *OrganizationQuery {
+	return NewSSOConnectionClient(_m.config).QueryOrganization(_m)
+}
+
+// Update returns a builder for updating this SSOConnection.
+// Note that you need to call SSOConnection.Unwrap() before calling this method if this SSOConnection
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SSOConnection) Update() *SSOConnectionUpdateOne {
+	return NewSSOConnectionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SSOConnection entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SSOConnection) Unwrap() *SSOConnection {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSOConnection is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SSOConnection) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSOConnection(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("protocol=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Protocol))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("saml_idp_metadata=")
+	builder.WriteString(_m.SamlIdpMetadata)
+	builder.WriteString(", ")
+	builder.WriteString("saml_idp_metadata_url=")
+	builder.WriteString(_m.SamlIdpMetadataURL)
+	builder.WriteString(", ")
+	builder.WriteString("oidc_issuer=")
+	builder.WriteString(_m.OidcIssuer)
+	builder.WriteString(", ")
+	builder.WriteString("oidc_client_id=")
+	builder.WriteString(_m.OidcClientID)
+	builder.WriteString(", ")
+	builder.WriteString("oidc_client_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSOConnections is a parsable slice of SSOConnection.
+type SSOConnections []*SSOConnection
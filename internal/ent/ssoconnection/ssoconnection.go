@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssoconnection
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the ssoconnection type in the database.
+	Label = "sso_connection"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldProtocol holds the string denoting the protocol field in the database.
+	FieldProtocol = "protocol"
+	// FieldEnabled holds the string denoting the enabled field in the database.
+	FieldEnabled = "enabled"
+	// FieldSamlIdpMetadata holds the string denoting the saml_idp_metadata field in the database.
+	FieldSamlIdpMetadata = "saml_idp_metadata"
+	// FieldSamlIdpMetadataURL holds the string denoting the saml_idp_metadata_url field in the database.
+	FieldSamlIdpMetadataURL = "saml_idp_metadata_url"
+	// FieldOidcIssuer holds the string denoting the oidc_issuer field in the database.
+	FieldOidcIssuer = "oidc_issuer"
+	// FieldOidcClientID holds the string denoting the oidc_client_id field in the database.
+	FieldOidcClientID = "oidc_client_id"
+	// FieldOidcClientSecret holds the string denoting the oidc_client_secret field in the database.
+	FieldOidcClientSecret = "oidc_client_secret"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// Table holds the table name of the ssoconnection in the database.
+	Table = "sso_connections"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "sso_connections"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_sso_connection"
+)
+
+// Columns holds all SQL columns for ssoconnection fields.
+var Columns = []string{
+	FieldID,
+	FieldProtocol,
+	FieldEnabled,
+	FieldSamlIdpMetadata,
+	FieldSamlIdpMetadataURL,
+	FieldOidcIssuer,
+	FieldOidcClientID,
+	FieldOidcClientSecret,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ForeignKeys holds the SQL foreign-keys that are owned by the "sso_connections"
+// table and are not defined as standalone fields in the schema.
+var ForeignKeys = []string{
+	"organization_sso_connection",
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	for i := range ForeignKeys {
+		if column == ForeignKeys[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultEnabled holds the default value on creation for the "enabled" field.
+	DefaultEnabled bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// Protocol defines the type for the "protocol" enum field.
+type Protocol string
+
+// Protocol values.
+const (
+	ProtocolSAML Protocol = "SAML"
+	ProtocolOIDC Protocol = "OIDC"
+)
+
+func (pr Protocol) String() string {
+	return string(pr)
+}
+
+// ProtocolValidator is a validator for the "protocol" field enum values. It is called by the builders before save.
+func ProtocolValidator(pr Protocol) error {
+	switch pr {
+	case ProtocolSAML, ProtocolOIDC:
+		return nil
+	default:
+		return fmt.Errorf("ssoconnection: invalid enum value for protocol field: %q", pr)
+	}
+}
+
+// OrderOption defines the ordering options for the SSOConnection queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByProtocol orders the results by the protocol field.
+func ByProtocol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProtocol, opts...).ToFunc()
+}
+
+// ByEnabled orders the results by the enabled field.
+func ByEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEnabled, opts...).ToFunc()
+}
+
+// BySamlIdpMetadata orders the results by the saml_idp_metadata field.
+func BySamlIdpMetadata(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlIdpMetadata, opts...).ToFunc()
+}
+
+// BySamlIdpMetadataURL orders the results by the saml_idp_metadata_url field.
+func BySamlIdpMetadataURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSamlIdpMetadataURL, opts...).ToFunc()
+}
+
+// ByOidcIssuer orders the results by the oidc_issuer field.
+func ByOidcIssuer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOidcIssuer, opts...).ToFunc()
+}
+
+// ByOidcClientID orders the results by the oidc_client_id field.
+func ByOidcClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOidcClientID, opts...).ToFunc()
+}
+
+// ByOidcClientSecret orders the results by the oidc_client_secret field.
+func ByOidcClientSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOidcClientSecret, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
@@ -0,0 +1,619 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssoconnection
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldID, id))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// SamlIdpMetadata applies equality check predicate on the "saml_idp_metadata" field. It's identical to SamlIdpMetadataEQ.
+func SamlIdpMetadata(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataURL applies equality check predicate on the "saml_idp_metadata_url" field. It's identical to SamlIdpMetadataURLEQ.
+func SamlIdpMetadataURL(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldSamlIdpMetadataURL, v))
+}
+
+// OidcIssuer applies equality check predicate on the "oidc_issuer" field. It's identical to OidcIssuerEQ.
+func OidcIssuer(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcIssuer, v))
+}
+
+// OidcClientID applies equality check predicate on the "oidc_client_id" field. It's identical to OidcClientIDEQ.
+func OidcClientID(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcClientID, v))
+}
+
+// OidcClientSecret applies equality check predicate on the "oidc_client_secret" field. It's identical to OidcClientSecretEQ.
+func OidcClientSecret(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcClientSecret, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ProtocolEQ applies the EQ predicate on the "protocol" field.
+func ProtocolEQ(v Protocol) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldProtocol, v))
+}
+
+// ProtocolNEQ applies the NEQ predicate on the "protocol" field.
+func ProtocolNEQ(v Protocol) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldProtocol, v))
+}
+
+// ProtocolIn applies the In predicate on the "protocol" field.
+func ProtocolIn(vs ...Protocol) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldProtocol, vs...))
+}
+
+// ProtocolNotIn applies the NotIn predicate on the "protocol" field.
+func ProtocolNotIn(vs ...Protocol) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldProtocol, vs...))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// SamlIdpMetadataEQ applies the EQ predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataNEQ applies the NEQ predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataIn applies the In predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldSamlIdpMetadata, vs...))
+}
+
+// SamlIdpMetadataNotIn applies the NotIn predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldSamlIdpMetadata, vs...))
+}
+
+// SamlIdpMetadataGT applies the GT predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataGTE applies the GTE predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataLT applies the LT predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataLTE applies the LTE predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataContains applies the Contains predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataHasPrefix applies the HasPrefix predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataHasSuffix applies the HasSuffix predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataIsNil applies the IsNil predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldSamlIdpMetadata))
+}
+
+// SamlIdpMetadataNotNil applies the NotNil predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldSamlIdpMetadata))
+}
+
+// SamlIdpMetadataEqualFold applies the EqualFold predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataContainsFold applies the ContainsFold predicate on the "saml_idp_metadata" field.
+func SamlIdpMetadataContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldSamlIdpMetadata, v))
+}
+
+// SamlIdpMetadataURLEQ applies the EQ predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLNEQ applies the NEQ predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLIn applies the In predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldSamlIdpMetadataURL, vs...))
+}
+
+// SamlIdpMetadataURLNotIn applies the NotIn predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldSamlIdpMetadataURL, vs...))
+}
+
+// SamlIdpMetadataURLGT applies the GT predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLGTE applies the GTE predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLLT applies the LT predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLLTE applies the LTE predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLContains applies the Contains predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLHasPrefix applies the HasPrefix predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLHasSuffix applies the HasSuffix predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLIsNil applies the IsNil predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldSamlIdpMetadataURL))
+}
+
+// SamlIdpMetadataURLNotNil applies the NotNil predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldSamlIdpMetadataURL))
+}
+
+// SamlIdpMetadataURLEqualFold applies the EqualFold predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldSamlIdpMetadataURL, v))
+}
+
+// SamlIdpMetadataURLContainsFold applies the ContainsFold predicate on the "saml_idp_metadata_url" field.
+func SamlIdpMetadataURLContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldSamlIdpMetadataURL, v))
+}
+
+// OidcIssuerEQ applies the EQ predicate on the "oidc_issuer" field.
+func OidcIssuerEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcIssuer, v))
+}
+
+// OidcIssuerNEQ applies the NEQ predicate on the "oidc_issuer" field.
+func OidcIssuerNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldOidcIssuer, v))
+}
+
+// OidcIssuerIn applies the In predicate on the "oidc_issuer" field.
+func OidcIssuerIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldOidcIssuer, vs...))
+}
+
+// OidcIssuerNotIn applies the NotIn predicate on the "oidc_issuer" field.
+func OidcIssuerNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldOidcIssuer, vs...))
+}
+
+// OidcIssuerGT applies the GT predicate on the "oidc_issuer" field.
+func OidcIssuerGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldOidcIssuer, v))
+}
+
+// OidcIssuerGTE applies the GTE predicate on the "oidc_issuer" field.
+func OidcIssuerGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldOidcIssuer, v))
+}
+
+// OidcIssuerLT applies the LT predicate on the "oidc_issuer" field.
+func OidcIssuerLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldOidcIssuer, v))
+}
+
+// OidcIssuerLTE applies the LTE predicate on the "oidc_issuer" field.
+func OidcIssuerLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldOidcIssuer, v))
+}
+
+// OidcIssuerContains applies the Contains predicate on the "oidc_issuer" field.
+func OidcIssuerContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldOidcIssuer, v))
+}
+
+// OidcIssuerHasPrefix applies the HasPrefix predicate on the "oidc_issuer" field.
+func OidcIssuerHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldOidcIssuer, v))
+}
+
+// OidcIssuerHasSuffix applies the HasSuffix predicate on the "oidc_issuer" field.
+func OidcIssuerHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldOidcIssuer, v))
+}
+
+// OidcIssuerIsNil applies the IsNil predicate on the "oidc_issuer" field.
+func OidcIssuerIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldOidcIssuer))
+}
+
+// OidcIssuerNotNil applies the NotNil predicate on the "oidc_issuer" field.
+func OidcIssuerNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldOidcIssuer))
+}
+
+// OidcIssuerEqualFold applies the EqualFold predicate on the "oidc_issuer" field.
+func OidcIssuerEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldOidcIssuer, v))
+}
+
+// OidcIssuerContainsFold applies the ContainsFold predicate on the "oidc_issuer" field.
+func OidcIssuerContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldOidcIssuer, v))
+}
+
+// OidcClientIDEQ applies the EQ predicate on the "oidc_client_id" field.
+func OidcClientIDEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcClientID, v))
+}
+
+// OidcClientIDNEQ applies the NEQ predicate on the "oidc_client_id" field.
+func OidcClientIDNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldOidcClientID, v))
+}
+
+// OidcClientIDIn applies the In predicate on the "oidc_client_id" field.
+func OidcClientIDIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldOidcClientID, vs...))
+}
+
+// OidcClientIDNotIn applies the NotIn predicate on the "oidc_client_id" field.
+func OidcClientIDNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldOidcClientID, vs...))
+}
+
+// OidcClientIDGT applies the GT predicate on the "oidc_client_id" field.
+func OidcClientIDGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldOidcClientID, v))
+}
+
+// OidcClientIDGTE applies the GTE predicate on the "oidc_client_id" field.
+func OidcClientIDGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldOidcClientID, v))
+}
+
+// OidcClientIDLT applies the LT predicate on the "oidc_client_id" field.
+func OidcClientIDLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldOidcClientID, v))
+}
+
+// OidcClientIDLTE applies the LTE predicate on the "oidc_client_id" field.
+func OidcClientIDLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldOidcClientID, v))
+}
+
+// OidcClientIDContains applies the Contains predicate on the "oidc_client_id" field.
+func OidcClientIDContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldOidcClientID, v))
+}
+
+// OidcClientIDHasPrefix applies the HasPrefix predicate on the "oidc_client_id" field.
+func OidcClientIDHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldOidcClientID, v))
+}
+
+// OidcClientIDHasSuffix applies the HasSuffix predicate on the "oidc_client_id" field.
+func OidcClientIDHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldOidcClientID, v))
+}
+
+// OidcClientIDIsNil applies the IsNil predicate on the "oidc_client_id" field.
+func OidcClientIDIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldOidcClientID))
+}
+
+// OidcClientIDNotNil applies the NotNil predicate on the "oidc_client_id" field.
+func OidcClientIDNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldOidcClientID))
+}
+
+// OidcClientIDEqualFold applies the EqualFold predicate on the "oidc_client_id" field.
+func OidcClientIDEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldOidcClientID, v))
+}
+
+// OidcClientIDContainsFold applies the ContainsFold predicate on the "oidc_client_id" field.
+func OidcClientIDContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldOidcClientID, v))
+}
+
+// OidcClientSecretEQ applies the EQ predicate on the "oidc_client_secret" field.
+func OidcClientSecretEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretNEQ applies the NEQ predicate on the "oidc_client_secret" field.
+func OidcClientSecretNEQ(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretIn applies the In predicate on the "oidc_client_secret" field.
+func OidcClientSecretIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldOidcClientSecret, vs...))
+}
+
+// OidcClientSecretNotIn applies the NotIn predicate on the "oidc_client_secret" field.
+func OidcClientSecretNotIn(vs ...string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldOidcClientSecret, vs...))
+}
+
+// OidcClientSecretGT applies the GT predicate on the "oidc_client_secret" field.
+func OidcClientSecretGT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretGTE applies the GTE predicate on the "oidc_client_secret" field.
+func OidcClientSecretGTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretLT applies the LT predicate on the "oidc_client_secret" field.
+func OidcClientSecretLT(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretLTE applies the LTE predicate on the "oidc_client_secret" field.
+func OidcClientSecretLTE(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretContains applies the Contains predicate on the "oidc_client_secret" field.
+func OidcClientSecretContains(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContains(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretHasPrefix applies the HasPrefix predicate on the "oidc_client_secret" field.
+func OidcClientSecretHasPrefix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasPrefix(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretHasSuffix applies the HasSuffix predicate on the "oidc_client_secret" field.
+func OidcClientSecretHasSuffix(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldHasSuffix(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretIsNil applies the IsNil predicate on the "oidc_client_secret" field.
+func OidcClientSecretIsNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIsNull(FieldOidcClientSecret))
+}
+
+// OidcClientSecretNotNil applies the NotNil predicate on the "oidc_client_secret" field.
+func OidcClientSecretNotNil() predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotNull(FieldOidcClientSecret))
+}
+
+// OidcClientSecretEqualFold applies the EqualFold predicate on the "oidc_client_secret" field.
+func OidcClientSecretEqualFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEqualFold(FieldOidcClientSecret, v))
+}
+
+// OidcClientSecretContainsFold applies the ContainsFold predicate on the "oidc_client_secret" field.
+func OidcClientSecretContainsFold(v string) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldContainsFold(FieldOidcClientSecret, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.SSOConnection {
+	return predicate.SSOConnection(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.SSOConnection {
+	return predicate.SSOConnection(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SSOConnection) predicate.SSOConnection {
+	return predicate.SSOConnection(sql.NotPredicates(p))
+}
@@ -0,0 +1,392 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+)
+
+// SSOConnectionCreate is the builder for creating a SSOConnection entity.
+type SSOConnectionCreate struct {
+	config
+	mutation *SSOConnectionMutation
+	hooks    []Hook
+}
+
+// SetProtocol sets the "protocol" field.
+func (_c *SSOConnectionCreate) SetProtocol(v ssoconnection.Protocol) *SSOConnectionCreate {
+	_c.mutation.SetProtocol(v)
+	return _c
+}
+
+// SetEnabled sets the "enabled" field.
+func (_c *SSOConnectionCreate) SetEnabled(v bool) *SSOConnectionCreate {
+	_c.mutation.SetEnabled(v)
+	return _c
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableEnabled(v *bool) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetEnabled(*v)
+	}
+	return _c
+}
+
+// SetSamlIdpMetadata sets the "saml_idp_metadata" field.
+func (_c *SSOConnectionCreate) SetSamlIdpMetadata(v string) *SSOConnectionCreate {
+	_c.mutation.SetSamlIdpMetadata(v)
+	return _c
+}
+
+// SetNillableSamlIdpMetadata sets the "saml_idp_metadata" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableSamlIdpMetadata(v *string) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetSamlIdpMetadata(*v)
+	}
+	return _c
+}
+
+// SetSamlIdpMetadataURL sets the "saml_idp_metadata_url" field.
+func (_c *SSOConnectionCreate) SetSamlIdpMetadataURL(v string) *SSOConnectionCreate {
+	_c.mutation.SetSamlIdpMetadataURL(v)
+	return _c
+}
+
+// SetNillableSamlIdpMetadataURL sets the "saml_idp_metadata_url" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableSamlIdpMetadataURL(v *string) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetSamlIdpMetadataURL(*v)
+	}
+	return _c
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_c *SSOConnectionCreate) SetOidcIssuer(v string) *SSOConnectionCreate {
+	_c.mutation.SetOidcIssuer(v)
+	return _c
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableOidcIssuer(v *string) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetOidcIssuer(*v)
+	}
+	return _c
+}
+
+// SetOidcClientID sets the "oidc_client_id" field.
+func (_c *SSOConnectionCreate) SetOidcClientID(v string) *SSOConnectionCreate {
+	_c.mutation.SetOidcClientID(v)
+	return _c
+}
+
+// SetNillableOidcClientID sets the "oidc_client_id" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableOidcClientID(v *string) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetOidcClientID(*v)
+	}
+	return _c
+}
+
+// SetOidcClientSecret sets the "oidc_client_secret" field.
+func (_c *SSOConnectionCreate) SetOidcClientSecret(v string) *SSOConnectionCreate {
+	_c.mutation.SetOidcClientSecret(v)
+	return _c
+}
+
+// SetNillableOidcClientSecret sets the "oidc_client_secret" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableOidcClientSecret(v *string) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetOidcClientSecret(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SSOConnectionCreate) SetCreatedAt(v time.Time) *SSOConnectionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableCreatedAt(v *time.Time) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SSOConnectionCreate) SetUpdatedAt(v time.Time) *SSOConnectionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SSOConnectionCreate) SetNillableUpdatedAt(v *time.Time) *SSOConnectionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *SSOConnectionCreate) SetOrganizationID(id int) *SSOConnectionCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *SSOConnectionCreate) SetOrganization(v *Organization) *SSOConnectionCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (_c *SSOConnectionCreate) Mutation() *SSOConnectionMutation {
+	return _c.mutation
+}
+
+// Save creates the SSOConnection in the database.
+func (_c *SSOConnectionCreate) Save(ctx context.Context) (*SSOConnection, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SSOConnectionCreate) SaveX(ctx context.Context) *SSOConnection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOConnectionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOConnectionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SSOConnectionCreate) defaults() {
+	if _, ok := _c.mutation.Enabled(); !ok {
+		v := ssoconnection.DefaultEnabled
+		_c.mutation.SetEnabled(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ssoconnection.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SSOConnectionCreate) check() error {
+	if _, ok := _c.mutation.Protocol(); !ok {
+		return &ValidationError{Name: "protocol", err: errors.New(`ent: missing required field "SSOConnection.protocol"`)}
+	}
+	if v, ok := _c.mutation.Protocol(); ok {
+		if err := ssoconnection.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.protocol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "SSOConnection.enabled"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSOConnection.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SSOConnection.updated_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "SSOConnection.organization"`)}
+	}
+	return nil
+}
+
+func (_c *SSOConnectionCreate) sqlSave(ctx context.Context) (*SSOConnection, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SSOConnectionCreate) createSpec() (*SSOConnection, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSOConnection{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ssoconnection.Table, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.Protocol(); ok {
+		_spec.SetField(ssoconnection.FieldProtocol, field.TypeEnum, value)
+		_node.Protocol = value
+	}
+	if value, ok := _c.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := _c.mutation.SamlIdpMetadata(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadata, field.TypeString, value)
+		_node.SamlIdpMetadata = value
+	}
+	if value, ok := _c.mutation.SamlIdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadataURL, field.TypeString, value)
+		_node.SamlIdpMetadataURL = value
+	}
+	if value, ok := _c.mutation.OidcIssuer(); ok {
+		_spec.SetField(ssoconnection.FieldOidcIssuer, field.TypeString, value)
+		_node.OidcIssuer = value
+	}
+	if value, ok := _c.mutation.OidcClientID(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientID, field.TypeString, value)
+		_node.OidcClientID = value
+	}
+	if value, ok := _c.mutation.OidcClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientSecret, field.TypeString, value)
+		_node.OidcClientSecret = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ssoconnection.OrganizationTable,
+			Columns: []string{ssoconnection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.organization_sso_connection = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SSOConnectionCreateBulk is the builder for creating many SSOConnection entities in bulk.
+type SSOConnectionCreateBulk struct {
+	config
+	err      error
+	builders []*SSOConnectionCreate
+}
+
+// Save creates the SSOConnection entities in the database.
+func (_c *SSOConnectionCreateBulk) Save(ctx context.Context) ([]*SSOConnection, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SSOConnection, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSOConnectionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SSOConnectionCreateBulk) SaveX(ctx context.Context) []*SSOConnection {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOConnectionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOConnectionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,665 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+)
+
+// SSOConnectionUpdate is the builder for updating SSOConnection entities.
+type SSOConnectionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSOConnectionMutation
+}
+
+// Where appends a list predicates to the SSOConnectionUpdate builder.
+func (_u *SSOConnectionUpdate) Where(ps ...predicate.SSOConnection) *SSOConnectionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProtocol sets the "protocol" field.
+func (_u *SSOConnectionUpdate) SetProtocol(v ssoconnection.Protocol) *SSOConnectionUpdate {
+	_u.mutation.SetProtocol(v)
+	return _u
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableProtocol(v *ssoconnection.Protocol) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetProtocol(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SSOConnectionUpdate) SetEnabled(v bool) *SSOConnectionUpdate {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableEnabled(v *bool) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSamlIdpMetadata sets the "saml_idp_metadata" field.
+func (_u *SSOConnectionUpdate) SetSamlIdpMetadata(v string) *SSOConnectionUpdate {
+	_u.mutation.SetSamlIdpMetadata(v)
+	return _u
+}
+
+// SetNillableSamlIdpMetadata sets the "saml_idp_metadata" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableSamlIdpMetadata(v *string) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetSamlIdpMetadata(*v)
+	}
+	return _u
+}
+
+// ClearSamlIdpMetadata clears the value of the "saml_idp_metadata" field.
+func (_u *SSOConnectionUpdate) ClearSamlIdpMetadata() *SSOConnectionUpdate {
+	_u.mutation.ClearSamlIdpMetadata()
+	return _u
+}
+
+// SetSamlIdpMetadataURL sets the "saml_idp_metadata_url" field.
+func (_u *SSOConnectionUpdate) SetSamlIdpMetadataURL(v string) *SSOConnectionUpdate {
+	_u.mutation.SetSamlIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableSamlIdpMetadataURL sets the "saml_idp_metadata_url" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableSamlIdpMetadataURL(v *string) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetSamlIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearSamlIdpMetadataURL clears the value of the "saml_idp_metadata_url" field.
+func (_u *SSOConnectionUpdate) ClearSamlIdpMetadataURL() *SSOConnectionUpdate {
+	_u.mutation.ClearSamlIdpMetadataURL()
+	return _u
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_u *SSOConnectionUpdate) SetOidcIssuer(v string) *SSOConnectionUpdate {
+	_u.mutation.SetOidcIssuer(v)
+	return _u
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableOidcIssuer(v *string) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetOidcIssuer(*v)
+	}
+	return _u
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (_u *SSOConnectionUpdate) ClearOidcIssuer() *SSOConnectionUpdate {
+	_u.mutation.ClearOidcIssuer()
+	return _u
+}
+
+// SetOidcClientID sets the "oidc_client_id" field.
+func (_u *SSOConnectionUpdate) SetOidcClientID(v string) *SSOConnectionUpdate {
+	_u.mutation.SetOidcClientID(v)
+	return _u
+}
+
+// SetNillableOidcClientID sets the "oidc_client_id" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableOidcClientID(v *string) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetOidcClientID(*v)
+	}
+	return _u
+}
+
+// ClearOidcClientID clears the value of the "oidc_client_id" field.
+func (_u *SSOConnectionUpdate) ClearOidcClientID() *SSOConnectionUpdate {
+	_u.mutation.ClearOidcClientID()
+	return _u
+}
+
+// SetOidcClientSecret sets the "oidc_client_secret" field.
+func (_u *SSOConnectionUpdate) SetOidcClientSecret(v string) *SSOConnectionUpdate {
+	_u.mutation.SetOidcClientSecret(v)
+	return _u
+}
+
+// SetNillableOidcClientSecret sets the "oidc_client_secret" field if the given value is not nil.
+func (_u *SSOConnectionUpdate) SetNillableOidcClientSecret(v *string) *SSOConnectionUpdate {
+	if v != nil {
+		_u.SetOidcClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearOidcClientSecret clears the value of the "oidc_client_secret" field.
+func (_u *SSOConnectionUpdate) ClearOidcClientSecret() *SSOConnectionUpdate {
+	_u.mutation.ClearOidcClientSecret()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SSOConnectionUpdate) SetUpdatedAt(v time.Time) *SSOConnectionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SSOConnectionUpdate) SetOrganizationID(id int) *SSOConnectionUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SSOConnectionUpdate) SetOrganization(v *Organization) *SSOConnectionUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (_u *SSOConnectionUpdate) Mutation() *SSOConnectionMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SSOConnectionUpdate) ClearOrganization() *SSOConnectionUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SSOConnectionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOConnectionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SSOConnectionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOConnectionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SSOConnectionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOConnectionUpdate) check() error {
+	if v, ok := _u.mutation.Protocol(); ok {
+		if err := ssoconnection.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.protocol": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOConnection.organization"`)
+	}
+	return nil
+}
+
+func (_u *SSOConnectionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoconnection.Table, ssoconnection.Columns, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Protocol(); ok {
+		_spec.SetField(ssoconnection.FieldProtocol, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SamlIdpMetadata(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadata, field.TypeString, value)
+	}
+	if _u.mutation.SamlIdpMetadataCleared() {
+		_spec.ClearField(ssoconnection.FieldSamlIdpMetadata, field.TypeString)
+	}
+	if value, ok := _u.mutation.SamlIdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.SamlIdpMetadataURLCleared() {
+		_spec.ClearField(ssoconnection.FieldSamlIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcIssuer(); ok {
+		_spec.SetField(ssoconnection.FieldOidcIssuer, field.TypeString, value)
+	}
+	if _u.mutation.OidcIssuerCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcClientID(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientID, field.TypeString, value)
+	}
+	if _u.mutation.OidcClientIDCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.OidcClientSecretCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ssoconnection.OrganizationTable,
+			Columns: []string{ssoconnection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ssoconnection.OrganizationTable,
+			Columns: []string{ssoconnection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SSOConnectionUpdateOne is the builder for updating a single SSOConnection entity.
+type SSOConnectionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSOConnectionMutation
+}
+
+// SetProtocol sets the "protocol" field.
+func (_u *SSOConnectionUpdateOne) SetProtocol(v ssoconnection.Protocol) *SSOConnectionUpdateOne {
+	_u.mutation.SetProtocol(v)
+	return _u
+}
+
+// SetNillableProtocol sets the "protocol" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableProtocol(v *ssoconnection.Protocol) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetProtocol(*v)
+	}
+	return _u
+}
+
+// SetEnabled sets the "enabled" field.
+func (_u *SSOConnectionUpdateOne) SetEnabled(v bool) *SSOConnectionUpdateOne {
+	_u.mutation.SetEnabled(v)
+	return _u
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableEnabled(v *bool) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetEnabled(*v)
+	}
+	return _u
+}
+
+// SetSamlIdpMetadata sets the "saml_idp_metadata" field.
+func (_u *SSOConnectionUpdateOne) SetSamlIdpMetadata(v string) *SSOConnectionUpdateOne {
+	_u.mutation.SetSamlIdpMetadata(v)
+	return _u
+}
+
+// SetNillableSamlIdpMetadata sets the "saml_idp_metadata" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableSamlIdpMetadata(v *string) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetSamlIdpMetadata(*v)
+	}
+	return _u
+}
+
+// ClearSamlIdpMetadata clears the value of the "saml_idp_metadata" field.
+func (_u *SSOConnectionUpdateOne) ClearSamlIdpMetadata() *SSOConnectionUpdateOne {
+	_u.mutation.ClearSamlIdpMetadata()
+	return _u
+}
+
+// SetSamlIdpMetadataURL sets the "saml_idp_metadata_url" field.
+func (_u *SSOConnectionUpdateOne) SetSamlIdpMetadataURL(v string) *SSOConnectionUpdateOne {
+	_u.mutation.SetSamlIdpMetadataURL(v)
+	return _u
+}
+
+// SetNillableSamlIdpMetadataURL sets the "saml_idp_metadata_url" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableSamlIdpMetadataURL(v *string) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetSamlIdpMetadataURL(*v)
+	}
+	return _u
+}
+
+// ClearSamlIdpMetadataURL clears the value of the "saml_idp_metadata_url" field.
+func (_u *SSOConnectionUpdateOne) ClearSamlIdpMetadataURL() *SSOConnectionUpdateOne {
+	_u.mutation.ClearSamlIdpMetadataURL()
+	return _u
+}
+
+// SetOidcIssuer sets the "oidc_issuer" field.
+func (_u *SSOConnectionUpdateOne) SetOidcIssuer(v string) *SSOConnectionUpdateOne {
+	_u.mutation.SetOidcIssuer(v)
+	return _u
+}
+
+// SetNillableOidcIssuer sets the "oidc_issuer" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableOidcIssuer(v *string) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetOidcIssuer(*v)
+	}
+	return _u
+}
+
+// ClearOidcIssuer clears the value of the "oidc_issuer" field.
+func (_u *SSOConnectionUpdateOne) ClearOidcIssuer() *SSOConnectionUpdateOne {
+	_u.mutation.ClearOidcIssuer()
+	return _u
+}
+
+// SetOidcClientID sets the "oidc_client_id" field.
+func (_u *SSOConnectionUpdateOne) SetOidcClientID(v string) *SSOConnectionUpdateOne {
+	_u.mutation.SetOidcClientID(v)
+	return _u
+}
+
+// SetNillableOidcClientID sets the "oidc_client_id" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableOidcClientID(v *string) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetOidcClientID(*v)
+	}
+	return _u
+}
+
+// ClearOidcClientID clears the value of the "oidc_client_id" field.
+func (_u *SSOConnectionUpdateOne) ClearOidcClientID() *SSOConnectionUpdateOne {
+	_u.mutation.ClearOidcClientID()
+	return _u
+}
+
+// SetOidcClientSecret sets the "oidc_client_secret" field.
+func (_u *SSOConnectionUpdateOne) SetOidcClientSecret(v string) *SSOConnectionUpdateOne {
+	_u.mutation.SetOidcClientSecret(v)
+	return _u
+}
+
+// SetNillableOidcClientSecret sets the "oidc_client_secret" field if the given value is not nil.
+func (_u *SSOConnectionUpdateOne) SetNillableOidcClientSecret(v *string) *SSOConnectionUpdateOne {
+	if v != nil {
+		_u.SetOidcClientSecret(*v)
+	}
+	return _u
+}
+
+// ClearOidcClientSecret clears the value of the "oidc_client_secret" field.
+func (_u *SSOConnectionUpdateOne) ClearOidcClientSecret() *SSOConnectionUpdateOne {
+	_u.mutation.ClearOidcClientSecret()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SSOConnectionUpdateOne) SetUpdatedAt(v time.Time) *SSOConnectionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SSOConnectionUpdateOne) SetOrganizationID(id int) *SSOConnectionUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SSOConnectionUpdateOne) SetOrganization(v *Organization) *SSOConnectionUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the SSOConnectionMutation object of the builder.
+func (_u *SSOConnectionUpdateOne) Mutation() *SSOConnectionMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SSOConnectionUpdateOne) ClearOrganization() *SSOConnectionUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the SSOConnectionUpdate builder.
+func (_u *SSOConnectionUpdateOne) Where(ps ...predicate.SSOConnection) *SSOConnectionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SSOConnectionUpdateOne) Select(field string, fields ...string) *SSOConnectionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SSOConnection entity.
+func (_u *SSOConnectionUpdateOne) Save(ctx context.Context) (*SSOConnection, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOConnectionUpdateOne) SaveX(ctx context.Context) *SSOConnection {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SSOConnectionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOConnectionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SSOConnectionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ssoconnection.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOConnectionUpdateOne) check() error {
+	if v, ok := _u.mutation.Protocol(); ok {
+		if err := ssoconnection.ProtocolValidator(v); err != nil {
+			return &ValidationError{Name: "protocol", err: fmt.Errorf(`ent: validator failed for field "SSOConnection.protocol": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOConnection.organization"`)
+	}
+	return nil
+}
+
+func (_u *SSOConnectionUpdateOne) sqlSave(ctx context.Context) (_node *SSOConnection, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssoconnection.Table, ssoconnection.Columns, sqlgraph.NewFieldSpec(ssoconnection.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSOConnection.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssoconnection.FieldID)
+		for _, f := range fields {
+			if !ssoconnection.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ssoconnection.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Protocol(); ok {
+		_spec.SetField(ssoconnection.FieldProtocol, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Enabled(); ok {
+		_spec.SetField(ssoconnection.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.SamlIdpMetadata(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadata, field.TypeString, value)
+	}
+	if _u.mutation.SamlIdpMetadataCleared() {
+		_spec.ClearField(ssoconnection.FieldSamlIdpMetadata, field.TypeString)
+	}
+	if value, ok := _u.mutation.SamlIdpMetadataURL(); ok {
+		_spec.SetField(ssoconnection.FieldSamlIdpMetadataURL, field.TypeString, value)
+	}
+	if _u.mutation.SamlIdpMetadataURLCleared() {
+		_spec.ClearField(ssoconnection.FieldSamlIdpMetadataURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcIssuer(); ok {
+		_spec.SetField(ssoconnection.FieldOidcIssuer, field.TypeString, value)
+	}
+	if _u.mutation.OidcIssuerCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcIssuer, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcClientID(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientID, field.TypeString, value)
+	}
+	if _u.mutation.OidcClientIDCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcClientID, field.TypeString)
+	}
+	if value, ok := _u.mutation.OidcClientSecret(); ok {
+		_spec.SetField(ssoconnection.FieldOidcClientSecret, field.TypeString, value)
+	}
+	if _u.mutation.OidcClientSecretCleared() {
+		_spec.ClearField(ssoconnection.FieldOidcClientSecret, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ssoconnection.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ssoconnection.OrganizationTable,
+			Columns: []string{ssoconnection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2O,
+			Inverse: true,
+			Table:   ssoconnection.OrganizationTable,
+			Columns: []string{ssoconnection.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SSOConnection{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssoconnection.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,266 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// SSOLogin is the model entity for the SSOLogin schema.
+type SSOLogin struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// StateHash holds the value of the "state_hash" field.
+	StateHash string `json:"-"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID string `json:"request_id,omitempty"`
+	// CodeVerifier holds the value of the "code_verifier" field.
+	CodeVerifier string `json:"-"`
+	// RedirectURL holds the value of the "redirect_url" field.
+	RedirectURL string `json:"redirect_url,omitempty"`
+	// CodeHash holds the value of the "code_hash" field.
+	CodeHash *string `json:"-"`
+	// ExpiresAt holds the value of the "expires_at" field.
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// UsedAt holds the value of the "used_at" field.
+	UsedAt *time.Time `json:"used_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SSOLoginQuery when eager-loading is set.
+	Edges                   SSOLoginEdges `json:"edges"`
+	organization_sso_logins *int
+	user_sso_logins         *int
+	selectValues            sql.SelectValues
+}
+
+// SSOLoginEdges holds the relations/edges for other nodes in the graph.
+type SSOLoginEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SSOLoginEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SSOLoginEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SSOLogin) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ssologin.FieldID:
+			values[i] = new(sql.NullInt64)
+		case ssologin.FieldStateHash, ssologin.FieldRequestID, ssologin.FieldCodeVerifier, ssologin.FieldRedirectURL, ssologin.FieldCodeHash:
+			values[i] = new(sql.NullString)
+		case ssologin.FieldExpiresAt, ssologin.FieldCompletedAt, ssologin.FieldUsedAt, ssologin.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case ssologin.ForeignKeys[0]: // organization_sso_logins
+			values[i] = new(sql.NullInt64)
+		case ssologin.ForeignKeys[1]: // user_sso_logins
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SSOLogin fields.
+func (_m *SSOLogin) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ssologin.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			_m.ID = int(value.Int64)
+		case ssologin.FieldStateHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state_hash", values[i])
+			} else if value.Valid {
+				_m.StateHash = value.String
+			}
+		case ssologin.FieldRequestID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value.Valid {
+				_m.RequestID = value.String
+			}
+		case ssologin.FieldCodeVerifier:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_verifier", values[i])
+			} else if value.Valid {
+				_m.CodeVerifier = value.String
+			}
+		case ssologin.FieldRedirectURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field redirect_url", values[i])
+			} else if value.Valid {
+				_m.RedirectURL = value.String
+			}
+		case ssologin.FieldCodeHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code_hash", values[i])
+			} else if value.Valid {
+				_m.CodeHash = new(string)
+				*_m.CodeHash = value.String
+			}
+		case ssologin.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case ssologin.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case ssologin.FieldUsedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field used_at", values[i])
+			} else if value.Valid {
+				_m.UsedAt = new(time.Time)
+				*_m.UsedAt = value.Time
+			}
+		case ssologin.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ssologin.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field organization_sso_logins", value)
+			} else if value.Valid {
+				_m.organization_sso_logins = new(int)
+				*_m.organization_sso_logins = int(value.Int64)
+			}
+		case ssologin.ForeignKeys[1]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field user_sso_logins", value)
+			} else if value.Valid {
+				_m.user_sso_logins = new(int)
+				*_m.user_sso_logins = int(value.Int64)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SSOLogin.
+// This includes values selected through modifiers, order, etc.
+func (_m *SSOLogin) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the SSOLogin entity.
+func (_m *SSOLogin) QueryOrganization() *OrganizationQuery {
+	return NewSSOLoginClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryUser queries the "user" edge of the SSOLogin entity.
+func (_m *SSOLogin) QueryUser() *UserQuery {
+	return NewSSOLoginClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this SSOLogin.
+// Note that you need to call SSOLogin.Unwrap() before calling this method if this SSOLogin
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *SSOLogin) Update() *SSOLoginUpdateOne {
+	return NewSSOLoginClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the SSOLogin entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *SSOLogin) Unwrap() *SSOLogin {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SSOLogin is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *SSOLogin) String() string {
+	var builder strings.Builder
+	builder.WriteString("SSOLogin(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("state_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(_m.RequestID)
+	builder.WriteString(", ")
+	builder.WriteString("code_verifier=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("redirect_url=")
+	builder.WriteString(_m.RedirectURL)
+	builder.WriteString(", ")
+	builder.WriteString("code_hash=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UsedAt; v != nil {
+		builder.WriteString("used_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SSOLogins is a parsable slice of SSOLogin.
+type SSOLogins []*SSOLogin
@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ssologin
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldID, id))
+}
+
+// StateHash applies equality check predicate on the "state_hash" field. It's identical to StateHashEQ.
+func StateHash(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldStateHash, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CodeVerifier applies equality check predicate on the "code_verifier" field. It's identical to CodeVerifierEQ.
+func CodeVerifier(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// RedirectURL applies equality check predicate on the "redirect_url" field. It's identical to RedirectURLEQ.
+func RedirectURL(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// CodeHash applies equality check predicate on the "code_hash" field. It's identical to CodeHashEQ.
+func CodeHash(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// UsedAt applies equality check predicate on the "used_at" field. It's identical to UsedAtEQ.
+func UsedAt(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StateHashEQ applies the EQ predicate on the "state_hash" field.
+func StateHashEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldStateHash, v))
+}
+
+// StateHashNEQ applies the NEQ predicate on the "state_hash" field.
+func StateHashNEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldStateHash, v))
+}
+
+// StateHashIn applies the In predicate on the "state_hash" field.
+func StateHashIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldStateHash, vs...))
+}
+
+// StateHashNotIn applies the NotIn predicate on the "state_hash" field.
+func StateHashNotIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldStateHash, vs...))
+}
+
+// StateHashGT applies the GT predicate on the "state_hash" field.
+func StateHashGT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldStateHash, v))
+}
+
+// StateHashGTE applies the GTE predicate on the "state_hash" field.
+func StateHashGTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldStateHash, v))
+}
+
+// StateHashLT applies the LT predicate on the "state_hash" field.
+func StateHashLT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldStateHash, v))
+}
+
+// StateHashLTE applies the LTE predicate on the "state_hash" field.
+func StateHashLTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldStateHash, v))
+}
+
+// StateHashContains applies the Contains predicate on the "state_hash" field.
+func StateHashContains(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContains(FieldStateHash, v))
+}
+
+// StateHashHasPrefix applies the HasPrefix predicate on the "state_hash" field.
+func StateHashHasPrefix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasPrefix(FieldStateHash, v))
+}
+
+// StateHashHasSuffix applies the HasSuffix predicate on the "state_hash" field.
+func StateHashHasSuffix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasSuffix(FieldStateHash, v))
+}
+
+// StateHashEqualFold applies the EqualFold predicate on the "state_hash" field.
+func StateHashEqualFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEqualFold(FieldStateHash, v))
+}
+
+// StateHashContainsFold applies the ContainsFold predicate on the "state_hash" field.
+func StateHashContainsFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContainsFold(FieldStateHash, v))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldRequestID, v))
+}
+
+// RequestIDContains applies the Contains predicate on the "request_id" field.
+func RequestIDContains(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContains(FieldRequestID, v))
+}
+
+// RequestIDHasPrefix applies the HasPrefix predicate on the "request_id" field.
+func RequestIDHasPrefix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasPrefix(FieldRequestID, v))
+}
+
+// RequestIDHasSuffix applies the HasSuffix predicate on the "request_id" field.
+func RequestIDHasSuffix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasSuffix(FieldRequestID, v))
+}
+
+// RequestIDEqualFold applies the EqualFold predicate on the "request_id" field.
+func RequestIDEqualFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEqualFold(FieldRequestID, v))
+}
+
+// RequestIDContainsFold applies the ContainsFold predicate on the "request_id" field.
+func RequestIDContainsFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContainsFold(FieldRequestID, v))
+}
+
+// CodeVerifierEQ applies the EQ predicate on the "code_verifier" field.
+func CodeVerifierEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierNEQ applies the NEQ predicate on the "code_verifier" field.
+func CodeVerifierNEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIn applies the In predicate on the "code_verifier" field.
+func CodeVerifierIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierNotIn applies the NotIn predicate on the "code_verifier" field.
+func CodeVerifierNotIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldCodeVerifier, vs...))
+}
+
+// CodeVerifierGT applies the GT predicate on the "code_verifier" field.
+func CodeVerifierGT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierGTE applies the GTE predicate on the "code_verifier" field.
+func CodeVerifierGTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLT applies the LT predicate on the "code_verifier" field.
+func CodeVerifierLT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldCodeVerifier, v))
+}
+
+// CodeVerifierLTE applies the LTE predicate on the "code_verifier" field.
+func CodeVerifierLTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContains applies the Contains predicate on the "code_verifier" field.
+func CodeVerifierContains(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContains(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasPrefix applies the HasPrefix predicate on the "code_verifier" field.
+func CodeVerifierHasPrefix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasPrefix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierHasSuffix applies the HasSuffix predicate on the "code_verifier" field.
+func CodeVerifierHasSuffix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasSuffix(FieldCodeVerifier, v))
+}
+
+// CodeVerifierIsNil applies the IsNil predicate on the "code_verifier" field.
+func CodeVerifierIsNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIsNull(FieldCodeVerifier))
+}
+
+// CodeVerifierNotNil applies the NotNil predicate on the "code_verifier" field.
+func CodeVerifierNotNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotNull(FieldCodeVerifier))
+}
+
+// CodeVerifierEqualFold applies the EqualFold predicate on the "code_verifier" field.
+func CodeVerifierEqualFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEqualFold(FieldCodeVerifier, v))
+}
+
+// CodeVerifierContainsFold applies the ContainsFold predicate on the "code_verifier" field.
+func CodeVerifierContainsFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContainsFold(FieldCodeVerifier, v))
+}
+
+// RedirectURLEQ applies the EQ predicate on the "redirect_url" field.
+func RedirectURLEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLNEQ applies the NEQ predicate on the "redirect_url" field.
+func RedirectURLNEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldRedirectURL, v))
+}
+
+// RedirectURLIn applies the In predicate on the "redirect_url" field.
+func RedirectURLIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLNotIn applies the NotIn predicate on the "redirect_url" field.
+func RedirectURLNotIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldRedirectURL, vs...))
+}
+
+// RedirectURLGT applies the GT predicate on the "redirect_url" field.
+func RedirectURLGT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldRedirectURL, v))
+}
+
+// RedirectURLGTE applies the GTE predicate on the "redirect_url" field.
+func RedirectURLGTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldRedirectURL, v))
+}
+
+// RedirectURLLT applies the LT predicate on the "redirect_url" field.
+func RedirectURLLT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldRedirectURL, v))
+}
+
+// RedirectURLLTE applies the LTE predicate on the "redirect_url" field.
+func RedirectURLLTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldRedirectURL, v))
+}
+
+// RedirectURLContains applies the Contains predicate on the "redirect_url" field.
+func RedirectURLContains(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContains(FieldRedirectURL, v))
+}
+
+// RedirectURLHasPrefix applies the HasPrefix predicate on the "redirect_url" field.
+func RedirectURLHasPrefix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasPrefix(FieldRedirectURL, v))
+}
+
+// RedirectURLHasSuffix applies the HasSuffix predicate on the "redirect_url" field.
+func RedirectURLHasSuffix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasSuffix(FieldRedirectURL, v))
+}
+
+// RedirectURLEqualFold applies the EqualFold predicate on the "redirect_url" field.
+func RedirectURLEqualFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEqualFold(FieldRedirectURL, v))
+}
+
+// RedirectURLContainsFold applies the ContainsFold predicate on the "redirect_url" field.
+func RedirectURLContainsFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContainsFold(FieldRedirectURL, v))
+}
+
+// CodeHashEQ applies the EQ predicate on the "code_hash" field.
+func CodeHashEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCodeHash, v))
+}
+
+// CodeHashNEQ applies the NEQ predicate on the "code_hash" field.
+func CodeHashNEQ(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldCodeHash, v))
+}
+
+// CodeHashIn applies the In predicate on the "code_hash" field.
+func CodeHashIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldCodeHash, vs...))
+}
+
+// CodeHashNotIn applies the NotIn predicate on the "code_hash" field.
+func CodeHashNotIn(vs ...string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldCodeHash, vs...))
+}
+
+// CodeHashGT applies the GT predicate on the "code_hash" field.
+func CodeHashGT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldCodeHash, v))
+}
+
+// CodeHashGTE applies the GTE predicate on the "code_hash" field.
+func CodeHashGTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldCodeHash, v))
+}
+
+// CodeHashLT applies the LT predicate on the "code_hash" field.
+func CodeHashLT(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldCodeHash, v))
+}
+
+// CodeHashLTE applies the LTE predicate on the "code_hash" field.
+func CodeHashLTE(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldCodeHash, v))
+}
+
+// CodeHashContains applies the Contains predicate on the "code_hash" field.
+func CodeHashContains(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContains(FieldCodeHash, v))
+}
+
+// CodeHashHasPrefix applies the HasPrefix predicate on the "code_hash" field.
+func CodeHashHasPrefix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasPrefix(FieldCodeHash, v))
+}
+
+// CodeHashHasSuffix applies the HasSuffix predicate on the "code_hash" field.
+func CodeHashHasSuffix(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldHasSuffix(FieldCodeHash, v))
+}
+
+// CodeHashIsNil applies the IsNil predicate on the "code_hash" field.
+func CodeHashIsNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIsNull(FieldCodeHash))
+}
+
+// CodeHashNotNil applies the NotNil predicate on the "code_hash" field.
+func CodeHashNotNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotNull(FieldCodeHash))
+}
+
+// CodeHashEqualFold applies the EqualFold predicate on the "code_hash" field.
+func CodeHashEqualFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEqualFold(FieldCodeHash, v))
+}
+
+// CodeHashContainsFold applies the ContainsFold predicate on the "code_hash" field.
+func CodeHashContainsFold(v string) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldContainsFold(FieldCodeHash, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// UsedAtEQ applies the EQ predicate on the "used_at" field.
+func UsedAtEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldUsedAt, v))
+}
+
+// UsedAtNEQ applies the NEQ predicate on the "used_at" field.
+func UsedAtNEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldUsedAt, v))
+}
+
+// UsedAtIn applies the In predicate on the "used_at" field.
+func UsedAtIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldUsedAt, vs...))
+}
+
+// UsedAtNotIn applies the NotIn predicate on the "used_at" field.
+func UsedAtNotIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldUsedAt, vs...))
+}
+
+// UsedAtGT applies the GT predicate on the "used_at" field.
+func UsedAtGT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldUsedAt, v))
+}
+
+// UsedAtGTE applies the GTE predicate on the "used_at" field.
+func UsedAtGTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldUsedAt, v))
+}
+
+// UsedAtLT applies the LT predicate on the "used_at" field.
+func UsedAtLT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldUsedAt, v))
+}
+
+// UsedAtLTE applies the LTE predicate on the "used_at" field.
+func UsedAtLTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldUsedAt, v))
+}
+
+// UsedAtIsNil applies the IsNil predicate on the "used_at" field.
+func UsedAtIsNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIsNull(FieldUsedAt))
+}
+
+// UsedAtNotNil applies the NotNil predicate on the "used_at" field.
+func UsedAtNotNil() predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotNull(FieldUsedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.SSOLogin {
+	return predicate.SSOLogin(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.SSOLogin {
+	return predicate.SSOLogin(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.SSOLogin {
+	return predicate.SSOLogin(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.SSOLogin {
+	return predicate.SSOLogin(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.SSOLogin) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.SSOLogin) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.SSOLogin) predicate.SSOLogin {
+	return predicate.SSOLogin(sql.NotPredicates(p))
+}
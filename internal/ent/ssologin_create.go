@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// SSOLoginCreate is the builder for creating a SSOLogin entity.
+type SSOLoginCreate struct {
+	config
+	mutation *SSOLoginMutation
+	hooks    []Hook
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_c *SSOLoginCreate) SetStateHash(v string) *SSOLoginCreate {
+	_c.mutation.SetStateHash(v)
+	return _c
+}
+
+// SetRequestID sets the "request_id" field.
+func (_c *SSOLoginCreate) SetRequestID(v string) *SSOLoginCreate {
+	_c.mutation.SetRequestID(v)
+	return _c
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_c *SSOLoginCreate) SetCodeVerifier(v string) *SSOLoginCreate {
+	_c.mutation.SetCodeVerifier(v)
+	return _c
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableCodeVerifier(v *string) *SSOLoginCreate {
+	if v != nil {
+		_c.SetCodeVerifier(*v)
+	}
+	return _c
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_c *SSOLoginCreate) SetRedirectURL(v string) *SSOLoginCreate {
+	_c.mutation.SetRedirectURL(v)
+	return _c
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_c *SSOLoginCreate) SetCodeHash(v string) *SSOLoginCreate {
+	_c.mutation.SetCodeHash(v)
+	return _c
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableCodeHash(v *string) *SSOLoginCreate {
+	if v != nil {
+		_c.SetCodeHash(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *SSOLoginCreate) SetExpiresAt(v time.Time) *SSOLoginCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *SSOLoginCreate) SetCompletedAt(v time.Time) *SSOLoginCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableCompletedAt(v *time.Time) *SSOLoginCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_c *SSOLoginCreate) SetUsedAt(v time.Time) *SSOLoginCreate {
+	_c.mutation.SetUsedAt(v)
+	return _c
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableUsedAt(v *time.Time) *SSOLoginCreate {
+	if v != nil {
+		_c.SetUsedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SSOLoginCreate) SetCreatedAt(v time.Time) *SSOLoginCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableCreatedAt(v *time.Time) *SSOLoginCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_c *SSOLoginCreate) SetOrganizationID(id int) *SSOLoginCreate {
+	_c.mutation.SetOrganizationID(id)
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *SSOLoginCreate) SetOrganization(v *Organization) *SSOLoginCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_c *SSOLoginCreate) SetUserID(id int) *SSOLoginCreate {
+	_c.mutation.SetUserID(id)
+	return _c
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_c *SSOLoginCreate) SetNillableUserID(id *int) *SSOLoginCreate {
+	if id != nil {
+		_c = _c.SetUserID(*id)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *SSOLoginCreate) SetUser(v *User) *SSOLoginCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the SSOLoginMutation object of the builder.
+func (_c *SSOLoginCreate) Mutation() *SSOLoginMutation {
+	return _c.mutation
+}
+
+// Save creates the SSOLogin in the database.
+func (_c *SSOLoginCreate) Save(ctx context.Context) (*SSOLogin, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SSOLoginCreate) SaveX(ctx context.Context) *SSOLogin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOLoginCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOLoginCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SSOLoginCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ssologin.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SSOLoginCreate) check() error {
+	if _, ok := _c.mutation.StateHash(); !ok {
+		return &ValidationError{Name: "state_hash", err: errors.New(`ent: missing required field "SSOLogin.state_hash"`)}
+	}
+	if v, ok := _c.mutation.StateHash(); ok {
+		if err := ssologin.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.state_hash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`ent: missing required field "SSOLogin.request_id"`)}
+	}
+	if v, ok := _c.mutation.RequestID(); ok {
+		if err := ssologin.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.request_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RedirectURL(); !ok {
+		return &ValidationError{Name: "redirect_url", err: errors.New(`ent: missing required field "SSOLogin.redirect_url"`)}
+	}
+	if v, ok := _c.mutation.RedirectURL(); ok {
+		if err := ssologin.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.redirect_url": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "SSOLogin.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SSOLogin.created_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "SSOLogin.organization"`)}
+	}
+	return nil
+}
+
+func (_c *SSOLoginCreate) sqlSave(ctx context.Context) (*SSOLogin, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SSOLoginCreate) createSpec() (*SSOLogin, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SSOLogin{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ssologin.Table, sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StateHash(); ok {
+		_spec.SetField(ssologin.FieldStateHash, field.TypeString, value)
+		_node.StateHash = value
+	}
+	if value, ok := _c.mutation.RequestID(); ok {
+		_spec.SetField(ssologin.FieldRequestID, field.TypeString, value)
+		_node.RequestID = value
+	}
+	if value, ok := _c.mutation.CodeVerifier(); ok {
+		_spec.SetField(ssologin.FieldCodeVerifier, field.TypeString, value)
+		_node.CodeVerifier = value
+	}
+	if value, ok := _c.mutation.RedirectURL(); ok {
+		_spec.SetField(ssologin.FieldRedirectURL, field.TypeString, value)
+		_node.RedirectURL = value
+	}
+	if value, ok := _c.mutation.CodeHash(); ok {
+		_spec.SetField(ssologin.FieldCodeHash, field.TypeString, value)
+		_node.CodeHash = &value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(ssologin.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(ssologin.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.UsedAt(); ok {
+		_spec.SetField(ssologin.FieldUsedAt, field.TypeTime, value)
+		_node.UsedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ssologin.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.OrganizationTable,
+			Columns: []string{ssologin.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.organization_sso_logins = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.UserTable,
+			Columns: []string{ssologin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.user_sso_logins = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SSOLoginCreateBulk is the builder for creating many SSOLogin entities in bulk.
+type SSOLoginCreateBulk struct {
+	config
+	err      error
+	builders []*SSOLoginCreate
+}
+
+// Save creates the SSOLogin entities in the database.
+func (_c *SSOLoginCreateBulk) Save(ctx context.Context) ([]*SSOLogin, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*SSOLogin, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SSOLoginMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SSOLoginCreateBulk) SaveX(ctx context.Context) []*SSOLogin {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SSOLoginCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SSOLoginCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,774 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// SSOLoginUpdate is the builder for updating SSOLogin entities.
+type SSOLoginUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SSOLoginMutation
+}
+
+// Where appends a list predicates to the SSOLoginUpdate builder.
+func (_u *SSOLoginUpdate) Where(ps ...predicate.SSOLogin) *SSOLoginUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_u *SSOLoginUpdate) SetStateHash(v string) *SSOLoginUpdate {
+	_u.mutation.SetStateHash(v)
+	return _u
+}
+
+// SetNillableStateHash sets the "state_hash" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableStateHash(v *string) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetStateHash(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *SSOLoginUpdate) SetRequestID(v string) *SSOLoginUpdate {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableRequestID(v *string) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_u *SSOLoginUpdate) SetCodeVerifier(v string) *SSOLoginUpdate {
+	_u.mutation.SetCodeVerifier(v)
+	return _u
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableCodeVerifier(v *string) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetCodeVerifier(*v)
+	}
+	return _u
+}
+
+// ClearCodeVerifier clears the value of the "code_verifier" field.
+func (_u *SSOLoginUpdate) ClearCodeVerifier() *SSOLoginUpdate {
+	_u.mutation.ClearCodeVerifier()
+	return _u
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_u *SSOLoginUpdate) SetRedirectURL(v string) *SSOLoginUpdate {
+	_u.mutation.SetRedirectURL(v)
+	return _u
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableRedirectURL(v *string) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetRedirectURL(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *SSOLoginUpdate) SetCodeHash(v string) *SSOLoginUpdate {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableCodeHash(v *string) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearCodeHash clears the value of the "code_hash" field.
+func (_u *SSOLoginUpdate) ClearCodeHash() *SSOLoginUpdate {
+	_u.mutation.ClearCodeHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SSOLoginUpdate) SetExpiresAt(v time.Time) *SSOLoginUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableExpiresAt(v *time.Time) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SSOLoginUpdate) SetCompletedAt(v time.Time) *SSOLoginUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableCompletedAt(v *time.Time) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SSOLoginUpdate) ClearCompletedAt() *SSOLoginUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *SSOLoginUpdate) SetUsedAt(v time.Time) *SSOLoginUpdate {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableUsedAt(v *time.Time) *SSOLoginUpdate {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *SSOLoginUpdate) ClearUsedAt() *SSOLoginUpdate {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SSOLoginUpdate) SetOrganizationID(id int) *SSOLoginUpdate {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SSOLoginUpdate) SetOrganization(v *Organization) *SSOLoginUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *SSOLoginUpdate) SetUserID(id int) *SSOLoginUpdate {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_u *SSOLoginUpdate) SetNillableUserID(id *int) *SSOLoginUpdate {
+	if id != nil {
+		_u = _u.SetUserID(*id)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SSOLoginUpdate) SetUser(v *User) *SSOLoginUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the SSOLoginMutation object of the builder.
+func (_u *SSOLoginUpdate) Mutation() *SSOLoginMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SSOLoginUpdate) ClearOrganization() *SSOLoginUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SSOLoginUpdate) ClearUser() *SSOLoginUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SSOLoginUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOLoginUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SSOLoginUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOLoginUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOLoginUpdate) check() error {
+	if v, ok := _u.mutation.StateHash(); ok {
+		if err := ssologin.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.state_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestID(); ok {
+		if err := ssologin.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURL(); ok {
+		if err := ssologin.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.redirect_url": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOLogin.organization"`)
+	}
+	return nil
+}
+
+func (_u *SSOLoginUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssologin.Table, ssologin.Columns, sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StateHash(); ok {
+		_spec.SetField(ssologin.FieldStateHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(ssologin.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeVerifier(); ok {
+		_spec.SetField(ssologin.FieldCodeVerifier, field.TypeString, value)
+	}
+	if _u.mutation.CodeVerifierCleared() {
+		_spec.ClearField(ssologin.FieldCodeVerifier, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectURL(); ok {
+		_spec.SetField(ssologin.FieldRedirectURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(ssologin.FieldCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(ssologin.FieldCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ssologin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(ssologin.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(ssologin.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(ssologin.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(ssologin.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.OrganizationTable,
+			Columns: []string{ssologin.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.OrganizationTable,
+			Columns: []string{ssologin.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.UserTable,
+			Columns: []string{ssologin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.UserTable,
+			Columns: []string{ssologin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssologin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SSOLoginUpdateOne is the builder for updating a single SSOLogin entity.
+type SSOLoginUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SSOLoginMutation
+}
+
+// SetStateHash sets the "state_hash" field.
+func (_u *SSOLoginUpdateOne) SetStateHash(v string) *SSOLoginUpdateOne {
+	_u.mutation.SetStateHash(v)
+	return _u
+}
+
+// SetNillableStateHash sets the "state_hash" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableStateHash(v *string) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetStateHash(*v)
+	}
+	return _u
+}
+
+// SetRequestID sets the "request_id" field.
+func (_u *SSOLoginUpdateOne) SetRequestID(v string) *SSOLoginUpdateOne {
+	_u.mutation.SetRequestID(v)
+	return _u
+}
+
+// SetNillableRequestID sets the "request_id" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableRequestID(v *string) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetRequestID(*v)
+	}
+	return _u
+}
+
+// SetCodeVerifier sets the "code_verifier" field.
+func (_u *SSOLoginUpdateOne) SetCodeVerifier(v string) *SSOLoginUpdateOne {
+	_u.mutation.SetCodeVerifier(v)
+	return _u
+}
+
+// SetNillableCodeVerifier sets the "code_verifier" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableCodeVerifier(v *string) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetCodeVerifier(*v)
+	}
+	return _u
+}
+
+// ClearCodeVerifier clears the value of the "code_verifier" field.
+func (_u *SSOLoginUpdateOne) ClearCodeVerifier() *SSOLoginUpdateOne {
+	_u.mutation.ClearCodeVerifier()
+	return _u
+}
+
+// SetRedirectURL sets the "redirect_url" field.
+func (_u *SSOLoginUpdateOne) SetRedirectURL(v string) *SSOLoginUpdateOne {
+	_u.mutation.SetRedirectURL(v)
+	return _u
+}
+
+// SetNillableRedirectURL sets the "redirect_url" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableRedirectURL(v *string) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetRedirectURL(*v)
+	}
+	return _u
+}
+
+// SetCodeHash sets the "code_hash" field.
+func (_u *SSOLoginUpdateOne) SetCodeHash(v string) *SSOLoginUpdateOne {
+	_u.mutation.SetCodeHash(v)
+	return _u
+}
+
+// SetNillableCodeHash sets the "code_hash" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableCodeHash(v *string) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetCodeHash(*v)
+	}
+	return _u
+}
+
+// ClearCodeHash clears the value of the "code_hash" field.
+func (_u *SSOLoginUpdateOne) ClearCodeHash() *SSOLoginUpdateOne {
+	_u.mutation.ClearCodeHash()
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *SSOLoginUpdateOne) SetExpiresAt(v time.Time) *SSOLoginUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableExpiresAt(v *time.Time) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *SSOLoginUpdateOne) SetCompletedAt(v time.Time) *SSOLoginUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableCompletedAt(v *time.Time) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *SSOLoginUpdateOne) ClearCompletedAt() *SSOLoginUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUsedAt sets the "used_at" field.
+func (_u *SSOLoginUpdateOne) SetUsedAt(v time.Time) *SSOLoginUpdateOne {
+	_u.mutation.SetUsedAt(v)
+	return _u
+}
+
+// SetNillableUsedAt sets the "used_at" field if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableUsedAt(v *time.Time) *SSOLoginUpdateOne {
+	if v != nil {
+		_u.SetUsedAt(*v)
+	}
+	return _u
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (_u *SSOLoginUpdateOne) ClearUsedAt() *SSOLoginUpdateOne {
+	_u.mutation.ClearUsedAt()
+	return _u
+}
+
+// SetOrganizationID sets the "organization" edge to the Organization entity by ID.
+func (_u *SSOLoginUpdateOne) SetOrganizationID(id int) *SSOLoginUpdateOne {
+	_u.mutation.SetOrganizationID(id)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *SSOLoginUpdateOne) SetOrganization(v *Organization) *SSOLoginUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// SetUserID sets the "user" edge to the User entity by ID.
+func (_u *SSOLoginUpdateOne) SetUserID(id int) *SSOLoginUpdateOne {
+	_u.mutation.SetUserID(id)
+	return _u
+}
+
+// SetNillableUserID sets the "user" edge to the User entity by ID if the given value is not nil.
+func (_u *SSOLoginUpdateOne) SetNillableUserID(id *int) *SSOLoginUpdateOne {
+	if id != nil {
+		_u = _u.SetUserID(*id)
+	}
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *SSOLoginUpdateOne) SetUser(v *User) *SSOLoginUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the SSOLoginMutation object of the builder.
+func (_u *SSOLoginUpdateOne) Mutation() *SSOLoginMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *SSOLoginUpdateOne) ClearOrganization() *SSOLoginUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *SSOLoginUpdateOne) ClearUser() *SSOLoginUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the SSOLoginUpdate builder.
+func (_u *SSOLoginUpdateOne) Where(ps ...predicate.SSOLogin) *SSOLoginUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SSOLoginUpdateOne) Select(field string, fields ...string) *SSOLoginUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated SSOLogin entity.
+func (_u *SSOLoginUpdateOne) Save(ctx context.Context) (*SSOLogin, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SSOLoginUpdateOne) SaveX(ctx context.Context) *SSOLogin {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SSOLoginUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SSOLoginUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SSOLoginUpdateOne) check() error {
+	if v, ok := _u.mutation.StateHash(); ok {
+		if err := ssologin.StateHashValidator(v); err != nil {
+			return &ValidationError{Name: "state_hash", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.state_hash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RequestID(); ok {
+		if err := ssologin.RequestIDValidator(v); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.request_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RedirectURL(); ok {
+		if err := ssologin.RedirectURLValidator(v); err != nil {
+			return &ValidationError{Name: "redirect_url", err: fmt.Errorf(`ent: validator failed for field "SSOLogin.redirect_url": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "SSOLogin.organization"`)
+	}
+	return nil
+}
+
+func (_u *SSOLoginUpdateOne) sqlSave(ctx context.Context) (_node *SSOLogin, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ssologin.Table, ssologin.Columns, sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SSOLogin.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ssologin.FieldID)
+		for _, f := range fields {
+			if !ssologin.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ssologin.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StateHash(); ok {
+		_spec.SetField(ssologin.FieldStateHash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.RequestID(); ok {
+		_spec.SetField(ssologin.FieldRequestID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeVerifier(); ok {
+		_spec.SetField(ssologin.FieldCodeVerifier, field.TypeString, value)
+	}
+	if _u.mutation.CodeVerifierCleared() {
+		_spec.ClearField(ssologin.FieldCodeVerifier, field.TypeString)
+	}
+	if value, ok := _u.mutation.RedirectURL(); ok {
+		_spec.SetField(ssologin.FieldRedirectURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.CodeHash(); ok {
+		_spec.SetField(ssologin.FieldCodeHash, field.TypeString, value)
+	}
+	if _u.mutation.CodeHashCleared() {
+		_spec.ClearField(ssologin.FieldCodeHash, field.TypeString)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ssologin.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(ssologin.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(ssologin.FieldCompletedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UsedAt(); ok {
+		_spec.SetField(ssologin.FieldUsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UsedAtCleared() {
+		_spec.ClearField(ssologin.FieldUsedAt, field.TypeTime)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.OrganizationTable,
+			Columns: []string{ssologin.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.OrganizationTable,
+			Columns: []string{ssologin.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.UserTable,
+			Columns: []string{ssologin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ssologin.UserTable,
+			Columns: []string{ssologin.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &SSOLogin{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ssologin.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -31,8 +31,12 @@ type Subscription struct {
 	CurrentPeriodEnd *time.Time `json:"current_period_end,omitempty"`
 	// CanceledAt holds the value of the "canceled_at" field.
 	CanceledAt *time.Time `json:"canceled_at,omitempty"`
+	// CancelAtPeriodEnd holds the value of the "cancel_at_period_end" field.
+	CancelAtPeriodEnd bool `json:"cancel_at_period_end,omitempty"`
 	// TrialEnd holds the value of the "trial_end" field.
 	TrialEnd *time.Time `json:"trial_end,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity int `json:"quantity,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -111,7 +115,9 @@ func (*Subscription) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case subscription.FieldID:
+		case subscription.FieldCancelAtPeriodEnd:
+			values[i] = new(sql.NullBool)
+		case subscription.FieldID, subscription.FieldQuantity:
 			values[i] = new(sql.NullInt64)
 		case subscription.FieldStatus, subscription.FieldStripeSubscriptionID:
 			values[i] = new(sql.NullString)
@@ -179,6 +185,12 @@ func (_m *Subscription) assignValues(columns []string, values []any) error {
 				_m.CanceledAt = new(time.Time)
 				*_m.CanceledAt = value.Time
 			}
+		case subscription.FieldCancelAtPeriodEnd:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field cancel_at_period_end", values[i])
+			} else if value.Valid {
+				_m.CancelAtPeriodEnd = value.Bool
+			}
 		case subscription.FieldTrialEnd:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field trial_end", values[i])
@@ -186,6 +198,12 @@ func (_m *Subscription) assignValues(columns []string, values []any) error {
 				_m.TrialEnd = new(time.Time)
 				*_m.TrialEnd = value.Time
 			}
+		case subscription.FieldQuantity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value.Valid {
+				_m.Quantity = int(value.Int64)
+			}
 		case subscription.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -303,11 +321,17 @@ func (_m *Subscription) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("cancel_at_period_end=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancelAtPeriodEnd))
+	builder.WriteString(", ")
 	if v := _m.TrialEnd; v != nil {
 		builder.WriteString("trial_end=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
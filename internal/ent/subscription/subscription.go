@@ -25,8 +25,12 @@ const (
 	FieldCurrentPeriodEnd = "current_period_end"
 	// FieldCanceledAt holds the string denoting the canceled_at field in the database.
 	FieldCanceledAt = "canceled_at"
+	// FieldCancelAtPeriodEnd holds the string denoting the cancel_at_period_end field in the database.
+	FieldCancelAtPeriodEnd = "cancel_at_period_end"
 	// FieldTrialEnd holds the string denoting the trial_end field in the database.
 	FieldTrialEnd = "trial_end"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -79,7 +83,9 @@ var Columns = []string{
 	FieldCurrentPeriodStart,
 	FieldCurrentPeriodEnd,
 	FieldCanceledAt,
+	FieldCancelAtPeriodEnd,
 	FieldTrialEnd,
+	FieldQuantity,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -109,6 +115,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultCancelAtPeriodEnd holds the default value on creation for the "cancel_at_period_end" field.
+	DefaultCancelAtPeriodEnd bool
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity int
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -180,11 +190,21 @@ func ByCanceledAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCanceledAt, opts...).ToFunc()
 }
 
+// ByCancelAtPeriodEnd orders the results by the cancel_at_period_end field.
+func ByCancelAtPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancelAtPeriodEnd, opts...).ToFunc()
+}
+
 // ByTrialEnd orders the results by the trial_end field.
 func ByTrialEnd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTrialEnd, opts...).ToFunc()
 }
 
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -75,11 +75,21 @@ func CanceledAt(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldCanceledAt, v))
 }
 
+// CancelAtPeriodEnd applies equality check predicate on the "cancel_at_period_end" field. It's identical to CancelAtPeriodEndEQ.
+func CancelAtPeriodEnd(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
 // TrialEnd applies equality check predicate on the "trial_end" field. It's identical to TrialEndEQ.
 func TrialEnd(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldTrialEnd, v))
 }
 
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldQuantity, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
@@ -335,6 +345,16 @@ func CanceledAtNotNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotNull(FieldCanceledAt))
 }
 
+// CancelAtPeriodEndEQ applies the EQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldCancelAtPeriodEnd, v))
+}
+
+// CancelAtPeriodEndNEQ applies the NEQ predicate on the "cancel_at_period_end" field.
+func CancelAtPeriodEndNEQ(v bool) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldCancelAtPeriodEnd, v))
+}
+
 // TrialEndEQ applies the EQ predicate on the "trial_end" field.
 func TrialEndEQ(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldTrialEnd, v))
@@ -385,6 +405,46 @@ func TrialEndNotNil() predicate.Subscription {
 	return predicate.Subscription(sql.FieldNotNull(FieldTrialEnd))
 }
 
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v int) predicate.Subscription {
+	return predicate.Subscription(sql.FieldLTE(FieldQuantity, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Subscription {
 	return predicate.Subscription(sql.FieldEQ(FieldCreatedAt, v))
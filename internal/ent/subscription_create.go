@@ -94,6 +94,20 @@ func (_c *SubscriptionCreate) SetNillableCanceledAt(v *time.Time) *SubscriptionC
 	return _c
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_c *SubscriptionCreate) SetCancelAtPeriodEnd(v bool) *SubscriptionCreate {
+	_c.mutation.SetCancelAtPeriodEnd(v)
+	return _c
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionCreate {
+	if v != nil {
+		_c.SetCancelAtPeriodEnd(*v)
+	}
+	return _c
+}
+
 // SetTrialEnd sets the "trial_end" field.
 func (_c *SubscriptionCreate) SetTrialEnd(v time.Time) *SubscriptionCreate {
 	_c.mutation.SetTrialEnd(v)
@@ -108,6 +122,20 @@ func (_c *SubscriptionCreate) SetNillableTrialEnd(v *time.Time) *SubscriptionCre
 	return _c
 }
 
+// SetQuantity sets the "quantity" field.
+func (_c *SubscriptionCreate) SetQuantity(v int) *SubscriptionCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *SubscriptionCreate) SetNillableQuantity(v *int) *SubscriptionCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *SubscriptionCreate) SetCreatedAt(v time.Time) *SubscriptionCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -235,6 +263,14 @@ func (_c *SubscriptionCreate) defaults() {
 		v := subscription.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		v := subscription.DefaultCancelAtPeriodEnd
+		_c.mutation.SetCancelAtPeriodEnd(v)
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := subscription.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := subscription.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -255,6 +291,12 @@ func (_c *SubscriptionCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Subscription.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.CancelAtPeriodEnd(); !ok {
+		return &ValidationError{Name: "cancel_at_period_end", err: errors.New(`ent: missing required field "Subscription.cancel_at_period_end"`)}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Subscription.quantity"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Subscription.created_at"`)}
 	}
@@ -313,10 +355,18 @@ func (_c *SubscriptionCreate) createSpec() (*Subscription, *sqlgraph.CreateSpec)
 		_spec.SetField(subscription.FieldCanceledAt, field.TypeTime, value)
 		_node.CanceledAt = &value
 	}
+	if value, ok := _c.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+		_node.CancelAtPeriodEnd = value
+	}
 	if value, ok := _c.mutation.TrialEnd(); ok {
 		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
 		_node.TrialEnd = &value
 	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(subscription.FieldQuantity, field.TypeInt, value)
+		_node.Quantity = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(subscription.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -126,6 +126,20 @@ func (_u *SubscriptionUpdate) ClearCanceledAt() *SubscriptionUpdate {
 	return _u
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdate) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdate {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
 // SetTrialEnd sets the "trial_end" field.
 func (_u *SubscriptionUpdate) SetTrialEnd(v time.Time) *SubscriptionUpdate {
 	_u.mutation.SetTrialEnd(v)
@@ -146,6 +160,27 @@ func (_u *SubscriptionUpdate) ClearTrialEnd() *SubscriptionUpdate {
 	return _u
 }
 
+// SetQuantity sets the "quantity" field.
+func (_u *SubscriptionUpdate) SetQuantity(v int) *SubscriptionUpdate {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *SubscriptionUpdate) SetNillableQuantity(v *int) *SubscriptionUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *SubscriptionUpdate) AddQuantity(v int) *SubscriptionUpdate {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SubscriptionUpdate) SetUpdatedAt(v time.Time) *SubscriptionUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -332,12 +367,21 @@ func (_u *SubscriptionUpdate) sqlSave(ctx context.Context) (_node int, err error
 	if _u.mutation.CanceledAtCleared() {
 		_spec.ClearField(subscription.FieldCanceledAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.TrialEnd(); ok {
 		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
 	}
 	if _u.mutation.TrialEndCleared() {
 		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(subscription.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(subscription.FieldQuantity, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -571,6 +615,20 @@ func (_u *SubscriptionUpdateOne) ClearCanceledAt() *SubscriptionUpdateOne {
 	return _u
 }
 
+// SetCancelAtPeriodEnd sets the "cancel_at_period_end" field.
+func (_u *SubscriptionUpdateOne) SetCancelAtPeriodEnd(v bool) *SubscriptionUpdateOne {
+	_u.mutation.SetCancelAtPeriodEnd(v)
+	return _u
+}
+
+// SetNillableCancelAtPeriodEnd sets the "cancel_at_period_end" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableCancelAtPeriodEnd(v *bool) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetCancelAtPeriodEnd(*v)
+	}
+	return _u
+}
+
 // SetTrialEnd sets the "trial_end" field.
 func (_u *SubscriptionUpdateOne) SetTrialEnd(v time.Time) *SubscriptionUpdateOne {
 	_u.mutation.SetTrialEnd(v)
@@ -591,6 +649,27 @@ func (_u *SubscriptionUpdateOne) ClearTrialEnd() *SubscriptionUpdateOne {
 	return _u
 }
 
+// SetQuantity sets the "quantity" field.
+func (_u *SubscriptionUpdateOne) SetQuantity(v int) *SubscriptionUpdateOne {
+	_u.mutation.ResetQuantity()
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *SubscriptionUpdateOne) SetNillableQuantity(v *int) *SubscriptionUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// AddQuantity adds value to the "quantity" field.
+func (_u *SubscriptionUpdateOne) AddQuantity(v int) *SubscriptionUpdateOne {
+	_u.mutation.AddQuantity(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *SubscriptionUpdateOne) SetUpdatedAt(v time.Time) *SubscriptionUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -807,12 +886,21 @@ func (_u *SubscriptionUpdateOne) sqlSave(ctx context.Context) (_node *Subscripti
 	if _u.mutation.CanceledAtCleared() {
 		_spec.ClearField(subscription.FieldCanceledAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.CancelAtPeriodEnd(); ok {
+		_spec.SetField(subscription.FieldCancelAtPeriodEnd, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.TrialEnd(); ok {
 		_spec.SetField(subscription.FieldTrialEnd, field.TypeTime, value)
 	}
 	if _u.mutation.TrialEndCleared() {
 		_spec.ClearField(subscription.FieldTrialEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(subscription.FieldQuantity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedQuantity(); ok {
+		_spec.AddField(subscription.FieldQuantity, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(subscription.FieldUpdatedAt, field.TypeTime, value)
 	}
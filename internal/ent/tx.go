@@ -14,12 +14,18 @@ type Tx struct {
 	config
 	// Achievement is the client for interacting with the Achievement builders.
 	Achievement *AchievementClient
+	// AdminAccount is the client for interacting with the AdminAccount builders.
+	AdminAccount *AdminAccountClient
 	// App is the client for interacting with the App builders.
 	App *AppClient
 	// Assignment is the client for interacting with the Assignment builders.
 	Assignment *AssignmentClient
 	// AssignmentSubmission is the client for interacting with the AssignmentSubmission builders.
 	AssignmentSubmission *AssignmentSubmissionClient
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// AuthSession is the client for interacting with the AuthSession builders.
+	AuthSession *AuthSessionClient
 	// BattleRoom is the client for interacting with the BattleRoom builders.
 	BattleRoom *BattleRoomClient
 	// BattleSession is the client for interacting with the BattleSession builders.
@@ -36,14 +42,28 @@ type Tx struct {
 	LiveSession *LiveSessionClient
 	// LiveSessionStudent is the client for interacting with the LiveSessionStudent builders.
 	LiveSessionStudent *LiveSessionStudentClient
+	// OAuthAuthorizationCode is the client for interacting with the OAuthAuthorizationCode builders.
+	OAuthAuthorizationCode *OAuthAuthorizationCodeClient
+	// OAuthConsent is the client for interacting with the OAuthConsent builders.
+	OAuthConsent *OAuthConsentClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// OrganizationDomain is the client for interacting with the OrganizationDomain builders.
+	OrganizationDomain *OrganizationDomainClient
 	// OrganizationInvitation is the client for interacting with the OrganizationInvitation builders.
 	OrganizationInvitation *OrganizationInvitationClient
 	// OrganizationMember is the client for interacting with the OrganizationMember builders.
 	OrganizationMember *OrganizationMemberClient
 	// Plan is the client for interacting with the Plan builders.
 	Plan *PlanClient
+	// RateLimitBucket is the client for interacting with the RateLimitBucket builders.
+	RateLimitBucket *RateLimitBucketClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
+	// SSOConnection is the client for interacting with the SSOConnection builders.
+	SSOConnection *SSOConnectionClient
+	// SSOLogin is the client for interacting with the SSOLogin builders.
+	SSOLogin *SSOLoginClient
 	// ShenbiProfile is the client for interacting with the ShenbiProfile builders.
 	ShenbiProfile *ShenbiProfileClient
 	// ShenbiSettings is the client for interacting with the ShenbiSettings builders.
@@ -56,6 +76,14 @@ type Tx struct {
 	UserApp *UserAppClient
 	// UserProgress is the client for interacting with the UserProgress builders.
 	UserProgress *UserProgressClient
+	// VerificationToken is the client for interacting with the VerificationToken builders.
+	VerificationToken *VerificationTokenClient
+	// WebhookAttempt is the client for interacting with the WebhookAttempt builders.
+	WebhookAttempt *WebhookAttemptClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEvent is the client for interacting with the WebhookEvent builders.
+	WebhookEvent *WebhookEventClient
 
 	// lazily loaded.
 	client     *Client
@@ -188,9 +216,12 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Achievement = NewAchievementClient(tx.config)
+	tx.AdminAccount = NewAdminAccountClient(tx.config)
 	tx.App = NewAppClient(tx.config)
 	tx.Assignment = NewAssignmentClient(tx.config)
 	tx.AssignmentSubmission = NewAssignmentSubmissionClient(tx.config)
+	tx.AuditEvent = NewAuditEventClient(tx.config)
+	tx.AuthSession = NewAuthSessionClient(tx.config)
 	tx.BattleRoom = NewBattleRoomClient(tx.config)
 	tx.BattleSession = NewBattleSessionClient(tx.config)
 	tx.Classroom = NewClassroomClient(tx.config)
@@ -199,16 +230,27 @@ func (tx *Tx) init() {
 	tx.EmailTemplate = NewEmailTemplateClient(tx.config)
 	tx.LiveSession = NewLiveSessionClient(tx.config)
 	tx.LiveSessionStudent = NewLiveSessionStudentClient(tx.config)
+	tx.OAuthAuthorizationCode = NewOAuthAuthorizationCodeClient(tx.config)
+	tx.OAuthConsent = NewOAuthConsentClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OrganizationDomain = NewOrganizationDomainClient(tx.config)
 	tx.OrganizationInvitation = NewOrganizationInvitationClient(tx.config)
 	tx.OrganizationMember = NewOrganizationMemberClient(tx.config)
 	tx.Plan = NewPlanClient(tx.config)
+	tx.RateLimitBucket = NewRateLimitBucketClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
+	tx.SSOConnection = NewSSOConnectionClient(tx.config)
+	tx.SSOLogin = NewSSOLoginClient(tx.config)
 	tx.ShenbiProfile = NewShenbiProfileClient(tx.config)
 	tx.ShenbiSettings = NewShenbiSettingsClient(tx.config)
 	tx.Subscription = NewSubscriptionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserApp = NewUserAppClient(tx.config)
 	tx.UserProgress = NewUserProgressClient(tx.config)
+	tx.VerificationToken = NewVerificationTokenClient(tx.config)
+	tx.WebhookAttempt = NewWebhookAttemptClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEvent = NewWebhookEventClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -10,8 +10,6 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
-	"gigaboo.io/lem/internal/ent/shenbiprofile"
-	"gigaboo.io/lem/internal/ent/shenbisettings"
 	"gigaboo.io/lem/internal/ent/user"
 )
 
@@ -40,6 +38,14 @@ type User struct {
 	GoogleRefreshToken string `json:"-"`
 	// GoogleTokenExpiresAt holds the value of the "google_token_expires_at" field.
 	GoogleTokenExpiresAt *time.Time `json:"google_token_expires_at,omitempty"`
+	// TotpSecretEncrypted holds the value of the "totp_secret_encrypted" field.
+	TotpSecretEncrypted string `json:"-"`
+	// TotpLastCounter holds the value of the "totp_last_counter" field.
+	TotpLastCounter int64 `json:"totp_last_counter,omitempty"`
+	// MfaEnabled holds the value of the "mfa_enabled" field.
+	MfaEnabled bool `json:"mfa_enabled,omitempty"`
+	// MfaRecoveryCodes holds the value of the "mfa_recovery_codes" field.
+	MfaRecoveryCodes []string `json:"-"`
 	// IsActive holds the value of the "is_active" field.
 	IsActive bool `json:"is_active,omitempty"`
 	// IsVerified holds the value of the "is_verified" field.
@@ -67,7 +73,7 @@ type UserEdges struct {
 	// Subscriptions holds the value of the subscriptions edge.
 	Subscriptions []*Subscription `json:"subscriptions,omitempty"`
 	// ShenbiProfile holds the value of the shenbi_profile edge.
-	ShenbiProfile *ShenbiProfile `json:"shenbi_profile,omitempty"`
+	ShenbiProfile []*ShenbiProfile `json:"shenbi_profile,omitempty"`
 	// ClassroomsTeaching holds the value of the classrooms_teaching edge.
 	ClassroomsTeaching []*Classroom `json:"classrooms_teaching,omitempty"`
 	// ClassroomMemberships holds the value of the classroom_memberships edge.
@@ -89,12 +95,22 @@ type UserEdges struct {
 	// ClassroomSessions holds the value of the classroom_sessions edge.
 	ClassroomSessions []*ClassroomSession `json:"classroom_sessions,omitempty"`
 	// ShenbiSettings holds the value of the shenbi_settings edge.
-	ShenbiSettings *ShenbiSettings `json:"shenbi_settings,omitempty"`
+	ShenbiSettings []*ShenbiSettings `json:"shenbi_settings,omitempty"`
 	// SentInvitations holds the value of the sent_invitations edge.
 	SentInvitations []*OrganizationInvitation `json:"sent_invitations,omitempty"`
+	// AuthSessions holds the value of the auth_sessions edge.
+	AuthSessions []*AuthSession `json:"auth_sessions,omitempty"`
+	// VerificationTokens holds the value of the verification_tokens edge.
+	VerificationTokens []*VerificationToken `json:"verification_tokens,omitempty"`
+	// OauthConsents holds the value of the oauth_consents edge.
+	OauthConsents []*OAuthConsent `json:"oauth_consents,omitempty"`
+	// OauthAuthorizationCodes holds the value of the oauth_authorization_codes edge.
+	OauthAuthorizationCodes []*OAuthAuthorizationCode `json:"oauth_authorization_codes,omitempty"`
+	// SSOLogins holds the value of the sso_logins edge.
+	SSOLogins []*SSOLogin `json:"sso_logins,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [16]bool
+	loadedTypes [21]bool
 }
 
 // UserAppsOrErr returns the UserApps value or an error if the edge
@@ -125,12 +141,10 @@ func (e UserEdges) SubscriptionsOrErr() ([]*Subscription, error) {
 }
 
 // ShenbiProfileOrErr returns the ShenbiProfile value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e UserEdges) ShenbiProfileOrErr() (*ShenbiProfile, error) {
-	if e.ShenbiProfile != nil {
+// was not loaded in eager-loading.
+func (e UserEdges) ShenbiProfileOrErr() ([]*ShenbiProfile, error) {
+	if e.loadedTypes[3] {
 		return e.ShenbiProfile, nil
-	} else if e.loadedTypes[3] {
-		return nil, &NotFoundError{label: shenbiprofile.Label}
 	}
 	return nil, &NotLoadedError{edge: "shenbi_profile"}
 }
@@ -226,12 +240,10 @@ func (e UserEdges) ClassroomSessionsOrErr() ([]*ClassroomSession, error) {
 }
 
 // ShenbiSettingsOrErr returns the ShenbiSettings value or an error if the edge
-// was not loaded in eager-loading, or loaded but was not found.
-func (e UserEdges) ShenbiSettingsOrErr() (*ShenbiSettings, error) {
-	if e.ShenbiSettings != nil {
+// was not loaded in eager-loading.
+func (e UserEdges) ShenbiSettingsOrErr() ([]*ShenbiSettings, error) {
+	if e.loadedTypes[14] {
 		return e.ShenbiSettings, nil
-	} else if e.loadedTypes[14] {
-		return nil, &NotFoundError{label: shenbisettings.Label}
 	}
 	return nil, &NotLoadedError{edge: "shenbi_settings"}
 }
@@ -245,18 +257,63 @@ func (e UserEdges) SentInvitationsOrErr() ([]*OrganizationInvitation, error) {
 	return nil, &NotLoadedError{edge: "sent_invitations"}
 }
 
+// AuthSessionsOrErr returns the AuthSessions value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AuthSessionsOrErr() ([]*AuthSession, error) {
+	if e.loadedTypes[16] {
+		return e.AuthSessions, nil
+	}
+	return nil, &NotLoadedError{edge: "auth_sessions"}
+}
+
+// VerificationTokensOrErr returns the VerificationTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) VerificationTokensOrErr() ([]*VerificationToken, error) {
+	if e.loadedTypes[17] {
+		return e.VerificationTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "verification_tokens"}
+}
+
+// OauthConsentsOrErr returns the OauthConsents value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthConsentsOrErr() ([]*OAuthConsent, error) {
+	if e.loadedTypes[18] {
+		return e.OauthConsents, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_consents"}
+}
+
+// OauthAuthorizationCodesOrErr returns the OauthAuthorizationCodes value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OauthAuthorizationCodesOrErr() ([]*OAuthAuthorizationCode, error) {
+	if e.loadedTypes[19] {
+		return e.OauthAuthorizationCodes, nil
+	}
+	return nil, &NotLoadedError{edge: "oauth_authorization_codes"}
+}
+
+// SSOLoginsOrErr returns the SSOLogins value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) SSOLoginsOrErr() ([]*SSOLogin, error) {
+	if e.loadedTypes[20] {
+		return e.SSOLogins, nil
+	}
+	return nil, &NotLoadedError{edge: "sso_logins"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldExtraData:
+		case user.FieldMfaRecoveryCodes, user.FieldExtraData:
 			values[i] = new([]byte)
-		case user.FieldIsActive, user.FieldIsVerified:
+		case user.FieldMfaEnabled, user.FieldIsActive, user.FieldIsVerified:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldTotpLastCounter:
 			values[i] = new(sql.NullInt64)
-		case user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldAvatarURL, user.FieldDeviceID, user.FieldGoogleID, user.FieldAppleID, user.FieldGoogleAccessToken, user.FieldGoogleRefreshToken:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldName, user.FieldAvatarURL, user.FieldDeviceID, user.FieldGoogleID, user.FieldAppleID, user.FieldGoogleAccessToken, user.FieldGoogleRefreshToken, user.FieldTotpSecretEncrypted:
 			values[i] = new(sql.NullString)
 		case user.FieldGoogleTokenExpiresAt, user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLoginAt:
 			values[i] = new(sql.NullTime)
@@ -345,6 +402,32 @@ func (_m *User) assignValues(columns []string, values []any) error {
 				_m.GoogleTokenExpiresAt = new(time.Time)
 				*_m.GoogleTokenExpiresAt = value.Time
 			}
+		case user.FieldTotpSecretEncrypted:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_secret_encrypted", values[i])
+			} else if value.Valid {
+				_m.TotpSecretEncrypted = value.String
+			}
+		case user.FieldTotpLastCounter:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field totp_last_counter", values[i])
+			} else if value.Valid {
+				_m.TotpLastCounter = value.Int64
+			}
+		case user.FieldMfaEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_enabled", values[i])
+			} else if value.Valid {
+				_m.MfaEnabled = value.Bool
+			}
+		case user.FieldMfaRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.MfaRecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field mfa_recovery_codes: %w", err)
+				}
+			}
 		case user.FieldIsActive:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_active", values[i])
@@ -477,6 +560,31 @@ func (_m *User) QuerySentInvitations() *OrganizationInvitationQuery {
 	return NewUserClient(_m.config).QuerySentInvitations(_m)
 }
 
+// QueryAuthSessions queries the "auth_sessions" edge of the User entity.
+func (_m *User) QueryAuthSessions() *AuthSessionQuery {
+	return NewUserClient(_m.config).QueryAuthSessions(_m)
+}
+
+// QueryVerificationTokens queries the "verification_tokens" edge of the User entity.
+func (_m *User) QueryVerificationTokens() *VerificationTokenQuery {
+	return NewUserClient(_m.config).QueryVerificationTokens(_m)
+}
+
+// QueryOauthConsents queries the "oauth_consents" edge of the User entity.
+func (_m *User) QueryOauthConsents() *OAuthConsentQuery {
+	return NewUserClient(_m.config).QueryOauthConsents(_m)
+}
+
+// QueryOauthAuthorizationCodes queries the "oauth_authorization_codes" edge of the User entity.
+func (_m *User) QueryOauthAuthorizationCodes() *OAuthAuthorizationCodeQuery {
+	return NewUserClient(_m.config).QueryOauthAuthorizationCodes(_m)
+}
+
+// QuerySSOLogins queries the "sso_logins" edge of the User entity.
+func (_m *User) QuerySSOLogins() *SSOLoginQuery {
+	return NewUserClient(_m.config).QuerySSOLogins(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -536,6 +644,16 @@ func (_m *User) String() string {
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	builder.WriteString("totp_secret_encrypted=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("totp_last_counter=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotpLastCounter))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MfaEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_recovery_codes=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
@@ -34,6 +34,14 @@ const (
 	FieldGoogleRefreshToken = "google_refresh_token"
 	// FieldGoogleTokenExpiresAt holds the string denoting the google_token_expires_at field in the database.
 	FieldGoogleTokenExpiresAt = "google_token_expires_at"
+	// FieldTotpSecretEncrypted holds the string denoting the totp_secret_encrypted field in the database.
+	FieldTotpSecretEncrypted = "totp_secret_encrypted"
+	// FieldTotpLastCounter holds the string denoting the totp_last_counter field in the database.
+	FieldTotpLastCounter = "totp_last_counter"
+	// FieldMfaEnabled holds the string denoting the mfa_enabled field in the database.
+	FieldMfaEnabled = "mfa_enabled"
+	// FieldMfaRecoveryCodes holds the string denoting the mfa_recovery_codes field in the database.
+	FieldMfaRecoveryCodes = "mfa_recovery_codes"
 	// FieldIsActive holds the string denoting the is_active field in the database.
 	FieldIsActive = "is_active"
 	// FieldIsVerified holds the string denoting the is_verified field in the database.
@@ -78,6 +86,16 @@ const (
 	EdgeShenbiSettings = "shenbi_settings"
 	// EdgeSentInvitations holds the string denoting the sent_invitations edge name in mutations.
 	EdgeSentInvitations = "sent_invitations"
+	// EdgeAuthSessions holds the string denoting the auth_sessions edge name in mutations.
+	EdgeAuthSessions = "auth_sessions"
+	// EdgeVerificationTokens holds the string denoting the verification_tokens edge name in mutations.
+	EdgeVerificationTokens = "verification_tokens"
+	// EdgeOauthConsents holds the string denoting the oauth_consents edge name in mutations.
+	EdgeOauthConsents = "oauth_consents"
+	// EdgeOauthAuthorizationCodes holds the string denoting the oauth_authorization_codes edge name in mutations.
+	EdgeOauthAuthorizationCodes = "oauth_authorization_codes"
+	// EdgeSSOLogins holds the string denoting the sso_logins edge name in mutations.
+	EdgeSSOLogins = "sso_logins"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// UserAppsTable is the table that holds the user_apps relation/edge.
@@ -192,6 +210,41 @@ const (
 	SentInvitationsInverseTable = "organization_invitations"
 	// SentInvitationsColumn is the table column denoting the sent_invitations relation/edge.
 	SentInvitationsColumn = "user_sent_invitations"
+	// AuthSessionsTable is the table that holds the auth_sessions relation/edge.
+	AuthSessionsTable = "auth_sessions"
+	// AuthSessionsInverseTable is the table name for the AuthSession entity.
+	// It exists in this package in order to avoid circular dependency with the "authsession" package.
+	AuthSessionsInverseTable = "auth_sessions"
+	// AuthSessionsColumn is the table column denoting the auth_sessions relation/edge.
+	AuthSessionsColumn = "user_auth_sessions"
+	// VerificationTokensTable is the table that holds the verification_tokens relation/edge.
+	VerificationTokensTable = "verification_tokens"
+	// VerificationTokensInverseTable is the table name for the VerificationToken entity.
+	// It exists in this package in order to avoid circular dependency with the "verificationtoken" package.
+	VerificationTokensInverseTable = "verification_tokens"
+	// VerificationTokensColumn is the table column denoting the verification_tokens relation/edge.
+	VerificationTokensColumn = "user_verification_tokens"
+	// OauthConsentsTable is the table that holds the oauth_consents relation/edge.
+	OauthConsentsTable = "oauth_consents"
+	// OauthConsentsInverseTable is the table name for the OAuthConsent entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthconsent" package.
+	OauthConsentsInverseTable = "oauth_consents"
+	// OauthConsentsColumn is the table column denoting the oauth_consents relation/edge.
+	OauthConsentsColumn = "user_oauth_consents"
+	// OauthAuthorizationCodesTable is the table that holds the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesInverseTable is the table name for the OAuthAuthorizationCode entity.
+	// It exists in this package in order to avoid circular dependency with the "oauthauthorizationcode" package.
+	OauthAuthorizationCodesInverseTable = "oauth_authorization_codes"
+	// OauthAuthorizationCodesColumn is the table column denoting the oauth_authorization_codes relation/edge.
+	OauthAuthorizationCodesColumn = "user_oauth_authorization_codes"
+	// SSOLoginsTable is the table that holds the sso_logins relation/edge.
+	SSOLoginsTable = "sso_logins"
+	// SSOLoginsInverseTable is the table name for the SSOLogin entity.
+	// It exists in this package in order to avoid circular dependency with the "ssologin" package.
+	SSOLoginsInverseTable = "sso_logins"
+	// SSOLoginsColumn is the table column denoting the sso_logins relation/edge.
+	SSOLoginsColumn = "user_sso_logins"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -207,6 +260,10 @@ var Columns = []string{
 	FieldGoogleAccessToken,
 	FieldGoogleRefreshToken,
 	FieldGoogleTokenExpiresAt,
+	FieldTotpSecretEncrypted,
+	FieldTotpLastCounter,
+	FieldMfaEnabled,
+	FieldMfaRecoveryCodes,
 	FieldIsActive,
 	FieldIsVerified,
 	FieldExtraData,
@@ -226,6 +283,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultTotpLastCounter holds the default value on creation for the "totp_last_counter" field.
+	DefaultTotpLastCounter int64
+	// DefaultMfaEnabled holds the default value on creation for the "mfa_enabled" field.
+	DefaultMfaEnabled bool
 	// DefaultIsActive holds the default value on creation for the "is_active" field.
 	DefaultIsActive bool
 	// DefaultIsVerified holds the default value on creation for the "is_verified" field.
@@ -296,6 +357,21 @@ func ByGoogleTokenExpiresAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldGoogleTokenExpiresAt, opts...).ToFunc()
 }
 
+// ByTotpSecretEncrypted orders the results by the totp_secret_encrypted field.
+func ByTotpSecretEncrypted(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpSecretEncrypted, opts...).ToFunc()
+}
+
+// ByTotpLastCounter orders the results by the totp_last_counter field.
+func ByTotpLastCounter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotpLastCounter, opts...).ToFunc()
+}
+
+// ByMfaEnabled orders the results by the mfa_enabled field.
+func ByMfaEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaEnabled, opts...).ToFunc()
+}
+
 // ByIsActive orders the results by the is_active field.
 func ByIsActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsActive, opts...).ToFunc()
@@ -363,10 +439,17 @@ func BySubscriptions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
-// ByShenbiProfileField orders the results by shenbi_profile field.
-func ByShenbiProfileField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByShenbiProfileCount orders the results by shenbi_profile count.
+func ByShenbiProfileCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newShenbiProfileStep(), opts...)
+	}
+}
+
+// ByShenbiProfile orders the results by shenbi_profile terms.
+func ByShenbiProfile(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newShenbiProfileStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborTerms(s, newShenbiProfileStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -510,10 +593,17 @@ func ByClassroomSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
-// ByShenbiSettingsField orders the results by shenbi_settings field.
-func ByShenbiSettingsField(field string, opts ...sql.OrderTermOption) OrderOption {
+// ByShenbiSettingsCount orders the results by shenbi_settings count.
+func ByShenbiSettingsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
-		sqlgraph.OrderByNeighborTerms(s, newShenbiSettingsStep(), sql.OrderByField(field, opts...))
+		sqlgraph.OrderByNeighborsCount(s, newShenbiSettingsStep(), opts...)
+	}
+}
+
+// ByShenbiSettings orders the results by shenbi_settings terms.
+func ByShenbiSettings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newShenbiSettingsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
 
@@ -530,6 +620,76 @@ func BySentInvitations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newSentInvitationsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByAuthSessionsCount orders the results by auth_sessions count.
+func ByAuthSessionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newAuthSessionsStep(), opts...)
+	}
+}
+
+// ByAuthSessions orders the results by auth_sessions terms.
+func ByAuthSessions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAuthSessionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByVerificationTokensCount orders the results by verification_tokens count.
+func ByVerificationTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newVerificationTokensStep(), opts...)
+	}
+}
+
+// ByVerificationTokens orders the results by verification_tokens terms.
+func ByVerificationTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVerificationTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthConsentsCount orders the results by oauth_consents count.
+func ByOauthConsentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthConsentsStep(), opts...)
+	}
+}
+
+// ByOauthConsents orders the results by oauth_consents terms.
+func ByOauthConsents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthConsentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByOauthAuthorizationCodesCount orders the results by oauth_authorization_codes count.
+func ByOauthAuthorizationCodesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOauthAuthorizationCodesStep(), opts...)
+	}
+}
+
+// ByOauthAuthorizationCodes orders the results by oauth_authorization_codes terms.
+func ByOauthAuthorizationCodes(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOauthAuthorizationCodesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySSOLoginsCount orders the results by sso_logins count.
+func BySSOLoginsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSSOLoginsStep(), opts...)
+	}
+}
+
+// BySSOLogins orders the results by sso_logins terms.
+func BySSOLogins(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSSOLoginsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserAppsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -555,7 +715,7 @@ func newShenbiProfileStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(ShenbiProfileInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, ShenbiProfileTable, ShenbiProfileColumn),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShenbiProfileTable, ShenbiProfileColumn),
 	)
 }
 func newClassroomsTeachingStep() *sqlgraph.Step {
@@ -632,7 +792,7 @@ func newShenbiSettingsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
 		sqlgraph.To(ShenbiSettingsInverseTable, FieldID),
-		sqlgraph.Edge(sqlgraph.O2O, false, ShenbiSettingsTable, ShenbiSettingsColumn),
+		sqlgraph.Edge(sqlgraph.O2M, false, ShenbiSettingsTable, ShenbiSettingsColumn),
 	)
 }
 func newSentInvitationsStep() *sqlgraph.Step {
@@ -642,3 +802,38 @@ func newSentInvitationsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, SentInvitationsTable, SentInvitationsColumn),
 	)
 }
+func newAuthSessionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AuthSessionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, AuthSessionsTable, AuthSessionsColumn),
+	)
+}
+func newVerificationTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VerificationTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, VerificationTokensTable, VerificationTokensColumn),
+	)
+}
+func newOauthConsentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthConsentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+	)
+}
+func newOauthAuthorizationCodesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OauthAuthorizationCodesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+	)
+}
+func newSSOLoginsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SSOLoginsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SSOLoginsTable, SSOLoginsColumn),
+	)
+}
@@ -105,6 +105,21 @@ func GoogleTokenExpiresAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldGoogleTokenExpiresAt, v))
 }
 
+// TotpSecretEncrypted applies equality check predicate on the "totp_secret_encrypted" field. It's identical to TotpSecretEncryptedEQ.
+func TotpSecretEncrypted(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecretEncrypted, v))
+}
+
+// TotpLastCounter applies equality check predicate on the "totp_last_counter" field. It's identical to TotpLastCounterEQ.
+func TotpLastCounter(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastCounter, v))
+}
+
+// MfaEnabled applies equality check predicate on the "mfa_enabled" field. It's identical to MfaEnabledEQ.
+func MfaEnabled(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
 // IsActive applies equality check predicate on the "is_active" field. It's identical to IsActiveEQ.
 func IsActive(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIsActive, v))
@@ -845,6 +860,141 @@ func GoogleTokenExpiresAtNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldGoogleTokenExpiresAt))
 }
 
+// TotpSecretEncryptedEQ applies the EQ predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedNEQ applies the NEQ predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedIn applies the In predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpSecretEncrypted, vs...))
+}
+
+// TotpSecretEncryptedNotIn applies the NotIn predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpSecretEncrypted, vs...))
+}
+
+// TotpSecretEncryptedGT applies the GT predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedGTE applies the GTE predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedLT applies the LT predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedLTE applies the LTE predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedContains applies the Contains predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedHasPrefix applies the HasPrefix predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedHasSuffix applies the HasSuffix predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedIsNil applies the IsNil predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldTotpSecretEncrypted))
+}
+
+// TotpSecretEncryptedNotNil applies the NotNil predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldTotpSecretEncrypted))
+}
+
+// TotpSecretEncryptedEqualFold applies the EqualFold predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldTotpSecretEncrypted, v))
+}
+
+// TotpSecretEncryptedContainsFold applies the ContainsFold predicate on the "totp_secret_encrypted" field.
+func TotpSecretEncryptedContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldTotpSecretEncrypted, v))
+}
+
+// TotpLastCounterEQ applies the EQ predicate on the "totp_last_counter" field.
+func TotpLastCounterEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterNEQ applies the NEQ predicate on the "totp_last_counter" field.
+func TotpLastCounterNEQ(v int64) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterIn applies the In predicate on the "totp_last_counter" field.
+func TotpLastCounterIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldIn(FieldTotpLastCounter, vs...))
+}
+
+// TotpLastCounterNotIn applies the NotIn predicate on the "totp_last_counter" field.
+func TotpLastCounterNotIn(vs ...int64) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldTotpLastCounter, vs...))
+}
+
+// TotpLastCounterGT applies the GT predicate on the "totp_last_counter" field.
+func TotpLastCounterGT(v int64) predicate.User {
+	return predicate.User(sql.FieldGT(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterGTE applies the GTE predicate on the "totp_last_counter" field.
+func TotpLastCounterGTE(v int64) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterLT applies the LT predicate on the "totp_last_counter" field.
+func TotpLastCounterLT(v int64) predicate.User {
+	return predicate.User(sql.FieldLT(FieldTotpLastCounter, v))
+}
+
+// TotpLastCounterLTE applies the LTE predicate on the "totp_last_counter" field.
+func TotpLastCounterLTE(v int64) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldTotpLastCounter, v))
+}
+
+// MfaEnabledEQ applies the EQ predicate on the "mfa_enabled" field.
+func MfaEnabledEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMfaEnabled, v))
+}
+
+// MfaEnabledNEQ applies the NEQ predicate on the "mfa_enabled" field.
+func MfaEnabledNEQ(v bool) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMfaEnabled, v))
+}
+
+// MfaRecoveryCodesIsNil applies the IsNil predicate on the "mfa_recovery_codes" field.
+func MfaRecoveryCodesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMfaRecoveryCodes))
+}
+
+// MfaRecoveryCodesNotNil applies the NotNil predicate on the "mfa_recovery_codes" field.
+func MfaRecoveryCodesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMfaRecoveryCodes))
+}
+
 // IsActiveEQ applies the EQ predicate on the "is_active" field.
 func IsActiveEQ(v bool) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldIsActive, v))
@@ -1079,7 +1229,7 @@ func HasShenbiProfile() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, ShenbiProfileTable, ShenbiProfileColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShenbiProfileTable, ShenbiProfileColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -1332,7 +1482,7 @@ func HasShenbiSettings() predicate.User {
 	return predicate.User(func(s *sql.Selector) {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(Table, FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, ShenbiSettingsTable, ShenbiSettingsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, ShenbiSettingsTable, ShenbiSettingsColumn),
 		)
 		sqlgraph.HasNeighbors(s, step)
 	})
@@ -1373,6 +1523,121 @@ func HasSentInvitationsWith(preds ...predicate.OrganizationInvitation) predicate
 	})
 }
 
+// HasAuthSessions applies the HasEdge predicate on the "auth_sessions" edge.
+func HasAuthSessions() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AuthSessionsTable, AuthSessionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAuthSessionsWith applies the HasEdge predicate on the "auth_sessions" edge with a given conditions (other predicates).
+func HasAuthSessionsWith(preds ...predicate.AuthSession) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAuthSessionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasVerificationTokens applies the HasEdge predicate on the "verification_tokens" edge.
+func HasVerificationTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, VerificationTokensTable, VerificationTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVerificationTokensWith applies the HasEdge predicate on the "verification_tokens" edge with a given conditions (other predicates).
+func HasVerificationTokensWith(preds ...predicate.VerificationToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newVerificationTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthConsents applies the HasEdge predicate on the "oauth_consents" edge.
+func HasOauthConsents() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthConsentsTable, OauthConsentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthConsentsWith applies the HasEdge predicate on the "oauth_consents" edge with a given conditions (other predicates).
+func HasOauthConsentsWith(preds ...predicate.OAuthConsent) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOauthConsentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOauthAuthorizationCodes applies the HasEdge predicate on the "oauth_authorization_codes" edge.
+func HasOauthAuthorizationCodes() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OauthAuthorizationCodesTable, OauthAuthorizationCodesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOauthAuthorizationCodesWith applies the HasEdge predicate on the "oauth_authorization_codes" edge with a given conditions (other predicates).
+func HasOauthAuthorizationCodesWith(preds ...predicate.OAuthAuthorizationCode) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newOauthAuthorizationCodesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSSOLogins applies the HasEdge predicate on the "sso_logins" edge.
+func HasSSOLogins() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SSOLoginsTable, SSOLoginsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSSOLoginsWith applies the HasEdge predicate on the "sso_logins" edge with a given conditions (other predicates).
+func HasSSOLoginsWith(preds ...predicate.SSOLogin) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newSSOLoginsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
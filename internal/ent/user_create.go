@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -19,14 +20,18 @@ import (
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
 )
 
 // UserCreate is the builder for creating a User entity.
@@ -168,6 +173,54 @@ func (_c *UserCreate) SetNillableGoogleTokenExpiresAt(v *time.Time) *UserCreate
 	return _c
 }
 
+// SetTotpSecretEncrypted sets the "totp_secret_encrypted" field.
+func (_c *UserCreate) SetTotpSecretEncrypted(v string) *UserCreate {
+	_c.mutation.SetTotpSecretEncrypted(v)
+	return _c
+}
+
+// SetNillableTotpSecretEncrypted sets the "totp_secret_encrypted" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpSecretEncrypted(v *string) *UserCreate {
+	if v != nil {
+		_c.SetTotpSecretEncrypted(*v)
+	}
+	return _c
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_c *UserCreate) SetTotpLastCounter(v int64) *UserCreate {
+	_c.mutation.SetTotpLastCounter(v)
+	return _c
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_c *UserCreate) SetNillableTotpLastCounter(v *int64) *UserCreate {
+	if v != nil {
+		_c.SetTotpLastCounter(*v)
+	}
+	return _c
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_c *UserCreate) SetMfaEnabled(v bool) *UserCreate {
+	_c.mutation.SetMfaEnabled(v)
+	return _c
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableMfaEnabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetMfaEnabled(*v)
+	}
+	return _c
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (_c *UserCreate) SetMfaRecoveryCodes(v []string) *UserCreate {
+	_c.mutation.SetMfaRecoveryCodes(v)
+	return _c
+}
+
 // SetIsActive sets the "is_active" field.
 func (_c *UserCreate) SetIsActive(v bool) *UserCreate {
 	_c.mutation.SetIsActive(v)
@@ -289,23 +342,19 @@ func (_c *UserCreate) AddSubscriptions(v ...*Subscription) *UserCreate {
 	return _c.AddSubscriptionIDs(ids...)
 }
 
-// SetShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID.
-func (_c *UserCreate) SetShenbiProfileID(id int) *UserCreate {
-	_c.mutation.SetShenbiProfileID(id)
+// AddShenbiProfileIDs adds the "shenbi_profile" edge to the ShenbiProfile entity by IDs.
+func (_c *UserCreate) AddShenbiProfileIDs(ids ...int) *UserCreate {
+	_c.mutation.AddShenbiProfileIDs(ids...)
 	return _c
 }
 
-// SetNillableShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID if the given value is not nil.
-func (_c *UserCreate) SetNillableShenbiProfileID(id *int) *UserCreate {
-	if id != nil {
-		_c = _c.SetShenbiProfileID(*id)
+// AddShenbiProfile adds the "shenbi_profile" edges to the ShenbiProfile entity.
+func (_c *UserCreate) AddShenbiProfile(v ...*ShenbiProfile) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _c
-}
-
-// SetShenbiProfile sets the "shenbi_profile" edge to the ShenbiProfile entity.
-func (_c *UserCreate) SetShenbiProfile(v *ShenbiProfile) *UserCreate {
-	return _c.SetShenbiProfileID(v.ID)
+	return _c.AddShenbiProfileIDs(ids...)
 }
 
 // AddClassroomsTeachingIDs adds the "classrooms_teaching" edge to the Classroom entity by IDs.
@@ -458,23 +507,19 @@ func (_c *UserCreate) AddClassroomSessions(v ...*ClassroomSession) *UserCreate {
 	return _c.AddClassroomSessionIDs(ids...)
 }
 
-// SetShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID.
-func (_c *UserCreate) SetShenbiSettingsID(id int) *UserCreate {
-	_c.mutation.SetShenbiSettingsID(id)
+// AddShenbiSettingIDs adds the "shenbi_settings" edge to the ShenbiSettings entity by IDs.
+func (_c *UserCreate) AddShenbiSettingIDs(ids ...int) *UserCreate {
+	_c.mutation.AddShenbiSettingIDs(ids...)
 	return _c
 }
 
-// SetNillableShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID if the given value is not nil.
-func (_c *UserCreate) SetNillableShenbiSettingsID(id *int) *UserCreate {
-	if id != nil {
-		_c = _c.SetShenbiSettingsID(*id)
+// AddShenbiSettings adds the "shenbi_settings" edges to the ShenbiSettings entity.
+func (_c *UserCreate) AddShenbiSettings(v ...*ShenbiSettings) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _c
-}
-
-// SetShenbiSettings sets the "shenbi_settings" edge to the ShenbiSettings entity.
-func (_c *UserCreate) SetShenbiSettings(v *ShenbiSettings) *UserCreate {
-	return _c.SetShenbiSettingsID(v.ID)
+	return _c.AddShenbiSettingIDs(ids...)
 }
 
 // AddSentInvitationIDs adds the "sent_invitations" edge to the OrganizationInvitation entity by IDs.
@@ -492,6 +537,81 @@ func (_c *UserCreate) AddSentInvitations(v ...*OrganizationInvitation) *UserCrea
 	return _c.AddSentInvitationIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_c *UserCreate) AddAuthSessionIDs(ids ...int) *UserCreate {
+	_c.mutation.AddAuthSessionIDs(ids...)
+	return _c
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_c *UserCreate) AddAuthSessions(v ...*AuthSession) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_c *UserCreate) AddVerificationTokenIDs(ids ...int) *UserCreate {
+	_c.mutation.AddVerificationTokenIDs(ids...)
+	return _c
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_c *UserCreate) AddVerificationTokens(v ...*VerificationToken) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_c *UserCreate) AddOauthConsentIDs(ids ...int) *UserCreate {
+	_c.mutation.AddOauthConsentIDs(ids...)
+	return _c
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_c *UserCreate) AddOauthConsents(v ...*OAuthConsent) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_c *UserCreate) AddOauthAuthorizationCodeIDs(ids ...int) *UserCreate {
+	_c.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _c
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_c *UserCreate) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_c *UserCreate) AddSSOLoginIDs(ids ...int) *UserCreate {
+	_c.mutation.AddSSOLoginIDs(ids...)
+	return _c
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_c *UserCreate) AddSSOLogins(v ...*SSOLogin) *UserCreate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -527,6 +647,14 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.TotpLastCounter(); !ok {
+		v := user.DefaultTotpLastCounter
+		_c.mutation.SetTotpLastCounter(v)
+	}
+	if _, ok := _c.mutation.MfaEnabled(); !ok {
+		v := user.DefaultMfaEnabled
+		_c.mutation.SetMfaEnabled(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := user.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -550,6 +678,12 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Email(); !ok {
 		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "User.email"`)}
 	}
+	if _, ok := _c.mutation.TotpLastCounter(); !ok {
+		return &ValidationError{Name: "totp_last_counter", err: errors.New(`ent: missing required field "User.totp_last_counter"`)}
+	}
+	if _, ok := _c.mutation.MfaEnabled(); !ok {
+		return &ValidationError{Name: "mfa_enabled", err: errors.New(`ent: missing required field "User.mfa_enabled"`)}
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		return &ValidationError{Name: "is_active", err: errors.New(`ent: missing required field "User.is_active"`)}
 	}
@@ -628,6 +762,22 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldGoogleTokenExpiresAt, field.TypeTime, value)
 		_node.GoogleTokenExpiresAt = &value
 	}
+	if value, ok := _c.mutation.TotpSecretEncrypted(); ok {
+		_spec.SetField(user.FieldTotpSecretEncrypted, field.TypeString, value)
+		_node.TotpSecretEncrypted = value
+	}
+	if value, ok := _c.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+		_node.TotpLastCounter = value
+	}
+	if value, ok := _c.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+		_node.MfaEnabled = value
+	}
+	if value, ok := _c.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+		_node.MfaRecoveryCodes = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
@@ -702,7 +852,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	}
 	if nodes := _c.mutation.ShenbiProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiProfileTable,
 			Columns: []string{user.ShenbiProfileColumn},
@@ -878,7 +1028,7 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 	}
 	if nodes := _c.mutation.ShenbiSettingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiSettingsTable,
 			Columns: []string{user.ShenbiSettingsColumn},
@@ -908,6 +1058,86 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -9,11 +9,13 @@ import (
 	"math"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -21,15 +23,19 @@ import (
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/predicate"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
 )
 
 // UserQuery is the builder for querying User entities.
@@ -55,6 +61,12 @@ type UserQuery struct {
 	withClassroomSessions         *ClassroomSessionQuery
 	withShenbiSettings            *ShenbiSettingsQuery
 	withSentInvitations           *OrganizationInvitationQuery
+	withAuthSessions              *AuthSessionQuery
+	withVerificationTokens        *VerificationTokenQuery
+	withOauthConsents             *OAuthConsentQuery
+	withOauthAuthorizationCodes   *OAuthAuthorizationCodeQuery
+	withSSOLogins                 *SSOLoginQuery
+	modifiers                     []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -171,7 +183,7 @@ func (_q *UserQuery) QueryShenbiProfile() *ShenbiProfileQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
 			sqlgraph.To(shenbiprofile.Table, shenbiprofile.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ShenbiProfileTable, user.ShenbiProfileColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShenbiProfileTable, user.ShenbiProfileColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -413,7 +425,7 @@ func (_q *UserQuery) QueryShenbiSettings() *ShenbiSettingsQuery {
 		step := sqlgraph.NewStep(
 			sqlgraph.From(user.Table, user.FieldID, selector),
 			sqlgraph.To(shenbisettings.Table, shenbisettings.FieldID),
-			sqlgraph.Edge(sqlgraph.O2O, false, user.ShenbiSettingsTable, user.ShenbiSettingsColumn),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ShenbiSettingsTable, user.ShenbiSettingsColumn),
 		)
 		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
 		return fromU, nil
@@ -443,6 +455,116 @@ func (_q *UserQuery) QuerySentInvitations() *OrganizationInvitationQuery {
 	return query
 }
 
+// QueryAuthSessions chains the current query on the "auth_sessions" edge.
+func (_q *UserQuery) QueryAuthSessions() *AuthSessionQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(authsession.Table, authsession.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.AuthSessionsTable, user.AuthSessionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryVerificationTokens chains the current query on the "verification_tokens" edge.
+func (_q *UserQuery) QueryVerificationTokens() *VerificationTokenQuery {
+	query := (&VerificationTokenClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(verificationtoken.Table, verificationtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.VerificationTokensTable, user.VerificationTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOauthConsents chains the current query on the "oauth_consents" edge.
+func (_q *UserQuery) QueryOauthConsents() *OAuthConsentQuery {
+	query := (&OAuthConsentClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(oauthconsent.Table, oauthconsent.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthConsentsTable, user.OauthConsentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryOauthAuthorizationCodes chains the current query on the "oauth_authorization_codes" edge.
+func (_q *UserQuery) QueryOauthAuthorizationCodes() *OAuthAuthorizationCodeQuery {
+	query := (&OAuthAuthorizationCodeClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(oauthauthorizationcode.Table, oauthauthorizationcode.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OauthAuthorizationCodesTable, user.OauthAuthorizationCodesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySSOLogins chains the current query on the "sso_logins" edge.
+func (_q *UserQuery) QuerySSOLogins() *SSOLoginQuery {
+	query := (&SSOLoginClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(ssologin.Table, ssologin.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.SSOLoginsTable, user.SSOLoginsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -651,6 +773,11 @@ func (_q *UserQuery) Clone() *UserQuery {
 		withClassroomSessions:         _q.withClassroomSessions.Clone(),
 		withShenbiSettings:            _q.withShenbiSettings.Clone(),
 		withSentInvitations:           _q.withSentInvitations.Clone(),
+		withAuthSessions:              _q.withAuthSessions.Clone(),
+		withVerificationTokens:        _q.withVerificationTokens.Clone(),
+		withOauthConsents:             _q.withOauthConsents.Clone(),
+		withOauthAuthorizationCodes:   _q.withOauthAuthorizationCodes.Clone(),
+		withSSOLogins:                 _q.withSSOLogins.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -833,6 +960,61 @@ func (_q *UserQuery) WithSentInvitations(opts ...func(*OrganizationInvitationQue
 	return _q
 }
 
+// WithAuthSessions tells the query-builder to eager-load the nodes that are connected to
+// the "auth_sessions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithAuthSessions(opts ...func(*AuthSessionQuery)) *UserQuery {
+	query := (&AuthSessionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withAuthSessions = query
+	return _q
+}
+
+// WithVerificationTokens tells the query-builder to eager-load the nodes that are connected to
+// the "verification_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithVerificationTokens(opts ...func(*VerificationTokenQuery)) *UserQuery {
+	query := (&VerificationTokenClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withVerificationTokens = query
+	return _q
+}
+
+// WithOauthConsents tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_consents" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOauthConsents(opts ...func(*OAuthConsentQuery)) *UserQuery {
+	query := (&OAuthConsentClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthConsents = query
+	return _q
+}
+
+// WithOauthAuthorizationCodes tells the query-builder to eager-load the nodes that are connected to
+// the "oauth_authorization_codes" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithOauthAuthorizationCodes(opts ...func(*OAuthAuthorizationCodeQuery)) *UserQuery {
+	query := (&OAuthAuthorizationCodeClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOauthAuthorizationCodes = query
+	return _q
+}
+
+// WithSSOLogins tells the query-builder to eager-load the nodes that are connected to
+// the "sso_logins" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithSSOLogins(opts ...func(*SSOLoginQuery)) *UserQuery {
+	query := (&SSOLoginClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSSOLogins = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -911,7 +1093,7 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [16]bool{
+		loadedTypes = [21]bool{
 			_q.withUserApps != nil,
 			_q.withOrganizationMemberships != nil,
 			_q.withSubscriptions != nil,
@@ -928,6 +1110,11 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			_q.withClassroomSessions != nil,
 			_q.withShenbiSettings != nil,
 			_q.withSentInvitations != nil,
+			_q.withAuthSessions != nil,
+			_q.withVerificationTokens != nil,
+			_q.withOauthConsents != nil,
+			_q.withOauthAuthorizationCodes != nil,
+			_q.withSSOLogins != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -939,6 +1126,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		node.Edges.loadedTypes = loadedTypes
 		return node.assignValues(columns, values)
 	}
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	for i := range hooks {
 		hooks[i](ctx, _spec)
 	}
@@ -972,8 +1162,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		}
 	}
 	if query := _q.withShenbiProfile; query != nil {
-		if err := _q.loadShenbiProfile(ctx, query, nodes, nil,
-			func(n *User, e *ShenbiProfile) { n.Edges.ShenbiProfile = e }); err != nil {
+		if err := _q.loadShenbiProfile(ctx, query, nodes,
+			func(n *User) { n.Edges.ShenbiProfile = []*ShenbiProfile{} },
+			func(n *User, e *ShenbiProfile) { n.Edges.ShenbiProfile = append(n.Edges.ShenbiProfile, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -1054,8 +1245,9 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 		}
 	}
 	if query := _q.withShenbiSettings; query != nil {
-		if err := _q.loadShenbiSettings(ctx, query, nodes, nil,
-			func(n *User, e *ShenbiSettings) { n.Edges.ShenbiSettings = e }); err != nil {
+		if err := _q.loadShenbiSettings(ctx, query, nodes,
+			func(n *User) { n.Edges.ShenbiSettings = []*ShenbiSettings{} },
+			func(n *User, e *ShenbiSettings) { n.Edges.ShenbiSettings = append(n.Edges.ShenbiSettings, e) }); err != nil {
 			return nil, err
 		}
 	}
@@ -1066,6 +1258,45 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withAuthSessions; query != nil {
+		if err := _q.loadAuthSessions(ctx, query, nodes,
+			func(n *User) { n.Edges.AuthSessions = []*AuthSession{} },
+			func(n *User, e *AuthSession) { n.Edges.AuthSessions = append(n.Edges.AuthSessions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withVerificationTokens; query != nil {
+		if err := _q.loadVerificationTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.VerificationTokens = []*VerificationToken{} },
+			func(n *User, e *VerificationToken) {
+				n.Edges.VerificationTokens = append(n.Edges.VerificationTokens, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOauthConsents; query != nil {
+		if err := _q.loadOauthConsents(ctx, query, nodes,
+			func(n *User) { n.Edges.OauthConsents = []*OAuthConsent{} },
+			func(n *User, e *OAuthConsent) { n.Edges.OauthConsents = append(n.Edges.OauthConsents, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withOauthAuthorizationCodes; query != nil {
+		if err := _q.loadOauthAuthorizationCodes(ctx, query, nodes,
+			func(n *User) { n.Edges.OauthAuthorizationCodes = []*OAuthAuthorizationCode{} },
+			func(n *User, e *OAuthAuthorizationCode) {
+				n.Edges.OauthAuthorizationCodes = append(n.Edges.OauthAuthorizationCodes, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSSOLogins; query != nil {
+		if err := _q.loadSSOLogins(ctx, query, nodes,
+			func(n *User) { n.Edges.SSOLogins = []*SSOLogin{} },
+			func(n *User, e *SSOLogin) { n.Edges.SSOLogins = append(n.Edges.SSOLogins, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -1168,6 +1399,9 @@ func (_q *UserQuery) loadShenbiProfile(ctx context.Context, query *ShenbiProfile
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.ShenbiProfile(func(s *sql.Selector) {
@@ -1506,6 +1740,9 @@ func (_q *UserQuery) loadShenbiSettings(ctx context.Context, query *ShenbiSettin
 	for i := range nodes {
 		fks = append(fks, nodes[i].ID)
 		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
 	}
 	query.withFKs = true
 	query.Where(predicate.ShenbiSettings(func(s *sql.Selector) {
@@ -1559,9 +1796,167 @@ func (_q *UserQuery) loadSentInvitations(ctx context.Context, query *Organizatio
 	}
 	return nil
 }
+func (_q *UserQuery) loadAuthSessions(ctx context.Context, query *AuthSessionQuery, nodes []*User, init func(*User), assign func(*User, *AuthSession)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.AuthSession(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.AuthSessionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_auth_sessions
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_auth_sessions" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_auth_sessions" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadVerificationTokens(ctx context.Context, query *VerificationTokenQuery, nodes []*User, init func(*User), assign func(*User, *VerificationToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.VerificationToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.VerificationTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_verification_tokens
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_verification_tokens" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_verification_tokens" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOauthConsents(ctx context.Context, query *OAuthConsentQuery, nodes []*User, init func(*User), assign func(*User, *OAuthConsent)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OAuthConsent(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OauthConsentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_oauth_consents
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_oauth_consents" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_oauth_consents" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadOauthAuthorizationCodes(ctx context.Context, query *OAuthAuthorizationCodeQuery, nodes []*User, init func(*User), assign func(*User, *OAuthAuthorizationCode)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.OAuthAuthorizationCode(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.OauthAuthorizationCodesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_oauth_authorization_codes
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_oauth_authorization_codes" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_oauth_authorization_codes" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadSSOLogins(ctx context.Context, query *SSOLoginQuery, nodes []*User, init func(*User), assign func(*User, *SSOLogin)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.SSOLogin(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.SSOLoginsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.user_sso_logins
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "user_sso_logins" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_sso_logins" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
+	if len(_q.modifiers) > 0 {
+		_spec.Modifiers = _q.modifiers
+	}
 	_spec.Node.Columns = _q.ctx.Fields
 	if len(_q.ctx.Fields) > 0 {
 		_spec.Unique = _q.ctx.Unique != nil && *_q.ctx.Unique
@@ -1624,6 +2019,9 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	if _q.ctx.Unique != nil && *_q.ctx.Unique {
 		selector.Distinct()
 	}
+	for _, m := range _q.modifiers {
+		m(selector)
+	}
 	for _, p := range _q.predicates {
 		p(selector)
 	}
@@ -1641,6 +2039,32 @@ func (_q *UserQuery) sqlQuery(ctx context.Context) *sql.Selector {
 	return selector
 }
 
+// ForUpdate locks the selected rows against concurrent updates, and prevent them from being
+// updated, deleted or "selected ... for update" by other sessions, until the transaction is
+// either committed or rolled-back.
+func (_q *UserQuery) ForUpdate(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForUpdate(opts...)
+	})
+	return _q
+}
+
+// ForShare behaves similarly to ForUpdate, except that it acquires a shared mode lock
+// on any rows that are read. Other sessions can read the rows, but cannot modify them
+// until your transaction commits.
+func (_q *UserQuery) ForShare(opts ...sql.LockOption) *UserQuery {
+	if _q.driver.Dialect() == dialect.Postgres {
+		_q.Unique(false)
+	}
+	_q.modifiers = append(_q.modifiers, func(s *sql.Selector) {
+		s.ForShare(opts...)
+	})
+	return _q
+}
+
 // UserGroupBy is the group-by builder for User entities.
 type UserGroupBy struct {
 	selector
@@ -10,9 +10,11 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"gigaboo.io/lem/internal/ent/achievement"
 	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/authsession"
 	"gigaboo.io/lem/internal/ent/battleroom"
 	"gigaboo.io/lem/internal/ent/battlesession"
 	"gigaboo.io/lem/internal/ent/classroom"
@@ -20,15 +22,19 @@ import (
 	"gigaboo.io/lem/internal/ent/classroomsession"
 	"gigaboo.io/lem/internal/ent/livesession"
 	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/predicate"
 	"gigaboo.io/lem/internal/ent/shenbiprofile"
 	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssologin"
 	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
 )
 
 // UserUpdate is the builder for updating User entities.
@@ -238,6 +244,79 @@ func (_u *UserUpdate) ClearGoogleTokenExpiresAt() *UserUpdate {
 	return _u
 }
 
+// SetTotpSecretEncrypted sets the "totp_secret_encrypted" field.
+func (_u *UserUpdate) SetTotpSecretEncrypted(v string) *UserUpdate {
+	_u.mutation.SetTotpSecretEncrypted(v)
+	return _u
+}
+
+// SetNillableTotpSecretEncrypted sets the "totp_secret_encrypted" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpSecretEncrypted(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetTotpSecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecretEncrypted clears the value of the "totp_secret_encrypted" field.
+func (_u *UserUpdate) ClearTotpSecretEncrypted() *UserUpdate {
+	_u.mutation.ClearTotpSecretEncrypted()
+	return _u
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_u *UserUpdate) SetTotpLastCounter(v int64) *UserUpdate {
+	_u.mutation.ResetTotpLastCounter()
+	_u.mutation.SetTotpLastCounter(v)
+	return _u
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableTotpLastCounter(v *int64) *UserUpdate {
+	if v != nil {
+		_u.SetTotpLastCounter(*v)
+	}
+	return _u
+}
+
+// AddTotpLastCounter adds value to the "totp_last_counter" field.
+func (_u *UserUpdate) AddTotpLastCounter(v int64) *UserUpdate {
+	_u.mutation.AddTotpLastCounter(v)
+	return _u
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_u *UserUpdate) SetMfaEnabled(v bool) *UserUpdate {
+	_u.mutation.SetMfaEnabled(v)
+	return _u
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_u *UserUpdate) SetNillableMfaEnabled(v *bool) *UserUpdate {
+	if v != nil {
+		_u.SetMfaEnabled(*v)
+	}
+	return _u
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (_u *UserUpdate) SetMfaRecoveryCodes(v []string) *UserUpdate {
+	_u.mutation.SetMfaRecoveryCodes(v)
+	return _u
+}
+
+// AppendMfaRecoveryCodes appends value to the "mfa_recovery_codes" field.
+func (_u *UserUpdate) AppendMfaRecoveryCodes(v []string) *UserUpdate {
+	_u.mutation.AppendMfaRecoveryCodes(v)
+	return _u
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (_u *UserUpdate) ClearMfaRecoveryCodes() *UserUpdate {
+	_u.mutation.ClearMfaRecoveryCodes()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *UserUpdate) SetIsActive(v bool) *UserUpdate {
 	_u.mutation.SetIsActive(v)
@@ -349,23 +428,19 @@ func (_u *UserUpdate) AddSubscriptions(v ...*Subscription) *UserUpdate {
 	return _u.AddSubscriptionIDs(ids...)
 }
 
-// SetShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID.
-func (_u *UserUpdate) SetShenbiProfileID(id int) *UserUpdate {
-	_u.mutation.SetShenbiProfileID(id)
+// AddShenbiProfileIDs adds the "shenbi_profile" edge to the ShenbiProfile entity by IDs.
+func (_u *UserUpdate) AddShenbiProfileIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddShenbiProfileIDs(ids...)
 	return _u
 }
 
-// SetNillableShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID if the given value is not nil.
-func (_u *UserUpdate) SetNillableShenbiProfileID(id *int) *UserUpdate {
-	if id != nil {
-		_u = _u.SetShenbiProfileID(*id)
+// AddShenbiProfile adds the "shenbi_profile" edges to the ShenbiProfile entity.
+func (_u *UserUpdate) AddShenbiProfile(v ...*ShenbiProfile) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// SetShenbiProfile sets the "shenbi_profile" edge to the ShenbiProfile entity.
-func (_u *UserUpdate) SetShenbiProfile(v *ShenbiProfile) *UserUpdate {
-	return _u.SetShenbiProfileID(v.ID)
+	return _u.AddShenbiProfileIDs(ids...)
 }
 
 // AddClassroomsTeachingIDs adds the "classrooms_teaching" edge to the Classroom entity by IDs.
@@ -518,23 +593,19 @@ func (_u *UserUpdate) AddClassroomSessions(v ...*ClassroomSession) *UserUpdate {
 	return _u.AddClassroomSessionIDs(ids...)
 }
 
-// SetShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID.
-func (_u *UserUpdate) SetShenbiSettingsID(id int) *UserUpdate {
-	_u.mutation.SetShenbiSettingsID(id)
+// AddShenbiSettingIDs adds the "shenbi_settings" edge to the ShenbiSettings entity by IDs.
+func (_u *UserUpdate) AddShenbiSettingIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddShenbiSettingIDs(ids...)
 	return _u
 }
 
-// SetNillableShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID if the given value is not nil.
-func (_u *UserUpdate) SetNillableShenbiSettingsID(id *int) *UserUpdate {
-	if id != nil {
-		_u = _u.SetShenbiSettingsID(*id)
+// AddShenbiSettings adds the "shenbi_settings" edges to the ShenbiSettings entity.
+func (_u *UserUpdate) AddShenbiSettings(v ...*ShenbiSettings) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// SetShenbiSettings sets the "shenbi_settings" edge to the ShenbiSettings entity.
-func (_u *UserUpdate) SetShenbiSettings(v *ShenbiSettings) *UserUpdate {
-	return _u.SetShenbiSettingsID(v.ID)
+	return _u.AddShenbiSettingIDs(ids...)
 }
 
 // AddSentInvitationIDs adds the "sent_invitations" edge to the OrganizationInvitation entity by IDs.
@@ -552,6 +623,81 @@ func (_u *UserUpdate) AddSentInvitations(v ...*OrganizationInvitation) *UserUpda
 	return _u.AddSentInvitationIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *UserUpdate) AddAuthSessionIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdate) AddAuthSessions(v ...*AuthSession) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_u *UserUpdate) AddVerificationTokenIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddVerificationTokenIDs(ids...)
+	return _u
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_u *UserUpdate) AddVerificationTokens(v ...*VerificationToken) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_u *UserUpdate) AddOauthConsentIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddOauthConsentIDs(ids...)
+	return _u
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_u *UserUpdate) AddOauthConsents(v ...*OAuthConsent) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_u *UserUpdate) AddOauthAuthorizationCodeIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *UserUpdate) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_u *UserUpdate) AddSSOLoginIDs(ids ...int) *UserUpdate {
+	_u.mutation.AddSSOLoginIDs(ids...)
+	return _u
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_u *UserUpdate) AddSSOLogins(v ...*SSOLogin) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -620,12 +766,27 @@ func (_u *UserUpdate) RemoveSubscriptions(v ...*Subscription) *UserUpdate {
 	return _u.RemoveSubscriptionIDs(ids...)
 }
 
-// ClearShenbiProfile clears the "shenbi_profile" edge to the ShenbiProfile entity.
+// ClearShenbiProfile clears all "shenbi_profile" edges to the ShenbiProfile entity.
 func (_u *UserUpdate) ClearShenbiProfile() *UserUpdate {
 	_u.mutation.ClearShenbiProfile()
 	return _u
 }
 
+// RemoveShenbiProfileIDs removes the "shenbi_profile" edge to ShenbiProfile entities by IDs.
+func (_u *UserUpdate) RemoveShenbiProfileIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveShenbiProfileIDs(ids...)
+	return _u
+}
+
+// RemoveShenbiProfile removes "shenbi_profile" edges to ShenbiProfile entities.
+func (_u *UserUpdate) RemoveShenbiProfile(v ...*ShenbiProfile) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShenbiProfileIDs(ids...)
+}
+
 // ClearClassroomsTeaching clears all "classrooms_teaching" edges to the Classroom entity.
 func (_u *UserUpdate) ClearClassroomsTeaching() *UserUpdate {
 	_u.mutation.ClearClassroomsTeaching()
@@ -836,12 +997,27 @@ func (_u *UserUpdate) RemoveClassroomSessions(v ...*ClassroomSession) *UserUpdat
 	return _u.RemoveClassroomSessionIDs(ids...)
 }
 
-// ClearShenbiSettings clears the "shenbi_settings" edge to the ShenbiSettings entity.
+// ClearShenbiSettings clears all "shenbi_settings" edges to the ShenbiSettings entity.
 func (_u *UserUpdate) ClearShenbiSettings() *UserUpdate {
 	_u.mutation.ClearShenbiSettings()
 	return _u
 }
 
+// RemoveShenbiSettingIDs removes the "shenbi_settings" edge to ShenbiSettings entities by IDs.
+func (_u *UserUpdate) RemoveShenbiSettingIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveShenbiSettingIDs(ids...)
+	return _u
+}
+
+// RemoveShenbiSettings removes "shenbi_settings" edges to ShenbiSettings entities.
+func (_u *UserUpdate) RemoveShenbiSettings(v ...*ShenbiSettings) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShenbiSettingIDs(ids...)
+}
+
 // ClearSentInvitations clears all "sent_invitations" edges to the OrganizationInvitation entity.
 func (_u *UserUpdate) ClearSentInvitations() *UserUpdate {
 	_u.mutation.ClearSentInvitations()
@@ -863,6 +1039,111 @@ func (_u *UserUpdate) RemoveSentInvitations(v ...*OrganizationInvitation) *UserU
 	return _u.RemoveSentInvitationIDs(ids...)
 }
 
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdate) ClearAuthSessions() *UserUpdate {
+	_u.mutation.ClearAuthSessions()
+	return _u
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *UserUpdate) RemoveAuthSessionIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
+	return _u
+}
+
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *UserUpdate) RemoveAuthSessions(v ...*AuthSession) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveAuthSessionIDs(ids...)
+}
+
+// ClearVerificationTokens clears all "verification_tokens" edges to the VerificationToken entity.
+func (_u *UserUpdate) ClearVerificationTokens() *UserUpdate {
+	_u.mutation.ClearVerificationTokens()
+	return _u
+}
+
+// RemoveVerificationTokenIDs removes the "verification_tokens" edge to VerificationToken entities by IDs.
+func (_u *UserUpdate) RemoveVerificationTokenIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveVerificationTokenIDs(ids...)
+	return _u
+}
+
+// RemoveVerificationTokens removes "verification_tokens" edges to VerificationToken entities.
+func (_u *UserUpdate) RemoveVerificationTokens(v ...*VerificationToken) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveVerificationTokenIDs(ids...)
+}
+
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (_u *UserUpdate) ClearOauthConsents() *UserUpdate {
+	_u.mutation.ClearOauthConsents()
+	return _u
+}
+
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (_u *UserUpdate) RemoveOauthConsentIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveOauthConsentIDs(ids...)
+	return _u
+}
+
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (_u *UserUpdate) RemoveOauthConsents(v ...*OAuthConsent) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthConsentIDs(ids...)
+}
+
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *UserUpdate) ClearOauthAuthorizationCodes() *UserUpdate {
+	_u.mutation.ClearOauthAuthorizationCodes()
+	return _u
+}
+
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (_u *UserUpdate) RemoveOauthAuthorizationCodeIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (_u *UserUpdate) RemoveOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOauthAuthorizationCodeIDs(ids...)
+}
+
+// ClearSSOLogins clears all "sso_logins" edges to the SSOLogin entity.
+func (_u *UserUpdate) ClearSSOLogins() *UserUpdate {
+	_u.mutation.ClearSSOLogins()
+	return _u
+}
+
+// RemoveSSOLoginIDs removes the "sso_logins" edge to SSOLogin entities by IDs.
+func (_u *UserUpdate) RemoveSSOLoginIDs(ids ...int) *UserUpdate {
+	_u.mutation.RemoveSSOLoginIDs(ids...)
+	return _u
+}
+
+// RemoveSSOLogins removes "sso_logins" edges to SSOLogin entities.
+func (_u *UserUpdate) RemoveSSOLogins(v ...*SSOLogin) *UserUpdate {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSSOLoginIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -965,6 +1246,32 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.GoogleTokenExpiresAtCleared() {
 		_spec.ClearField(user.FieldGoogleTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.TotpSecretEncrypted(); ok {
+		_spec.SetField(user.FieldTotpSecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretEncryptedCleared() {
+		_spec.ClearField(user.FieldTotpSecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastCounter(); ok {
+		_spec.AddField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
@@ -1123,7 +1430,20 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if _u.mutation.ShenbiProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShenbiProfileTable,
+			Columns: []string{user.ShenbiProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shenbiprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShenbiProfileIDs(); len(nodes) > 0 && !_u.mutation.ShenbiProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiProfileTable,
 			Columns: []string{user.ShenbiProfileColumn},
@@ -1132,11 +1452,14 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(shenbiprofile.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ShenbiProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiProfileTable,
 			Columns: []string{user.ShenbiProfileColumn},
@@ -1602,7 +1925,20 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	}
 	if _u.mutation.ShenbiSettingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShenbiSettingsTable,
+			Columns: []string{user.ShenbiSettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shenbisettings.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShenbiSettingsIDs(); len(nodes) > 0 && !_u.mutation.ShenbiSettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiSettingsTable,
 			Columns: []string{user.ShenbiSettingsColumn},
@@ -1611,11 +1947,14 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(shenbisettings.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ShenbiSettingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiSettingsTable,
 			Columns: []string{user.ShenbiSettingsColumn},
@@ -1674,41 +2013,266 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
-	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
-		if _, ok := err.(*sqlgraph.NotFoundError); ok {
-			err = &NotFoundError{user.Label}
-		} else if sqlgraph.IsConstraintError(err) {
-			err = &ConstraintError{msg: err.Error(), wrap: err}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
 		}
-		return 0, err
-	}
-	_u.mutation.done = true
-	return _node, nil
-}
-
-// UserUpdateOne is the builder for updating a single User entity.
-type UserUpdateOne struct {
-	config
-	fields   []string
-	hooks    []Hook
-	mutation *UserMutation
-}
-
-// SetEmail sets the "email" field.
-func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
-	_u.mutation.SetEmail(v)
-	return _u
-}
-
-// SetNillableEmail sets the "email" field if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
-	if v != nil {
-		_u.SetEmail(*v)
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
-	return _u
-}
-
-// SetPasswordHash sets the "password_hash" field.
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationTokensIDs(); len(nodes) > 0 && !_u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !_u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSSOLoginsIDs(); len(nodes) > 0 && !_u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{user.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// UserUpdateOne is the builder for updating a single User entity.
+type UserUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserMutation
+}
+
+// SetEmail sets the "email" field.
+func (_u *UserUpdateOne) SetEmail(v string) *UserUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableEmail(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetPasswordHash sets the "password_hash" field.
 func (_u *UserUpdateOne) SetPasswordHash(v string) *UserUpdateOne {
 	_u.mutation.SetPasswordHash(v)
 	return _u
@@ -1888,6 +2452,79 @@ func (_u *UserUpdateOne) ClearGoogleTokenExpiresAt() *UserUpdateOne {
 	return _u
 }
 
+// SetTotpSecretEncrypted sets the "totp_secret_encrypted" field.
+func (_u *UserUpdateOne) SetTotpSecretEncrypted(v string) *UserUpdateOne {
+	_u.mutation.SetTotpSecretEncrypted(v)
+	return _u
+}
+
+// SetNillableTotpSecretEncrypted sets the "totp_secret_encrypted" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpSecretEncrypted(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpSecretEncrypted(*v)
+	}
+	return _u
+}
+
+// ClearTotpSecretEncrypted clears the value of the "totp_secret_encrypted" field.
+func (_u *UserUpdateOne) ClearTotpSecretEncrypted() *UserUpdateOne {
+	_u.mutation.ClearTotpSecretEncrypted()
+	return _u
+}
+
+// SetTotpLastCounter sets the "totp_last_counter" field.
+func (_u *UserUpdateOne) SetTotpLastCounter(v int64) *UserUpdateOne {
+	_u.mutation.ResetTotpLastCounter()
+	_u.mutation.SetTotpLastCounter(v)
+	return _u
+}
+
+// SetNillableTotpLastCounter sets the "totp_last_counter" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableTotpLastCounter(v *int64) *UserUpdateOne {
+	if v != nil {
+		_u.SetTotpLastCounter(*v)
+	}
+	return _u
+}
+
+// AddTotpLastCounter adds value to the "totp_last_counter" field.
+func (_u *UserUpdateOne) AddTotpLastCounter(v int64) *UserUpdateOne {
+	_u.mutation.AddTotpLastCounter(v)
+	return _u
+}
+
+// SetMfaEnabled sets the "mfa_enabled" field.
+func (_u *UserUpdateOne) SetMfaEnabled(v bool) *UserUpdateOne {
+	_u.mutation.SetMfaEnabled(v)
+	return _u
+}
+
+// SetNillableMfaEnabled sets the "mfa_enabled" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillableMfaEnabled(v *bool) *UserUpdateOne {
+	if v != nil {
+		_u.SetMfaEnabled(*v)
+	}
+	return _u
+}
+
+// SetMfaRecoveryCodes sets the "mfa_recovery_codes" field.
+func (_u *UserUpdateOne) SetMfaRecoveryCodes(v []string) *UserUpdateOne {
+	_u.mutation.SetMfaRecoveryCodes(v)
+	return _u
+}
+
+// AppendMfaRecoveryCodes appends value to the "mfa_recovery_codes" field.
+func (_u *UserUpdateOne) AppendMfaRecoveryCodes(v []string) *UserUpdateOne {
+	_u.mutation.AppendMfaRecoveryCodes(v)
+	return _u
+}
+
+// ClearMfaRecoveryCodes clears the value of the "mfa_recovery_codes" field.
+func (_u *UserUpdateOne) ClearMfaRecoveryCodes() *UserUpdateOne {
+	_u.mutation.ClearMfaRecoveryCodes()
+	return _u
+}
+
 // SetIsActive sets the "is_active" field.
 func (_u *UserUpdateOne) SetIsActive(v bool) *UserUpdateOne {
 	_u.mutation.SetIsActive(v)
@@ -1999,23 +2636,19 @@ func (_u *UserUpdateOne) AddSubscriptions(v ...*Subscription) *UserUpdateOne {
 	return _u.AddSubscriptionIDs(ids...)
 }
 
-// SetShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID.
-func (_u *UserUpdateOne) SetShenbiProfileID(id int) *UserUpdateOne {
-	_u.mutation.SetShenbiProfileID(id)
+// AddShenbiProfileIDs adds the "shenbi_profile" edge to the ShenbiProfile entity by IDs.
+func (_u *UserUpdateOne) AddShenbiProfileIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddShenbiProfileIDs(ids...)
 	return _u
 }
 
-// SetNillableShenbiProfileID sets the "shenbi_profile" edge to the ShenbiProfile entity by ID if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableShenbiProfileID(id *int) *UserUpdateOne {
-	if id != nil {
-		_u = _u.SetShenbiProfileID(*id)
+// AddShenbiProfile adds the "shenbi_profile" edges to the ShenbiProfile entity.
+func (_u *UserUpdateOne) AddShenbiProfile(v ...*ShenbiProfile) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// SetShenbiProfile sets the "shenbi_profile" edge to the ShenbiProfile entity.
-func (_u *UserUpdateOne) SetShenbiProfile(v *ShenbiProfile) *UserUpdateOne {
-	return _u.SetShenbiProfileID(v.ID)
+	return _u.AddShenbiProfileIDs(ids...)
 }
 
 // AddClassroomsTeachingIDs adds the "classrooms_teaching" edge to the Classroom entity by IDs.
@@ -2168,23 +2801,19 @@ func (_u *UserUpdateOne) AddClassroomSessions(v ...*ClassroomSession) *UserUpdat
 	return _u.AddClassroomSessionIDs(ids...)
 }
 
-// SetShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID.
-func (_u *UserUpdateOne) SetShenbiSettingsID(id int) *UserUpdateOne {
-	_u.mutation.SetShenbiSettingsID(id)
+// AddShenbiSettingIDs adds the "shenbi_settings" edge to the ShenbiSettings entity by IDs.
+func (_u *UserUpdateOne) AddShenbiSettingIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddShenbiSettingIDs(ids...)
 	return _u
 }
 
-// SetNillableShenbiSettingsID sets the "shenbi_settings" edge to the ShenbiSettings entity by ID if the given value is not nil.
-func (_u *UserUpdateOne) SetNillableShenbiSettingsID(id *int) *UserUpdateOne {
-	if id != nil {
-		_u = _u.SetShenbiSettingsID(*id)
+// AddShenbiSettings adds the "shenbi_settings" edges to the ShenbiSettings entity.
+func (_u *UserUpdateOne) AddShenbiSettings(v ...*ShenbiSettings) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
-}
-
-// SetShenbiSettings sets the "shenbi_settings" edge to the ShenbiSettings entity.
-func (_u *UserUpdateOne) SetShenbiSettings(v *ShenbiSettings) *UserUpdateOne {
-	return _u.SetShenbiSettingsID(v.ID)
+	return _u.AddShenbiSettingIDs(ids...)
 }
 
 // AddSentInvitationIDs adds the "sent_invitations" edge to the OrganizationInvitation entity by IDs.
@@ -2202,6 +2831,81 @@ func (_u *UserUpdateOne) AddSentInvitations(v ...*OrganizationInvitation) *UserU
 	return _u.AddSentInvitationIDs(ids...)
 }
 
+// AddAuthSessionIDs adds the "auth_sessions" edge to the AuthSession entity by IDs.
+func (_u *UserUpdateOne) AddAuthSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddAuthSessionIDs(ids...)
+	return _u
+}
+
+// AddAuthSessions adds the "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdateOne) AddAuthSessions(v ...*AuthSession) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddAuthSessionIDs(ids...)
+}
+
+// AddVerificationTokenIDs adds the "verification_tokens" edge to the VerificationToken entity by IDs.
+func (_u *UserUpdateOne) AddVerificationTokenIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddVerificationTokenIDs(ids...)
+	return _u
+}
+
+// AddVerificationTokens adds the "verification_tokens" edges to the VerificationToken entity.
+func (_u *UserUpdateOne) AddVerificationTokens(v ...*VerificationToken) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddVerificationTokenIDs(ids...)
+}
+
+// AddOauthConsentIDs adds the "oauth_consents" edge to the OAuthConsent entity by IDs.
+func (_u *UserUpdateOne) AddOauthConsentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddOauthConsentIDs(ids...)
+	return _u
+}
+
+// AddOauthConsents adds the "oauth_consents" edges to the OAuthConsent entity.
+func (_u *UserUpdateOne) AddOauthConsents(v ...*OAuthConsent) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthConsentIDs(ids...)
+}
+
+// AddOauthAuthorizationCodeIDs adds the "oauth_authorization_codes" edge to the OAuthAuthorizationCode entity by IDs.
+func (_u *UserUpdateOne) AddOauthAuthorizationCodeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddOauthAuthorizationCodeIDs(ids...)
+	return _u
+}
+
+// AddOauthAuthorizationCodes adds the "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *UserUpdateOne) AddOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOauthAuthorizationCodeIDs(ids...)
+}
+
+// AddSSOLoginIDs adds the "sso_logins" edge to the SSOLogin entity by IDs.
+func (_u *UserUpdateOne) AddSSOLoginIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.AddSSOLoginIDs(ids...)
+	return _u
+}
+
+// AddSSOLogins adds the "sso_logins" edges to the SSOLogin entity.
+func (_u *UserUpdateOne) AddSSOLogins(v ...*SSOLogin) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSSOLoginIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -2270,12 +2974,27 @@ func (_u *UserUpdateOne) RemoveSubscriptions(v ...*Subscription) *UserUpdateOne
 	return _u.RemoveSubscriptionIDs(ids...)
 }
 
-// ClearShenbiProfile clears the "shenbi_profile" edge to the ShenbiProfile entity.
+// ClearShenbiProfile clears all "shenbi_profile" edges to the ShenbiProfile entity.
 func (_u *UserUpdateOne) ClearShenbiProfile() *UserUpdateOne {
 	_u.mutation.ClearShenbiProfile()
 	return _u
 }
 
+// RemoveShenbiProfileIDs removes the "shenbi_profile" edge to ShenbiProfile entities by IDs.
+func (_u *UserUpdateOne) RemoveShenbiProfileIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveShenbiProfileIDs(ids...)
+	return _u
+}
+
+// RemoveShenbiProfile removes "shenbi_profile" edges to ShenbiProfile entities.
+func (_u *UserUpdateOne) RemoveShenbiProfile(v ...*ShenbiProfile) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShenbiProfileIDs(ids...)
+}
+
 // ClearClassroomsTeaching clears all "classrooms_teaching" edges to the Classroom entity.
 func (_u *UserUpdateOne) ClearClassroomsTeaching() *UserUpdateOne {
 	_u.mutation.ClearClassroomsTeaching()
@@ -2408,109 +3127,229 @@ func (_u *UserUpdateOne) ClearBattleSessions() *UserUpdateOne {
 	return _u
 }
 
-// RemoveBattleSessionIDs removes the "battle_sessions" edge to BattleSession entities by IDs.
-func (_u *UserUpdateOne) RemoveBattleSessionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveBattleSessionIDs(ids...)
+// RemoveBattleSessionIDs removes the "battle_sessions" edge to BattleSession entities by IDs.
+func (_u *UserUpdateOne) RemoveBattleSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveBattleSessionIDs(ids...)
+	return _u
+}
+
+// RemoveBattleSessions removes "battle_sessions" edges to BattleSession entities.
+func (_u *UserUpdateOne) RemoveBattleSessions(v ...*BattleSession) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBattleSessionIDs(ids...)
+}
+
+// ClearLiveSessionsTeaching clears all "live_sessions_teaching" edges to the LiveSession entity.
+func (_u *UserUpdateOne) ClearLiveSessionsTeaching() *UserUpdateOne {
+	_u.mutation.ClearLiveSessionsTeaching()
+	return _u
+}
+
+// RemoveLiveSessionsTeachingIDs removes the "live_sessions_teaching" edge to LiveSession entities by IDs.
+func (_u *UserUpdateOne) RemoveLiveSessionsTeachingIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLiveSessionsTeachingIDs(ids...)
+	return _u
+}
+
+// RemoveLiveSessionsTeaching removes "live_sessions_teaching" edges to LiveSession entities.
+func (_u *UserUpdateOne) RemoveLiveSessionsTeaching(v ...*LiveSession) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiveSessionsTeachingIDs(ids...)
+}
+
+// ClearLiveSessionParticipations clears all "live_session_participations" edges to the LiveSessionStudent entity.
+func (_u *UserUpdateOne) ClearLiveSessionParticipations() *UserUpdateOne {
+	_u.mutation.ClearLiveSessionParticipations()
+	return _u
+}
+
+// RemoveLiveSessionParticipationIDs removes the "live_session_participations" edge to LiveSessionStudent entities by IDs.
+func (_u *UserUpdateOne) RemoveLiveSessionParticipationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveLiveSessionParticipationIDs(ids...)
+	return _u
+}
+
+// RemoveLiveSessionParticipations removes "live_session_participations" edges to LiveSessionStudent entities.
+func (_u *UserUpdateOne) RemoveLiveSessionParticipations(v ...*LiveSessionStudent) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveLiveSessionParticipationIDs(ids...)
+}
+
+// ClearClassroomSessions clears all "classroom_sessions" edges to the ClassroomSession entity.
+func (_u *UserUpdateOne) ClearClassroomSessions() *UserUpdateOne {
+	_u.mutation.ClearClassroomSessions()
+	return _u
+}
+
+// RemoveClassroomSessionIDs removes the "classroom_sessions" edge to ClassroomSession entities by IDs.
+func (_u *UserUpdateOne) RemoveClassroomSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveClassroomSessionIDs(ids...)
+	return _u
+}
+
+// RemoveClassroomSessions removes "classroom_sessions" edges to ClassroomSession entities.
+func (_u *UserUpdateOne) RemoveClassroomSessions(v ...*ClassroomSession) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveClassroomSessionIDs(ids...)
+}
+
+// ClearShenbiSettings clears all "shenbi_settings" edges to the ShenbiSettings entity.
+func (_u *UserUpdateOne) ClearShenbiSettings() *UserUpdateOne {
+	_u.mutation.ClearShenbiSettings()
+	return _u
+}
+
+// RemoveShenbiSettingIDs removes the "shenbi_settings" edge to ShenbiSettings entities by IDs.
+func (_u *UserUpdateOne) RemoveShenbiSettingIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveShenbiSettingIDs(ids...)
+	return _u
+}
+
+// RemoveShenbiSettings removes "shenbi_settings" edges to ShenbiSettings entities.
+func (_u *UserUpdateOne) RemoveShenbiSettings(v ...*ShenbiSettings) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShenbiSettingIDs(ids...)
+}
+
+// ClearSentInvitations clears all "sent_invitations" edges to the OrganizationInvitation entity.
+func (_u *UserUpdateOne) ClearSentInvitations() *UserUpdateOne {
+	_u.mutation.ClearSentInvitations()
+	return _u
+}
+
+// RemoveSentInvitationIDs removes the "sent_invitations" edge to OrganizationInvitation entities by IDs.
+func (_u *UserUpdateOne) RemoveSentInvitationIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSentInvitationIDs(ids...)
+	return _u
+}
+
+// RemoveSentInvitations removes "sent_invitations" edges to OrganizationInvitation entities.
+func (_u *UserUpdateOne) RemoveSentInvitations(v ...*OrganizationInvitation) *UserUpdateOne {
+	ids := make([]int, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSentInvitationIDs(ids...)
+}
+
+// ClearAuthSessions clears all "auth_sessions" edges to the AuthSession entity.
+func (_u *UserUpdateOne) ClearAuthSessions() *UserUpdateOne {
+	_u.mutation.ClearAuthSessions()
+	return _u
+}
+
+// RemoveAuthSessionIDs removes the "auth_sessions" edge to AuthSession entities by IDs.
+func (_u *UserUpdateOne) RemoveAuthSessionIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveAuthSessionIDs(ids...)
 	return _u
 }
 
-// RemoveBattleSessions removes "battle_sessions" edges to BattleSession entities.
-func (_u *UserUpdateOne) RemoveBattleSessions(v ...*BattleSession) *UserUpdateOne {
+// RemoveAuthSessions removes "auth_sessions" edges to AuthSession entities.
+func (_u *UserUpdateOne) RemoveAuthSessions(v ...*AuthSession) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveBattleSessionIDs(ids...)
+	return _u.RemoveAuthSessionIDs(ids...)
 }
 
-// ClearLiveSessionsTeaching clears all "live_sessions_teaching" edges to the LiveSession entity.
-func (_u *UserUpdateOne) ClearLiveSessionsTeaching() *UserUpdateOne {
-	_u.mutation.ClearLiveSessionsTeaching()
+// ClearVerificationTokens clears all "verification_tokens" edges to the VerificationToken entity.
+func (_u *UserUpdateOne) ClearVerificationTokens() *UserUpdateOne {
+	_u.mutation.ClearVerificationTokens()
 	return _u
 }
 
-// RemoveLiveSessionsTeachingIDs removes the "live_sessions_teaching" edge to LiveSession entities by IDs.
-func (_u *UserUpdateOne) RemoveLiveSessionsTeachingIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLiveSessionsTeachingIDs(ids...)
+// RemoveVerificationTokenIDs removes the "verification_tokens" edge to VerificationToken entities by IDs.
+func (_u *UserUpdateOne) RemoveVerificationTokenIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveVerificationTokenIDs(ids...)
 	return _u
 }
 
-// RemoveLiveSessionsTeaching removes "live_sessions_teaching" edges to LiveSession entities.
-func (_u *UserUpdateOne) RemoveLiveSessionsTeaching(v ...*LiveSession) *UserUpdateOne {
+// RemoveVerificationTokens removes "verification_tokens" edges to VerificationToken entities.
+func (_u *UserUpdateOne) RemoveVerificationTokens(v ...*VerificationToken) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLiveSessionsTeachingIDs(ids...)
+	return _u.RemoveVerificationTokenIDs(ids...)
 }
 
-// ClearLiveSessionParticipations clears all "live_session_participations" edges to the LiveSessionStudent entity.
-func (_u *UserUpdateOne) ClearLiveSessionParticipations() *UserUpdateOne {
-	_u.mutation.ClearLiveSessionParticipations()
+// ClearOauthConsents clears all "oauth_consents" edges to the OAuthConsent entity.
+func (_u *UserUpdateOne) ClearOauthConsents() *UserUpdateOne {
+	_u.mutation.ClearOauthConsents()
 	return _u
 }
 
-// RemoveLiveSessionParticipationIDs removes the "live_session_participations" edge to LiveSessionStudent entities by IDs.
-func (_u *UserUpdateOne) RemoveLiveSessionParticipationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveLiveSessionParticipationIDs(ids...)
+// RemoveOauthConsentIDs removes the "oauth_consents" edge to OAuthConsent entities by IDs.
+func (_u *UserUpdateOne) RemoveOauthConsentIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveOauthConsentIDs(ids...)
 	return _u
 }
 
-// RemoveLiveSessionParticipations removes "live_session_participations" edges to LiveSessionStudent entities.
-func (_u *UserUpdateOne) RemoveLiveSessionParticipations(v ...*LiveSessionStudent) *UserUpdateOne {
+// RemoveOauthConsents removes "oauth_consents" edges to OAuthConsent entities.
+func (_u *UserUpdateOne) RemoveOauthConsents(v ...*OAuthConsent) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveLiveSessionParticipationIDs(ids...)
+	return _u.RemoveOauthConsentIDs(ids...)
 }
 
-// ClearClassroomSessions clears all "classroom_sessions" edges to the ClassroomSession entity.
-func (_u *UserUpdateOne) ClearClassroomSessions() *UserUpdateOne {
-	_u.mutation.ClearClassroomSessions()
+// ClearOauthAuthorizationCodes clears all "oauth_authorization_codes" edges to the OAuthAuthorizationCode entity.
+func (_u *UserUpdateOne) ClearOauthAuthorizationCodes() *UserUpdateOne {
+	_u.mutation.ClearOauthAuthorizationCodes()
 	return _u
 }
 
-// RemoveClassroomSessionIDs removes the "classroom_sessions" edge to ClassroomSession entities by IDs.
-func (_u *UserUpdateOne) RemoveClassroomSessionIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveClassroomSessionIDs(ids...)
+// RemoveOauthAuthorizationCodeIDs removes the "oauth_authorization_codes" edge to OAuthAuthorizationCode entities by IDs.
+func (_u *UserUpdateOne) RemoveOauthAuthorizationCodeIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveOauthAuthorizationCodeIDs(ids...)
 	return _u
 }
 
-// RemoveClassroomSessions removes "classroom_sessions" edges to ClassroomSession entities.
-func (_u *UserUpdateOne) RemoveClassroomSessions(v ...*ClassroomSession) *UserUpdateOne {
+// RemoveOauthAuthorizationCodes removes "oauth_authorization_codes" edges to OAuthAuthorizationCode entities.
+func (_u *UserUpdateOne) RemoveOauthAuthorizationCodes(v ...*OAuthAuthorizationCode) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveClassroomSessionIDs(ids...)
-}
-
-// ClearShenbiSettings clears the "shenbi_settings" edge to the ShenbiSettings entity.
-func (_u *UserUpdateOne) ClearShenbiSettings() *UserUpdateOne {
-	_u.mutation.ClearShenbiSettings()
-	return _u
+	return _u.RemoveOauthAuthorizationCodeIDs(ids...)
 }
 
-// ClearSentInvitations clears all "sent_invitations" edges to the OrganizationInvitation entity.
-func (_u *UserUpdateOne) ClearSentInvitations() *UserUpdateOne {
-	_u.mutation.ClearSentInvitations()
+// ClearSSOLogins clears all "sso_logins" edges to the SSOLogin entity.
+func (_u *UserUpdateOne) ClearSSOLogins() *UserUpdateOne {
+	_u.mutation.ClearSSOLogins()
 	return _u
 }
 
-// RemoveSentInvitationIDs removes the "sent_invitations" edge to OrganizationInvitation entities by IDs.
-func (_u *UserUpdateOne) RemoveSentInvitationIDs(ids ...int) *UserUpdateOne {
-	_u.mutation.RemoveSentInvitationIDs(ids...)
+// RemoveSSOLoginIDs removes the "sso_logins" edge to SSOLogin entities by IDs.
+func (_u *UserUpdateOne) RemoveSSOLoginIDs(ids ...int) *UserUpdateOne {
+	_u.mutation.RemoveSSOLoginIDs(ids...)
 	return _u
 }
 
-// RemoveSentInvitations removes "sent_invitations" edges to OrganizationInvitation entities.
-func (_u *UserUpdateOne) RemoveSentInvitations(v ...*OrganizationInvitation) *UserUpdateOne {
+// RemoveSSOLogins removes "sso_logins" edges to SSOLogin entities.
+func (_u *UserUpdateOne) RemoveSSOLogins(v ...*SSOLogin) *UserUpdateOne {
 	ids := make([]int, len(v))
 	for i := range v {
 		ids[i] = v[i].ID
 	}
-	return _u.RemoveSentInvitationIDs(ids...)
+	return _u.RemoveSSOLoginIDs(ids...)
 }
 
 // Where appends a list predicates to the UserUpdate builder.
@@ -2645,6 +3484,32 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if _u.mutation.GoogleTokenExpiresAtCleared() {
 		_spec.ClearField(user.FieldGoogleTokenExpiresAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.TotpSecretEncrypted(); ok {
+		_spec.SetField(user.FieldTotpSecretEncrypted, field.TypeString, value)
+	}
+	if _u.mutation.TotpSecretEncryptedCleared() {
+		_spec.ClearField(user.FieldTotpSecretEncrypted, field.TypeString)
+	}
+	if value, ok := _u.mutation.TotpLastCounter(); ok {
+		_spec.SetField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTotpLastCounter(); ok {
+		_spec.AddField(user.FieldTotpLastCounter, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.MfaEnabled(); ok {
+		_spec.SetField(user.FieldMfaEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MfaRecoveryCodes(); ok {
+		_spec.SetField(user.FieldMfaRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedMfaRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, user.FieldMfaRecoveryCodes, value)
+		})
+	}
+	if _u.mutation.MfaRecoveryCodesCleared() {
+		_spec.ClearField(user.FieldMfaRecoveryCodes, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.IsActive(); ok {
 		_spec.SetField(user.FieldIsActive, field.TypeBool, value)
 	}
@@ -2803,7 +3668,20 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	}
 	if _u.mutation.ShenbiProfileCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShenbiProfileTable,
+			Columns: []string{user.ShenbiProfileColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shenbiprofile.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShenbiProfileIDs(); len(nodes) > 0 && !_u.mutation.ShenbiProfileCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiProfileTable,
 			Columns: []string{user.ShenbiProfileColumn},
@@ -2812,11 +3690,14 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(shenbiprofile.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ShenbiProfileIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiProfileTable,
 			Columns: []string{user.ShenbiProfileColumn},
@@ -3282,7 +4163,20 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	}
 	if _u.mutation.ShenbiSettingsCleared() {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ShenbiSettingsTable,
+			Columns: []string{user.ShenbiSettingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(shenbisettings.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedShenbiSettingsIDs(); len(nodes) > 0 && !_u.mutation.ShenbiSettingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiSettingsTable,
 			Columns: []string{user.ShenbiSettingsColumn},
@@ -3291,11 +4185,14 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 				IDSpec: sqlgraph.NewFieldSpec(shenbisettings.FieldID, field.TypeInt),
 			},
 		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
 	}
 	if nodes := _u.mutation.ShenbiSettingsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
-			Rel:     sqlgraph.O2O,
+			Rel:     sqlgraph.O2M,
 			Inverse: false,
 			Table:   user.ShenbiSettingsTable,
 			Columns: []string{user.ShenbiSettingsColumn},
@@ -3354,6 +4251,231 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedAuthSessionsIDs(); len(nodes) > 0 && !_u.mutation.AuthSessionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AuthSessionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.AuthSessionsTable,
+			Columns: []string{user.AuthSessionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(authsession.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedVerificationTokensIDs(); len(nodes) > 0 && !_u.mutation.VerificationTokensCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.VerificationTokensIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.VerificationTokensTable,
+			Columns: []string{user.VerificationTokensColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(verificationtoken.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthConsentsIDs(); len(nodes) > 0 && !_u.mutation.OauthConsentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthConsentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthConsentsTable,
+			Columns: []string{user.OauthConsentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthconsent.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOauthAuthorizationCodesIDs(); len(nodes) > 0 && !_u.mutation.OauthAuthorizationCodesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OauthAuthorizationCodesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.OauthAuthorizationCodesTable,
+			Columns: []string{user.OauthAuthorizationCodesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(oauthauthorizationcode.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSSOLoginsIDs(); len(nodes) > 0 && !_u.mutation.SSOLoginsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SSOLoginsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.SSOLoginsTable,
+			Columns: []string{user.SSOLoginsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ssologin.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+)
+
+// WebhookAttemptCreate is the builder for creating a WebhookAttempt entity.
+type WebhookAttemptCreate struct {
+	config
+	mutation *WebhookAttemptMutation
+	hooks    []Hook
+}
+
+// SetStatusCode sets the "status_code" field.
+func (_c *WebhookAttemptCreate) SetStatusCode(v int) *WebhookAttemptCreate {
+	_c.mutation.SetStatusCode(v)
+	return _c
+}
+
+// SetNillableStatusCode sets the "status_code" field if the given value is not nil.
+func (_c *WebhookAttemptCreate) SetNillableStatusCode(v *int) *WebhookAttemptCreate {
+	if v != nil {
+		_c.SetStatusCode(*v)
+	}
+	return _c
+}
+
+// SetError sets the "error" field.
+func (_c *WebhookAttemptCreate) SetError(v string) *WebhookAttemptCreate {
+	_c.mutation.SetError(v)
+	return _c
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (_c *WebhookAttemptCreate) SetNillableError(v *string) *WebhookAttemptCreate {
+	if v != nil {
+		_c.SetError(*v)
+	}
+	return _c
+}
+
+// SetResponseBody sets the "response_body" field.
+func (_c *WebhookAttemptCreate) SetResponseBody(v string) *WebhookAttemptCreate {
+	_c.mutation.SetResponseBody(v)
+	return _c
+}
+
+// SetNillableResponseBody sets the "response_body" field if the given value is not nil.
+func (_c *WebhookAttemptCreate) SetNillableResponseBody(v *string) *WebhookAttemptCreate {
+	if v != nil {
+		_c.SetResponseBody(*v)
+	}
+	return _c
+}
+
+// SetDurationMs sets the "duration_ms" field.
+func (_c *WebhookAttemptCreate) SetDurationMs(v int) *WebhookAttemptCreate {
+	_c.mutation.SetDurationMs(v)
+	return _c
+}
+
+// SetNillableDurationMs sets the "duration_ms" field if the given value is not nil.
+func (_c *WebhookAttemptCreate) SetNillableDurationMs(v *int) *WebhookAttemptCreate {
+	if v != nil {
+		_c.SetDurationMs(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WebhookAttemptCreate) SetCreatedAt(v time.Time) *WebhookAttemptCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WebhookAttemptCreate) SetNillableCreatedAt(v *time.Time) *WebhookAttemptCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetDeliveryID sets the "delivery" edge to the WebhookDelivery entity by ID.
+func (_c *WebhookAttemptCreate) SetDeliveryID(id int) *WebhookAttemptCreate {
+	_c.mutation.SetDeliveryID(id)
+	return _c
+}
+
+// SetDelivery sets the "delivery" edge to the WebhookDelivery entity.
+func (_c *WebhookAttemptCreate) SetDelivery(v *WebhookDelivery) *WebhookAttemptCreate {
+	return _c.SetDeliveryID(v.ID)
+}
+
+// Mutation returns the WebhookAttemptMutation object of the builder.
+func (_c *WebhookAttemptCreate) Mutation() *WebhookAttemptMutation {
+	return _c.mutation
+}
+
+// Save creates the WebhookAttempt in the database.
+func (_c *WebhookAttemptCreate) Save(ctx context.Context) (*WebhookAttempt, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WebhookAttemptCreate) SaveX(ctx context.Context) *WebhookAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookAttemptCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookAttemptCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WebhookAttemptCreate) defaults() {
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		v := webhookattempt.DefaultDurationMs
+		_c.mutation.SetDurationMs(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := webhookattempt.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WebhookAttemptCreate) check() error {
+	if _, ok := _c.mutation.DurationMs(); !ok {
+		return &ValidationError{Name: "duration_ms", err: errors.New(`ent: missing required field "WebhookAttempt.duration_ms"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WebhookAttempt.created_at"`)}
+	}
+	if len(_c.mutation.DeliveryIDs()) == 0 {
+		return &ValidationError{Name: "delivery", err: errors.New(`ent: missing required edge "WebhookAttempt.delivery"`)}
+	}
+	return nil
+}
+
+func (_c *WebhookAttemptCreate) sqlSave(ctx context.Context) (*WebhookAttempt, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WebhookAttemptCreate) createSpec() (*WebhookAttempt, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WebhookAttempt{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(webhookattempt.Table, sqlgraph.NewFieldSpec(webhookattempt.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.StatusCode(); ok {
+		_spec.SetField(webhookattempt.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = &value
+	}
+	if value, ok := _c.mutation.Error(); ok {
+		_spec.SetField(webhookattempt.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := _c.mutation.ResponseBody(); ok {
+		_spec.SetField(webhookattempt.FieldResponseBody, field.TypeString, value)
+		_node.ResponseBody = value
+	}
+	if value, ok := _c.mutation.DurationMs(); ok {
+		_spec.SetField(webhookattempt.FieldDurationMs, field.TypeInt, value)
+		_node.DurationMs = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(webhookattempt.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.DeliveryIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   webhookattempt.DeliveryTable,
+			Columns: []string{webhookattempt.DeliveryColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookdelivery.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.webhook_delivery_attempt_logs = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WebhookAttemptCreateBulk is the builder for creating many WebhookAttempt entities in bulk.
+type WebhookAttemptCreateBulk struct {
+	config
+	err      error
+	builders []*WebhookAttemptCreate
+}
+
+// Save creates the WebhookAttempt entities in the database.
+func (_c *WebhookAttemptCreateBulk) Save(ctx context.Context) ([]*WebhookAttempt, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WebhookAttempt, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WebhookAttemptMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WebhookAttemptCreateBulk) SaveX(ctx context.Context) []*WebhookAttempt {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WebhookAttemptCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WebhookAttemptCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
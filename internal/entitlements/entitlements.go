@@ -0,0 +1,141 @@
+// Package entitlements decides which features and limits users and
+// organizations have, from the features of their plans.
+//
+// A plan's features map holds two kinds of entries: a boolean turns a feature
+// on, e.g. "battles": true, and a number sets a limit, e.g. "classrooms": 3,
+// with Unlimited for no limit. Features a plan does not turn on are off and
+// limits it does not set are 0.
+//
+// A user or organization has the plans of its live subscriptions, combined so
+// that the most generous wins, or else the app's default plan. Apps without
+// any active plans do not sell anything, so nobody in them is restricted.
+package entitlements
+
+import (
+	"encoding/json"
+
+	"gigaboo.io/lem/internal/ent"
+)
+
+// Features gated by plans.
+const (
+	FeatureBattles          = "battles"
+	FeatureLiveSessions     = "live_sessions"
+	FeatureCustomAdventures = "custom_adventures"
+)
+
+// Limits set by plans.
+const (
+	// LimitClassrooms is how many classrooms a teacher may have.
+	LimitClassrooms = "classrooms"
+)
+
+// Unlimited is the limit of plans that set no limit.
+const Unlimited = -1
+
+// Where entitlements come from.
+const (
+	SourceSubscription = "subscription"
+	SourceDefaultPlan  = "default_plan"
+	SourceNone         = "none"
+	SourceUnrestricted = "unrestricted"
+)
+
+// Entitlements are the features and limits a user or organization has.
+type Entitlements struct {
+	// Unrestricted is set in apps without plans, where every feature is on
+	// and nothing is limited
+	Unrestricted bool            `json:"unrestricted"`
+	Source       string          `json:"source"`
+	Plans        []string        `json:"plans"`
+	Features     map[string]bool `json:"features"`
+	Limits       map[string]int  `json:"limits"`
+}
+
+// Has reports whether a feature is on.
+func (e *Entitlements) Has(feature string) bool {
+	return e.Unrestricted || e.Features[feature]
+}
+
+// Limit returns a limit, or Unlimited.
+func (e *Entitlements) Limit(name string) int {
+	if e.Unrestricted {
+		return Unlimited
+	}
+	return e.Limits[name]
+}
+
+// Allows reports whether n of what a limit counts are within it.
+func (e *Entitlements) Allows(name string, n int) bool {
+	limit := e.Limit(name)
+	return limit == Unlimited || n <= limit
+}
+
+// unrestricted returns the entitlements of apps without plans.
+func unrestricted() *Entitlements {
+	return &Entitlements{
+		Unrestricted: true,
+		Source:       SourceUnrestricted,
+		Plans:        []string{},
+		Features:     map[string]bool{},
+		Limits:       map[string]int{},
+	}
+}
+
+// FromPlans combines the features of plans, keeping the most generous value
+// of each.
+func FromPlans(source string, plans ...*ent.Plan) *Entitlements {
+	e := &Entitlements{
+		Source:   source,
+		Plans:    []string{},
+		Features: map[string]bool{},
+		Limits:   map[string]int{},
+	}
+	for _, p := range plans {
+		e.Plans = append(e.Plans, p.Slug)
+		for name, value := range p.Features {
+			switch v := value.(type) {
+			case bool:
+				e.Features[name] = e.Features[name] || v
+			default:
+				limit, ok := number(v)
+				if !ok {
+					continue
+				}
+				current, set := e.Limits[name]
+				switch {
+				case !set, limit == Unlimited:
+					e.Limits[name] = limit
+				case current != Unlimited && limit > current:
+					e.Limits[name] = limit
+				}
+			}
+		}
+	}
+	return e
+}
+
+// number returns a limit from a features value, which is a float64 when read
+// from the database.
+func number(value interface{}) (int, bool) {
+	switch v := value.(type) {
+	case float64:
+		if v < 0 {
+			return Unlimited, true
+		}
+		return int(v), true
+	case int:
+		if v < 0 {
+			return Unlimited, true
+		}
+		return v, true
+	case json.Number:
+		n, err := v.Int64()
+		if err != nil {
+			return 0, false
+		}
+		return number(int(n))
+	default:
+		return 0, false
+	}
+}
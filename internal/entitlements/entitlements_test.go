@@ -0,0 +1,65 @@
+package entitlements
+
+import (
+	"testing"
+
+	"gigaboo.io/lem/internal/ent"
+)
+
+// planWith is a plan with features as read from the database, where numbers
+// are float64.
+func planWith(slug string, features map[string]interface{}) *ent.Plan {
+	return &ent.Plan{Slug: slug, Features: features}
+}
+
+var (
+	free      = planWith("free", map[string]interface{}{"classrooms": float64(1)})
+	pro       = planWith("pro", map[string]interface{}{"battles": true, "live_sessions": true, "classrooms": float64(10)})
+	school    = planWith("school", map[string]interface{}{"battles": false, "classrooms": float64(-1)})
+	malformed = planWith("malformed", map[string]interface{}{"battles": "yes", "classrooms": "many"})
+)
+
+func TestFromPlans(t *testing.T) {
+	tests := []struct {
+		name     string
+		plans    []*ent.Plan
+		feature  string
+		has      bool
+		limit    string
+		count    int
+		allowed  bool
+		maxLimit int
+	}{
+		{"no plan has nothing", nil, FeatureBattles, false, LimitClassrooms, 1, false, 0},
+		{"free plan is limited", []*ent.Plan{free}, FeatureBattles, false, LimitClassrooms, 1, true, 1},
+		{"free plan limit is enforced", []*ent.Plan{free}, FeatureBattles, false, LimitClassrooms, 2, false, 1},
+		{"pro plan has features", []*ent.Plan{pro}, FeatureLiveSessions, true, LimitClassrooms, 10, true, 10},
+		{"unset feature is off", []*ent.Plan{pro}, FeatureCustomAdventures, false, LimitClassrooms, 11, false, 10},
+		{"negative limit is unlimited", []*ent.Plan{school}, FeatureBattles, false, LimitClassrooms, 1000, true, Unlimited},
+		{"most generous feature wins", []*ent.Plan{school, pro}, FeatureBattles, true, LimitClassrooms, 1000, true, Unlimited},
+		{"highest limit wins", []*ent.Plan{pro, free}, FeatureBattles, true, LimitClassrooms, 10, true, 10},
+		{"malformed values are ignored", []*ent.Plan{malformed}, FeatureBattles, false, LimitClassrooms, 1, false, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := FromPlans(SourceSubscription, tt.plans...)
+			if got := e.Has(tt.feature); got != tt.has {
+				t.Errorf("Has(%q) = %v, want %v", tt.feature, got, tt.has)
+			}
+			if got := e.Allows(tt.limit, tt.count); got != tt.allowed {
+				t.Errorf("Allows(%q, %d) = %v, want %v", tt.limit, tt.count, got, tt.allowed)
+			}
+			if got := e.Limit(tt.limit); got != tt.maxLimit {
+				t.Errorf("Limit(%q) = %d, want %d", tt.limit, got, tt.maxLimit)
+			}
+		})
+	}
+}
+
+func TestUnrestricted(t *testing.T) {
+	e := unrestricted()
+	if !e.Has(FeatureBattles) || e.Limit(LimitClassrooms) != Unlimited || !e.Allows(LimitClassrooms, 1000) {
+		t.Errorf("unrestricted entitlements are limited: %+v", e)
+	}
+}
@@ -0,0 +1,126 @@
+package entitlements
+
+import (
+	"context"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/classroom"
+	"gigaboo.io/lem/internal/ent/classroommembership"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// LiveStatuses are the statuses of subscriptions whose plans apply. Past due
+// subscriptions keep their plan while Stripe retries the payment.
+var LiveStatuses = []subscription.Status{
+	subscription.StatusACTIVE,
+	subscription.StatusTRIALING,
+	subscription.StatusPAST_DUE,
+}
+
+// Resolver reads entitlements from the database.
+type Resolver struct {
+	client *ent.Client
+}
+
+// NewResolver creates a new resolver.
+func NewResolver(client *ent.Client) *Resolver {
+	return &Resolver{
+		client: client,
+	}
+}
+
+// ForUser returns the entitlements of a user of an app. Besides their own
+// subscriptions, users have those of organizations they hold a seat in, and
+// students those of organizations with a seat held by one of their
+// classroom's teachers.
+func (r *Resolver) ForUser(ctx context.Context, appID, userID int) (*Entitlements, error) {
+	seated := organizationmember.HasSeat(true)
+	return r.resolve(ctx, appID, subscription.Or(
+		subscription.HasUserWith(user.ID(userID)),
+		subscription.HasOrganizationWith(organization.HasMembersWith(
+			seated,
+			organizationmember.HasUserWith(user.ID(userID)),
+		)),
+		subscription.HasOrganizationWith(organization.HasMembersWith(
+			seated,
+			organizationmember.HasUserWith(user.HasClassroomsTeachingWith(
+				classroom.HasMembershipsWith(
+					classroommembership.HasStudentWith(user.ID(userID)),
+				),
+			)),
+		)),
+	))
+}
+
+// ForOrganization returns the entitlements of an organization.
+func (r *Resolver) ForOrganization(ctx context.Context, appID, orgID int) (*Entitlements, error) {
+	return r.resolve(ctx, appID, subscription.HasOrganizationWith(organization.ID(orgID)))
+}
+
+// resolve returns the entitlements from the plans of the live subscriptions
+// matching owner, or from the app's default plan.
+func (r *Resolver) resolve(ctx context.Context, appID int, owner predicate.Subscription) (*Entitlements, error) {
+	subs, err := r.client.Subscription.Query().
+		Where(
+			owner,
+			subscription.HasAppWith(app.ID(appID)),
+			subscription.StatusIn(LiveStatuses...),
+		).
+		WithPlan().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(subs) > 0 {
+		plans := make([]*ent.Plan, len(subs))
+		for i, sub := range subs {
+			plans[i] = sub.Edges.Plan
+		}
+		return FromPlans(SourceSubscription, plans...), nil
+	}
+
+	activePlans := r.client.Plan.Query().
+		Where(
+			plan.HasAppWith(app.ID(appID)),
+			plan.IsActive(true),
+		)
+
+	defaultPlan, err := activePlans.Clone().
+		Where(plan.IsDefault(true)).
+		Order(ent.Asc(plan.FieldID)).
+		First(ctx)
+	if err == nil {
+		return FromPlans(SourceDefaultPlan, defaultPlan), nil
+	}
+	if !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	sells, err := activePlans.Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !sells {
+		return unrestricted(), nil
+	}
+	return FromPlans(SourceNone), nil
+}
+
+// Usage counts how much of what a limit counts a user of an app has.
+type Usage func(ctx context.Context, client *ent.Client, appID, userID int) (int, error)
+
+// Classrooms counts the classrooms a user teaches, for LimitClassrooms.
+func Classrooms(ctx context.Context, client *ent.Client, appID, userID int) (int, error) {
+	return client.Classroom.Query().
+		Where(
+			classroom.HasAppWith(app.ID(appID)),
+			classroom.HasTeacherWith(user.ID(userID)),
+		).
+		Count(ctx)
+}
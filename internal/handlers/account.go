@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
+	"gigaboo.io/lem/internal/services"
+)
+
+// AccountHandler handles device account upgrade endpoints.
+type AccountHandler struct {
+	accountService *services.AccountService
+	authService    *services.AuthService
+}
+
+// NewAccountHandler creates a new account handler.
+func NewAccountHandler(accountService *services.AccountService, authService *services.AuthService) *AccountHandler {
+	return &AccountHandler{
+		accountService: accountService,
+		authService:    authService,
+	}
+}
+
+// Upgrade attaches an email and password to the current device account.
+func (h *AccountHandler) Upgrade(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	if user == nil || app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.UpgradeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upgraded, err := h.accountService.Upgrade(c.Request.Context(), user, input)
+	if err != nil {
+		var locked *ratelimit.LockedError
+		if errors.As(err, &locked) {
+			c.Header("Retry-After", strconv.Itoa(locked.RetryAfterSeconds()))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.respondWithTokens(c, upgraded, app.ID)
+}
+
+// UpgradeWithGoogle attaches a Google identity to the current device account.
+func (h *AccountHandler) UpgradeWithGoogle(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	if user == nil || app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.UpgradeGoogleInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	upgraded, err := h.accountService.UpgradeWithGoogle(c.Request.Context(), user, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	h.respondWithTokens(c, upgraded, app.ID)
+}
+
+// respondWithTokens logs in to the account that survived the upgrade.
+func (h *AccountHandler) respondWithTokens(c *gin.Context, user *ent.User, appID int) {
+	tokens, err := h.authService.CompleteLogin(c.Request.Context(), user, appID, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
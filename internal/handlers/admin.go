@@ -1,6 +1,10 @@
 package handlers
 
 import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,10 +14,13 @@ import (
 
 	"github.com/gin-gonic/gin"
 
+	"gigaboo.io/lem/internal/audit"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
 	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/adminaccount"
 	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/auditevent"
 	"gigaboo.io/lem/internal/ent/emailtemplate"
 	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/organizationmember"
@@ -23,18 +30,25 @@ import (
 	"gigaboo.io/lem/internal/ent/user"
 	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/ent/webhookevent"
 	"gigaboo.io/lem/internal/middleware"
 	"gigaboo.io/lem/internal/services"
+	"gigaboo.io/lem/internal/webhook"
 )
 
 // AdminHandler handles admin API requests.
 type AdminHandler struct {
-	cfg          *config.Config
-	client       *ent.Client
-	adminAuth    *middleware.AdminAuthMiddleware
-	auth         *middleware.AuthMiddleware
-	email        *services.EmailService
-	storage      *services.StorageService
+	cfg         *config.Config
+	client      *ent.Client
+	adminAuth   *middleware.AdminAuthMiddleware
+	authService *services.AuthService
+	apps        *services.AppService
+	stripe      *services.StripeService
+	email       *services.EmailService
+	storage     *services.StorageService
+	sso         *services.SSOService
 }
 
 // NewAdminHandler creates a new admin handler.
@@ -42,17 +56,23 @@ func NewAdminHandler(
 	cfg *config.Config,
 	client *ent.Client,
 	adminAuth *middleware.AdminAuthMiddleware,
-	auth *middleware.AuthMiddleware,
+	authService *services.AuthService,
+	apps *services.AppService,
+	stripe *services.StripeService,
 	email *services.EmailService,
 	storage *services.StorageService,
+	sso *services.SSOService,
 ) *AdminHandler {
 	return &AdminHandler{
-		cfg:       cfg,
-		client:    client,
-		adminAuth: adminAuth,
-		auth:      auth,
-		email:     email,
-		storage:   storage,
+		cfg:         cfg,
+		client:      client,
+		adminAuth:   adminAuth,
+		authService: authService,
+		apps:        apps,
+		stripe:      stripe,
+		email:       email,
+		storage:     storage,
+		sso:         sso,
 	}
 }
 
@@ -80,12 +100,23 @@ func (h *AdminHandler) GoogleAuth(c *gin.Context) {
 		return
 	}
 
-	// Check if email is in admin allowlist
-	if !h.adminAuth.IsAdminEmail(userInfo.Email) {
+	// Check that the email has an admin account
+	account, err := h.adminAuth.GetAdminAccount(c.Request.Context(), userInfo.Email)
+	if err != nil {
 		c.JSON(http.StatusForbidden, gin.H{"success": false, "error": fmt.Sprintf("Access denied. %s is not an authorized admin.", userInfo.Email)})
 		return
 	}
 
+	update := h.client.AdminAccount.UpdateOne(account).
+		SetLastLoginAt(time.Now())
+	if account.Name == "" && userInfo.Name != "" {
+		update.SetName(userInfo.Name)
+	}
+	if err := update.Exec(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"success": false, "error": "Failed to create session"})
+		return
+	}
+
 	// Create admin session token
 	token, err := h.adminAuth.CreateAdminToken(userInfo.Email, userInfo.Name)
 	if err != nil {
@@ -116,7 +147,17 @@ func (h *AdminHandler) GetMe(c *gin.Context) {
 		c.JSON(http.StatusUnauthorized, gin.H{"detail": "Admin authentication required"})
 		return
 	}
-	c.JSON(http.StatusOK, gin.H{"email": admin.Email})
+	appIDs := admin.AppIDs
+	if appIDs == nil {
+		appIDs = []int{}
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"email":    admin.Email,
+		"name":     admin.Name,
+		"role":     admin.Role,
+		"all_apps": admin.AllApps(),
+		"app_ids":  appIDs,
+	})
 }
 
 // Logout clears admin session.
@@ -133,13 +174,251 @@ func (h *AdminHandler) Logout(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": true})
 }
 
+// =============================================================================
+// Admin Accounts
+// =============================================================================
+
+// AdminAccountRequest represents create/update admin account request.
+type AdminAccountRequest struct {
+	Email    *string `json:"email"`
+	Name     *string `json:"name"`
+	Role     *string `json:"role"`
+	AppIDs   []int   `json:"app_ids"`
+	IsActive *bool   `json:"is_active"`
+}
+
+// GetAdminAccounts lists admin accounts.
+func (h *AdminHandler) GetAdminAccounts(c *gin.Context) {
+	accounts, err := h.client.AdminAccount.Query().
+		WithApps().
+		Order(ent.Asc(adminaccount.FieldEmail)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch admins"})
+		return
+	}
+
+	result := make([]gin.H, len(accounts))
+	for i, a := range accounts {
+		result[i] = adminAccountJSON(a)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"admins": result})
+}
+
+// CreateAdminAccount grants an admin role to an email.
+func (h *AdminHandler) CreateAdminAccount(c *gin.Context) {
+	var req AdminAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Email == nil || req.Role == nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "email and role are required"})
+		return
+	}
+
+	email := strings.ToLower(strings.TrimSpace(*req.Email))
+	if email == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "email and role are required"})
+		return
+	}
+
+	role := adminaccount.Role(strings.ToUpper(*req.Role))
+	if err := adminaccount.RoleValidator(role); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid role"})
+		return
+	}
+
+	if err := h.checkAdminApps(c, role, req.AppIDs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	create := h.client.AdminAccount.Create().
+		SetEmail(email).
+		SetRole(role).
+		AddAppIDs(req.AppIDs...)
+	if req.Name != nil {
+		create.SetName(*req.Name)
+	}
+	if req.IsActive != nil {
+		create.SetIsActive(*req.IsActive)
+	}
+
+	account, err := create.Save(c.Request.Context())
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			c.JSON(http.StatusConflict, gin.H{"detail": "An admin with this email already exists"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to create admin"})
+		return
+	}
+
+	account, err = h.client.AdminAccount.Query().
+		Where(adminaccount.ID(account.ID)).
+		WithApps().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to create admin"})
+		return
+	}
+
+	c.JSON(http.StatusCreated, adminAccountJSON(account))
+}
+
+// UpdateAdminAccount changes an admin's role, app scope or status. Admins
+// cannot change their own account so that they cannot lock themselves out.
+func (h *AdminHandler) UpdateAdminAccount(c *gin.Context) {
+	accountID, err := strconv.Atoi(c.Param("admin_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid admin ID"})
+		return
+	}
+
+	var req AdminAccountRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid request"})
+		return
+	}
+
+	account, err := h.client.AdminAccount.Get(c.Request.Context(), accountID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"detail": "Admin not found"})
+		return
+	}
+
+	if admin := middleware.GetAdminFromGin(c); admin != nil && admin.Email == account.Email {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "You cannot change your own admin account"})
+		return
+	}
+
+	role := account.Role
+	if req.Role != nil {
+		role = adminaccount.Role(strings.ToUpper(*req.Role))
+		if err := adminaccount.RoleValidator(role); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid role"})
+			return
+		}
+	}
+
+	if err := h.checkAdminApps(c, role, req.AppIDs); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	update := h.client.AdminAccount.UpdateOne(account).
+		SetRole(role)
+	if req.Name != nil {
+		update.SetName(*req.Name)
+	}
+	if req.IsActive != nil {
+		update.SetIsActive(*req.IsActive)
+	}
+	// Only APP_ADMINs are scoped to apps
+	if role != adminaccount.RoleAPP_ADMIN {
+		update.ClearApps()
+	} else if req.AppIDs != nil {
+		update.ClearApps().AddAppIDs(req.AppIDs...)
+	}
+
+	if err := update.Exec(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to update admin"})
+		return
+	}
+
+	account, err = h.client.AdminAccount.Query().
+		Where(adminaccount.ID(accountID)).
+		WithApps().
+		Only(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to update admin"})
+		return
+	}
+
+	c.JSON(http.StatusOK, adminAccountJSON(account))
+}
+
+// DeleteAdminAccount revokes an admin's access.
+func (h *AdminHandler) DeleteAdminAccount(c *gin.Context) {
+	accountID, err := strconv.Atoi(c.Param("admin_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid admin ID"})
+		return
+	}
+
+	account, err := h.client.AdminAccount.Get(c.Request.Context(), accountID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"detail": "Admin not found"})
+		return
+	}
+
+	if admin := middleware.GetAdminFromGin(c); admin != nil && admin.Email == account.Email {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "You cannot delete your own admin account"})
+		return
+	}
+
+	if err := h.client.AdminAccount.DeleteOne(account).Exec(c.Request.Context()); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to delete admin"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": true})
+}
+
+// checkAdminApps validates the app scope given for an admin role.
+func (h *AdminHandler) checkAdminApps(c *gin.Context, role adminaccount.Role, appIDs []int) error {
+	if role != adminaccount.RoleAPP_ADMIN {
+		if len(appIDs) > 0 {
+			return errors.New("only APP_ADMIN accounts are scoped to apps")
+		}
+		return nil
+	}
+
+	if len(appIDs) == 0 {
+		return nil
+	}
+	count, err := h.client.App.Query().
+		Where(app.IDIn(appIDs...)).
+		Count(c.Request.Context())
+	if err != nil || count != len(appIDs) {
+		return errors.New("unknown app in app_ids")
+	}
+	return nil
+}
+
+func adminAccountJSON(a *ent.AdminAccount) gin.H {
+	appIDs := make([]int, len(a.Edges.Apps))
+	for i, ap := range a.Edges.Apps {
+		appIDs[i] = ap.ID
+	}
+
+	var lastLoginAt interface{}
+	if a.LastLoginAt != nil {
+		lastLoginAt = a.LastLoginAt.Format(time.RFC3339)
+	}
+
+	return gin.H{
+		"id":            a.ID,
+		"email":         a.Email,
+		"name":          a.Name,
+		"role":          a.Role,
+		"app_ids":       appIDs,
+		"is_active":     a.IsActive,
+		"last_login_at": lastLoginAt,
+		"created_at":    a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
 // =============================================================================
 // Apps API
 // =============================================================================
 
-// GetApps returns all apps.
+// GetApps returns the apps the admin can access.
 func (h *AdminHandler) GetApps(c *gin.Context) {
-	apps, err := h.client.App.Query().
+	query := h.client.App.Query()
+	if admin := middleware.GetAdminFromGin(c); admin != nil && !admin.AllApps() {
+		query = query.Where(app.IDIn(admin.AppIDs...))
+	}
+
+	apps, err := query.
 		Order(ent.Desc(app.FieldCreatedAt)).
 		All(c.Request.Context())
 	if err != nil {
@@ -149,12 +428,7 @@ func (h *AdminHandler) GetApps(c *gin.Context) {
 
 	result := make([]gin.H, len(apps))
 	for i, a := range apps {
-		result[i] = gin.H{
-			"id":         a.ID,
-			"name":       a.Name,
-			"slug":       a.Slug,
-			"created_at": a.CreatedAt.Format(time.RFC3339),
-		}
+		result[i] = appJSON(a)
 	}
 
 	c.JSON(http.StatusOK, gin.H{"apps": result})
@@ -174,12 +448,195 @@ func (h *AdminHandler) GetApp(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{
-		"id":         a.ID,
-		"name":       a.Name,
-		"slug":       a.Slug,
-		"created_at": a.CreatedAt.Format(time.RFC3339),
+	c.JSON(http.StatusOK, appJSON(a))
+}
+
+// CreateApp creates an app. Its API key is only returned in this response.
+func (h *AdminHandler) CreateApp(c *gin.Context) {
+	var req services.CreateAppInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "name and slug are required"})
+		return
+	}
+
+	a, key, err := h.apps.Create(c.Request.Context(), req)
+	if err != nil {
+		if errors.Is(err, services.ErrAppSlugTaken) {
+			c.JSON(http.StatusConflict, gin.H{"detail": "An app with this slug already exists"})
+			return
+		}
+		if errors.Is(err, services.ErrInvalidOrigin) || errors.Is(err, webhook.ErrInvalidURL) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to create app"})
+		return
+	}
+
+	result := appJSON(a)
+	result["api_key"] = key
+	c.JSON(http.StatusCreated, result)
+}
+
+// UpdateApp updates an app's settings.
+func (h *AdminHandler) UpdateApp(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	var req services.UpdateAppInput
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid request"})
+		return
+	}
+	if req.Name != nil && strings.TrimSpace(*req.Name) == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Name cannot be empty"})
+		return
+	}
+
+	a, err := h.apps.Update(c.Request.Context(), appID, req)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "App not found"})
+			return
+		}
+		if errors.Is(err, services.ErrInvalidOrigin) || errors.Is(err, webhook.ErrInvalidURL) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to update app"})
+		return
+	}
+
+	c.JSON(http.StatusOK, appJSON(a))
+}
+
+// ToggleAppStatus activates or deactivates an app.
+func (h *AdminHandler) ToggleAppStatus(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	a, err := h.apps.ToggleStatus(c.Request.Context(), appID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "App not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to toggle app status"})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"success": true, "is_active": a.IsActive})
+}
+
+// RotateKeyRequest represents an API key or secret rotation request.
+// OverlapHours is how long the replaced key keeps working, 24 by default;
+// 0 revokes it at once.
+type RotateKeyRequest struct {
+	OverlapHours *int `json:"overlap_hours"`
+}
+
+// RegenerateAPIKey gives an app a new API key, returned only in this
+// response.
+func (h *AdminHandler) RegenerateAPIKey(c *gin.Context) {
+	h.rotateCredential(c, "app.rotate_api_key", "api_key", h.apps.RotateAPIKey)
+}
+
+// RegenerateSecret gives an app a new secret key, returned only in this
+// response.
+func (h *AdminHandler) RegenerateSecret(c *gin.Context) {
+	h.rotateCredential(c, "app.rotate_secret", "api_secret", h.apps.RotateSecret)
+}
+
+func (h *AdminHandler) rotateCredential(
+	c *gin.Context,
+	action, field string,
+	rotate func(context.Context, int, time.Duration) (*ent.App, string, error),
+) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	// The body is optional
+	var req RotateKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid request"})
+		return
+	}
+	overlap := services.DefaultKeyOverlap
+	if req.OverlapHours != nil {
+		overlap = time.Duration(*req.OverlapHours) * time.Hour
+	}
+	if overlap < 0 || overlap > services.MaxKeyOverlap {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": fmt.Sprintf("overlap_hours must be between 0 and %d", int(services.MaxKeyOverlap.Hours()))})
+		return
+	}
+
+	a, key, err := rotate(c.Request.Context(), appID, overlap)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "App not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to rotate credentials"})
+		return
+	}
+
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     action,
+		TargetType: "app",
+		TargetID:   strconv.Itoa(appID),
+		Metadata: map[string]interface{}{
+			"overlap_hours": int(overlap.Hours()),
+		},
 	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
+	result := appJSON(a)
+	result[field] = key
+	c.JSON(http.StatusOK, result)
+}
+
+// appJSON returns an app's settings. Keys and secrets are never included;
+// only the prefix of the API key identifies it.
+func appJSON(a *ent.App) gin.H {
+	result := gin.H{
+		"id":                             a.ID,
+		"name":                           a.Name,
+		"slug":                           a.Slug,
+		"api_key_prefix":                 a.APIKeyPrefix,
+		"previous_api_key_expires_at":    nil,
+		"has_api_secret":                 a.APISecretHash != "",
+		"api_secret_signs":               a.APISecretEncrypted != "",
+		"previous_api_secret_expires_at": nil,
+		"allowed_origins":                a.AllowedOrigins,
+		"oauth_redirect_uris":            a.OauthRedirectUris,
+		"webhook_url":                    a.WebhookURL,
+		"stripe_product_id":              a.StripeProductID,
+		"rate_limit_per_minute":          a.RateLimitPerMinute,
+		"rate_limit_burst":               a.RateLimitBurst,
+		"magic_link_signup":              a.MagicLinkSignup,
+		"is_active":                      a.IsActive,
+		"created_at":                     a.CreatedAt.Format(time.RFC3339),
+	}
+	now := time.Now()
+	if a.PreviousAPIKeyExpiresAt != nil && a.PreviousAPIKeyExpiresAt.After(now) {
+		result["previous_api_key_expires_at"] = a.PreviousAPIKeyExpiresAt.Format(time.RFC3339)
+	}
+	if a.PreviousAPISecretExpiresAt != nil && a.PreviousAPISecretExpiresAt.After(now) {
+		result["previous_api_secret_expires_at"] = a.PreviousAPISecretExpiresAt.Format(time.RFC3339)
+	}
+	return result
 }
 
 // =============================================================================
@@ -414,11 +871,19 @@ func (h *AdminHandler) UpdateShenbiRole(c *gin.Context) {
 }
 
 // =============================================================================
-// Generate Token
+// Impersonation
 // =============================================================================
 
-// GenerateToken generates JWT tokens for a user.
-func (h *AdminHandler) GenerateToken(c *gin.Context) {
+// Impersonate issues a short-lived access token that lets the admin act as a
+// user. The token names the admin in its act claim, cannot be refreshed, and
+// every request made with it is written to the audit log.
+func (h *AdminHandler) Impersonate(c *gin.Context) {
+	admin := middleware.GetAdminFromGin(c)
+	if admin == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"detail": "Not authenticated"})
+		return
+	}
+
 	appID, err := strconv.Atoi(c.Param("app_id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
@@ -438,31 +903,332 @@ func (h *AdminHandler) GenerateToken(c *gin.Context) {
 		return
 	}
 
-	// Verify user exists
-	_, err = h.client.User.Get(c.Request.Context(), userID)
+	tokens, err := h.authService.Impersonate(c.Request.Context(), admin.Email, userID, appID, sessionInfo(c))
 	if err != nil {
-		c.JSON(http.StatusNotFound, gin.H{"detail": "User not found"})
+		if err.Error() == "user not found" {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "User not found"})
+			return
+		}
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"access_token": tokens.AccessToken,
+		"token_type":   tokens.TokenType,
+		"expires_in":   tokens.ExpiresIn,
+		"impersonator": admin.Email,
+	})
+}
+
+// GetImpersonationEvents lists impersonation audit events for an app, newest
+// first. It can be filtered by user_id and actor and paged with limit and offset.
+func (h *AdminHandler) GetImpersonationEvents(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	limit, offset, err := pagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	query := h.client.AuditEvent.Query().
+		Where(
+			auditevent.HasAppWith(app.ID(appID)),
+			auditevent.ActionHasPrefix("impersonation."),
+		)
+	if userID := c.Query("user_id"); userID != "" {
+		query = query.Where(auditevent.TargetType("user"), auditevent.TargetID(userID))
+	}
+	if actor := c.Query("actor"); actor != "" {
+		query = query.Where(auditevent.Actor(actor))
+	}
+
+	total, err := query.Clone().Count(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch audit events"})
+		return
+	}
+
+	events, err := query.
+		WithApp().
+		Order(ent.Desc(auditevent.FieldCreatedAt), ent.Desc(auditevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch audit events"})
 		return
 	}
 
-	// Generate tokens
-	accessToken, err := h.auth.GenerateAccessToken(userID, appID, 0, "")
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to generate access token"})
-		return
+	result := make([]gin.H, len(events))
+	for i, e := range events {
+		result[i] = auditEventJSON(e)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": result, "total": total})
+}
+
+// =============================================================================
+// Audit Log
+// =============================================================================
+
+// auditExportBatchSize is the number of events read at a time when exporting.
+const auditExportBatchSize = 1000
+
+// GetAuditEvents lists audit events, newest first. It can be filtered by
+// app_id, actor, actor_type, action (a trailing * matches a prefix),
+// target_type, target_id, and a since/until RFC 3339 time range, and paged
+// with limit and offset.
+func (h *AdminHandler) GetAuditEvents(c *gin.Context) {
+	query, err := h.auditEventQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	limit, offset, err := pagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	total, err := query.Clone().Count(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch audit events"})
+		return
+	}
+
+	events, err := query.
+		WithApp().
+		Order(ent.Desc(auditevent.FieldCreatedAt), ent.Desc(auditevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch audit events"})
+		return
+	}
+
+	result := make([]gin.H, len(events))
+	for i, e := range events {
+		result[i] = auditEventJSON(e)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": result, "total": total})
+}
+
+// ExportAuditEvents downloads all audit events matching the GetAuditEvents
+// filters, oldest first, as CSV or JSON depending on the format parameter.
+func (h *AdminHandler) ExportAuditEvents(c *gin.Context) {
+	query, err := h.auditEventQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	format := c.DefaultQuery("format", "csv")
+	if format != "csv" && format != "json" {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "format must be csv or json"})
+		return
+	}
+
+	filename := fmt.Sprintf("audit-events-%s.%s", time.Now().UTC().Format("20060102-150405"), format)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+
+	var (
+		csvWriter *csv.Writer
+		first     = true
+	)
+	if format == "csv" {
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Status(http.StatusOK)
+		csvWriter = csv.NewWriter(c.Writer)
+		csvWriter.Write([]string{
+			"id", "created_at", "actor", "actor_type", "action", "target_type", "target_id",
+			"app_id", "ip_address", "user_agent", "before", "after", "metadata",
+		})
+	} else {
+		c.Header("Content-Type", "application/json; charset=utf-8")
+		c.Status(http.StatusOK)
+		c.Writer.WriteString("[")
+	}
+
+	// Page by ID so that events written during the export are not skipped
+	// or repeated
+	lastID := 0
+	for {
+		events, err := query.Clone().
+			Where(auditevent.IDGT(lastID)).
+			WithApp().
+			Order(ent.Asc(auditevent.FieldID)).
+			Limit(auditExportBatchSize).
+			All(c.Request.Context())
+		if err != nil {
+			// Headers are already sent; a truncated file is the best we can do
+			c.Error(err)
+			break
+		}
+
+		for _, e := range events {
+			if csvWriter != nil {
+				csvWriter.Write(auditEventCSV(e))
+				continue
+			}
+
+			data, err := json.Marshal(auditEventJSON(e))
+			if err != nil {
+				continue
+			}
+			if !first {
+				c.Writer.WriteString(",")
+			}
+			first = false
+			c.Writer.Write(data)
+		}
+
+		if len(events) < auditExportBatchSize {
+			break
+		}
+		lastID = events[len(events)-1].ID
+	}
+
+	if csvWriter != nil {
+		csvWriter.Flush()
+	} else {
+		c.Writer.WriteString("]")
+	}
+}
+
+// auditEventQuery builds an audit event query from the request's filters.
+func (h *AdminHandler) auditEventQuery(c *gin.Context) (*ent.AuditEventQuery, error) {
+	query := h.client.AuditEvent.Query()
+
+	// Admins limited to some apps only see those apps' events
+	admin := middleware.GetAdminFromGin(c)
+	if admin != nil && !admin.AllApps() {
+		query = query.Where(auditevent.HasAppWith(app.IDIn(admin.AppIDs...)))
+	}
+
+	if v := c.Query("app_id"); v != "" {
+		appID, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, errors.New("invalid app_id")
+		}
+		query = query.Where(auditevent.HasAppWith(app.ID(appID)))
+	}
+	if v := c.Query("actor"); v != "" {
+		query = query.Where(auditevent.Actor(v))
+	}
+	if v := c.Query("actor_type"); v != "" {
+		actorType := auditevent.ActorType(strings.ToUpper(v))
+		if err := auditevent.ActorTypeValidator(actorType); err != nil {
+			return nil, errors.New("invalid actor_type")
+		}
+		query = query.Where(auditevent.ActorTypeEQ(actorType))
+	}
+	if v := c.Query("action"); v != "" {
+		if prefix, ok := strings.CutSuffix(v, "*"); ok {
+			query = query.Where(auditevent.ActionHasPrefix(prefix))
+		} else {
+			query = query.Where(auditevent.Action(v))
+		}
+	}
+	if v := c.Query("target_type"); v != "" {
+		query = query.Where(auditevent.TargetType(v))
+	}
+	if v := c.Query("target_id"); v != "" {
+		query = query.Where(auditevent.TargetID(v))
+	}
+	if v := c.Query("since"); v != "" {
+		since, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, errors.New("since must be an RFC 3339 time")
+		}
+		query = query.Where(auditevent.CreatedAtGTE(since))
+	}
+	if v := c.Query("until"); v != "" {
+		until, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, errors.New("until must be an RFC 3339 time")
+		}
+		query = query.Where(auditevent.CreatedAtLT(until))
+	}
+
+	return query, nil
+}
+
+// pagination reads the limit and offset query parameters.
+func pagination(c *gin.Context) (int, int, error) {
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "50"))
+	if err != nil || limit < 1 || limit > 200 {
+		return 0, 0, errors.New("limit must be between 1 and 200")
+	}
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		return 0, 0, errors.New("invalid offset")
+	}
+	return limit, offset, nil
+}
+
+func auditEventJSON(e *ent.AuditEvent) gin.H {
+	var appID interface{}
+	if e.Edges.App != nil {
+		appID = e.Edges.App.ID
+	}
+	return gin.H{
+		"id":          e.ID,
+		"actor":       e.Actor,
+		"actor_type":  e.ActorType,
+		"action":      e.Action,
+		"target_type": e.TargetType,
+		"target_id":   e.TargetID,
+		"app_id":      appID,
+		"ip_address":  e.IPAddress,
+		"user_agent":  e.UserAgent,
+		"before":      e.Before,
+		"after":       e.After,
+		"metadata":    e.Metadata,
+		"created_at":  e.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func auditEventCSV(e *ent.AuditEvent) []string {
+	appID := ""
+	if e.Edges.App != nil {
+		appID = strconv.Itoa(e.Edges.App.ID)
+	}
+	return []string{
+		strconv.Itoa(e.ID),
+		e.CreatedAt.Format(time.RFC3339),
+		e.Actor,
+		string(e.ActorType),
+		e.Action,
+		e.TargetType,
+		e.TargetID,
+		appID,
+		e.IPAddress,
+		e.UserAgent,
+		jsonString(e.Before),
+		jsonString(e.After),
+		jsonString(e.Metadata),
 	}
+}
 
-	refreshToken, err := h.auth.GenerateRefreshToken(userID, appID, 0)
+// jsonString encodes a map for a CSV cell, leaving it empty if there is none.
+func jsonString(m map[string]interface{}) string {
+	if len(m) == 0 {
+		return ""
+	}
+	data, err := json.Marshal(m)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to generate refresh token"})
-		return
+		return ""
 	}
-
-	c.JSON(http.StatusOK, gin.H{
-		"access_token":  accessToken,
-		"refresh_token": refreshToken,
-		"expires_in":    h.cfg.AccessTokenExpireMinutes * 60,
-	})
+	return string(data)
 }
 
 // =============================================================================
@@ -519,6 +1285,20 @@ func (h *AdminHandler) ResetProgress(c *gin.Context) {
 		achievementsDeleted = 0
 	}
 
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     "user.reset_progress",
+		TargetType: "user",
+		TargetID:   strconv.Itoa(userID),
+		Metadata: map[string]interface{}{
+			"progress_deleted":     progressDeleted,
+			"achievements_deleted": achievementsDeleted,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"success":              true,
 		"progress_deleted":     progressDeleted,
@@ -941,6 +1721,12 @@ func (h *AdminHandler) CreatePlan(c *gin.Context) {
 	// Parse billing interval
 	billingInterval := plan.BillingInterval(strings.ToUpper(req.BillingInterval))
 
+	features, err := planFeatures(req.Features)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
 	p, err := h.client.Plan.Create().
 		SetAppID(appID).
 		SetName(req.Name).
@@ -950,6 +1736,7 @@ func (h *AdminHandler) CreatePlan(c *gin.Context) {
 		SetCurrency(req.Currency).
 		SetBillingInterval(billingInterval).
 		SetStripePriceID(req.StripePriceID).
+		SetFeatures(features).
 		SetIsDefault(req.IsDefault).
 		Save(c.Request.Context())
 	if err != nil {
@@ -969,6 +1756,7 @@ type UpdatePlanRequest struct {
 	Currency        *string `json:"currency"`
 	BillingInterval *string `json:"billing_interval"`
 	StripePriceID   *string `json:"stripe_price_id"`
+	Features        *string `json:"features"`
 	IsActive        *bool   `json:"is_active"`
 	IsDefault       *bool   `json:"is_default"`
 }
@@ -1025,6 +1813,14 @@ func (h *AdminHandler) UpdatePlan(c *gin.Context) {
 	if req.StripePriceID != nil {
 		update.SetStripePriceID(*req.StripePriceID)
 	}
+	if req.Features != nil {
+		features, err := planFeatures(*req.Features)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+			return
+		}
+		update.SetFeatures(features)
+	}
 	if req.IsActive != nil {
 		update.SetIsActive(*req.IsActive)
 	}
@@ -1041,6 +1837,27 @@ func (h *AdminHandler) UpdatePlan(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": true, "id": p.ID})
 }
 
+// planFeatures parses a plan's features from the JSON object an admin
+// entered, e.g. {"battles": true, "classrooms": 3}. Features are booleans and
+// limits are numbers, with -1 for no limit.
+func planFeatures(text string) (map[string]interface{}, error) {
+	features := map[string]interface{}{}
+	if strings.TrimSpace(text) == "" {
+		return features, nil
+	}
+	if err := json.Unmarshal([]byte(text), &features); err != nil {
+		return nil, errors.New("features must be a JSON object")
+	}
+	for name, value := range features {
+		switch value.(type) {
+		case bool, float64:
+		default:
+			return nil, fmt.Errorf("feature %q must be true, false or a number", name)
+		}
+	}
+	return features, nil
+}
+
 // DeletePlan deletes a plan.
 func (h *AdminHandler) DeletePlan(c *gin.Context) {
 	appID, err := strconv.Atoi(c.Param("app_id"))
@@ -1067,6 +1884,88 @@ func (h *AdminHandler) DeletePlan(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": true})
 }
 
+// GetPlansStripeDiff lists the changes publishing an app's plans to Stripe
+// would make, without making them.
+func (h *AdminHandler) GetPlansStripeDiff(c *gin.Context) {
+	h.syncPlans(c, true)
+}
+
+// PublishPlans publishes an app's plans to Stripe as its product and prices.
+func (h *AdminHandler) PublishPlans(c *gin.Context) {
+	h.syncPlans(c, false)
+}
+
+func (h *AdminHandler) syncPlans(c *gin.Context, dryRun bool) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	result, err := h.stripe.SyncPlans(c.Request.Context(), appID, dryRun)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			c.JSON(http.StatusNotFound, gin.H{"detail": "App not found"})
+		case errors.Is(err, services.ErrPlanChanged):
+			c.JSON(http.StatusConflict, gin.H{"detail": err.Error()})
+		default:
+			c.JSON(http.StatusBadGateway, gin.H{"detail": "Failed to sync plans with Stripe: " + err.Error()})
+		}
+		return
+	}
+
+	if !dryRun && len(result.Changes) > 0 {
+		err = audit.Record(c.Request.Context(), h.client, audit.Event{
+			Action:     "plan.publish",
+			TargetType: "app",
+			TargetID:   strconv.Itoa(appID),
+			Metadata: map[string]interface{}{
+				"product_id": result.ProductID,
+				"changes":    len(result.Changes),
+			},
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// ReconcileSubscriptions re-syncs every subscription from Stripe now rather
+// than at the next scheduled reconciliation. It spans every app, so only
+// admins of every app may run it.
+func (h *AdminHandler) ReconcileSubscriptions(c *gin.Context) {
+	if !requireAllApps(c, "reconcile subscriptions") {
+		return
+	}
+
+	result, err := h.stripe.ReconcileSubscriptions(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"detail": "Failed to list subscriptions from Stripe: " + err.Error()})
+		return
+	}
+
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     "subscription.reconcile",
+		TargetType: "subscription",
+		Metadata: map[string]interface{}{
+			"checked": result.Checked,
+			"updated": result.Updated,
+			"created": result.Created,
+			"failed":  result.Failed,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 // =============================================================================
 // Organizations
 // =============================================================================
@@ -1272,6 +2171,44 @@ func (h *AdminHandler) ToggleOrganizationStatus(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"success": true, "is_active": newStatus})
 }
 
+// VerifyOrganizationDomain marks an organization's email domain as verified
+// without checking DNS, e.g. for domains of a local test IdP.
+func (h *AdminHandler) VerifyOrganizationDomain(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	orgID, err := strconv.Atoi(c.Param("org_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid organization ID"})
+		return
+	}
+
+	domainID, err := strconv.Atoi(c.Param("domain_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid domain ID"})
+		return
+	}
+
+	exists, err := h.client.Organization.Query().
+		Where(organization.ID(orgID), organization.HasAppWith(app.ID(appID))).
+		Exist(c.Request.Context())
+	if err != nil || !exists {
+		c.JSON(http.StatusNotFound, gin.H{"detail": "Organization not found"})
+		return
+	}
+
+	domain, err := h.sso.MarkDomainVerified(c.Request.Context(), orgID, domainID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain)
+}
+
 // DeleteOrganization deletes an organization.
 func (h *AdminHandler) DeleteOrganization(c *gin.Context) {
 	appID, err := strconv.Atoi(c.Param("app_id"))
@@ -1385,13 +2322,30 @@ func (h *AdminHandler) DeleteUser(c *gin.Context) {
 		subscriptionsDeleted = 0
 	}
 
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     "user.delete",
+		TargetType: "user",
+		TargetID:   strconv.Itoa(userID),
+		Metadata: map[string]interface{}{
+			"user_app_deleted":       userAppDeleted,
+			"shenbi_profile_deleted": shenbiDeleted,
+			"progress_deleted":       progressDeleted,
+			"achievements_deleted":   achievementsDeleted,
+			"subscriptions_deleted":  subscriptionsDeleted,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
-		"success":               true,
-		"user_app_deleted":      userAppDeleted,
+		"success":                true,
+		"user_app_deleted":       userAppDeleted,
 		"shenbi_profile_deleted": shenbiDeleted,
-		"progress_deleted":      progressDeleted,
-		"achievements_deleted":  achievementsDeleted,
-		"subscriptions_deleted": subscriptionsDeleted,
+		"progress_deleted":       progressDeleted,
+		"achievements_deleted":   achievementsDeleted,
+		"subscriptions_deleted":  subscriptionsDeleted,
 	})
 }
 
@@ -1524,3 +2478,368 @@ func (h *AdminHandler) DeleteStorageFile(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"success": true})
 }
+
+// =============================================================================
+// Webhooks
+// =============================================================================
+
+// GetWebhookDeliveries lists an app's webhook deliveries, newest first. They
+// can be filtered by status and event_type, and paged with limit and offset.
+func (h *AdminHandler) GetWebhookDeliveries(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	limit, offset, err := pagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	query := h.client.WebhookDelivery.Query().
+		Where(webhookdelivery.HasAppWith(app.ID(appID)))
+	if status := c.Query("status"); status != "" {
+		s := webhookdelivery.Status(status)
+		if err := webhookdelivery.StatusValidator(s); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid status"})
+			return
+		}
+		query = query.Where(webhookdelivery.StatusEQ(s))
+	}
+	if eventType := c.Query("event_type"); eventType != "" {
+		query = query.Where(webhookdelivery.EventType(eventType))
+	}
+
+	total, err := query.Clone().Count(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch webhook deliveries"})
+		return
+	}
+
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch webhook deliveries"})
+		return
+	}
+
+	result := make([]gin.H, len(deliveries))
+	for i, d := range deliveries {
+		result[i] = webhookDeliveryJSON(d)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deliveries": result, "total": total})
+}
+
+// GetWebhookDelivery returns a webhook delivery with its payload and the log
+// of its attempts.
+func (h *AdminHandler) GetWebhookDelivery(c *gin.Context) {
+	d, ok := h.webhookDelivery(c)
+	if !ok {
+		return
+	}
+
+	attempts, err := d.QueryAttemptLogs().
+		Order(ent.Desc(webhookattempt.FieldCreatedAt), ent.Desc(webhookattempt.FieldID)).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch webhook attempts"})
+		return
+	}
+
+	attemptLogs := make([]gin.H, len(attempts))
+	for i, a := range attempts {
+		attemptLogs[i] = gin.H{
+			"id":            a.ID,
+			"status_code":   a.StatusCode,
+			"error":         a.Error,
+			"response_body": a.ResponseBody,
+			"duration_ms":   a.DurationMs,
+			"created_at":    a.CreatedAt.Format(time.RFC3339),
+		}
+	}
+
+	result := webhookDeliveryJSON(d)
+	result["payload"] = d.Payload
+	result["attempt_logs"] = attemptLogs
+	c.JSON(http.StatusOK, result)
+}
+
+// RedeliverWebhook queues a webhook delivery to be sent again, including a
+// dead one.
+func (h *AdminHandler) RedeliverWebhook(c *gin.Context) {
+	d, ok := h.webhookDelivery(c)
+	if !ok {
+		return
+	}
+
+	d, err := webhook.Redeliver(c.Request.Context(), h.client, d.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to redeliver webhook"})
+		return
+	}
+
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     "webhook.redeliver",
+		TargetType: "webhook_delivery",
+		TargetID:   strconv.Itoa(d.ID),
+		Metadata: map[string]interface{}{
+			"event_id":   d.EventID,
+			"event_type": d.EventType,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
+	c.JSON(http.StatusOK, webhookDeliveryJSON(d))
+}
+
+// SendTestWebhook queues a ping event to an app's webhook URL, e.g. to check
+// that a local receiver gets and verifies webhooks.
+func (h *AdminHandler) SendTestWebhook(c *gin.Context) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return
+	}
+
+	a, err := h.client.App.Get(c.Request.Context(), appID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"detail": "App not found"})
+		return
+	}
+
+	d, err := webhook.Enqueue(c.Request.Context(), h.client, a, webhook.EventPing, map[string]interface{}{
+		"message": "Test webhook from lem",
+	})
+	if err != nil {
+		if errors.Is(err, webhook.ErrNoWebhookURL) {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "App has no webhook URL"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to queue webhook"})
+		return
+	}
+
+	c.JSON(http.StatusAccepted, webhookDeliveryJSON(d))
+}
+
+// webhookDelivery returns the delivery named in the path, or responds with
+// an error.
+func (h *AdminHandler) webhookDelivery(c *gin.Context) (*ent.WebhookDelivery, bool) {
+	appID, err := strconv.Atoi(c.Param("app_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid app ID"})
+		return nil, false
+	}
+
+	deliveryID, err := strconv.Atoi(c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid delivery ID"})
+		return nil, false
+	}
+
+	d, err := h.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.ID(deliveryID),
+			webhookdelivery.HasAppWith(app.ID(appID)),
+		).
+		Only(c.Request.Context())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "Delivery not found"})
+			return nil, false
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch webhook delivery"})
+		return nil, false
+	}
+	return d, true
+}
+
+func webhookDeliveryJSON(d *ent.WebhookDelivery) gin.H {
+	result := gin.H{
+		"id":               d.ID,
+		"event_id":         d.EventID,
+		"event_type":       d.EventType,
+		"status":           d.Status,
+		"attempts":         d.Attempts,
+		"max_attempts":     webhook.MaxAttempts,
+		"next_attempt_at":  nil,
+		"last_attempt_at":  nil,
+		"last_status_code": d.LastStatusCode,
+		"last_error":       d.LastError,
+		"delivered_at":     nil,
+		"created_at":       d.CreatedAt.Format(time.RFC3339),
+	}
+	if d.Status == webhookdelivery.StatusPENDING {
+		result["next_attempt_at"] = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	if d.LastAttemptAt != nil {
+		result["last_attempt_at"] = d.LastAttemptAt.Format(time.RFC3339)
+	}
+	if d.DeliveredAt != nil {
+		result["delivered_at"] = d.DeliveredAt.Format(time.RFC3339)
+	}
+	return result
+}
+
+// =============================================================================
+// Stripe events
+// =============================================================================
+
+// GetStripeEvents lists received Stripe webhook events, newest first. They
+// can be filtered by status and type, and paged with limit and offset.
+func (h *AdminHandler) GetStripeEvents(c *gin.Context) {
+	if !requireAllApps(c, "view Stripe events") {
+		return
+	}
+
+	limit, offset, err := pagination(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": err.Error()})
+		return
+	}
+
+	query := h.client.WebhookEvent.Query()
+	if status := c.Query("status"); status != "" {
+		s := webhookevent.Status(status)
+		if err := webhookevent.StatusValidator(s); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid status"})
+			return
+		}
+		query = query.Where(webhookevent.StatusEQ(s))
+	}
+	if eventType := c.Query("type"); eventType != "" {
+		query = query.Where(webhookevent.Type(eventType))
+	}
+
+	total, err := query.Clone().Count(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch Stripe events"})
+		return
+	}
+
+	events, err := query.
+		Order(ent.Desc(webhookevent.FieldCreatedAt), ent.Desc(webhookevent.FieldID)).
+		Limit(limit).
+		Offset(offset).
+		All(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch Stripe events"})
+		return
+	}
+
+	result := make([]gin.H, len(events))
+	for i, e := range events {
+		result[i] = stripeEventJSON(e)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"events": result, "total": total})
+}
+
+// GetStripeEvent returns a received Stripe event with its payload.
+func (h *AdminHandler) GetStripeEvent(c *gin.Context) {
+	if !requireAllApps(c, "view Stripe events") {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid event ID"})
+		return
+	}
+
+	e, err := h.client.WebhookEvent.Get(c.Request.Context(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			c.JSON(http.StatusNotFound, gin.H{"detail": "Event not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to fetch Stripe event"})
+		return
+	}
+
+	result := stripeEventJSON(e)
+	result["payload"] = json.RawMessage(e.Payload)
+	c.JSON(http.StatusOK, result)
+}
+
+// ReplayStripeEvent queues a failed Stripe event to be processed again.
+func (h *AdminHandler) ReplayStripeEvent(c *gin.Context) {
+	if !requireAllApps(c, "replay Stripe events") {
+		return
+	}
+
+	id, err := strconv.Atoi(c.Param("event_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"detail": "Invalid event ID"})
+		return
+	}
+
+	e, err := h.stripe.ReplayWebhookEvent(c.Request.Context(), id)
+	if err != nil {
+		switch {
+		case ent.IsNotFound(err):
+			c.JSON(http.StatusNotFound, gin.H{"detail": "Event not found"})
+		case errors.Is(err, services.ErrEventNotFailed):
+			c.JSON(http.StatusConflict, gin.H{"detail": "Only failed events can be replayed"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to replay Stripe event"})
+		}
+		return
+	}
+
+	err = audit.Record(c.Request.Context(), h.client, audit.Event{
+		Action:     "stripe_event.replay",
+		TargetType: "stripe_event",
+		TargetID:   e.EventID,
+		Metadata: map[string]interface{}{
+			"type": e.Type,
+		},
+	})
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"detail": "Failed to record audit event"})
+		return
+	}
+
+	c.JSON(http.StatusOK, stripeEventJSON(e))
+}
+
+// requireAllApps responds with 403 unless the admin may manage every app,
+// which is needed for data that is not any one app's.
+func requireAllApps(c *gin.Context, action string) bool {
+	if admin := middleware.GetAdminFromGin(c); admin == nil || !admin.AllApps() {
+		c.JSON(http.StatusForbidden, gin.H{"detail": "Only admins of every app can " + action})
+		return false
+	}
+	return true
+}
+
+func stripeEventJSON(e *ent.WebhookEvent) gin.H {
+	result := gin.H{
+		"id":               e.ID,
+		"event_id":         e.EventID,
+		"type":             e.Type,
+		"status":           e.Status,
+		"attempts":         e.Attempts,
+		"next_attempt_at":  nil,
+		"last_error":       e.LastError,
+		"event_created_at": e.EventCreatedAt.Format(time.RFC3339),
+		"processed_at":     nil,
+		"created_at":       e.CreatedAt.Format(time.RFC3339),
+	}
+	if e.Status == webhookevent.StatusPENDING {
+		result["next_attempt_at"] = e.NextAttemptAt.Format(time.RFC3339)
+	}
+	if e.ProcessedAt != nil {
+		result["processed_at"] = e.ProcessedAt.Format(time.RFC3339)
+	}
+	return result
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/services"
+)
+
+// AppleAuthHandler handles Sign in with Apple endpoints.
+type AppleAuthHandler struct {
+	appleAuthService *services.AppleAuthService
+	authService      *services.AuthService
+}
+
+// NewAppleAuthHandler creates a new Apple auth handler.
+func NewAppleAuthHandler(appleAuthService *services.AppleAuthService, authService *services.AuthService) *AppleAuthHandler {
+	return &AppleAuthHandler{
+		appleAuthService: appleAuthService,
+		authService:      authService,
+	}
+}
+
+// Login handles Sign in with Apple with an identity token.
+func (h *AppleAuthHandler) Login(c *gin.Context) {
+	var input services.AppleLoginInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	// Verify identity token and get/create user
+	user, err := h.appleAuthService.VerifyIDToken(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	if !user.IsActive {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "account is disabled"})
+		return
+	}
+
+	// Ensure user-app association exists
+	if err := h.appleAuthService.EnsureUserApp(c.Request.Context(), user.ID, app.ID); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to associate user with app"})
+		return
+	}
+
+	// Generate JWT tokens, or an MFA challenge
+	tokens, err := h.authService.CompleteLogin(c.Request.Context(), user, app.ID, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, tokens)
+}
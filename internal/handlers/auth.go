@@ -1,25 +1,31 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
 	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
 	"gigaboo.io/lem/internal/services"
 )
 
 // AuthHandler handles authentication endpoints.
 type AuthHandler struct {
-	authService *services.AuthService
-	auth        *middleware.AuthMiddleware
+	authService        *services.AuthService
+	googleOAuthService *services.GoogleOAuthService
+	auth               *middleware.AuthMiddleware
 }
 
 // NewAuthHandler creates a new auth handler.
-func NewAuthHandler(authService *services.AuthService, auth *middleware.AuthMiddleware) *AuthHandler {
+func NewAuthHandler(authService *services.AuthService, googleOAuthService *services.GoogleOAuthService, auth *middleware.AuthMiddleware) *AuthHandler {
 	return &AuthHandler{
-		authService: authService,
-		auth:        auth,
+		authService:        authService,
+		googleOAuthService: googleOAuthService,
+		auth:               auth,
 	}
 }
 
@@ -37,7 +43,7 @@ func (h *AuthHandler) Signup(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.authService.Signup(c.Request.Context(), app.ID, input)
+	resp, err := h.authService.Signup(c.Request.Context(), app.ID, input, sessionInfo(c))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
@@ -60,8 +66,14 @@ func (h *AuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.authService.Login(c.Request.Context(), app.ID, input)
+	resp, err := h.authService.Login(c.Request.Context(), app.ID, input, sessionInfo(c))
 	if err != nil {
+		var locked *ratelimit.LockedError
+		if errors.As(err, &locked) {
+			c.Header("Retry-After", strconv.Itoa(locked.RetryAfterSeconds()))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
 	}
@@ -83,7 +95,7 @@ func (h *AuthHandler) DeviceLogin(c *gin.Context) {
 		return
 	}
 
-	resp, err := h.authService.DeviceLogin(c.Request.Context(), app.ID, input)
+	resp, err := h.authService.DeviceLogin(c.Request.Context(), app.ID, input, sessionInfo(c))
 	if err != nil {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
 		return
@@ -119,3 +131,160 @@ func (h *AuthHandler) GetMe(c *gin.Context) {
 
 	c.JSON(http.StatusOK, gin.H{"user": user})
 }
+
+// SwitchOrg scopes the current session's tokens to an organization.
+func (h *AuthHandler) SwitchOrg(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || app == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.SwitchOrgInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.authService.SwitchOrg(c.Request.Context(), user.ID, app.ID, claims.SessionID, input, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// ClearOrg removes the organization context from the current session's tokens.
+func (h *AuthHandler) ClearOrg(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || app == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	resp, err := h.authService.ClearOrg(c.Request.Context(), user.ID, app.ID, claims.SessionID, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Revoke signs out the current session.
+// If a google_token query parameter is given, the Google token is revoked too.
+func (h *AuthHandler) Revoke(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	if claims.SessionID != 0 {
+		if err := h.authService.RevokeSession(c.Request.Context(), user.ID, claims.SessionID); err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if googleToken := c.Query("google_token"); googleToken != "" {
+		if err := h.googleOAuthService.RevokeToken(c.Request.Context(), googleToken); err != nil {
+			c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": true})
+}
+
+// ListSessions lists the current user's active sessions.
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || app == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	sessions, err := h.authService.ListSessions(c.Request.Context(), user.ID, app.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	result := make([]gin.H, len(sessions))
+	for i, s := range sessions {
+		var lastUsedAt *string
+		if s.LastUsedAt != nil {
+			formatted := s.LastUsedAt.Format(time.RFC3339)
+			lastUsedAt = &formatted
+		}
+		result[i] = gin.H{
+			"id":           s.ID,
+			"user_agent":   s.UserAgent,
+			"ip_address":   s.IPAddress,
+			"created_at":   s.CreatedAt.Format(time.RFC3339),
+			"last_used_at": lastUsedAt,
+			"expires_at":   s.ExpiresAt.Format(time.RFC3339),
+			"current":      s.ID == claims.SessionID,
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sessions": result})
+}
+
+// RevokeSession revokes one of the current user's sessions.
+func (h *AuthHandler) RevokeSession(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	sessionID, err := strconv.Atoi(c.Param("session_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session id"})
+		return
+	}
+
+	if err := h.authService.RevokeSession(c.Request.Context(), user.ID, sessionID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": true})
+}
+
+// RevokeOtherSessions revokes all of the current user's sessions in the app
+// except the current one.
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	count, err := h.authService.RevokeOtherSessions(c.Request.Context(), user.ID, claims.AppID, claims.SessionID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": count})
+}
+
+// sessionInfo describes the device making the request.
+func sessionInfo(c *gin.Context) services.SessionInfo {
+	return services.SessionInfo{
+		UserAgent: c.Request.UserAgent(),
+		IPAddress: c.ClientIP(),
+	}
+}
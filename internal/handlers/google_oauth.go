@@ -12,14 +12,14 @@ import (
 // GoogleOAuthHandler handles Google OAuth endpoints.
 type GoogleOAuthHandler struct {
 	googleOAuthService *services.GoogleOAuthService
-	auth               *middleware.AuthMiddleware
+	authService        *services.AuthService
 }
 
 // NewGoogleOAuthHandler creates a new Google OAuth handler.
-func NewGoogleOAuthHandler(googleOAuthService *services.GoogleOAuthService, auth *middleware.AuthMiddleware) *GoogleOAuthHandler {
+func NewGoogleOAuthHandler(googleOAuthService *services.GoogleOAuthService, authService *services.AuthService) *GoogleOAuthHandler {
 	return &GoogleOAuthHandler{
 		googleOAuthService: googleOAuthService,
-		auth:               auth,
+		authService:        authService,
 	}
 }
 
@@ -61,24 +61,23 @@ func (h *GoogleOAuthHandler) Login(c *gin.Context) {
 		return
 	}
 
-	// Generate JWT tokens
-	accessToken, err := h.auth.GenerateAccessToken(user.ID, app.ID, 0, "")
+	// Generate JWT tokens, or an MFA challenge
+	tokens, err := h.authService.CompleteLogin(c.Request.Context(), user, app.ID, sessionInfo(c))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	refreshToken, err := h.auth.GenerateRefreshToken(user.ID, app.ID, 0)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if tokens.MFARequired {
+		c.JSON(http.StatusOK, tokens)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"access_token":  accessToken,
-		"refresh_token": refreshToken,
-		"token_type":    "Bearer",
-		"expires_in":    1800, // 30 minutes
+		"access_token":  tokens.AccessToken,
+		"refresh_token": tokens.RefreshToken,
+		"token_type":    tokens.TokenType,
+		"expires_in":    tokens.ExpiresIn,
 		"user": gin.H{
 			"id":          user.ID,
 			"email":       user.Email,
@@ -123,25 +122,24 @@ func (h *GoogleOAuthHandler) Callback(c *gin.Context) {
 		return
 	}
 
-	// Generate JWT tokens
-	accessToken, err := h.auth.GenerateAccessToken(user.ID, app.ID, 0, "")
+	// Generate JWT tokens, or an MFA challenge
+	tokens, err := h.authService.CompleteLogin(c.Request.Context(), user, app.ID, sessionInfo(c))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	refreshToken, err := h.auth.GenerateRefreshToken(user.ID, app.ID, 0)
-	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	if tokens.MFARequired {
+		c.JSON(http.StatusOK, tokens)
 		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"access_token":       accessToken,
-		"refresh_token":      refreshToken,
-		"token_type":         "Bearer",
-		"google_token":       token.AccessToken,
-		"google_expiry":      token.Expiry,
-		"user":               user,
+		"access_token":  tokens.AccessToken,
+		"refresh_token": tokens.RefreshToken,
+		"token_type":    tokens.TokenType,
+		"google_token":  token.AccessToken,
+		"google_expiry": token.Expiry,
+		"user":          user,
 	})
 }
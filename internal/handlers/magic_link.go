@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/services"
+)
+
+// MagicLinkHandler handles passwordless magic-link login endpoints.
+type MagicLinkHandler struct {
+	verificationService *services.VerificationService
+	authService         *services.AuthService
+}
+
+// NewMagicLinkHandler creates a new magic link handler.
+func NewMagicLinkHandler(verificationService *services.VerificationService, authService *services.AuthService) *MagicLinkHandler {
+	return &MagicLinkHandler{
+		verificationService: verificationService,
+		authService:         authService,
+	}
+}
+
+// Request emails a magic login link.
+func (h *MagicLinkHandler) Request(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	var input services.MagicLinkInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.verificationService.RequestMagicLink(c.Request.Context(), app, input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sent": true})
+}
+
+// Exchange logs in with a magic link token.
+func (h *MagicLinkHandler) Exchange(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	var input services.MagicLinkExchangeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.verificationService.ConsumeMagicLink(c.Request.Context(), app, input)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.authService.CompleteLogin(c.Request.Context(), user, app.ID, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
+	"gigaboo.io/lem/internal/services"
+)
+
+// MFAHandler handles two-factor authentication endpoints.
+type MFAHandler struct {
+	mfaService  *services.MFAService
+	authService *services.AuthService
+}
+
+// NewMFAHandler creates a new MFA handler.
+func NewMFAHandler(mfaService *services.MFAService, authService *services.AuthService) *MFAHandler {
+	return &MFAHandler{
+		mfaService:  mfaService,
+		authService: authService,
+	}
+}
+
+// Verify completes a two-factor login with a TOTP or recovery code.
+func (h *MFAHandler) Verify(c *gin.Context) {
+	var input services.MFAVerifyInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	resp, err := h.authService.VerifyMFA(c.Request.Context(), app.ID, input, sessionInfo(c))
+	if err != nil {
+		var locked *ratelimit.LockedError
+		if errors.As(err, &locked) {
+			c.Header("Retry-After", strconv.Itoa(locked.RetryAfterSeconds()))
+			c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// SetupTOTP starts TOTP enrollment and returns the secret and provisioning URI.
+func (h *MFAHandler) SetupTOTP(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	if user == nil || app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	resp, err := h.mfaService.SetupTOTP(c.Request.Context(), user.ID, app.Name)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// EnableTOTP confirms TOTP enrollment with a code and returns recovery codes.
+func (h *MFAHandler) EnableTOTP(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.MFACodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.mfaService.EnableTOTP(c.Request.Context(), user.ID, claims.SessionID, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DisableTOTP turns off two-factor authentication.
+func (h *MFAHandler) DisableTOTP(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.MFACodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.mfaService.DisableTOTP(c.Request.Context(), user.ID, input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"disabled": true})
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes.
+func (h *MFAHandler) RegenerateRecoveryCodes(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.MFACodeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.mfaService.RegenerateRecoveryCodes(c.Request.Context(), user.ID, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
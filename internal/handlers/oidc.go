@@ -0,0 +1,183 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/services"
+)
+
+// OIDCHandler handles the OpenID Connect provider endpoints.
+type OIDCHandler struct {
+	oidcService *services.OIDCService
+}
+
+// NewOIDCHandler creates a new OIDC handler.
+func NewOIDCHandler(oidcService *services.OIDCService) *OIDCHandler {
+	return &OIDCHandler{
+		oidcService: oidcService,
+	}
+}
+
+// Discovery returns the OpenID Provider configuration.
+func (h *OIDCHandler) Discovery(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, h.oidcService.Discovery())
+}
+
+// AuthorizeRedirect validates an authentication request from the browser and
+// forwards it to the login page.
+func (h *OIDCHandler) AuthorizeRedirect(c *gin.Context) {
+	var req services.AuthorizeRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+
+	// An unknown client or redirect URI must not be redirected to
+	if _, err := h.oidcService.ValidateClient(c.Request.Context(), req); err != nil {
+		c.JSON(http.StatusBadRequest, err)
+		return
+	}
+
+	if _, err := h.oidcService.ValidateRequest(req); err != nil {
+		c.Redirect(http.StatusFound, h.oidcService.ErrorRedirect(req, err))
+		return
+	}
+
+	loginURL, err := h.oidcService.LoginPageURL(c.Request.URL.RawQuery)
+	if err != nil {
+		c.Redirect(http.StatusFound, h.oidcService.ErrorRedirect(req, err))
+		return
+	}
+
+	c.Redirect(http.StatusFound, loginURL)
+}
+
+// Authorize answers an authentication request for the signed-in user.
+func (h *OIDCHandler) Authorize(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	claims := middleware.GetClaimsFromGin(c)
+	if user == nil || claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.AuthorizeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.oidcService.Authorize(c.Request.Context(), user, claims.SessionID, input)
+	if err != nil {
+		var oauthErr *services.OAuthError
+		if errors.As(err, &oauthErr) {
+			c.JSON(http.StatusBadRequest, oauthErr)
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Token exchanges an authorization code or refresh token for tokens.
+func (h *OIDCHandler) Token(c *gin.Context) {
+	c.Header("Cache-Control", "no-store")
+	c.Header("Pragma", "no-cache")
+
+	var input services.TokenInput
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid_request", "error_description": err.Error()})
+		return
+	}
+
+	// Client credentials in the Authorization header are form-encoded
+	var basicID, basicSecret string
+	if id, secret, ok := c.Request.BasicAuth(); ok {
+		basicID, _ = url.QueryUnescape(id)
+		basicSecret, _ = url.QueryUnescape(secret)
+	}
+
+	resp, err := h.oidcService.Token(c.Request.Context(), input, basicID, basicSecret, sessionInfo(c))
+	if err != nil {
+		var oauthErr *services.OAuthError
+		if !errors.As(err, &oauthErr) {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "server_error"})
+			return
+		}
+		status := http.StatusBadRequest
+		if oauthErr.Code == "invalid_client" {
+			c.Header("WWW-Authenticate", `Basic realm="lem"`)
+			status = http.StatusUnauthorized
+		}
+		c.JSON(status, oauthErr)
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// UserInfo returns the claims about the user of an access token.
+func (h *OIDCHandler) UserInfo(c *gin.Context) {
+	claims := middleware.GetClaimsFromGin(c)
+	if claims == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid_token"})
+		return
+	}
+
+	info, err := h.oidcService.UserInfo(c.Request.Context(), claims.UserID, claims.AppID)
+	if err != nil {
+		c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+		c.JSON(http.StatusUnauthorized, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, info)
+}
+
+// ListConsents returns the apps the user has allowed to log them in.
+func (h *OIDCHandler) ListConsents(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	consents, err := h.oidcService.ListConsents(c.Request.Context(), user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"consents": consents})
+}
+
+// RevokeConsent withdraws the user's consent for an app.
+func (h *OIDCHandler) RevokeConsent(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	if user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	consentID, err := strconv.Atoi(c.Param("consent_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid consent id"})
+		return
+	}
+
+	if err := h.oidcService.RevokeConsent(c.Request.Context(), user.ID, consentID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": true})
+}
@@ -12,13 +12,15 @@ import (
 
 // OrganizationHandler handles organization endpoints.
 type OrganizationHandler struct {
-	orgService *services.OrganizationService
+	orgService    *services.OrganizationService
+	stripeService *services.StripeService
 }
 
 // NewOrganizationHandler creates a new organization handler.
-func NewOrganizationHandler(orgService *services.OrganizationService) *OrganizationHandler {
+func NewOrganizationHandler(orgService *services.OrganizationService, stripeService *services.StripeService) *OrganizationHandler {
 	return &OrganizationHandler{
-		orgService: orgService,
+		orgService:    orgService,
+		stripeService: stripeService,
 	}
 }
 
@@ -42,13 +44,13 @@ func (h *OrganizationHandler) List(c *gin.Context) {
 
 // Get returns a single organization.
 func (h *OrganizationHandler) Get(c *gin.Context) {
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
-	org, err := h.orgService.GetByID(c.Request.Context(), orgID)
+	org, err := h.orgService.GetByID(c.Request.Context(), orgCtx.OrgID)
 	if err != nil {
 		c.JSON(http.StatusNotFound, gin.H{"error": "organization not found"})
 		return
@@ -83,22 +85,9 @@ func (h *OrganizationHandler) Create(c *gin.Context) {
 
 // Update updates an organization.
 func (h *OrganizationHandler) Update(c *gin.Context) {
-	user := middleware.GetUserFromGin(c)
-	if user == nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
-		return
-	}
-
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
-		return
-	}
-
-	// Check if user is admin
-	isAdmin, err := h.orgService.IsAdmin(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": "permission denied"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
@@ -108,7 +97,7 @@ func (h *OrganizationHandler) Update(c *gin.Context) {
 		return
 	}
 
-	org, err := h.orgService.Update(c.Request.Context(), orgID, input)
+	org, err := h.orgService.Update(c.Request.Context(), orgCtx.OrgID, input)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -119,26 +108,13 @@ func (h *OrganizationHandler) Update(c *gin.Context) {
 
 // Delete deletes an organization.
 func (h *OrganizationHandler) Delete(c *gin.Context) {
-	user := middleware.GetUserFromGin(c)
-	if user == nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
-		return
-	}
-
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
-	// Check if user is owner
-	isOwner, err := h.orgService.IsOwner(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isOwner {
-		c.JSON(http.StatusForbidden, gin.H{"error": "only owner can delete organization"})
-		return
-	}
-
-	if err := h.orgService.Delete(c.Request.Context(), orgID); err != nil {
+	if err := h.orgService.Delete(c.Request.Context(), orgCtx.OrgID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -148,13 +124,13 @@ func (h *OrganizationHandler) Delete(c *gin.Context) {
 
 // ListMembers lists all members of an organization.
 func (h *OrganizationHandler) ListMembers(c *gin.Context) {
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
-	members, err := h.orgService.GetMembers(c.Request.Context(), orgID)
+	members, err := h.orgService.GetMembers(c.Request.Context(), orgCtx.OrgID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -165,15 +141,9 @@ func (h *OrganizationHandler) ListMembers(c *gin.Context) {
 
 // RemoveMember removes a member from organization.
 func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
-	user := middleware.GetUserFromGin(c)
-	if user == nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
-		return
-	}
-
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
@@ -183,45 +153,59 @@ func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
 		return
 	}
 
-	// Check if user is admin
-	isAdmin, err := h.orgService.IsAdmin(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": "permission denied"})
-		return
-	}
-
-	if err := h.orgService.RemoveMember(c.Request.Context(), memberID); err != nil {
+	if err := h.orgService.RemoveMember(c.Request.Context(), orgCtx.OrgID, memberID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
+	h.stripeService.SeatsChanged(c.Request.Context(), orgCtx.OrgID)
 
 	c.JSON(http.StatusOK, gin.H{"removed": true})
 }
 
-// UpdateMemberRole updates a member's role.
-func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
-	user := middleware.GetUserFromGin(c)
-	if user == nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+// AssignSeat gives a member a seat of the organization's subscription.
+func (h *OrganizationHandler) AssignSeat(c *gin.Context) {
+	h.setSeat(c, true)
+}
+
+// ReleaseSeat takes a member's seat back.
+func (h *OrganizationHandler) ReleaseSeat(c *gin.Context) {
+	h.setSeat(c, false)
+}
+
+func (h *OrganizationHandler) setSeat(c *gin.Context, hasSeat bool) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
-	orgID, err := strconv.Atoi(c.Param("org_id"))
+	memberID, err := strconv.Atoi(c.Param("member_id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid member id"})
 		return
 	}
 
-	memberID, err := strconv.Atoi(c.Param("member_id"))
+	member, err := h.orgService.SetMemberSeat(c.Request.Context(), orgCtx.OrgID, memberID, hasSeat)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid member id"})
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
 		return
 	}
+	h.stripeService.SeatsChanged(c.Request.Context(), orgCtx.OrgID)
 
-	// Check if user is owner (only owners can change roles)
-	isOwner, err := h.orgService.IsOwner(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isOwner {
-		c.JSON(http.StatusForbidden, gin.H{"error": "only owner can change roles"})
+	c.JSON(http.StatusOK, member)
+}
+
+// UpdateMemberRole updates a member's role.
+func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	memberID, err := strconv.Atoi(c.Param("member_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid member id"})
 		return
 	}
 
@@ -231,7 +215,7 @@ func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
 		return
 	}
 
-	member, err := h.orgService.UpdateMemberRole(c.Request.Context(), memberID, input.Role)
+	member, err := h.orgService.UpdateMemberRole(c.Request.Context(), orgCtx.OrgID, memberID, input.Role)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -242,13 +226,13 @@ func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
 
 // ListInvitations lists all invitations for an organization.
 func (h *OrganizationHandler) ListInvitations(c *gin.Context) {
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
-	invitations, err := h.orgService.GetInvitations(c.Request.Context(), orgID)
+	invitations, err := h.orgService.GetInvitations(c.Request.Context(), orgCtx.OrgID)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -265,16 +249,9 @@ func (h *OrganizationHandler) CreateInvitation(c *gin.Context) {
 		return
 	}
 
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
-		return
-	}
-
-	// Check if user is admin
-	isAdmin, err := h.orgService.IsAdmin(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": "permission denied"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
@@ -284,7 +261,7 @@ func (h *OrganizationHandler) CreateInvitation(c *gin.Context) {
 		return
 	}
 
-	invitation, err := h.orgService.CreateInvitation(c.Request.Context(), orgID, user.ID, input)
+	invitation, err := h.orgService.CreateInvitation(c.Request.Context(), orgCtx.OrgID, user.ID, input)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
@@ -312,21 +289,16 @@ func (h *OrganizationHandler) AcceptInvitation(c *gin.Context) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 		return
 	}
+	h.stripeService.SeatsChanged(c.Request.Context(), org.ID)
 
 	c.JSON(http.StatusOK, gin.H{"organization": org})
 }
 
 // RevokeInvitation revokes an invitation.
 func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
-	user := middleware.GetUserFromGin(c)
-	if user == nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
-		return
-	}
-
-	orgID, err := strconv.Atoi(c.Param("org_id"))
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
 		return
 	}
 
@@ -336,14 +308,7 @@ func (h *OrganizationHandler) RevokeInvitation(c *gin.Context) {
 		return
 	}
 
-	// Check if user is admin
-	isAdmin, err := h.orgService.IsAdmin(c.Request.Context(), orgID, user.ID)
-	if err != nil || !isAdmin {
-		c.JSON(http.StatusForbidden, gin.H{"error": "permission denied"})
-		return
-	}
-
-	if err := h.orgService.RevokeInvitation(c.Request.Context(), invID); err != nil {
+	if err := h.orgService.RevokeInvitation(c.Request.Context(), orgCtx.OrgID, invID); err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/services"
+)
+
+// SSOHandler handles enterprise single sign-on endpoints.
+type SSOHandler struct {
+	ssoService *services.SSOService
+}
+
+// NewSSOHandler creates a new SSO handler.
+func NewSSOHandler(ssoService *services.SSOService) *SSOHandler {
+	return &SSOHandler{
+		ssoService: ssoService,
+	}
+}
+
+// Login sends the browser to the organization's identity provider.
+func (h *SSOHandler) Login(c *gin.Context) {
+	orgID, err := strconv.Atoi(c.Param("org_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	authURL, err := h.ssoService.StartLogin(c.Request.Context(), orgID, c.Query("redirect_url"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// SAMLMetadata returns the SAML service provider metadata to register at the IdP.
+func (h *SSOHandler) SAMLMetadata(c *gin.Context) {
+	orgID, err := strconv.Atoi(c.Param("org_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	metadata, err := h.ssoService.SAMLMetadata(c.Request.Context(), orgID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Data(http.StatusOK, "application/samlmetadata+xml", metadata)
+}
+
+// SAMLACS is the assertion consumer service the IdP posts SAML responses to.
+func (h *SSOHandler) SAMLACS(c *gin.Context) {
+	orgID, err := strconv.Atoi(c.Param("org_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	redirectTo, err := h.ssoService.HandleSAMLResponse(c.Request.Context(), orgID, c.PostForm("SAMLResponse"), c.PostForm("RelayState"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Redirect(http.StatusSeeOther, redirectTo)
+}
+
+// OIDCCallback is the redirect URI registered at the OpenID provider.
+func (h *SSOHandler) OIDCCallback(c *gin.Context) {
+	orgID, err := strconv.Atoi(c.Param("org_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid organization id"})
+		return
+	}
+
+	redirectTo, err := h.ssoService.HandleOIDCCallback(c.Request.Context(), orgID, c.Query("state"), c.Query("code"), c.Query("error"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Redirect(http.StatusFound, redirectTo)
+}
+
+// Discover tells the app whether an email signs in through SSO.
+func (h *SSOHandler) Discover(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	var input services.SSODiscoverInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ssoService.Discover(c.Request.Context(), app.ID, input)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// Exchange logs in with the code an SSO login returned to the app.
+func (h *SSOHandler) Exchange(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	var input services.SSOExchangeInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ssoService.Exchange(c.Request.Context(), app.ID, input, sessionInfo(c))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetConnection returns the organization's SSO connection.
+func (h *SSOHandler) GetConnection(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	resp, err := h.ssoService.GetConnection(c.Request.Context(), orgCtx.OrgID)
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// SaveConnection configures the organization's SSO connection.
+func (h *SSOHandler) SaveConnection(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	var input services.SSOConnectionInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := h.ssoService.SaveConnection(c.Request.Context(), orgCtx.OrgID, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// DeleteConnection removes the organization's SSO connection.
+func (h *SSOHandler) DeleteConnection(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	if err := h.ssoService.DeleteConnection(c.Request.Context(), orgCtx.OrgID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": true})
+}
+
+// ListDomains lists the organization's email domains.
+func (h *SSOHandler) ListDomains(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	domains, err := h.ssoService.ListDomains(c.Request.Context(), orgCtx.OrgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"domains": domains})
+}
+
+// AddDomain claims an email domain for the organization.
+func (h *SSOHandler) AddDomain(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	var input services.AddDomainInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	domain, err := h.ssoService.AddDomain(c.Request.Context(), orgCtx.OrgID, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, domain)
+}
+
+// VerifyDomain checks the DNS record of a domain.
+func (h *SSOHandler) VerifyDomain(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	domainID, err := strconv.Atoi(c.Param("domain_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid domain id"})
+		return
+	}
+
+	domain, err := h.ssoService.VerifyDomain(c.Request.Context(), orgCtx.OrgID, domainID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, domain)
+}
+
+// DeleteDomain removes a domain from the organization.
+func (h *SSOHandler) DeleteDomain(c *gin.Context) {
+	orgCtx := middleware.GetOrgFromGin(c)
+	if orgCtx == nil {
+		c.JSON(http.StatusForbidden, gin.H{"error": "organization context required"})
+		return
+	}
+
+	domainID, err := strconv.Atoi(c.Param("domain_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid domain id"})
+		return
+	}
+
+	if err := h.ssoService.DeleteDomain(c.Request.Context(), orgCtx.OrgID, domainID); err != nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"deleted": true})
+}
@@ -58,6 +58,43 @@ func (h *SubscriptionHandler) GetCurrentSubscription(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"subscription": subscription})
 }
 
+// GetEntitlements returns the features and limits the user's plan gives them.
+func (h *SubscriptionHandler) GetEntitlements(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	user := middleware.GetUserFromGin(c)
+	if app == nil || user == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	entitlements, err := h.stripeService.GetEntitlements(c.Request.Context(), app.ID, user.ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get entitlements"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entitlements)
+}
+
+// GetOrganizationEntitlements returns the features and limits an
+// organization's plan gives it.
+func (h *SubscriptionHandler) GetOrganizationEntitlements(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	org := middleware.GetOrgFromGin(c)
+	if app == nil || org == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	entitlements, err := h.stripeService.GetOrganizationEntitlements(c.Request.Context(), app.ID, org.OrgID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to get entitlements"})
+		return
+	}
+
+	c.JSON(http.StatusOK, entitlements)
+}
+
 // CreateCheckout creates a Stripe checkout session.
 func (h *SubscriptionHandler) CreateCheckout(c *gin.Context) {
 	var input services.CreateCheckoutInput
@@ -85,9 +122,63 @@ func (h *SubscriptionHandler) CreateCheckout(c *gin.Context) {
 	})
 }
 
+// CreateOrganizationCheckout creates a Stripe checkout session for an
+// organization's subscription, with a seat for each member. The input is
+// JSON or, as the SDK sends it, query parameters.
+func (h *SubscriptionHandler) CreateOrganizationCheckout(c *gin.Context) {
+	var input services.CreateCheckoutInput
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	app := middleware.GetAppFromGin(c)
+	user := middleware.GetUserFromGin(c)
+	org := middleware.GetOrgFromGin(c)
+	if app == nil || user == nil || org == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	session, err := h.stripeService.CreateOrganizationCheckoutSession(c.Request.Context(), app.ID, org.OrgID, user.ID, input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"session_id": session.ID,
+		"url":        session.URL,
+	})
+}
+
+// GetOrganizationSubscription returns an organization's subscription and its
+// seats.
+func (h *SubscriptionHandler) GetOrganizationSubscription(c *gin.Context) {
+	org := middleware.GetOrgFromGin(c)
+	if org == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	subscription, seated, err := h.stripeService.GetOrganizationSubscription(c.Request.Context(), org.OrgID)
+	if err != nil {
+		c.JSON(http.StatusOK, gin.H{"subscription": nil})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"subscription": subscription,
+		"seats": gin.H{
+			"paid":     subscription.Quantity,
+			"assigned": seated,
+		},
+	})
+}
+
 // CreatePortalInput represents portal session request.
 type CreatePortalInput struct {
-	ReturnURL string `json:"return_url" binding:"required"`
+	ReturnURL string `json:"return_url" form:"return_url" binding:"required"`
 }
 
 // CreatePortal creates a Stripe billing portal session.
@@ -114,6 +205,30 @@ func (h *SubscriptionHandler) CreatePortal(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"url": session.URL})
 }
 
+// CreateOrganizationPortal creates a Stripe billing portal session for an
+// organization. The input is JSON or query parameters.
+func (h *SubscriptionHandler) CreateOrganizationPortal(c *gin.Context) {
+	var input CreatePortalInput
+	if err := c.ShouldBind(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	org := middleware.GetOrgFromGin(c)
+	if org == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	session, err := h.stripeService.CreateOrganizationPortalSession(c.Request.Context(), org.OrgID, input.ReturnURL)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"url": session.URL})
+}
+
 // HandleWebhook handles Stripe webhook events.
 func (h *SubscriptionHandler) HandleWebhook(c *gin.Context) {
 	signature := c.GetHeader("Stripe-Signature")
@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/services"
+)
+
+// VerificationHandler handles password reset and email verification endpoints.
+type VerificationHandler struct {
+	verificationService *services.VerificationService
+}
+
+// NewVerificationHandler creates a new verification handler.
+func NewVerificationHandler(verificationService *services.VerificationService) *VerificationHandler {
+	return &VerificationHandler{
+		verificationService: verificationService,
+	}
+}
+
+// ForgotPassword emails a password reset link.
+func (h *VerificationHandler) ForgotPassword(c *gin.Context) {
+	app := middleware.GetAppFromGin(c)
+	if app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "app not found"})
+		return
+	}
+
+	var input services.ForgotPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.verificationService.RequestPasswordReset(c.Request.Context(), app, input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sent": true})
+}
+
+// ResetPassword sets a new password using a reset token.
+func (h *VerificationHandler) ResetPassword(c *gin.Context) {
+	var input services.ResetPasswordInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.verificationService.ResetPassword(c.Request.Context(), input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"reset": true})
+}
+
+// SendVerification emails an email verification link to the current user.
+func (h *VerificationHandler) SendVerification(c *gin.Context) {
+	user := middleware.GetUserFromGin(c)
+	app := middleware.GetAppFromGin(c)
+	if user == nil || app == nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return
+	}
+
+	var input services.SendVerificationInput
+	if err := c.ShouldBindJSON(&input); err != nil && c.Request.ContentLength > 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.verificationService.SendVerification(c.Request.Context(), app, user, input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"sent": true})
+}
+
+// ConfirmVerification verifies an email address using a verification token.
+func (h *VerificationHandler) ConfirmVerification(c *gin.Context) {
+	var input services.ConfirmVerificationInput
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	user, err := h.verificationService.ConfirmVerification(c.Request.Context(), input)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": user})
+}
@@ -0,0 +1,343 @@
+// Package jwtkeys manages the keys used to sign and verify lem's JWTs.
+//
+// A KeySet signs with a single active key and verifies with any of its keys,
+// so keys can be rotated without invalidating tokens already issued: publish
+// the new key for verification first, then make it the signing key, and drop
+// the old key once its tokens have expired.
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Supported algorithms.
+const (
+	HS256 = "HS256"
+	RS256 = "RS256"
+	EdDSA = "EdDSA"
+)
+
+// Key is a single asymmetric key identified by its kid.
+type Key struct {
+	ID      string
+	private crypto.Signer
+	public  crypto.PublicKey
+}
+
+// KeySet signs tokens with its active key and verifies them with any of its keys.
+type KeySet struct {
+	method  jwt.SigningMethod
+	secret  []byte
+	signing *Key
+	keys    map[string]*Key
+	ordered []*Key
+}
+
+// NewHMAC creates a key set that signs and verifies with a shared secret.
+func NewHMAC(secret string) *KeySet {
+	return &KeySet{
+		method: jwt.SigningMethodHS256,
+		secret: []byte(secret),
+	}
+}
+
+// Load creates a key set from PEM files. The first file holds the private
+// signing key; the remaining files hold private or public keys that are only
+// used for verification.
+func Load(algorithm string, paths []string) (*KeySet, error) {
+	if len(paths) == 0 {
+		return nil, errors.New("no signing keys configured")
+	}
+
+	pems := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key %s: %w", path, err)
+		}
+		pems = append(pems, data)
+	}
+	return Parse(algorithm, pems)
+}
+
+// Parse creates a key set from PEM-encoded keys, the first being the signing key.
+func Parse(algorithm string, pems [][]byte) (*KeySet, error) {
+	method, err := signingMethod(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &KeySet{
+		method: method,
+		keys:   make(map[string]*Key),
+	}
+	for i, data := range pems {
+		key, err := parseKey(algorithm, data)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			if key.private == nil {
+				return nil, errors.New("signing key must be a private key")
+			}
+			set.signing = key
+		}
+		if _, ok := set.keys[key.ID]; ok {
+			continue
+		}
+		set.keys[key.ID] = key
+		set.ordered = append(set.ordered, key)
+	}
+	return set, nil
+}
+
+// Generate creates a key set with a new random key. Tokens signed with it
+// do not survive a restart, so it is only meant for local development.
+func Generate(algorithm string) (*KeySet, error) {
+	method, err := signingMethod(algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	var private crypto.Signer
+	switch algorithm {
+	case RS256:
+		private, err = rsa.GenerateKey(rand.Reader, 2048)
+	case EdDSA:
+		_, private, err = ed25519.GenerateKey(rand.Reader)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := newKey(private, private.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &KeySet{
+		method:  method,
+		signing: key,
+		keys:    map[string]*Key{key.ID: key},
+		ordered: []*Key{key},
+	}, nil
+}
+
+// Algorithm returns the signing algorithm of the key set.
+func (s *KeySet) Algorithm() string {
+	return s.method.Alg()
+}
+
+// Sign signs claims with the active key, setting the kid header.
+func (s *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(s.method, claims)
+	if s.secret != nil {
+		return token.SignedString(s.secret)
+	}
+
+	token.Header["kid"] = s.signing.ID
+	return token.SignedString(s.signing.private)
+}
+
+// ParseWithClaims verifies a token against the key set and decodes its claims.
+func (s *KeySet) ParseWithClaims(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, s.keyfunc, jwt.WithValidMethods([]string{s.method.Alg()}))
+}
+
+func (s *KeySet) keyfunc(token *jwt.Token) (interface{}, error) {
+	if s.secret != nil {
+		return s.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.New("unknown signing key")
+	}
+	return key.public, nil
+}
+
+// JWK is a public key in JSON Web Key format.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS returns the public verification keys. It is empty for HMAC key sets,
+// whose secret must never be published.
+func (s *KeySet) JWKS() JWKS {
+	jwks := JWKS{Keys: []JWK{}}
+	for _, key := range s.ordered {
+		jwk := publicJWK(key.public)
+		jwk.Kid = key.ID
+		jwk.Use = "sig"
+		jwk.Alg = s.method.Alg()
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+	return jwks
+}
+
+func signingMethod(algorithm string) (jwt.SigningMethod, error) {
+	switch algorithm {
+	case RS256:
+		return jwt.SigningMethodRS256, nil
+	case EdDSA:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q", algorithm)
+	}
+}
+
+// parseKey parses a PEM-encoded private or public key for algorithm.
+func parseKey(algorithm string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid PEM key")
+	}
+
+	var private crypto.Signer
+	var public crypto.PublicKey
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		signer, ok := parsed.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported private key type")
+		}
+		private, public = signer, signer.Public()
+	case "RSA PRIVATE KEY":
+		parsed, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		private, public = parsed, parsed.Public()
+	case "PUBLIC KEY":
+		parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		public = parsed
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+
+	switch public.(type) {
+	case *rsa.PublicKey:
+		if algorithm != RS256 {
+			return nil, fmt.Errorf("RSA key cannot be used with %s", algorithm)
+		}
+	case ed25519.PublicKey:
+		if algorithm != EdDSA {
+			return nil, fmt.Errorf("Ed25519 key cannot be used with %s", algorithm)
+		}
+	default:
+		return nil, errors.New("unsupported key type")
+	}
+
+	return newKey(private, public)
+}
+
+func newKey(private crypto.Signer, public crypto.PublicKey) (*Key, error) {
+	kid, err := thumbprint(public)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{
+		ID:      kid,
+		private: private,
+		public:  public,
+	}, nil
+}
+
+// thumbprint returns the RFC 7638 JWK thumbprint of a public key, which
+// serves as a stable kid that needs no configuration.
+func thumbprint(public crypto.PublicKey) (string, error) {
+	jwk := publicJWK(public)
+
+	// Members must be in lexicographic order with no whitespace.
+	var members interface{}
+	switch jwk.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{jwk.E, jwk.Kty, jwk.N}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{jwk.Crv, jwk.Kty, jwk.X}
+	default:
+		return "", errors.New("unsupported key type")
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(sum[:]), nil
+}
+
+func publicJWK(public crypto.PublicKey) JWK {
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(key),
+		}
+	}
+	return JWK{}
+}
+
+// New creates a key set for algorithm. HS256 signs with secret; the other
+// algorithms load keys from paths, or generate a temporary key when no paths
+// are given and allowGenerate is set.
+func New(algorithm, secret string, paths []string, allowGenerate bool) (*KeySet, error) {
+	if algorithm == "" || algorithm == HS256 {
+		if secret == "" {
+			return nil, errors.New("no signing secret configured")
+		}
+		return NewHMAC(secret), nil
+	}
+	if len(paths) == 0 && allowGenerate {
+		return Generate(algorithm)
+	}
+	return Load(algorithm, paths)
+}
@@ -2,8 +2,11 @@ package middleware
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -11,8 +14,13 @@ import (
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/api/idtoken"
 
+	"gigaboo.io/lem/internal/audit"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/adminaccount"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/tenant"
 )
 
 const (
@@ -20,6 +28,11 @@ const (
 	AdminCookieName = "admin_session"
 	// AdminTokenExpireHours is the duration of admin session
 	AdminTokenExpireHours = 24
+	// AdminKeyHeader carries the admin API key
+	AdminKeyHeader = "X-Admin-Key"
+	// adminKeyName is who requests made with the admin API key are
+	// attributed to
+	adminKeyName = "admin API key"
 )
 
 // AdminContextKey is the key for admin user in context
@@ -37,24 +50,85 @@ type AdminClaims struct {
 type AdminUser struct {
 	Email string
 	Name  string
+	Role  adminaccount.Role
+	// AppIDs are the apps an APP_ADMIN manages
+	AppIDs []int
+}
+
+// AdminPermission is something an admin role may be allowed to do.
+type AdminPermission string
+
+const (
+	// AdminPermRead allows viewing apps, users and logs
+	AdminPermRead AdminPermission = "read"
+	// AdminPermWrite allows changing users, organizations, templates and files
+	AdminPermWrite AdminPermission = "write"
+	// AdminPermBilling allows managing plans
+	AdminPermBilling AdminPermission = "billing"
+	// AdminPermManageAdmins allows managing admin accounts
+	AdminPermManageAdmins AdminPermission = "manage_admins"
+	// AdminPermManageApps allows creating and deactivating apps
+	AdminPermManageApps AdminPermission = "manage_apps"
+)
+
+// adminRolePermissions lists what each admin role can do.
+var adminRolePermissions = map[adminaccount.Role][]AdminPermission{
+	adminaccount.RoleSUPER_ADMIN: {AdminPermRead, AdminPermWrite, AdminPermBilling, AdminPermManageAdmins, AdminPermManageApps},
+	adminaccount.RoleAPP_ADMIN:   {AdminPermRead, AdminPermWrite, AdminPermBilling},
+	adminaccount.RoleSUPPORT:     {AdminPermRead},
+	adminaccount.RoleBILLING:     {AdminPermRead, AdminPermBilling},
+}
+
+// Can reports whether the admin's role grants perm.
+func (a *AdminUser) Can(perm AdminPermission) bool {
+	for _, p := range adminRolePermissions[a.Role] {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// AllApps reports whether the admin can access every app. Only APP_ADMINs
+// are limited to the apps they are linked to.
+func (a *AdminUser) AllApps() bool {
+	return a.Role != adminaccount.RoleAPP_ADMIN
+}
+
+// CanAccessApp reports whether the admin can access the app.
+func (a *AdminUser) CanAccessApp(appID int) bool {
+	if a.AllApps() {
+		return true
+	}
+	for _, id := range a.AppIDs {
+		if id == appID {
+			return true
+		}
+	}
+	return false
 }
 
 // AdminAuthMiddleware provides admin authentication middleware
 type AdminAuthMiddleware struct {
 	cfg    *config.Config
 	client *ent.Client
+	keys   *jwtkeys.KeySet
 }
 
-// NewAdminAuthMiddleware creates a new admin auth middleware
-func NewAdminAuthMiddleware(cfg *config.Config, client *ent.Client) *AdminAuthMiddleware {
+// NewAdminAuthMiddleware creates a new admin auth middleware.
+// keys signs admin sessions and must differ from the end-user keys.
+func NewAdminAuthMiddleware(cfg *config.Config, client *ent.Client, keys *jwtkeys.KeySet) *AdminAuthMiddleware {
 	return &AdminAuthMiddleware{
 		cfg:    cfg,
 		client: client,
+		keys:   keys,
 	}
 }
 
-// RequireAdmin validates admin session from cookie
-func (m *AdminAuthMiddleware) RequireAdmin() gin.HandlerFunc {
+// RequireAdmin validates admin session from cookie and checks that the
+// admin's role grants perm. Routes with an :app_id must be in the admin's
+// app scope.
+func (m *AdminAuthMiddleware) RequireAdmin(perm AdminPermission) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		token, err := c.Cookie(AdminCookieName)
 		if err != nil || token == "" {
@@ -68,16 +142,121 @@ func (m *AdminAuthMiddleware) RequireAdmin() gin.HandlerFunc {
 			return
 		}
 
+		// Roles are read on every request so that changes apply immediately
+		account, err := m.client.AdminAccount.Query().
+			Where(
+				adminaccount.Email(strings.ToLower(claims.Email)),
+				adminaccount.IsActive(true),
+			).
+			WithApps(func(q *ent.AppQuery) {
+				q.Select(app.FieldID)
+			}).
+			Only(c.Request.Context())
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"detail": "Admin access has been revoked"})
+			return
+		}
+
 		admin := &AdminUser{
-			Email: claims.Email,
+			Email: account.Email,
 			Name:  claims.Name,
+			Role:  account.Role,
+		}
+		for _, a := range account.Edges.Apps {
+			admin.AppIDs = append(admin.AppIDs, a.ID)
 		}
 
-		c.Set(string(AdminContextKey), admin)
-		c.Next()
+		m.authorize(c, admin, perm)
 	}
 }
 
+// RequireAdminKey authenticates requests made with the server's admin API
+// key in the AdminKeyHeader, as the SDK's AdminService does, and checks that
+// a super-admin would have perm. Without ADMIN_API_KEY set every request is
+// rejected.
+func (m *AdminAuthMiddleware) RequireAdminKey(perm AdminPermission) gin.HandlerFunc {
+	want := sha256.Sum256([]byte(m.cfg.AdminAPIKey))
+	return func(c *gin.Context) {
+		got := sha256.Sum256([]byte(c.GetHeader(AdminKeyHeader)))
+		if m.cfg.AdminAPIKey == "" || subtle.ConstantTimeCompare(got[:], want[:]) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"detail": "Valid admin API key required"})
+			return
+		}
+
+		m.authorize(c, &AdminUser{
+			Email: adminKeyName,
+			Name:  adminKeyName,
+			Role:  adminaccount.RoleSUPER_ADMIN,
+		}, perm)
+	}
+}
+
+// authorize continues an admin's request if their role grants perm and
+// they can access the app in the path, if any.
+func (m *AdminAuthMiddleware) authorize(c *gin.Context, admin *AdminUser, perm AdminPermission) {
+	if !admin.Can(perm) {
+		c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"detail": "Your admin role does not allow this action"})
+		return
+	}
+
+	// Attribute the changes the request makes to the admin, and to the
+	// app in the path if there is one. Requests for an app are limited
+	// to its data; the others may read every app's.
+	actor := audit.Actor{
+		Name:      admin.Email,
+		Type:      audit.ActorAdmin,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+	}
+	ctx := tenant.AllApps(c.Request.Context())
+	if appID, err := strconv.Atoi(c.Param("app_id")); err == nil {
+		if !admin.CanAccessApp(appID) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"detail": "You do not have access to this app"})
+			return
+		}
+		if exists, _ := m.client.App.Query().Where(app.ID(appID)).Exist(ctx); exists {
+			actor.AppID = appID
+		}
+		ctx = tenant.NewContext(ctx, appID)
+	}
+	c.Request = c.Request.WithContext(audit.NewContext(ctx, actor))
+
+	c.Set(string(AdminContextKey), admin)
+	c.Next()
+}
+
+// BootstrapAdmins makes every ADMIN_EMAILS entry a super-admin unless it
+// already has an admin account. Existing accounts keep the role they were
+// given in the console.
+func (m *AdminAuthMiddleware) BootstrapAdmins(ctx context.Context) error {
+	for _, email := range m.cfg.AdminEmails {
+		email = strings.ToLower(strings.TrimSpace(email))
+		if email == "" {
+			continue
+		}
+
+		exists, err := m.client.AdminAccount.Query().
+			Where(adminaccount.Email(email)).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		err = m.client.AdminAccount.Create().
+			SetEmail(email).
+			SetRole(adminaccount.RoleSUPER_ADMIN).
+			Exec(ctx)
+		// Another instance may have created it first
+		if err != nil && !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+	return nil
+}
+
 // CreateAdminToken creates a signed JWT token for admin session
 func (m *AdminAuthMiddleware) CreateAdminToken(email, name string) (string, error) {
 	claims := AdminClaims{
@@ -90,19 +269,12 @@ func (m *AdminAuthMiddleware) CreateAdminToken(email, name string) (string, erro
 		Type:  "admin_session",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.cfg.JWTSecretKey))
+	return m.keys.Sign(claims)
 }
 
 // ValidateAdminToken validates and decodes admin session token
 func (m *AdminAuthMiddleware) ValidateAdminToken(tokenString string) (*AdminClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &AdminClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(m.cfg.JWTSecretKey), nil
-	})
-
+	token, err := m.keys.ParseWithClaims(tokenString, &AdminClaims{})
 	if err != nil {
 		return nil, err
 	}
@@ -119,18 +291,14 @@ func (m *AdminAuthMiddleware) ValidateAdminToken(tokenString string) (*AdminClai
 	return claims, nil
 }
 
-// IsAdminEmail checks if email is in the admin allowlist
-func (m *AdminAuthMiddleware) IsAdminEmail(email string) bool {
-	if len(m.cfg.AdminEmails) == 0 {
-		return false
-	}
-	email = strings.ToLower(email)
-	for _, adminEmail := range m.cfg.AdminEmails {
-		if strings.ToLower(adminEmail) == email {
-			return true
-		}
-	}
-	return false
+// GetAdminAccount returns the active admin account for email.
+func (m *AdminAuthMiddleware) GetAdminAccount(ctx context.Context, email string) (*ent.AdminAccount, error) {
+	return m.client.AdminAccount.Query().
+		Where(
+			adminaccount.Email(strings.ToLower(email)),
+			adminaccount.IsActive(true),
+		).
+		Only(ctx)
 }
 
 // VerifyGoogleIDToken verifies Google ID token and extracts user info
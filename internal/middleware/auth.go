@@ -2,17 +2,29 @@ package middleware
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/golang-jwt/jwt/v5"
 
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/audit"
+	"gigaboo.io/lem/internal/authz"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
 	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/authsession"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/entitlements"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/tenant"
 )
 
 // Context keys
@@ -27,28 +39,51 @@ const (
 // TokenClaims represents JWT claims.
 type TokenClaims struct {
 	jwt.RegisteredClaims
-	UserID  int    `json:"user_id,omitempty"`
-	AppID   int    `json:"app_id,omitempty"`
-	OrgID   int    `json:"org_id,omitempty"`
-	OrgRole string `json:"org_role,omitempty"`
-	Type    string `json:"type"` // "access" or "refresh"
+	UserID    int    `json:"user_id,omitempty"`
+	AppID     int    `json:"app_id,omitempty"`
+	OrgID     int    `json:"org_id,omitempty"`
+	OrgRole   string `json:"org_role,omitempty"`
+	SessionID int    `json:"sid,omitempty"`
+	MFA       bool   `json:"mfa,omitempty"`
+	Act       *Actor `json:"act,omitempty"`
+	Type      string `json:"type"` // "access", "refresh" or "mfa"
 }
 
+// Actor identifies who is acting on behalf of the token's user (RFC 8693).
+// Subject is the email of the impersonating admin.
+type Actor struct {
+	Subject string `json:"sub"`
+}
+
+// MFATokenDuration is how long a login has to complete its second factor.
+const MFATokenDuration = 5 * time.Minute
+
+// ImpersonationTokenDuration is how long an admin can act as a user with one
+// impersonation token. Impersonation tokens cannot be refreshed.
+const ImpersonationTokenDuration = 15 * time.Minute
+
 // AuthMiddleware provides authentication middleware.
 type AuthMiddleware struct {
-	cfg    *config.Config
-	client *ent.Client
+	cfg          *config.Config
+	client       *ent.Client
+	keys         *jwtkeys.KeySet
+	authz        *authz.Loader
+	entitlements *entitlements.Resolver
 }
 
 // NewAuthMiddleware creates a new auth middleware.
-func NewAuthMiddleware(cfg *config.Config, client *ent.Client) *AuthMiddleware {
+// keys signs and verifies end-user tokens.
+func NewAuthMiddleware(cfg *config.Config, client *ent.Client, keys *jwtkeys.KeySet) *AuthMiddleware {
 	return &AuthMiddleware{
-		cfg:    cfg,
-		client: client,
+		cfg:          cfg,
+		client:       client,
+		keys:         keys,
+		authz:        authz.NewLoader(client),
+		entitlements: entitlements.NewResolver(client),
 	}
 }
 
-// APIKeyAuth validates API key from X-API-Key header.
+// APIKeyAuth validates the publishable API key from X-API-Key header.
 func (m *AuthMiddleware) APIKeyAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		apiKey := c.GetHeader("X-API-Key")
@@ -56,10 +91,15 @@ func (m *AuthMiddleware) APIKeyAuth() gin.HandlerFunc {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "API key required"})
 			return
 		}
+		if apikey.IsSecret(apiKey) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Secret keys must not be sent; send the publishable API key and sign the request"})
+			return
+		}
 
-		// Find app by API key
+		// Find app by API key, or by the key it replaced while that is still
+		// accepted
 		foundApp, err := m.client.App.Query().
-			Where(app.APIKey(apiKey)).
+			Where(apiKeyPredicate(apikey.Hash(apiKey))).
 			First(c.Request.Context())
 
 		if err != nil {
@@ -72,12 +112,25 @@ func (m *AuthMiddleware) APIKeyAuth() gin.HandlerFunc {
 			return
 		}
 
-		// Store app in context
+		// Store app in context and limit the request to its data
 		c.Set(string(AppContextKey), foundApp)
+		c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), foundApp.ID))
 		c.Next()
 	}
 }
 
+// apiKeyPredicate selects the app with an API key hash, or whose previous
+// key has the hash while it is still accepted.
+func apiKeyPredicate(hash string) predicate.App {
+	return app.Or(
+		app.APIKeyHash(hash),
+		app.And(
+			app.PreviousAPIKeyHash(hash),
+			app.PreviousAPIKeyExpiresAtGT(time.Now()),
+		),
+	)
+}
+
 // JWTAuth validates JWT token from Authorization header.
 func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -109,6 +162,11 @@ func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 			return
 		}
 
+		if !setTenant(c, claims) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Token was issued for another app"})
+			return
+		}
+
 		// Get user from database
 		user, err := m.client.User.Get(c.Request.Context(), claims.UserID)
 		if err != nil {
@@ -121,13 +179,71 @@ func (m *AuthMiddleware) JWTAuth() gin.HandlerFunc {
 			return
 		}
 
+		// Reject tokens whose session has been revoked
+		if !m.isSessionActive(c.Request.Context(), claims.SessionID) {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Session has been revoked"})
+			return
+		}
+
+		if err := m.setAuditActor(c, user, claims); err != nil {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "failed to record impersonated request"})
+			return
+		}
+
 		// Store user and claims in context
 		c.Set(string(UserContextKey), user)
 		c.Set("claims", claims)
+		setOrgContext(c, claims)
 		c.Next()
 	}
 }
 
+// BlockImpersonation rejects requests made with an impersonation token, for
+// endpoints such as payments that an admin must not use on a user's behalf.
+// Must run after JWTAuth.
+func (m *AuthMiddleware) BlockImpersonation() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if claims := GetClaimsFromGin(c); claims != nil && claims.Act != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "not allowed while impersonating a user"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// setAuditActor attributes the changes the request makes to its user, or to
+// the admin when impersonating. Every request made while impersonating is
+// recorded before it runs.
+func (m *AuthMiddleware) setAuditActor(c *gin.Context, user *ent.User, claims *TokenClaims) error {
+	actor := audit.Actor{
+		Name:      user.Email,
+		Type:      audit.ActorUser,
+		IPAddress: c.ClientIP(),
+		UserAgent: c.Request.UserAgent(),
+		AppID:     claims.AppID,
+	}
+	if claims.Act != nil {
+		actor.Name = claims.Act.Subject
+		actor.Type = audit.ActorAdmin
+	}
+	ctx := audit.NewContext(c.Request.Context(), actor)
+	c.Request = c.Request.WithContext(ctx)
+
+	if claims.Act == nil {
+		return nil
+	}
+	return audit.Record(ctx, m.client, audit.Event{
+		Action:     "impersonation.request",
+		TargetType: "user",
+		TargetID:   strconv.Itoa(claims.UserID),
+		Metadata: map[string]interface{}{
+			"method":     c.Request.Method,
+			"path":       c.Request.URL.Path,
+			"session_id": claims.SessionID,
+		},
+	})
+}
+
 // OptionalJWTAuth validates JWT token if present but doesn't require it.
 func (m *AuthMiddleware) OptionalJWTAuth() gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -150,7 +266,7 @@ func (m *AuthMiddleware) OptionalJWTAuth() gin.HandlerFunc {
 			return
 		}
 
-		if claims.Type != "access" {
+		if claims.Type != "access" || !setTenant(c, claims) {
 			c.Next()
 			return
 		}
@@ -161,56 +277,129 @@ func (m *AuthMiddleware) OptionalJWTAuth() gin.HandlerFunc {
 			return
 		}
 
+		if !m.isSessionActive(c.Request.Context(), claims.SessionID) {
+			c.Next()
+			return
+		}
+
+		if err := m.setAuditActor(c, user, claims); err != nil {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "failed to record impersonated request"})
+			return
+		}
+
 		c.Set(string(UserContextKey), user)
 		c.Set("claims", claims)
+		setOrgContext(c, claims)
 		c.Next()
 	}
 }
 
+// setTenant limits the request to the app its token was issued for. It
+// reports false if the request's API key is for another app.
+func setTenant(c *gin.Context, claims *TokenClaims) bool {
+	if currentApp := GetAppFromGin(c); currentApp != nil {
+		return currentApp.ID == claims.AppID
+	}
+	c.Request = c.Request.WithContext(tenant.NewContext(c.Request.Context(), claims.AppID))
+	return true
+}
+
+// setOrgContext exposes the organization an access token is scoped to.
+func setOrgContext(c *gin.Context, claims *TokenClaims) {
+	if claims.OrgID == 0 {
+		return
+	}
+	c.Set(string(OrgContextKey), &OrgContext{
+		OrgID: claims.OrgID,
+		Role:  organizationmember.Role(claims.OrgRole),
+	})
+}
+
 // GenerateAccessToken generates a new access token.
-func (m *AuthMiddleware) GenerateAccessToken(userID, appID int, orgID int, orgRole string) (string, error) {
+// sessionID ties the token to a server-side session so it stops working once
+// the session is revoked; pass 0 for tokens that are not backed by a session.
+// mfa records that the session completed two-factor authentication.
+func (m *AuthMiddleware) GenerateAccessToken(userID, appID int, orgID int, orgRole string, sessionID int, mfa bool) (string, error) {
 	claims := TokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.cfg.AccessTokenDuration())),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
-		UserID:  userID,
-		AppID:   appID,
-		OrgID:   orgID,
-		OrgRole: orgRole,
-		Type:    "access",
+		UserID:    userID,
+		AppID:     appID,
+		OrgID:     orgID,
+		OrgRole:   orgRole,
+		SessionID: sessionID,
+		MFA:       mfa,
+		Type:      "access",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.cfg.JWTSecretKey))
+	return m.keys.Sign(claims)
 }
 
-// GenerateRefreshToken generates a new refresh token.
-func (m *AuthMiddleware) GenerateRefreshToken(userID, appID int, orgID int) (string, error) {
+// GenerateImpersonationToken generates a short-lived access token that lets
+// the admin identified by actor act as the user. Its act claim marks every
+// request made with it as impersonated.
+func (m *AuthMiddleware) GenerateImpersonationToken(userID, appID, sessionID int, actor string) (string, error) {
 	claims := TokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.cfg.RefreshTokenDuration())),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ImpersonationTokenDuration)),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		UserID:    userID,
+		AppID:     appID,
+		SessionID: sessionID,
+		Act:       &Actor{Subject: actor},
+		Type:      "access",
+	}
+
+	return m.keys.Sign(claims)
+}
+
+// GenerateMFAToken generates a short-lived token for a login whose password
+// check passed but that still has to complete two-factor authentication.
+func (m *AuthMiddleware) GenerateMFAToken(userID, appID int) (string, error) {
+	claims := TokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(MFATokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 		UserID: userID,
 		AppID:  appID,
-		OrgID:  orgID,
-		Type:   "refresh",
+		Type:   "mfa",
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(m.cfg.JWTSecretKey))
+	return m.keys.Sign(claims)
+}
+
+// GenerateRefreshToken generates a new refresh token for a session.
+// Every token carries a random ID so that it is unique even when several
+// are issued for the same session within one second.
+func (m *AuthMiddleware) GenerateRefreshToken(userID, appID int, orgID int, sessionID int) (string, error) {
+	jti, err := generateTokenID()
+	if err != nil {
+		return "", err
+	}
+
+	claims := TokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        jti,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(m.cfg.RefreshTokenDuration())),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+		UserID:    userID,
+		AppID:     appID,
+		OrgID:     orgID,
+		SessionID: sessionID,
+		Type:      "refresh",
+	}
+
+	return m.keys.Sign(claims)
 }
 
 // ValidateToken validates a JWT token and returns claims.
 func (m *AuthMiddleware) ValidateToken(tokenString string) (*TokenClaims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &TokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, errors.New("unexpected signing method")
-		}
-		return []byte(m.cfg.JWTSecretKey), nil
-	})
-
+	token, err := m.keys.ParseWithClaims(tokenString, &TokenClaims{})
 	if err != nil {
 		return nil, err
 	}
@@ -222,6 +411,31 @@ func (m *AuthMiddleware) ValidateToken(tokenString string) (*TokenClaims, error)
 	return nil, errors.New("invalid token")
 }
 
+// isSessionActive reports whether the session a token belongs to is still
+// usable. Tokens without a session ID are not session-bound.
+func (m *AuthMiddleware) isSessionActive(ctx context.Context, sessionID int) bool {
+	if sessionID == 0 {
+		return true
+	}
+
+	exists, err := m.client.AuthSession.Query().
+		Where(
+			authsession.ID(sessionID),
+			authsession.RevokedAtIsNil(),
+			authsession.ExpiresAtGT(time.Now()),
+		).
+		Exist(ctx)
+	return err == nil && exists
+}
+
+func generateTokenID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(bytes), nil
+}
+
 // GetApp returns the app from context.
 func GetApp(ctx context.Context) *ent.App {
 	if app, ok := ctx.Value(AppContextKey).(*ent.App); ok {
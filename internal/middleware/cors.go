@@ -1,29 +1,76 @@
 package middleware
 
 import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
 	"github.com/gin-gonic/gin"
 
+	"gigaboo.io/lem/internal/apikey"
 	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
 )
 
-// CORS returns a CORS middleware handler.
-func CORS(cfg *config.Config) gin.HandlerFunc {
-	return func(c *gin.Context) {
-		origin := c.Request.Header.Get("Origin")
+// corsCacheTTL is how long apps' allowed origins are cached, and so how long
+// a change to them takes to apply.
+const corsCacheTTL = time.Minute
+
+// corsCacheSize bounds the number of API keys whose origins are cached.
+const corsCacheSize = 10000
+
+type cachedOrigins struct {
+	origins   []string
+	expiresAt time.Time
+}
+
+// CORSMiddleware allows cross-origin requests from the server's CORS origins,
+// where * allows every origin, and from the allowed origins of the app whose
+// API key is used.
+//
+// Preflight requests carry no API key, so those to the API are allowed for
+// the origins of any active app; the request itself is then checked against
+// its app. The admin console is served from the server's own origin and
+// signs in with a cookie, so /admin gets no CORS headers at all.
+type CORSMiddleware struct {
+	cfg    *config.Config
+	client *ent.Client
 
-		// Check if origin is allowed
-		allowed := false
-		for _, allowedOrigin := range cfg.CORSOrigins {
-			if origin == allowedOrigin || allowedOrigin == "*" {
-				allowed = true
-				break
-			}
+	mu     sync.Mutex
+	byKey  map[string]cachedOrigins
+	allApp cachedOrigins
+}
+
+// NewCORSMiddleware creates a new CORS middleware.
+func NewCORSMiddleware(cfg *config.Config, client *ent.Client) *CORSMiddleware {
+	return &CORSMiddleware{
+		cfg:    cfg,
+		client: client,
+		byKey:  make(map[string]cachedOrigins),
+	}
+}
+
+// Handler returns the CORS middleware handler.
+func (m *CORSMiddleware) Handler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		path := c.Request.URL.Path
+		if path == "/admin" || strings.HasPrefix(path, "/admin/") {
+			c.Next()
+			return
 		}
 
-		if allowed {
+		origin := c.Request.Header.Get("Origin")
+		anyApp := c.Request.Method == "OPTIONS" && strings.HasPrefix(path, "/api/v1/")
+
+		if origin != "" && m.allowed(c.Request.Context(), origin, c.GetHeader("X-API-Key"), anyApp) {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", origin)
 		}
 
+		c.Writer.Header().Add("Vary", "Origin")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
 		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-API-Key")
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, PATCH, DELETE")
@@ -36,3 +83,120 @@ func CORS(cfg *config.Config) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// allowed reports whether origin may make a request with apiKey, which is
+// empty for preflight requests and requests without one. Without an API key,
+// the origins of every app are only allowed if anyApp is set.
+func (m *CORSMiddleware) allowed(ctx context.Context, origin, apiKey string, anyApp bool) bool {
+	if slices.Contains(m.cfg.CORSOrigins, "*") || OriginAllowed(m.cfg.CORSOrigins, origin) {
+		return true
+	}
+
+	var (
+		origins []string
+		err     error
+	)
+	switch {
+	case apiKey != "":
+		origins, err = m.appOrigins(ctx, apiKey)
+	case anyApp:
+		origins, err = m.allAppOrigins(ctx)
+	}
+	return err == nil && OriginAllowed(origins, origin)
+}
+
+// appOrigins returns the allowed origins of the active app with an API key,
+// or none if there is no such app.
+func (m *CORSMiddleware) appOrigins(ctx context.Context, apiKey string) ([]string, error) {
+	hash := apikey.Hash(apiKey)
+
+	m.mu.Lock()
+	cached, ok := m.byKey[hash]
+	m.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.origins, nil
+	}
+
+	a, err := m.client.App.Query().
+		Where(apiKeyPredicate(hash), app.IsActive(true)).
+		Select(app.FieldAllowedOrigins).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+	var origins []string
+	if a != nil {
+		origins = a.AllowedOrigins
+	}
+
+	m.mu.Lock()
+	if len(m.byKey) >= corsCacheSize {
+		m.byKey = make(map[string]cachedOrigins)
+	}
+	m.byKey[hash] = cachedOrigins{origins: origins, expiresAt: time.Now().Add(corsCacheTTL)}
+	m.mu.Unlock()
+
+	return origins, nil
+}
+
+// allAppOrigins returns the allowed origins of every active app.
+func (m *CORSMiddleware) allAppOrigins(ctx context.Context) ([]string, error) {
+	m.mu.Lock()
+	cached := m.allApp
+	m.mu.Unlock()
+	if time.Now().Before(cached.expiresAt) {
+		return cached.origins, nil
+	}
+
+	apps, err := m.client.App.Query().
+		Where(app.IsActive(true)).
+		Select(app.FieldAllowedOrigins).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var origins []string
+	for _, a := range apps {
+		origins = append(origins, a.AllowedOrigins...)
+	}
+
+	m.mu.Lock()
+	m.allApp = cachedOrigins{origins: origins, expiresAt: time.Now().Add(corsCacheTTL)}
+	m.mu.Unlock()
+
+	return origins, nil
+}
+
+// OriginAllowed reports whether origin matches one of patterns. A pattern is
+// an origin such as https://example.com, or an origin whose host starts with
+// a wildcard label such as https://*.example.com matching any subdomain.
+func OriginAllowed(patterns []string, origin string) bool {
+	for _, pattern := range patterns {
+		if pattern == origin {
+			return true
+		}
+
+		scheme, host, ok := strings.Cut(pattern, "://*.")
+		if !ok {
+			continue
+		}
+		prefix := scheme + "://"
+		suffix := "." + host
+		if strings.HasPrefix(origin, prefix) && strings.HasSuffix(origin, suffix) &&
+			len(origin) > len(prefix)+len(suffix) &&
+			!strings.ContainsAny(origin[len(prefix):len(origin)-len(suffix)], "/:@") {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidOriginPattern reports whether pattern is a valid OriginAllowed
+// pattern: a scheme and host with an optional port and no path.
+func ValidOriginPattern(pattern string) bool {
+	u, err := url.Parse(strings.Replace(pattern, "://*.", "://wildcard.", 1))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return false
+	}
+	return u.Scheme+"://"+u.Host == strings.Replace(pattern, "://*.", "://wildcard.", 1)
+}
@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent/enttest"
+)
+
+func TestCORS(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:cors?mode=memory&_fk=1")
+	defer client.Close()
+	client.App.Create().
+		SetName("App").
+		SetSlug("app").
+		SetAPIKeyHash(apikey.Hash("lem_pk_app")).
+		SetAllowedOrigins([]string{"https://app.test"}).
+		SaveX(context.Background())
+
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	r.Use(NewCORSMiddleware(&config.Config{CORSOrigins: []string{"https://lem.test"}}, client).Handler())
+	r.Any("/*path", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		origin string
+		apiKey string
+		// allowed is whether the origin gets credentialed CORS headers
+		allowed bool
+	}{
+		{"server origin", http.MethodGet, "/api/v1/users/me", "https://lem.test", "", true},
+		{"app origin with its API key", http.MethodGet, "/api/v1/users/me", "https://app.test", "lem_pk_app", true},
+		{"app origin with another API key", http.MethodGet, "/api/v1/users/me", "https://app.test", "lem_pk_other", false},
+		{"app origin preflight", http.MethodOptions, "/api/v1/users/me", "https://app.test", "", true},
+		{"app origin without API key", http.MethodGet, "/api/v1/users/me", "https://app.test", "", false},
+		{"app origin preflight outside the API", http.MethodOptions, "/shenbi/", "https://app.test", "", false},
+		{"unknown origin preflight", http.MethodOptions, "/api/v1/users/me", "https://other.test", "", false},
+		{"app origin on the admin API", http.MethodGet, "/admin/api/apps", "https://app.test", "", false},
+		{"app origin preflight on the admin API", http.MethodOptions, "/admin/api/apps", "https://app.test", "", false},
+		{"app origin on the admin API with its API key", http.MethodGet, "/admin/api/apps", "https://app.test", "lem_pk_app", false},
+		{"server origin on the admin API", http.MethodGet, "/admin/api/apps", "https://lem.test", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Origin", tt.origin)
+			req.AddCookie(&http.Cookie{Name: AdminCookieName, Value: "session"})
+			if tt.apiKey != "" {
+				req.Header.Set("X-API-Key", tt.apiKey)
+			}
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			allowOrigin := w.Header().Get("Access-Control-Allow-Origin")
+			if tt.allowed && allowOrigin != tt.origin {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", allowOrigin, tt.origin)
+			}
+			if !tt.allowed && allowOrigin != "" {
+				t.Errorf("Access-Control-Allow-Origin = %q, want none", allowOrigin)
+			}
+			if credentials := w.Header().Get("Access-Control-Allow-Credentials"); tt.path == "/admin/api/apps" && credentials != "" {
+				t.Errorf("admin API sent Access-Control-Allow-Credentials %q", credentials)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/entitlements"
+)
+
+// RequireFeature ensures the current user's plan includes a feature.
+// Must run after JWTAuth and APIKeyAuth.
+func (m *AuthMiddleware) RequireFeature(feature string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ents, ok := m.userEntitlements(c)
+		if !ok {
+			return
+		}
+
+		if !ents.Has(feature) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error":   "your plan does not include this feature",
+				"feature": feature,
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CheckLimit ensures the current user can have one more of what a limit of
+// their plan counts, as counted by usage, e.g. before creating a classroom.
+// Must run after JWTAuth and APIKeyAuth.
+func (m *AuthMiddleware) CheckLimit(limit string, usage entitlements.Usage) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ents, ok := m.userEntitlements(c)
+		if !ok {
+			return
+		}
+
+		if max := ents.Limit(limit); max != entitlements.Unlimited {
+			currentUser := GetUserFromGin(c)
+			currentApp := GetAppFromGin(c)
+			n, err := usage(c.Request.Context(), m.client, currentApp.ID, currentUser.ID)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check plan limits"})
+				return
+			}
+			if !ents.Allows(limit, n+1) {
+				c.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+					"error": "your plan's limit has been reached",
+					"limit": limit,
+					"max":   max,
+				})
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
+
+// userEntitlements returns the current user's entitlements, or responds with
+// an error.
+func (m *AuthMiddleware) userEntitlements(c *gin.Context) (*entitlements.Entitlements, bool) {
+	currentUser := GetUserFromGin(c)
+	currentApp := GetAppFromGin(c)
+	if currentUser == nil || currentApp == nil {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+		return nil, false
+	}
+
+	ents, err := m.entitlements.ForUser(c.Request.Context(), currentApp.ID, currentUser.ID)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check plan"})
+		return nil, false
+	}
+	return ents, true
+}
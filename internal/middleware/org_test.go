@@ -0,0 +1,76 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent/enttest"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/jwtkeys"
+)
+
+func TestRequireOrgRole(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:org?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(ctx)
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+	memberOf := client.Organization.Create().SetName("Member").SetSlug("member").SetApp(a).SaveX(ctx)
+	adminOf := client.Organization.Create().SetName("Admin").SetSlug("admin").SetApp(a).SaveX(ctx)
+	disabled := client.Organization.Create().SetName("Disabled").SetSlug("disabled").SetApp(a).SetIsActive(false).SaveX(ctx)
+	other := client.Organization.Create().SetName("Other").SetSlug("other").SetApp(a).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganization(memberOf).SetUser(u).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganization(adminOf).SetUser(u).SetRole(organizationmember.RoleADMIN).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganization(disabled).SetUser(u).SetRole(organizationmember.RoleADMIN).SaveX(ctx)
+
+	m := NewAuthMiddleware(&config.Config{}, client, jwtkeys.NewHMAC("test"))
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name string
+		// claims are the org claims of the access token
+		claims TokenClaims
+		path   string
+		status int
+	}{
+		{"token role is trusted", TokenClaims{OrgID: memberOf.ID, OrgRole: "ADMIN"}, "/orgs/" + strconv.Itoa(memberOf.ID), http.StatusOK},
+		{"token role is checked", TokenClaims{OrgID: adminOf.ID, OrgRole: "MEMBER"}, "/orgs/" + strconv.Itoa(adminOf.ID), http.StatusForbidden},
+		{"current organization", TokenClaims{OrgID: memberOf.ID, OrgRole: "ADMIN"}, "/current", http.StatusOK},
+		{"no current organization", TokenClaims{}, "/current", http.StatusBadRequest},
+		{"another organization is looked up", TokenClaims{OrgID: memberOf.ID, OrgRole: "MEMBER"}, "/orgs/" + strconv.Itoa(adminOf.ID), http.StatusOK},
+		{"unscoped token is looked up", TokenClaims{}, "/orgs/" + strconv.Itoa(memberOf.ID), http.StatusForbidden},
+		{"disabled organization", TokenClaims{}, "/orgs/" + strconv.Itoa(disabled.ID), http.StatusForbidden},
+		{"not a member", TokenClaims{}, "/orgs/" + strconv.Itoa(other.ID), http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := gin.New()
+			r.Use(func(c *gin.Context) {
+				claims := tt.claims
+				c.Set(string(AppContextKey), a)
+				c.Set(string(UserContextKey), u)
+				c.Set("claims", &claims)
+				setOrgContext(c, &claims)
+			})
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			orgAdmin := m.RequireOrgRole(organizationmember.RoleOWNER, organizationmember.RoleADMIN)
+			r.GET("/orgs/:org_id", orgAdmin, ok)
+			r.GET("/current", orgAdmin, ok)
+
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, tt.path, nil))
+			if w.Code != tt.status {
+				t.Errorf("GET %s = %d %s, want %d", tt.path, w.Code, w.Body, tt.status)
+			}
+		})
+	}
+}
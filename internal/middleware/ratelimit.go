@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ratelimit"
+)
+
+// RateLimitMiddleware provides rate limiting middleware.
+type RateLimitMiddleware struct {
+	cfg   *config.Config
+	store ratelimit.Store
+}
+
+// NewRateLimitMiddleware creates a new rate limit middleware.
+func NewRateLimitMiddleware(cfg *config.Config, store ratelimit.Store) *RateLimitMiddleware {
+	return &RateLimitMiddleware{
+		cfg:   cfg,
+		store: store,
+	}
+}
+
+// ByIP limits requests per client IP.
+func (m *RateLimitMiddleware) ByIP() gin.HandlerFunc {
+	limit := ratelimit.PerMinute(m.cfg.RateLimitIPPerMinute)
+	return func(c *gin.Context) {
+		m.limit(c, "ip:"+c.ClientIP(), limit)
+	}
+}
+
+// ByApp limits requests per API key using the app's own limits if it has any.
+// Must run after APIKeyAuth.
+func (m *RateLimitMiddleware) ByApp() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		app := GetAppFromGin(c)
+		if app == nil {
+			c.Next()
+			return
+		}
+
+		limit := ratelimit.PerMinute(m.cfg.RateLimitAppPerMinute)
+		if app.RateLimitPerMinute != nil {
+			limit = ratelimit.PerMinute(*app.RateLimitPerMinute)
+		}
+		if app.RateLimitBurst != nil {
+			limit.Burst = *app.RateLimitBurst
+		}
+
+		m.limit(c, "app:"+strconv.Itoa(app.ID), limit)
+	}
+}
+
+// ByUser limits requests per authenticated user. Must run after JWTAuth.
+func (m *RateLimitMiddleware) ByUser() gin.HandlerFunc {
+	limit := ratelimit.PerMinute(m.cfg.RateLimitUserPerMinute)
+	return func(c *gin.Context) {
+		user := GetUserFromGin(c)
+		if user == nil {
+			c.Next()
+			return
+		}
+		m.limit(c, "user:"+strconv.Itoa(user.ID), limit)
+	}
+}
+
+// ByEmail limits requests per email address in the JSON request body, for
+// endpoints such as login that act on an account before authentication.
+func (m *RateLimitMiddleware) ByEmail() gin.HandlerFunc {
+	limit := ratelimit.PerMinute(m.cfg.RateLimitEmailPerMinute)
+	return func(c *gin.Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid request body"})
+			return
+		}
+		// Put the body back for the handler
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		var input struct {
+			Email string `json:"email"`
+		}
+		if json.Unmarshal(body, &input) != nil || input.Email == "" {
+			c.Next()
+			return
+		}
+
+		m.limit(c, "email:"+strings.ToLower(input.Email), limit)
+	}
+}
+
+// limit takes a token for key and aborts with 429 when none is left.
+// Requests are let through if the store is unavailable.
+func (m *RateLimitMiddleware) limit(c *gin.Context, key string, limit ratelimit.Limit) {
+	if limit.Rate <= 0 {
+		c.Next()
+		return
+	}
+
+	result, err := m.store.Take(c.Request.Context(), key, limit)
+	if err != nil {
+		c.Next()
+		return
+	}
+
+	header := c.Writer.Header()
+	header.Set("X-RateLimit-Limit", strconv.Itoa(result.Limit))
+	header.Set("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	header.Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(result.ResetAfter).Unix(), 10))
+
+	if !result.Allowed {
+		header.Set("Retry-After", strconv.Itoa(int(math.Ceil(result.RetryAfter.Seconds()))))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, gin.H{"error": "rate limit exceeded"})
+		return
+	}
+
+	c.Next()
+}
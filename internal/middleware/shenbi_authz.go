@@ -0,0 +1,173 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/authz"
+)
+
+// Authorize checks that the current user may take action on the Shenbi
+// resource the request names, and that the token was issued for the app
+// whose API key was used. Must run after JWTAuth and APIKeyAuth.
+func (m *AuthMiddleware) Authorize(action authz.Action) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		currentUser := GetUserFromGin(c)
+		currentApp := GetAppFromGin(c)
+		claims := GetClaimsFromGin(c)
+		if currentUser == nil || currentApp == nil || claims == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "not authenticated"})
+			return
+		}
+
+		if claims.AppID != currentApp.ID {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "token was not issued for this app"})
+			return
+		}
+
+		ctx := c.Request.Context()
+		subject, err := m.authz.Subject(ctx, currentUser.ID, currentApp.ID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check permissions"})
+			return
+		}
+
+		action, resource, err := m.loadResource(c, action, currentUser.ID)
+		if err != nil {
+			switch {
+			case errors.Is(err, authz.ErrNotFound):
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "not found"})
+			case errors.Is(err, errInvalidResource):
+				c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "invalid request"})
+			default:
+				c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "failed to check permissions"})
+			}
+			return
+		}
+
+		if !authz.Can(subject, action, resource) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "permission denied"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+var errInvalidResource = errors.New("invalid resource")
+
+// loadResource finds the resource an action applies to from the path or,
+// for actions that name it in the body, the JSON body. It returns the action
+// to check, which for joining a classroom session depends on the role asked for.
+func (m *AuthMiddleware) loadResource(c *gin.Context, action authz.Action, userID int) (authz.Action, authz.Resource, error) {
+	ctx := c.Request.Context()
+
+	switch action {
+	case authz.ClassroomView, authz.ClassroomUpdate, authz.ClassroomDelete,
+		authz.ClassroomViewMembers, authz.AssignmentList, authz.AssignmentCreate:
+		classroomID, err := strconv.Atoi(c.Param("classroom_id"))
+		if err != nil {
+			return action, authz.Resource{}, errInvalidResource
+		}
+		resource, err := m.authz.Classroom(ctx, classroomID, userID)
+		return action, resource, err
+
+	case authz.AssignmentPublish, authz.AssignmentSubmit, authz.SubmissionList:
+		classroomID, err := strconv.Atoi(c.Param("classroom_id"))
+		if err != nil {
+			return action, authz.Resource{}, errInvalidResource
+		}
+		assignmentID, err := strconv.Atoi(c.Param("assignment_id"))
+		if err != nil {
+			return action, authz.Resource{}, errInvalidResource
+		}
+		resource, err := m.authz.Assignment(ctx, classroomID, assignmentID, userID)
+		return action, resource, err
+
+	case authz.ClassroomJoin:
+		var body struct {
+			JoinCode string `json:"join_code"`
+		}
+		if err := peekJSON(c, &body); err != nil {
+			return action, authz.Resource{}, err
+		}
+		resource, err := m.authz.ClassroomByJoinCode(ctx, body.JoinCode, userID)
+		return action, resource, err
+
+	case authz.LiveSessionCreate:
+		var body struct {
+			ClassroomID int `json:"classroom_id"`
+		}
+		if err := peekJSON(c, &body); err != nil {
+			return action, authz.Resource{}, err
+		}
+		resource, err := m.authz.Classroom(ctx, body.ClassroomID, userID)
+		return action, resource, err
+
+	case authz.LiveSessionView, authz.LiveSessionManage, authz.LiveSessionJoin, authz.LiveSessionComplete:
+		resource, err := m.authz.LiveSession(ctx, c.Param("room_code"), userID)
+		return action, resource, err
+
+	case authz.BattleJoin:
+		var body struct {
+			RoomCode string `json:"room_code"`
+		}
+		if err := peekJSON(c, &body); err != nil {
+			return action, authz.Resource{}, err
+		}
+		resource, err := m.authz.BattleRoom(ctx, body.RoomCode)
+		return action, resource, err
+
+	case authz.BattleView, authz.BattleStart, authz.BattleComplete:
+		resource, err := m.authz.BattleRoom(ctx, c.Param("room_code"))
+		return action, resource, err
+
+	case authz.SessionJoin:
+		var body struct {
+			ClassroomID int    `json:"classroom_id"`
+			Role        string `json:"role"`
+		}
+		if err := peekJSON(c, &body); err != nil {
+			return action, authz.Resource{}, err
+		}
+		if body.Role == "teacher" {
+			action = authz.SessionJoinAsTeacher
+		}
+		resource, err := m.authz.Classroom(ctx, body.ClassroomID, userID)
+		return action, resource, err
+
+	case authz.SessionView, authz.SessionLeave:
+		sessionID, err := strconv.Atoi(c.Param("session_id"))
+		if err != nil {
+			return action, authz.Resource{}, errInvalidResource
+		}
+		resource, err := m.authz.ClassroomSession(ctx, sessionID)
+		return action, resource, err
+	}
+
+	// Actions on the user's own data or creating new resources
+	return action, authz.Resource{}, nil
+}
+
+// peekJSON decodes the JSON body into v and puts the body back for the handler.
+func peekJSON(c *gin.Context, v interface{}) error {
+	if c.Request.Body == nil {
+		return errInvalidResource
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return errInvalidResource
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return errInvalidResource
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/ratelimit"
+)
+
+// SignatureTolerance is how far a signed request's timestamp may be from the
+// server's clock.
+const SignatureTolerance = 5 * time.Minute
+
+// SignatureMiddleware authenticates server-to-server requests signed with
+// an app's secret key.
+type SignatureMiddleware struct {
+	store  ratelimit.Store
+	sealer *apikey.Sealer
+}
+
+// NewSignatureMiddleware creates a new signature middleware. store remembers
+// the signatures already used and sealer decrypts apps' secret keys.
+func NewSignatureMiddleware(store ratelimit.Store, sealer *apikey.Sealer) *SignatureMiddleware {
+	return &SignatureMiddleware{
+		store:  store,
+		sealer: sealer,
+	}
+}
+
+// RequireSignature only lets through requests signed with the secret key of
+// the app whose API key was used, or the secret key it replaced while that
+// is still accepted. See apikey.Sign for the signature. Each signature is
+// accepted once, within SignatureTolerance of its timestamp. Must run after
+// APIKeyAuth.
+func (m *SignatureMiddleware) RequireSignature() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		app := GetAppFromGin(c)
+		if app == nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "API key required"})
+			return
+		}
+		if app.APISecretHash == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App has no secret key to sign requests with"})
+			return
+		}
+		if app.APISecretEncrypted == "" {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "App's secret key must be rotated to sign requests"})
+			return
+		}
+		secret, err := m.sealer.Open(app.APISecretEncrypted)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Failed to check request signature"})
+			return
+		}
+
+		signature := c.GetHeader(apikey.SignatureHeader)
+		timestamp, err := strconv.ParseInt(c.GetHeader(apikey.TimestampHeader), 10, 64)
+		if signature == "" || err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Signed request required"})
+			return
+		}
+
+		age := time.Since(time.Unix(timestamp, 0))
+		if age > SignatureTolerance || age < -SignatureTolerance {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Request timestamp is too old or too far in the future"})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": "Failed to read request body"})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		method, uri := c.Request.Method, c.Request.URL.RequestURI()
+		valid := apikey.VerifySignature(signature, secret, timestamp, method, uri, body)
+		if !valid && app.PreviousAPISecretEncrypted != "" &&
+			app.PreviousAPISecretExpiresAt != nil && app.PreviousAPISecretExpiresAt.After(time.Now()) {
+			if previous, err := m.sealer.Open(app.PreviousAPISecretEncrypted); err == nil {
+				valid = apikey.VerifySignature(signature, previous, timestamp, method, uri, body)
+			}
+		}
+		if !valid {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid request signature"})
+			return
+		}
+
+		// A signature can be replayed until its timestamp leaves the
+		// tolerance on either side
+		result, err := m.store.Take(c.Request.Context(), "signature:"+signature, ratelimit.Limit{
+			Rate:   1,
+			Period: 2 * SignatureTolerance,
+			Burst:  1,
+		})
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "Failed to check request signature"})
+			return
+		}
+		if !result.Allowed {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Request signature already used"})
+			return
+		}
+
+		c.Next()
+	}
+}
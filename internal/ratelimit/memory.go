@@ -0,0 +1,114 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// memoryIdleTimeout is how long an unused entry is kept in memory.
+const memoryIdleTimeout = time.Hour
+
+type memoryEntry struct {
+	tokens      float64
+	failures    int
+	lockedUntil time.Time
+	updatedAt   time.Time
+	failedAt    time.Time
+}
+
+// MemoryStore keeps rate limiter state in process memory. It is suitable for
+// a single instance; use PostgresStore when running several instances.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]*memoryEntry
+	lastSweep time.Time
+}
+
+// NewMemoryStore creates a new in-memory store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries:   make(map[string]*memoryEntry),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take removes one token from the bucket stored under key.
+func (s *MemoryStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	s.sweep(now)
+
+	e, ok := s.entries[key]
+	if !ok {
+		e = &memoryEntry{tokens: float64(limit.Burst), updatedAt: now}
+		s.entries[key] = e
+	}
+
+	var result Result
+	e.tokens, result = take(e.tokens, e.updatedAt, now, limit)
+	e.updatedAt = now
+
+	return result, nil
+}
+
+// Fail records a failure for key and returns the time it is locked until.
+func (s *MemoryStore) Fail(ctx context.Context, key string, policy LockoutPolicy) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	e, ok := s.entries[key]
+	if !ok {
+		e = &memoryEntry{}
+		s.entries[key] = e
+	}
+
+	if now.Sub(e.failedAt) > policy.Window {
+		e.failures = 0
+	}
+	e.failures++
+	e.failedAt = now
+	e.updatedAt = now
+	if d := policy.lockFor(e.failures); d > 0 {
+		e.lockedUntil = now.Add(d)
+	}
+
+	return e.lockedUntil, nil
+}
+
+// LockedUntil returns the time key is locked until, or zero.
+func (s *MemoryStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if e, ok := s.entries[key]; ok {
+		return e.lockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+// Reset clears the failures and lock of key.
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.entries, key)
+	return nil
+}
+
+// sweep drops idle entries at most once per memoryIdleTimeout.
+func (s *MemoryStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memoryIdleTimeout {
+		return
+	}
+	s.lastSweep = now
+
+	for key, e := range s.entries {
+		if now.Sub(e.updatedAt) > memoryIdleTimeout && now.After(e.lockedUntil) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package ratelimit
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/ratelimitbucket"
+)
+
+// legacyBucketTimeout is how long rows without an expiry are kept after their
+// last update. No limit or lockout in use lasts that long.
+const legacyBucketTimeout = 24 * time.Hour
+
+// PostgresStore keeps rate limiter state in the database so that all
+// instances share it. Each operation locks the key's row for its duration.
+// Rows record when they stop mattering: a bucket once it has refilled, a
+// lockout key once its lock and failure window have passed. Sweep deletes
+// them after that.
+type PostgresStore struct {
+	client *ent.Client
+}
+
+// NewPostgresStore creates a new Postgres-backed store.
+func NewPostgresStore(client *ent.Client) *PostgresStore {
+	return &PostgresStore{
+		client: client,
+	}
+}
+
+// Take removes one token from the bucket stored under key.
+func (s *PostgresStore) Take(ctx context.Context, key string, limit Limit) (Result, error) {
+	var result Result
+	err := s.withBucket(ctx, key, float64(limit.Burst), func(b *ent.RateLimitBucket, update *ent.RateLimitBucketUpdateOne, now time.Time) time.Time {
+		var tokens float64
+		tokens, result = take(b.Tokens, b.UpdatedAt, now, limit)
+		update.SetTokens(tokens)
+		return now.Add(result.ResetAfter)
+	})
+	return result, err
+}
+
+// Fail records a failure for key and returns the time it is locked until.
+func (s *PostgresStore) Fail(ctx context.Context, key string, policy LockoutPolicy) (time.Time, error) {
+	var lockedUntil time.Time
+	err := s.withBucket(ctx, key, 0, func(b *ent.RateLimitBucket, update *ent.RateLimitBucketUpdateOne, now time.Time) time.Time {
+		failures := b.Failures
+		if now.Sub(b.UpdatedAt) > policy.Window {
+			failures = 0
+		}
+		failures++
+		update.SetFailures(failures)
+
+		if b.LockedUntil != nil {
+			lockedUntil = *b.LockedUntil
+		}
+		if d := policy.lockFor(failures); d > 0 {
+			lockedUntil = now.Add(d)
+			update.SetLockedUntil(lockedUntil)
+		}
+
+		expiresAt := now.Add(policy.Window)
+		if lockedUntil.After(expiresAt) {
+			expiresAt = lockedUntil
+		}
+		return expiresAt
+	})
+	return lockedUntil, err
+}
+
+// LockedUntil returns the time key is locked until, or zero.
+func (s *PostgresStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	b, err := s.client.RateLimitBucket.Query().
+		Where(ratelimitbucket.Key(key)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return time.Time{}, nil
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	if b.LockedUntil == nil {
+		return time.Time{}, nil
+	}
+	return *b.LockedUntil, nil
+}
+
+// Reset clears the failures and lock of key.
+func (s *PostgresStore) Reset(ctx context.Context, key string) error {
+	_, err := s.client.RateLimitBucket.Delete().
+		Where(ratelimitbucket.Key(key)).
+		Exec(ctx)
+	return err
+}
+
+// Sweep deletes the rows that have expired and returns how many it deleted.
+func (s *PostgresStore) Sweep(ctx context.Context) (int, error) {
+	now := time.Now()
+	return s.client.RateLimitBucket.Delete().
+		Where(ratelimitbucket.Or(
+			ratelimitbucket.ExpiresAtLT(now),
+			ratelimitbucket.And(
+				ratelimitbucket.ExpiresAtIsNil(),
+				ratelimitbucket.UpdatedAtLT(now.Add(-legacyBucketTimeout)),
+			),
+		)).
+		Exec(ctx)
+}
+
+// RunSweeper sweeps expired rows every interval until ctx is done.
+func (s *PostgresStore) RunSweeper(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if _, err := s.Sweep(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Failed to sweep rate limiter state: %v", err)
+		}
+	}
+}
+
+// withBucket locks the bucket for key, creating it with initialTokens if it
+// does not exist, and saves the changes fn makes to it. fn returns when the
+// row can be deleted; a row is never made to expire sooner than it already
+// does, as a key may be used both as a bucket and for lockouts.
+func (s *PostgresStore) withBucket(ctx context.Context, key string, initialTokens float64, fn func(*ent.RateLimitBucket, *ent.RateLimitBucketUpdateOne, time.Time) time.Time) error {
+	// Creating a missing bucket can race with another instance; retry once.
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		err = s.updateBucket(ctx, key, initialTokens, fn)
+		if !ent.IsConstraintError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (s *PostgresStore) updateBucket(ctx context.Context, key string, initialTokens float64, fn func(*ent.RateLimitBucket, *ent.RateLimitBucketUpdateOne, time.Time) time.Time) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	b, err := tx.RateLimitBucket.Query().
+		Where(ratelimitbucket.Key(key)).
+		ForUpdate().
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		b, err = tx.RateLimitBucket.Create().
+			SetKey(key).
+			SetTokens(initialTokens).
+			SetUpdatedAt(now).
+			Save(ctx)
+	}
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	update := tx.RateLimitBucket.UpdateOne(b).SetUpdatedAt(now)
+	expiresAt := fn(b, update, now)
+	if b.ExpiresAt == nil || expiresAt.After(*b.ExpiresAt) {
+		update.SetExpiresAt(expiresAt)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
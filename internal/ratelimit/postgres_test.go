@@ -0,0 +1,60 @@
+package ratelimit
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/ent/enttest"
+)
+
+func TestPostgresStoreSweep(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:ratelimit?mode=memory&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	now := time.Now()
+
+	rows := []struct {
+		key       string
+		updatedAt time.Time
+		expiresAt *time.Time
+	}{
+		{"refilled", now.Add(-time.Minute), ptr(now.Add(-time.Second))},
+		{"refilling", now.Add(-time.Minute), ptr(now.Add(time.Minute))},
+		{"locked", now.Add(-2 * time.Hour), ptr(now.Add(time.Hour))},
+		{"legacy idle", now.Add(-legacyBucketTimeout - time.Minute), nil},
+		{"legacy recent", now.Add(-time.Hour), nil},
+	}
+	for _, r := range rows {
+		client.RateLimitBucket.Create().
+			SetKey(r.key).
+			SetUpdatedAt(r.updatedAt).
+			SetNillableExpiresAt(r.expiresAt).
+			SaveX(ctx)
+	}
+
+	deleted, err := NewPostgresStore(client).Sweep(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Errorf("deleted %d rows, want 2", deleted)
+	}
+
+	var kept []string
+	for _, b := range client.RateLimitBucket.Query().AllX(ctx) {
+		kept = append(kept, b.Key)
+	}
+	slices.Sort(kept)
+	if want := []string{"legacy recent", "locked", "refilling"}; !slices.Equal(kept, want) {
+		t.Errorf("kept %v, want %v", kept, want)
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
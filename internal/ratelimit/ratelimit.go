@@ -0,0 +1,154 @@
+// Package ratelimit provides token bucket rate limiting and progressive
+// lockouts with pluggable storage.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Limit describes a token bucket: Burst tokens that refill at Rate tokens per Period.
+type Limit struct {
+	Rate   int
+	Period time.Duration
+	Burst  int
+}
+
+// PerMinute returns a limit of n requests per minute with a burst of n.
+func PerMinute(n int) Limit {
+	return Limit{Rate: n, Period: time.Minute, Burst: n}
+}
+
+// refillPerSecond returns how many tokens are added per second.
+func (l Limit) refillPerSecond() float64 {
+	return float64(l.Rate) / l.Period.Seconds()
+}
+
+// Result is the outcome of taking a token.
+type Result struct {
+	Allowed    bool
+	Limit      int
+	Remaining  int
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+// LockoutPolicy describes a progressive lockout. Once a key reaches
+// MaxFailures failures it is locked for BaseDuration, and every further
+// failure doubles the lock up to MaxDuration. Failures are forgotten after
+// Window without a new failure.
+type LockoutPolicy struct {
+	MaxFailures  int
+	BaseDuration time.Duration
+	MaxDuration  time.Duration
+	Window       time.Duration
+}
+
+// lockFor returns how long a key is locked after its nth failure.
+func (p LockoutPolicy) lockFor(failures int) time.Duration {
+	if failures < p.MaxFailures {
+		return 0
+	}
+	d := p.BaseDuration << (failures - p.MaxFailures)
+	if d <= 0 || d > p.MaxDuration {
+		return p.MaxDuration
+	}
+	return d
+}
+
+// Store keeps rate limiter state. Implementations must be safe for concurrent use.
+type Store interface {
+	// Take removes one token from the bucket stored under key.
+	Take(ctx context.Context, key string, limit Limit) (Result, error)
+	// Fail records a failure for key and returns the time it is locked until,
+	// which is zero while the key is not locked.
+	Fail(ctx context.Context, key string, policy LockoutPolicy) (time.Time, error)
+	// LockedUntil returns the time key is locked until, or zero.
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// Reset clears the failures and lock of key.
+	Reset(ctx context.Context, key string) error
+}
+
+// LockedError is returned when a key is locked out.
+type LockedError struct {
+	Until time.Time
+}
+
+func (e *LockedError) Error() string {
+	return fmt.Sprintf("too many failed attempts, try again in %d seconds", e.RetryAfterSeconds())
+}
+
+// RetryAfterSeconds returns the remaining lock time, rounded up to whole seconds.
+func (e *LockedError) RetryAfterSeconds() int {
+	return int(math.Ceil(time.Until(e.Until).Seconds()))
+}
+
+// Lockout applies a LockoutPolicy to keys in a store.
+type Lockout struct {
+	store  Store
+	policy LockoutPolicy
+}
+
+// NewLockout creates a new lockout.
+func NewLockout(store Store, policy LockoutPolicy) *Lockout {
+	return &Lockout{
+		store:  store,
+		policy: policy,
+	}
+}
+
+// Check returns a *LockedError if key is currently locked.
+func (l *Lockout) Check(ctx context.Context, key string) error {
+	until, err := l.store.LockedUntil(ctx, key)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(until) {
+		return &LockedError{Until: until}
+	}
+	return nil
+}
+
+// Fail records a failure for key and returns a *LockedError if it is now locked.
+func (l *Lockout) Fail(ctx context.Context, key string) error {
+	until, err := l.store.Fail(ctx, key, l.policy)
+	if err != nil {
+		return err
+	}
+	if time.Now().Before(until) {
+		return &LockedError{Until: until}
+	}
+	return nil
+}
+
+// Reset clears the failures of key, e.g. after a successful login.
+func (l *Lockout) Reset(ctx context.Context, key string) error {
+	return l.store.Reset(ctx, key)
+}
+
+// take applies the token bucket algorithm to a bucket that had tokens at
+// updated, and returns the new token count and the result.
+func take(tokens float64, updated, now time.Time, limit Limit) (float64, Result) {
+	refill := limit.refillPerSecond()
+	burst := float64(limit.Burst)
+
+	tokens = math.Min(burst, tokens+now.Sub(updated).Seconds()*refill)
+
+	result := Result{Limit: limit.Burst}
+	if tokens >= 1 {
+		tokens--
+		result.Allowed = true
+	} else {
+		result.RetryAfter = secondsToDuration((1 - tokens) / refill)
+	}
+	result.Remaining = int(math.Floor(tokens))
+	result.ResetAfter = secondsToDuration((burst - tokens) / refill)
+
+	return tokens, result
+}
+
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
@@ -1,17 +1,26 @@
 package routes
 
 import (
+	"context"
+	"log"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/authz"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/entitlements"
 	"gigaboo.io/lem/internal/handlers"
+	"gigaboo.io/lem/internal/jwtkeys"
 	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
 	"gigaboo.io/lem/internal/services"
 )
 
@@ -30,73 +39,208 @@ func SetupRouter(cfg *config.Config, client *ent.Client) *gin.Engine {
 	r := gin.Default()
 
 	// Middleware
-	r.Use(middleware.CORS(cfg))
+	r.Use(middleware.NewCORSMiddleware(cfg, client).Handler())
+
+	// Rate limiting
+	var rateLimitStore ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.RateLimitBackend == "postgres" {
+		rateLimitStore = ratelimit.NewPostgresStore(client)
+	}
+	rateLimit := middleware.NewRateLimitMiddleware(cfg, rateLimitStore)
+	signature := middleware.NewSignatureMiddleware(rateLimitStore, apikey.NewSealer(cfg.SecretKeyEncryptionKey()))
+	loginLockout := ratelimit.NewLockout(rateLimitStore, ratelimit.LockoutPolicy{
+		MaxFailures:  cfg.LoginMaxFailures,
+		BaseDuration: time.Duration(cfg.LoginLockoutMinutes) * time.Minute,
+		MaxDuration:  time.Hour,
+		Window:       time.Hour,
+	})
+
+	// Token signing keys. Outside prod a temporary key is generated when an
+	// asymmetric algorithm is configured without key files.
+	allowGeneratedKeys := cfg.Env != "prod"
+	userKeys, err := jwtkeys.New(cfg.JWTAlgorithm, cfg.JWTSecretKey, cfg.JWTSigningKeys, allowGeneratedKeys)
+	if err != nil {
+		log.Fatalf("Failed to load JWT signing keys: %v", err)
+	}
+	adminKeys, err := jwtkeys.New(cfg.JWTAlgorithm, cfg.AdminJWTSecret(), cfg.AdminJWTSigningKeys, allowGeneratedKeys)
+	if err != nil {
+		log.Fatalf("Failed to load admin JWT signing keys: %v", err)
+	}
 
 	// Services
-	auth := middleware.NewAuthMiddleware(cfg, client)
-	authService := services.NewAuthService(cfg, client, auth)
+	auth := middleware.NewAuthMiddleware(cfg, client, userKeys)
+	sessionService := services.NewSessionService(cfg, client, auth)
+	orgService := services.NewOrganizationService(cfg, client)
+	mfaService := services.NewMFAService(cfg, client, sessionService)
+	authService := services.NewAuthService(cfg, client, auth, sessionService, orgService, loginLockout, mfaService)
 	stripeService := services.NewStripeService(cfg, client)
 	storageService, _ := services.NewStorageService(cfg)
 	googleOAuthService := services.NewGoogleOAuthService(cfg, client)
+	appleAuthService := services.NewAppleAuthService(cfg, client)
 	driveService := services.NewDriveService(cfg, googleOAuthService)
 	emailService := services.NewEmailService(cfg, client)
-	orgService := services.NewOrganizationService(cfg, client)
+	verificationService := services.NewVerificationService(cfg, client, emailService, sessionService)
+	oidcService, err := services.NewOIDCService(cfg, client, userKeys, authService)
+	if err != nil {
+		log.Printf("OpenID Connect provider disabled: %v", err)
+	}
+	ssoService, err := services.NewSSOService(cfg, client, authService)
+	if err != nil {
+		log.Fatalf("Failed to set up SSO: %v", err)
+	}
+	accountService := services.NewAccountService(cfg, client, googleOAuthService, sessionService, loginLockout)
 	shenbiService := services.NewShenbiService(cfg, client)
+	appService := services.NewAppService(cfg, client)
 	_ = services.NewAnalyticsService(cfg)
 
 	// Admin auth middleware
-	adminAuth := middleware.NewAdminAuthMiddleware(cfg, client)
+	adminAuth := middleware.NewAdminAuthMiddleware(cfg, client, adminKeys)
+	if err := adminAuth.BootstrapAdmins(context.Background()); err != nil {
+		log.Fatalf("Failed to bootstrap admin accounts: %v", err)
+	}
 
 	// Handlers
-	authHandler := handlers.NewAuthHandler(authService, auth)
+	authHandler := handlers.NewAuthHandler(authService, googleOAuthService, auth)
 	subscriptionHandler := handlers.NewSubscriptionHandler(stripeService)
 	storageHandler := handlers.NewStorageHandler(storageService)
-	googleOAuthHandler := handlers.NewGoogleOAuthHandler(googleOAuthService, auth)
+	googleOAuthHandler := handlers.NewGoogleOAuthHandler(googleOAuthService, authService)
+	appleAuthHandler := handlers.NewAppleAuthHandler(appleAuthService, authService)
 	driveHandler := handlers.NewDriveHandler(driveService)
 	emailHandler := handlers.NewEmailHandler(emailService)
-	orgHandler := handlers.NewOrganizationHandler(orgService)
+	verificationHandler := handlers.NewVerificationHandler(verificationService)
+	magicLinkHandler := handlers.NewMagicLinkHandler(verificationService, authService)
+	accountHandler := handlers.NewAccountHandler(accountService, authService)
+	mfaHandler := handlers.NewMFAHandler(mfaService, authService)
+	orgHandler := handlers.NewOrganizationHandler(orgService, stripeService)
 	shenbiHandler := handlers.NewShenbiHandler(shenbiService)
-	adminHandler := handlers.NewAdminHandler(cfg, client, adminAuth, auth, emailService, storageService)
+	jwksHandler := handlers.NewJWKSHandler(userKeys)
+	ssoHandler := handlers.NewSSOHandler(ssoService)
+	adminHandler := handlers.NewAdminHandler(cfg, client, adminAuth, authService, appService, stripeService, emailService, storageService, ssoService)
 
 	// Health check
 	r.GET("/health", func(c *gin.Context) {
 		c.JSON(200, gin.H{"status": "ok"})
 	})
 
+	// Public keys for verifying end-user tokens
+	r.GET("/.well-known/jwks.json", jwksHandler.GetJWKS)
+
+	// OpenID Connect provider, only served with asymmetric signing keys
+	var oidcHandler *handlers.OIDCHandler
+	if oidcService != nil {
+		oidcHandler = handlers.NewOIDCHandler(oidcService)
+		r.GET("/.well-known/openid-configuration", oidcHandler.Discovery)
+		oauthRoutes := r.Group("/oauth")
+		oauthRoutes.Use(rateLimit.ByIP())
+		{
+			oauthRoutes.GET("/authorize", oidcHandler.AuthorizeRedirect)
+			oauthRoutes.POST("/token", oidcHandler.Token)
+			oauthRoutes.GET("/userinfo", auth.JWTAuth(), oidcHandler.UserInfo)
+			oauthRoutes.POST("/userinfo", auth.JWTAuth(), oidcHandler.UserInfo)
+		}
+	}
+
+	// Enterprise SSO, visited by the browser and the organization's IdP
+	ssoRoutes := r.Group("/sso")
+	ssoRoutes.Use(rateLimit.ByIP())
+	{
+		ssoRoutes.GET("/:org_id/login", ssoHandler.Login)
+		ssoRoutes.GET("/:org_id/saml/metadata", ssoHandler.SAMLMetadata)
+		ssoRoutes.POST("/:org_id/saml/acs", ssoHandler.SAMLACS)
+		ssoRoutes.GET("/:org_id/oidc/callback", ssoHandler.OIDCCallback)
+	}
+
 	// API routes
 	api := r.Group("/api/" + cfg.APIVersion)
+	api.Use(rateLimit.ByIP())
 	{
+		// Stripe webhook, authenticated by its signature. Stripe sends no
+		// API key and its events may be for any app.
+		api.POST("/subscriptions/webhook", subscriptionHandler.HandleWebhook)
+
 		// Public routes (require API key only)
 		public := api.Group("")
 		public.Use(auth.APIKeyAuth())
+		public.Use(rateLimit.ByApp())
 		{
 			// Auth routes
 			authRoutes := public.Group("/auth")
 			{
-				authRoutes.POST("/signup", authHandler.Signup)
-				authRoutes.POST("/login", authHandler.Login)
+				authRoutes.POST("/signup", rateLimit.ByEmail(), authHandler.Signup)
+				authRoutes.POST("/login", rateLimit.ByEmail(), authHandler.Login)
 				authRoutes.POST("/device", authHandler.DeviceLogin)
 				authRoutes.POST("/refresh", authHandler.RefreshToken)
 				authRoutes.POST("/google", googleOAuthHandler.Login)
 				authRoutes.POST("/google/callback", googleOAuthHandler.Callback)
+				authRoutes.POST("/apple", appleAuthHandler.Login)
+				authRoutes.POST("/mfa/verify", mfaHandler.Verify)
+				authRoutes.POST("/forgot-password", rateLimit.ByEmail(), verificationHandler.ForgotPassword)
+				authRoutes.POST("/reset-password", verificationHandler.ResetPassword)
+				authRoutes.POST("/confirm-verification", verificationHandler.ConfirmVerification)
+				authRoutes.POST("/magic-link", rateLimit.ByEmail(), magicLinkHandler.Request)
+				authRoutes.POST("/magic-link/exchange", magicLinkHandler.Exchange)
+				authRoutes.POST("/sso/discover", ssoHandler.Discover)
+				authRoutes.POST("/sso/exchange", ssoHandler.Exchange)
 			}
+		}
 
-			// Subscription webhook (no JWT required)
-			subscriptionRoutes := public.Group("/subscriptions")
+		// Server routes (require API key + a request signed with the app's
+		// secret key), for the app's backend only
+		server := api.Group("")
+		server.Use(auth.APIKeyAuth())
+		server.Use(signature.RequireSignature())
+		server.Use(rateLimit.ByApp())
+		{
+			// Email routes
+			emailRoutes := server.Group("/email")
 			{
-				subscriptionRoutes.POST("/webhook", subscriptionHandler.HandleWebhook)
+				emailRoutes.POST("/send", emailHandler.Send)
+				emailRoutes.GET("/templates", emailHandler.ListTemplates)
+				emailRoutes.GET("/templates/:name", emailHandler.GetTemplate)
+				emailRoutes.POST("/templates", emailHandler.CreateTemplate)
+				emailRoutes.PUT("/templates/:name", emailHandler.UpdateTemplate)
+				emailRoutes.DELETE("/templates/:name", emailHandler.DeleteTemplate)
 			}
 		}
 
 		// Protected routes (require API key + JWT)
 		protected := api.Group("")
 		protected.Use(auth.APIKeyAuth())
+		protected.Use(rateLimit.ByApp())
 		protected.Use(auth.JWTAuth())
+		protected.Use(rateLimit.ByUser())
 		{
+			// Endpoints an admin impersonating a user must not use: payments,
+			// credentials, and anything that outlives the impersonation
+			noImpersonation := auth.BlockImpersonation()
+
 			// Auth routes
 			authRoutes := protected.Group("/auth")
 			{
 				authRoutes.GET("/me", authHandler.GetMe)
+				authRoutes.POST("/switch-org", authHandler.SwitchOrg)
+				authRoutes.POST("/clear-org", authHandler.ClearOrg)
+				authRoutes.POST("/send-verification", verificationHandler.SendVerification)
+				authRoutes.POST("/upgrade", noImpersonation, accountHandler.Upgrade)
+				authRoutes.POST("/upgrade/google", noImpersonation, accountHandler.UpgradeWithGoogle)
+				authRoutes.POST("/revoke", authHandler.Revoke)
+				authRoutes.GET("/sessions", authHandler.ListSessions)
+				authRoutes.DELETE("/sessions", noImpersonation, authHandler.RevokeOtherSessions)
+				authRoutes.DELETE("/sessions/:session_id", authHandler.RevokeSession)
+				authRoutes.POST("/mfa/totp/setup", noImpersonation, mfaHandler.SetupTOTP)
+				authRoutes.POST("/mfa/totp/enable", noImpersonation, mfaHandler.EnableTOTP)
+				authRoutes.POST("/mfa/totp/disable", noImpersonation, mfaHandler.DisableTOTP)
+				authRoutes.POST("/mfa/recovery-codes", noImpersonation, mfaHandler.RegenerateRecoveryCodes)
+			}
+
+			// OpenID Connect login page and consent management
+			if oidcHandler != nil {
+				protectedOAuthRoutes := protected.Group("/oauth")
+				{
+					protectedOAuthRoutes.POST("/authorize", noImpersonation, oidcHandler.Authorize)
+					protectedOAuthRoutes.GET("/consents", oidcHandler.ListConsents)
+					protectedOAuthRoutes.DELETE("/consents/:consent_id", noImpersonation, oidcHandler.RevokeConsent)
+				}
 			}
 
 			// Subscription routes
@@ -104,8 +248,9 @@ func SetupRouter(cfg *config.Config, client *ent.Client) *gin.Engine {
 			{
 				subscriptionRoutes.GET("/plans", subscriptionHandler.GetPlans)
 				subscriptionRoutes.GET("/current", subscriptionHandler.GetCurrentSubscription)
-				subscriptionRoutes.POST("/checkout", subscriptionHandler.CreateCheckout)
-				subscriptionRoutes.POST("/portal", subscriptionHandler.CreatePortal)
+				subscriptionRoutes.GET("/entitlements", subscriptionHandler.GetEntitlements)
+				subscriptionRoutes.POST("/checkout", noImpersonation, subscriptionHandler.CreateCheckout)
+				subscriptionRoutes.POST("/portal", noImpersonation, subscriptionHandler.CreatePortal)
 			}
 
 			// Storage routes
@@ -129,112 +274,124 @@ func SetupRouter(cfg *config.Config, client *ent.Client) *gin.Engine {
 			}
 
 			// Organization routes
+			orgMember := auth.RequireOrgRole()
+			orgAdmin := auth.RequireOrgRole(organizationmember.RoleOWNER, organizationmember.RoleADMIN)
+			orgOwner := auth.RequireOrgRole(organizationmember.RoleOWNER)
 			orgRoutes := protected.Group("/organizations")
 			{
 				orgRoutes.GET("", orgHandler.List)
 				orgRoutes.POST("", orgHandler.Create)
-				orgRoutes.GET("/:org_id", orgHandler.Get)
-				orgRoutes.PUT("/:org_id", orgHandler.Update)
-				orgRoutes.DELETE("/:org_id", orgHandler.Delete)
-				orgRoutes.GET("/:org_id/members", orgHandler.ListMembers)
-				orgRoutes.DELETE("/:org_id/members/:member_id", orgHandler.RemoveMember)
-				orgRoutes.PATCH("/:org_id/members/:member_id/role", orgHandler.UpdateMemberRole)
-				orgRoutes.GET("/:org_id/invitations", orgHandler.ListInvitations)
-				orgRoutes.POST("/:org_id/invitations", orgHandler.CreateInvitation)
-				orgRoutes.POST("/:org_id/invitations/:inv_id/revoke", orgHandler.RevokeInvitation)
+				orgRoutes.GET("/:org_id", orgMember, orgHandler.Get)
+				orgRoutes.PUT("/:org_id", orgAdmin, orgHandler.Update)
+				orgRoutes.DELETE("/:org_id", noImpersonation, orgOwner, orgHandler.Delete)
+				orgRoutes.GET("/:org_id/members", orgMember, orgHandler.ListMembers)
+				orgRoutes.GET("/:org_id/entitlements", orgMember, subscriptionHandler.GetOrganizationEntitlements)
+				orgRoutes.GET("/:org_id/subscription", orgMember, subscriptionHandler.GetOrganizationSubscription)
+				orgRoutes.POST("/:org_id/checkout", noImpersonation, orgOwner, subscriptionHandler.CreateOrganizationCheckout)
+				orgRoutes.POST("/:org_id/portal", noImpersonation, orgOwner, subscriptionHandler.CreateOrganizationPortal)
+				// The organization the access token is scoped to, as the SDK calls them
+				orgRoutes.GET("/current/subscription", orgMember, subscriptionHandler.GetOrganizationSubscription)
+				orgRoutes.POST("/current/checkout", noImpersonation, orgOwner, subscriptionHandler.CreateOrganizationCheckout)
+				orgRoutes.POST("/current/portal", noImpersonation, orgOwner, subscriptionHandler.CreateOrganizationPortal)
+				orgRoutes.DELETE("/:org_id/members/:member_id", orgAdmin, orgHandler.RemoveMember)
+				orgRoutes.PATCH("/:org_id/members/:member_id/role", orgOwner, orgHandler.UpdateMemberRole)
+				orgRoutes.PUT("/:org_id/members/:member_id/seat", orgAdmin, orgHandler.AssignSeat)
+				orgRoutes.DELETE("/:org_id/members/:member_id/seat", orgAdmin, orgHandler.ReleaseSeat)
+				orgRoutes.GET("/:org_id/invitations", orgMember, orgHandler.ListInvitations)
+				orgRoutes.POST("/:org_id/invitations", orgAdmin, orgHandler.CreateInvitation)
+				orgRoutes.POST("/:org_id/invitations/:inv_id/revoke", orgAdmin, orgHandler.RevokeInvitation)
 				orgRoutes.POST("/invitations/accept", orgHandler.AcceptInvitation)
-			}
-
-			// Email routes
-			emailRoutes := protected.Group("/email")
-			{
-				emailRoutes.POST("/send", emailHandler.Send)
-				emailRoutes.GET("/templates", emailHandler.ListTemplates)
-				emailRoutes.GET("/templates/:name", emailHandler.GetTemplate)
-				emailRoutes.POST("/templates", emailHandler.CreateTemplate)
-				emailRoutes.PUT("/templates/:name", emailHandler.UpdateTemplate)
-				emailRoutes.DELETE("/templates/:name", emailHandler.DeleteTemplate)
+				orgRoutes.GET("/:org_id/sso", orgAdmin, ssoHandler.GetConnection)
+				orgRoutes.PUT("/:org_id/sso", orgAdmin, ssoHandler.SaveConnection)
+				orgRoutes.DELETE("/:org_id/sso", orgAdmin, ssoHandler.DeleteConnection)
+				orgRoutes.GET("/:org_id/domains", orgAdmin, ssoHandler.ListDomains)
+				orgRoutes.POST("/:org_id/domains", orgAdmin, ssoHandler.AddDomain)
+				orgRoutes.POST("/:org_id/domains/:domain_id/verify", orgAdmin, ssoHandler.VerifyDomain)
+				orgRoutes.DELETE("/:org_id/domains/:domain_id", orgAdmin, ssoHandler.DeleteDomain)
 			}
 
 			// Shenbi app routes
+			// Every Shenbi route checks the caller's permission on the
+			// resource it names. Creating classrooms, battles and live
+			// sessions is also limited by the caller's plan.
 			shenbiRoutes := protected.Group("/shenbi")
 			{
 				// Profile
 				profileRoutes := shenbiRoutes.Group("/profile")
 				{
-					profileRoutes.GET("", shenbiHandler.GetProfile)
-					profileRoutes.POST("", shenbiHandler.CreateProfile)
-					profileRoutes.PUT("", shenbiHandler.UpdateProfile)
+					profileRoutes.GET("", auth.Authorize(authz.OwnData), shenbiHandler.GetProfile)
+					profileRoutes.POST("", auth.Authorize(authz.OwnData), shenbiHandler.CreateProfile)
+					profileRoutes.PUT("", auth.Authorize(authz.OwnData), shenbiHandler.UpdateProfile)
 				}
 
 				// Progress
 				progressRoutes := shenbiRoutes.Group("/progress")
 				{
-					progressRoutes.GET("", shenbiHandler.GetProgress)
-					progressRoutes.GET("/:adventure/:level", shenbiHandler.GetLevelProgress)
-					progressRoutes.POST("/:adventure/:level", shenbiHandler.UpdateProgress)
+					progressRoutes.GET("", auth.Authorize(authz.OwnData), shenbiHandler.GetProgress)
+					progressRoutes.GET("/:adventure/:level", auth.Authorize(authz.OwnData), shenbiHandler.GetLevelProgress)
+					progressRoutes.POST("/:adventure/:level", auth.Authorize(authz.OwnData), shenbiHandler.UpdateProgress)
 				}
 
 				// Achievements
 				achievementRoutes := shenbiRoutes.Group("/achievements")
 				{
-					achievementRoutes.GET("", shenbiHandler.GetAchievements)
-					achievementRoutes.POST("/unlock", shenbiHandler.UnlockAchievement)
+					achievementRoutes.GET("", auth.Authorize(authz.OwnData), shenbiHandler.GetAchievements)
+					achievementRoutes.POST("/unlock", auth.Authorize(authz.OwnData), shenbiHandler.UnlockAchievement)
 				}
 
 				// Classrooms
 				classroomRoutes := shenbiRoutes.Group("/classrooms")
 				{
-					classroomRoutes.GET("", shenbiHandler.GetClassrooms)
-					classroomRoutes.GET("/enrolled", shenbiHandler.GetEnrolledClassrooms)
-					classroomRoutes.POST("", shenbiHandler.CreateClassroom)
-					classroomRoutes.GET("/:classroom_id", shenbiHandler.GetClassroom)
-					classroomRoutes.PUT("/:classroom_id", shenbiHandler.UpdateClassroom)
-					classroomRoutes.DELETE("/:classroom_id", shenbiHandler.DeleteClassroom)
-					classroomRoutes.POST("/join", shenbiHandler.JoinClassroom)
-					classroomRoutes.GET("/:classroom_id/members", shenbiHandler.GetClassroomMembers)
-					classroomRoutes.GET("/:classroom_id/assignments", shenbiHandler.GetAssignments)
-					classroomRoutes.POST("/:classroom_id/assignments", shenbiHandler.CreateAssignment)
-					classroomRoutes.POST("/:classroom_id/assignments/:assignment_id/publish", shenbiHandler.PublishAssignment)
-					classroomRoutes.POST("/:classroom_id/assignments/:assignment_id/submit", shenbiHandler.SubmitAssignment)
-					classroomRoutes.GET("/:classroom_id/assignments/:assignment_id/submissions", shenbiHandler.GetSubmissions)
+					classroomRoutes.GET("", auth.Authorize(authz.OwnData), shenbiHandler.GetClassrooms)
+					classroomRoutes.GET("/enrolled", auth.Authorize(authz.OwnData), shenbiHandler.GetEnrolledClassrooms)
+					classroomRoutes.POST("", auth.Authorize(authz.ClassroomCreate), auth.CheckLimit(entitlements.LimitClassrooms, entitlements.Classrooms), shenbiHandler.CreateClassroom)
+					classroomRoutes.GET("/:classroom_id", auth.Authorize(authz.ClassroomView), shenbiHandler.GetClassroom)
+					classroomRoutes.PUT("/:classroom_id", auth.Authorize(authz.ClassroomUpdate), shenbiHandler.UpdateClassroom)
+					classroomRoutes.DELETE("/:classroom_id", auth.Authorize(authz.ClassroomDelete), shenbiHandler.DeleteClassroom)
+					classroomRoutes.POST("/join", auth.Authorize(authz.ClassroomJoin), shenbiHandler.JoinClassroom)
+					classroomRoutes.GET("/:classroom_id/members", auth.Authorize(authz.ClassroomViewMembers), shenbiHandler.GetClassroomMembers)
+					classroomRoutes.GET("/:classroom_id/assignments", auth.Authorize(authz.AssignmentList), shenbiHandler.GetAssignments)
+					classroomRoutes.POST("/:classroom_id/assignments", auth.Authorize(authz.AssignmentCreate), shenbiHandler.CreateAssignment)
+					classroomRoutes.POST("/:classroom_id/assignments/:assignment_id/publish", auth.Authorize(authz.AssignmentPublish), shenbiHandler.PublishAssignment)
+					classroomRoutes.POST("/:classroom_id/assignments/:assignment_id/submit", auth.Authorize(authz.AssignmentSubmit), shenbiHandler.SubmitAssignment)
+					classroomRoutes.GET("/:classroom_id/assignments/:assignment_id/submissions", auth.Authorize(authz.SubmissionList), shenbiHandler.GetSubmissions)
 				}
 
 				// Battles
 				battleRoutes := shenbiRoutes.Group("/battles")
 				{
-					battleRoutes.POST("/create-room", shenbiHandler.CreateBattleRoom)
-					battleRoutes.POST("/join-room", shenbiHandler.JoinBattleRoom)
-					battleRoutes.GET("/room/:room_code", shenbiHandler.GetBattleRoom)
-					battleRoutes.POST("/room/:room_code/start", shenbiHandler.StartBattle)
-					battleRoutes.POST("/room/:room_code/complete", shenbiHandler.CompleteBattle)
+					battleRoutes.POST("/create-room", auth.Authorize(authz.BattleCreate), auth.RequireFeature(entitlements.FeatureBattles), shenbiHandler.CreateBattleRoom)
+					battleRoutes.POST("/join-room", auth.Authorize(authz.BattleJoin), shenbiHandler.JoinBattleRoom)
+					battleRoutes.GET("/room/:room_code", auth.Authorize(authz.BattleView), shenbiHandler.GetBattleRoom)
+					battleRoutes.POST("/room/:room_code/start", auth.Authorize(authz.BattleStart), shenbiHandler.StartBattle)
+					battleRoutes.POST("/room/:room_code/complete", auth.Authorize(authz.BattleComplete), shenbiHandler.CompleteBattle)
 				}
 
 				// Live sessions
 				liveRoutes := shenbiRoutes.Group("/live")
 				{
-					liveRoutes.POST("/session/create", shenbiHandler.CreateLiveSession)
-					liveRoutes.GET("/session/:room_code", shenbiHandler.GetLiveSession)
-					liveRoutes.POST("/session/:room_code/start", shenbiHandler.StartLiveSession)
-					liveRoutes.POST("/session/:room_code/set-level", shenbiHandler.SetLiveSessionLevel)
-					liveRoutes.POST("/session/:room_code/student-join", shenbiHandler.JoinLiveSession)
-					liveRoutes.POST("/session/:room_code/student-complete", shenbiHandler.CompleteLiveSessionLevel)
-					liveRoutes.POST("/session/:room_code/end", shenbiHandler.EndLiveSession)
+					liveRoutes.POST("/session/create", auth.Authorize(authz.LiveSessionCreate), auth.RequireFeature(entitlements.FeatureLiveSessions), shenbiHandler.CreateLiveSession)
+					liveRoutes.GET("/session/:room_code", auth.Authorize(authz.LiveSessionView), shenbiHandler.GetLiveSession)
+					liveRoutes.POST("/session/:room_code/start", auth.Authorize(authz.LiveSessionManage), shenbiHandler.StartLiveSession)
+					liveRoutes.POST("/session/:room_code/set-level", auth.Authorize(authz.LiveSessionManage), shenbiHandler.SetLiveSessionLevel)
+					liveRoutes.POST("/session/:room_code/student-join", auth.Authorize(authz.LiveSessionJoin), shenbiHandler.JoinLiveSession)
+					liveRoutes.POST("/session/:room_code/student-complete", auth.Authorize(authz.LiveSessionComplete), shenbiHandler.CompleteLiveSessionLevel)
+					liveRoutes.POST("/session/:room_code/end", auth.Authorize(authz.LiveSessionManage), shenbiHandler.EndLiveSession)
 				}
 
 				// Sessions (classroom sessions)
 				sessionRoutes := shenbiRoutes.Group("/sessions")
 				{
-					sessionRoutes.POST("/join", shenbiHandler.JoinSession)
-					sessionRoutes.GET("/:session_id", shenbiHandler.GetSession)
-					sessionRoutes.POST("/:session_id/leave", shenbiHandler.LeaveSession)
+					sessionRoutes.POST("/join", auth.Authorize(authz.SessionJoin), shenbiHandler.JoinSession)
+					sessionRoutes.GET("/:session_id", auth.Authorize(authz.SessionView), shenbiHandler.GetSession)
+					sessionRoutes.POST("/:session_id/leave", auth.Authorize(authz.SessionLeave), shenbiHandler.LeaveSession)
 				}
 
 				// Settings
 				settingsRoutes := shenbiRoutes.Group("/settings")
 				{
-					settingsRoutes.GET("", shenbiHandler.GetSettings)
-					settingsRoutes.PUT("", shenbiHandler.UpdateSettings)
+					settingsRoutes.GET("", auth.Authorize(authz.OwnData), shenbiHandler.GetSettings)
+					settingsRoutes.PUT("", auth.Authorize(authz.OwnData), shenbiHandler.UpdateSettings)
 				}
 			}
 		}
@@ -250,48 +407,95 @@ func SetupRouter(cfg *config.Config, client *ent.Client) *gin.Engine {
 		admin.POST("/auth/google", adminHandler.GoogleAuth)
 		admin.GET("/logout", adminHandler.Logout)
 
+		// Each admin route requires a permission of the admin's role
+		adminRead := adminAuth.RequireAdmin(middleware.AdminPermRead)
+		adminWrite := adminAuth.RequireAdmin(middleware.AdminPermWrite)
+		adminBilling := adminAuth.RequireAdmin(middleware.AdminPermBilling)
+		adminManage := adminAuth.RequireAdmin(middleware.AdminPermManageAdmins)
+		adminManageApps := adminAuth.RequireAdmin(middleware.AdminPermManageApps)
+
 		// Protected admin API routes
 		adminAPI := admin.Group("/api")
-		adminAPI.Use(adminAuth.RequireAdmin())
 		{
-			adminAPI.GET("/me", adminHandler.GetMe)
-			adminAPI.POST("/logout", adminHandler.Logout)
-			adminAPI.GET("/apps", adminHandler.GetApps)
-			adminAPI.GET("/apps/:app_id", adminHandler.GetApp)
-			adminAPI.GET("/apps/:app_id/users", adminHandler.GetAppUsers)
-			adminAPI.POST("/apps/:app_id/users/:user_id/shenbi-role", adminHandler.UpdateShenbiRole)
-			adminAPI.POST("/apps/:app_id/users/:user_id/generate-token", adminHandler.GenerateToken)
-			adminAPI.POST("/apps/:app_id/users/:user_id/reset-progress", adminHandler.ResetProgress)
-			adminAPI.DELETE("/apps/:app_id/users/:user_id", adminHandler.DeleteUser)
-			adminAPI.POST("/apps/:app_id/users/:user_id/send-email", adminHandler.SendEmail)
-			adminAPI.POST("/apps/:app_id/users/:user_id/send-template-email", adminHandler.SendTemplateEmail)
-			adminAPI.GET("/apps/:app_id/email-templates", adminHandler.GetEmailTemplates)
-			adminAPI.GET("/apps/:app_id/email-templates/:template_id", adminHandler.GetEmailTemplate)
-			adminAPI.GET("/apps/:app_id/plans", adminHandler.GetPlans)
-			adminAPI.PUT("/apps/:app_id/plans/:plan_id", adminHandler.UpdatePlan)
-			adminAPI.DELETE("/apps/:app_id/plans/:plan_id", adminHandler.DeletePlan)
-			adminAPI.GET("/apps/:app_id/organizations", adminHandler.GetOrganizations)
+			adminAPI.GET("/me", adminRead, adminHandler.GetMe)
+			adminAPI.POST("/logout", adminRead, adminHandler.Logout)
+			adminAPI.GET("/audit-events", adminRead, adminHandler.GetAuditEvents)
+			adminAPI.GET("/audit-events/export", adminRead, adminHandler.ExportAuditEvents)
+			adminAPI.GET("/admins", adminManage, adminHandler.GetAdminAccounts)
+			adminAPI.POST("/admins", adminManage, adminHandler.CreateAdminAccount)
+			adminAPI.PUT("/admins/:admin_id", adminManage, adminHandler.UpdateAdminAccount)
+			adminAPI.DELETE("/admins/:admin_id", adminManage, adminHandler.DeleteAdminAccount)
+			adminAPI.GET("/apps", adminRead, adminHandler.GetApps)
+			adminAPI.POST("/apps", adminManageApps, adminHandler.CreateApp)
+			adminAPI.GET("/apps/:app_id", adminRead, adminHandler.GetApp)
+			adminAPI.PUT("/apps/:app_id", adminWrite, adminHandler.UpdateApp)
+			adminAPI.POST("/apps/:app_id/toggle-status", adminManageApps, adminHandler.ToggleAppStatus)
+			adminAPI.POST("/apps/:app_id/regenerate-key", adminWrite, adminHandler.RegenerateAPIKey)
+			adminAPI.POST("/apps/:app_id/regenerate-secret", adminWrite, adminHandler.RegenerateSecret)
+			adminAPI.GET("/apps/:app_id/users", adminRead, adminHandler.GetAppUsers)
+			adminAPI.POST("/apps/:app_id/users/:user_id/shenbi-role", adminWrite, adminHandler.UpdateShenbiRole)
+			adminAPI.POST("/apps/:app_id/users/:user_id/impersonate", adminWrite, adminHandler.Impersonate)
+			adminAPI.GET("/apps/:app_id/impersonation-events", adminRead, adminHandler.GetImpersonationEvents)
+			adminAPI.POST("/apps/:app_id/users/:user_id/reset-progress", adminWrite, adminHandler.ResetProgress)
+			adminAPI.DELETE("/apps/:app_id/users/:user_id", adminWrite, adminHandler.DeleteUser)
+			adminAPI.POST("/apps/:app_id/users/:user_id/send-email", adminWrite, adminHandler.SendEmail)
+			adminAPI.POST("/apps/:app_id/users/:user_id/send-template-email", adminWrite, adminHandler.SendTemplateEmail)
+			adminAPI.GET("/apps/:app_id/email-templates", adminRead, adminHandler.GetEmailTemplates)
+			adminAPI.GET("/apps/:app_id/email-templates/:template_id", adminRead, adminHandler.GetEmailTemplate)
+			adminAPI.GET("/apps/:app_id/plans", adminRead, adminHandler.GetPlans)
+			adminAPI.GET("/apps/:app_id/plans/stripe-diff", adminRead, adminHandler.GetPlansStripeDiff)
+			adminAPI.POST("/apps/:app_id/plans/publish", adminBilling, adminHandler.PublishPlans)
+			adminAPI.PUT("/apps/:app_id/plans/:plan_id", adminBilling, adminHandler.UpdatePlan)
+			adminAPI.DELETE("/apps/:app_id/plans/:plan_id", adminBilling, adminHandler.DeletePlan)
+			adminAPI.POST("/subscriptions/reconcile", adminBilling, adminHandler.ReconcileSubscriptions)
+			adminAPI.GET("/stripe-events", adminRead, adminHandler.GetStripeEvents)
+			adminAPI.GET("/stripe-events/:event_id", adminRead, adminHandler.GetStripeEvent)
+			adminAPI.POST("/stripe-events/:event_id/replay", adminBilling, adminHandler.ReplayStripeEvent)
+			adminAPI.GET("/apps/:app_id/organizations", adminRead, adminHandler.GetOrganizations)
+			adminAPI.GET("/apps/:app_id/webhook-deliveries", adminRead, adminHandler.GetWebhookDeliveries)
+			adminAPI.GET("/apps/:app_id/webhook-deliveries/:delivery_id", adminRead, adminHandler.GetWebhookDelivery)
+			adminAPI.POST("/apps/:app_id/webhook-deliveries/:delivery_id/redeliver", adminWrite, adminHandler.RedeliverWebhook)
+			adminAPI.POST("/apps/:app_id/webhooks/test", adminWrite, adminHandler.SendTestWebhook)
 		}
 
 		// Protected admin form/action routes (without /api prefix)
 		adminProtected := admin.Group("")
-		adminProtected.Use(adminAuth.RequireAdmin())
 		{
-			adminProtected.POST("/apps/:app_id/email-templates", adminHandler.CreateEmailTemplate)
-			adminProtected.PUT("/apps/:app_id/email-templates/:template_id", adminHandler.UpdateEmailTemplate)
-			adminProtected.DELETE("/apps/:app_id/email-templates/:template_id", adminHandler.DeleteEmailTemplate)
-			adminProtected.POST("/apps/:app_id/plans", adminHandler.CreatePlan)
-			adminProtected.POST("/apps/:app_id/organizations", adminHandler.CreateOrganization)
-			adminProtected.PUT("/apps/:app_id/organizations/:org_id", adminHandler.UpdateOrganization)
-			adminProtected.POST("/apps/:app_id/organizations/:org_id/toggle-status", adminHandler.ToggleOrganizationStatus)
-			adminProtected.DELETE("/apps/:app_id/organizations/:org_id", adminHandler.DeleteOrganization)
-			adminProtected.GET("/apps/:app_id/storage/files", adminHandler.GetStorageFiles)
-			adminProtected.POST("/apps/:app_id/storage/upload", adminHandler.UploadStorageFile)
-			adminProtected.GET("/apps/:app_id/storage/signed-url", adminHandler.GetStorageSignedURL)
-			adminProtected.DELETE("/apps/:app_id/storage/file", adminHandler.DeleteStorageFile)
+			adminProtected.POST("/apps/:app_id/email-templates", adminWrite, adminHandler.CreateEmailTemplate)
+			adminProtected.PUT("/apps/:app_id/email-templates/:template_id", adminWrite, adminHandler.UpdateEmailTemplate)
+			adminProtected.DELETE("/apps/:app_id/email-templates/:template_id", adminWrite, adminHandler.DeleteEmailTemplate)
+			adminProtected.POST("/apps/:app_id/plans", adminBilling, adminHandler.CreatePlan)
+			adminProtected.POST("/apps/:app_id/organizations", adminWrite, adminHandler.CreateOrganization)
+			adminProtected.PUT("/apps/:app_id/organizations/:org_id", adminWrite, adminHandler.UpdateOrganization)
+			adminProtected.POST("/apps/:app_id/organizations/:org_id/toggle-status", adminWrite, adminHandler.ToggleOrganizationStatus)
+			adminProtected.POST("/apps/:app_id/organizations/:org_id/domains/:domain_id/verify", adminWrite, adminHandler.VerifyOrganizationDomain)
+			adminProtected.DELETE("/apps/:app_id/organizations/:org_id", adminWrite, adminHandler.DeleteOrganization)
+			adminProtected.GET("/apps/:app_id/storage/files", adminRead, adminHandler.GetStorageFiles)
+			adminProtected.POST("/apps/:app_id/storage/upload", adminWrite, adminHandler.UploadStorageFile)
+			adminProtected.GET("/apps/:app_id/storage/signed-url", adminRead, adminHandler.GetStorageSignedURL)
+			adminProtected.DELETE("/apps/:app_id/storage/file", adminWrite, adminHandler.DeleteStorageFile)
 		}
 	}
 
+	// Admin API for scripts and app backends, as the SDK's AdminService
+	// calls it, authenticated with the admin API key instead of a session
+	adminKeyRead := adminAuth.RequireAdminKey(middleware.AdminPermRead)
+	adminKeyWrite := adminAuth.RequireAdminKey(middleware.AdminPermWrite)
+	adminKeyBilling := adminAuth.RequireAdminKey(middleware.AdminPermBilling)
+	adminKeyManageApps := adminAuth.RequireAdminKey(middleware.AdminPermManageApps)
+	adminKeyAPI := api.Group("/admin")
+	{
+		adminKeyAPI.GET("/apps", adminKeyRead, adminHandler.GetApps)
+		adminKeyAPI.POST("/apps", adminKeyManageApps, adminHandler.CreateApp)
+		adminKeyAPI.GET("/apps/:app_id", adminKeyRead, adminHandler.GetApp)
+		adminKeyAPI.PUT("/apps/:app_id", adminKeyWrite, adminHandler.UpdateApp)
+		adminKeyAPI.POST("/apps/:app_id/toggle-status", adminKeyManageApps, adminHandler.ToggleAppStatus)
+		adminKeyAPI.POST("/apps/:app_id/regenerate-key", adminKeyWrite, adminHandler.RegenerateAPIKey)
+		adminKeyAPI.POST("/apps/:app_id/regenerate-secret", adminKeyWrite, adminHandler.RegenerateSecret)
+		adminKeyAPI.GET("/apps/:app_id/plans", adminKeyRead, adminHandler.GetPlans)
+		adminKeyAPI.POST("/apps/:app_id/plans", adminKeyBilling, adminHandler.CreatePlan)
+	}
+
 	// Serve static files for shenbi (public app) and admin UI
 	setupStaticFiles(r, cfg)
 
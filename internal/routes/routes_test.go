@@ -0,0 +1,202 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/gin-gonic/gin"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/audit"
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/auditevent"
+	"gigaboo.io/lem/internal/ent/enttest"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// newTestRouter returns the server's router for an empty in-memory database,
+// with change applied to its configuration.
+func newTestRouter(t *testing.T, change func(cfg *config.Config)) (*gin.Engine, *ent.Client, *config.Config) {
+	t.Helper()
+	cfg, err := config.Load("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Debug = false
+	cfg.JWTAlgorithm = jwtkeys.HS256
+	cfg.JWTSecretKey = "test-secret"
+	if change != nil {
+		change(cfg)
+	}
+
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	tenant.Register(client)
+	audit.Register(client)
+	return SetupRouter(cfg, client), client, cfg
+}
+
+// TestCurrentOrganizationRoutes calls the organization billing routes the
+// way the SDK does, for the organization the access token is scoped to.
+func TestCurrentOrganizationRoutes(t *testing.T) {
+	r, client, cfg := newTestRouter(t, nil)
+
+	a := client.App.Create().
+		SetName("App").
+		SetSlug("app").
+		SetAPIKeyHash(apikey.Hash("lem_pk_test")).
+		SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	owner := client.User.Create().SetEmail("owner@example.com").SaveX(ctx)
+	member := client.User.Create().SetEmail("member@example.com").SaveX(ctx)
+	org := client.Organization.Create().SetName("School").SetSlug("school").SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganization(org).SetUser(owner).SetRole(organizationmember.RoleOWNER).SaveX(ctx)
+	client.OrganizationMember.Create().SetOrganization(org).SetUser(member).SaveX(ctx)
+	plan := client.Plan.Create().SetName("School").SetSlug("school").SetPriceCents(500).SaveX(ctx)
+
+	auth := middleware.NewAuthMiddleware(cfg, client, jwtkeys.NewHMAC(cfg.JWTSecretKey))
+	token := func(userID, orgID int, role organizationmember.Role) string {
+		token, err := auth.GenerateAccessToken(userID, a.ID, orgID, string(role), 0, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	checkout := "/api/v1/organizations/current/checkout?" + url.Values{
+		"plan_id":     {strconv.Itoa(plan.ID)},
+		"success_url": {"https://app.test/success"},
+		"cancel_url":  {"https://app.test/cancel"},
+	}.Encode()
+	portal := "/api/v1/organizations/current/portal?return_url=" + url.QueryEscape("https://app.test/billing")
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		status int
+		// err is the error returned, if any
+		err string
+	}{
+		{"owner gets subscription", http.MethodGet, "/api/v1/organizations/current/subscription", token(owner.ID, org.ID, organizationmember.RoleOWNER), http.StatusOK, ""},
+		{"member gets subscription", http.MethodGet, "/api/v1/organizations/current/subscription", token(member.ID, org.ID, organizationmember.RoleMEMBER), http.StatusOK, ""},
+		{"owner checks out", http.MethodPost, checkout, token(owner.ID, org.ID, organizationmember.RoleOWNER), http.StatusBadRequest, "plan has no Stripe price ID"},
+		{"owner opens portal", http.MethodPost, portal, token(owner.ID, org.ID, organizationmember.RoleOWNER), http.StatusBadRequest, "no Stripe customer found"},
+		{"member checks out", http.MethodPost, checkout, token(member.ID, org.ID, organizationmember.RoleMEMBER), http.StatusForbidden, "permission denied"},
+		{"member opens portal", http.MethodPost, portal, token(member.ID, org.ID, organizationmember.RoleMEMBER), http.StatusForbidden, "permission denied"},
+		{"no organization selected", http.MethodGet, "/api/v1/organizations/current/subscription", token(owner.ID, 0, ""), http.StatusBadRequest, "no organization selected, switch to one first"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("X-API-Key", "lem_pk_test")
+			req.Header.Set("Authorization", "Bearer "+tt.token)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			var body struct {
+				Error string `json:"error"`
+			}
+			json.Unmarshal(w.Body.Bytes(), &body)
+			if w.Code != tt.status || body.Error != tt.err {
+				t.Errorf("%s %s = %d %s, want %d with error %q", tt.method, tt.path, w.Code, w.Body, tt.status, tt.err)
+			}
+		})
+	}
+}
+
+// TestAdminKeyRoutes calls the admin API the way the SDK's AdminService does.
+func TestAdminKeyRoutes(t *testing.T) {
+	r, client, _ := newTestRouter(t, func(cfg *config.Config) {
+		cfg.AdminAPIKey = "admin-key"
+	})
+
+	call := func(method, path, adminKey string, body interface{}) (int, map[string]interface{}) {
+		t.Helper()
+		var reqBody bytes.Buffer
+		if body != nil {
+			json.NewEncoder(&reqBody).Encode(body)
+		}
+		req := httptest.NewRequest(method, path, &reqBody)
+		req.Header.Set("Content-Type", "application/json")
+		if adminKey != "" {
+			req.Header.Set(middleware.AdminKeyHeader, adminKey)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		var resp map[string]interface{}
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp
+	}
+
+	for _, key := range []string{"", "other-key"} {
+		if status, _ := call(http.MethodGet, "/api/v1/admin/apps", key, nil); status != http.StatusUnauthorized {
+			t.Errorf("listing apps with key %q = %d, want 401", key, status)
+		}
+	}
+
+	status, created := call(http.MethodPost, "/api/v1/admin/apps", "admin-key", map[string]interface{}{
+		"name": "App",
+		"slug": "app",
+	})
+	if status != http.StatusCreated || created["api_key"] == "" {
+		t.Fatalf("creating an app = %d %v, want 201 with its API key", status, created)
+	}
+	path := "/api/v1/admin/apps/" + strconv.Itoa(int(created["id"].(float64)))
+
+	if status, got := call(http.MethodGet, path, "admin-key", nil); status != http.StatusOK || got["slug"] != "app" {
+		t.Errorf("getting the app = %d %v", status, got)
+	}
+	if status, got := call(http.MethodPut, path, "admin-key", map[string]interface{}{"name": "Renamed"}); status != http.StatusOK || got["name"] != "Renamed" {
+		t.Errorf("updating the app = %d %v", status, got)
+	}
+	if status, plan := call(http.MethodPost, path+"/plans", "admin-key", map[string]interface{}{
+		"name": "Pro",
+		"slug": "pro",
+	}); status != http.StatusOK && status != http.StatusCreated {
+		t.Errorf("creating a plan = %d %v", status, plan)
+	}
+
+	// Rotating keys locks the app's row, which SQLite can't, so only check
+	// that the request gets to the handler.
+	if status, got := call(http.MethodPost, path+"/regenerate-key", "admin-key", map[string]interface{}{"overlap_hours": -1}); status != http.StatusBadRequest {
+		t.Errorf("regenerating the API key = %d %v, want the handler's 400", status, got)
+	}
+	if status, _ := call(http.MethodPost, path+"/regenerate-key", "other-key", nil); status != http.StatusUnauthorized {
+		t.Errorf("regenerating the API key with another key = %d, want 401", status)
+	}
+
+	event := client.AuditEvent.Query().
+		Where(auditevent.Action("app.update")).
+		OnlyX(tenant.AllApps(context.Background()))
+	if event.Actor != "admin API key" {
+		t.Errorf("app update attributed to %q, want the admin API key", event.Actor)
+	}
+}
+
+// TestAdminKeyRoutesDisabled checks that without ADMIN_API_KEY set, no key
+// gets in, including an empty one.
+func TestAdminKeyRoutesDisabled(t *testing.T) {
+	r, _, _ := newTestRouter(t, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/admin/apps", nil)
+	req.Header.Set(middleware.AdminKeyHeader, "")
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("listing apps without ADMIN_API_KEY = %d, want 401", w.Code)
+	}
+}
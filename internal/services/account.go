@@ -0,0 +1,447 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/classroom"
+	"gigaboo.io/lem/internal/ent/classroommembership"
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/ent/userapp"
+	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ratelimit"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// AccountService upgrades anonymous device accounts into real accounts.
+//
+// A device account that claims an identity nobody has registered yet is
+// upgraded in place. If the identity already belongs to another account,
+// the device account's data is merged into that account and the device
+// account is disabled. Accounts with two-factor authentication are never
+// merged into, since the device account would skip the second factor.
+type AccountService struct {
+	cfg         *config.Config
+	client      *ent.Client
+	googleOAuth *GoogleOAuthService
+	sessions    *SessionService
+	lockout     *ratelimit.Lockout
+}
+
+// NewAccountService creates a new account service. lockout is the login
+// lockout, which also counts wrong passwords for existing accounts.
+func NewAccountService(cfg *config.Config, client *ent.Client, googleOAuth *GoogleOAuthService, sessions *SessionService, lockout *ratelimit.Lockout) *AccountService {
+	return &AccountService{
+		cfg:         cfg,
+		client:      client,
+		googleOAuth: googleOAuth,
+		sessions:    sessions,
+		lockout:     lockout,
+	}
+}
+
+// ErrMergeMFA is returned when upgrading a device account into an account
+// with two-factor authentication.
+var ErrMergeMFA = errors.New("account has two-factor authentication, log in to it instead")
+
+// UpgradeInput represents device account upgrade request data.
+// When the email is already registered, password must be that account's password.
+type UpgradeInput struct {
+	Email    string `json:"email" binding:"required,email"`
+	Password string `json:"password" binding:"required,min=6"`
+	Name     string `json:"name"`
+}
+
+// UpgradeGoogleInput represents device account upgrade with Google request data.
+type UpgradeGoogleInput struct {
+	IDToken string `json:"id_token" binding:"required"`
+}
+
+// Upgrade attaches an email and password to a device account and returns the
+// surviving account. Wrong passwords for an existing account count towards
+// its login lockout, and a locked account fails with a *ratelimit.LockedError.
+func (s *AccountService) Upgrade(ctx context.Context, device *ent.User, input UpgradeInput) (*ent.User, error) {
+	if device.DeviceID == nil {
+		return nil, errors.New("account is not a device account")
+	}
+
+	target, err := s.client.User.Query().
+		Where(user.Email(input.Email)).
+		First(ctx)
+	if err == nil {
+		// Merging requires proof that the caller owns the existing account
+		lockoutKey := loginLockoutKey(input.Email)
+		if err := s.lockout.Check(ctx, lockoutKey); err != nil {
+			return nil, err
+		}
+		if target.PasswordHash == "" ||
+			bcrypt.CompareHashAndPassword([]byte(target.PasswordHash), []byte(input.Password)) != nil {
+			return nil, loginFailed(ctx, s.lockout, lockoutKey)
+		}
+		if err := s.lockout.Reset(ctx, lockoutKey); err != nil {
+			return nil, err
+		}
+		if !target.IsActive {
+			return nil, errors.New("account is disabled")
+		}
+		if target.MfaEnabled {
+			return nil, ErrMergeMFA
+		}
+		return s.merge(ctx, device, target)
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.client.User.UpdateOne(device).
+		SetEmail(input.Email).
+		SetPasswordHash(string(hashedPassword)).
+		ClearDeviceID()
+	if input.Name != "" {
+		update.SetName(input.Name)
+	}
+	return update.Save(ctx)
+}
+
+// UpgradeWithGoogle attaches a Google identity to a device account and returns
+// the surviving account.
+func (s *AccountService) UpgradeWithGoogle(ctx context.Context, device *ent.User, input UpgradeGoogleInput) (*ent.User, error) {
+	if device.DeviceID == nil {
+		return nil, errors.New("account is not a device account")
+	}
+
+	info, err := s.googleOAuth.ValidateIDToken(ctx, input.IDToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prefer the account already linked to this Google identity
+	target, err := s.client.User.Query().
+		Where(user.GoogleID(info.ID)).
+		First(ctx)
+	if err != nil {
+		target, err = s.client.User.Query().
+			Where(user.Email(info.Email)).
+			First(ctx)
+	}
+	if err == nil {
+		if !target.IsActive {
+			return nil, errors.New("account is disabled")
+		}
+		if target.MfaEnabled {
+			return nil, ErrMergeMFA
+		}
+		if target.GoogleID == nil {
+			// Link Google account
+			target, err = s.client.User.UpdateOne(target).
+				SetGoogleID(info.ID).
+				Save(ctx)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return s.merge(ctx, device, target)
+	}
+
+	return s.client.User.UpdateOne(device).
+		SetEmail(info.Email).
+		SetName(info.Name).
+		SetAvatarURL(info.Picture).
+		SetGoogleID(info.ID).
+		SetIsVerified(info.VerifiedEmail).
+		ClearDeviceID().
+		Save(ctx)
+}
+
+// merge moves the device account's data onto target and disables the device
+// account. When both accounts have a record for the same thing, the best
+// result wins: the most stars, the earliest first completion and the earliest
+// achievement. In each app, the target's profile and settings are kept if it
+// has them.
+func (s *AccountService) merge(ctx context.Context, source, target *ent.User) (*ent.User, error) {
+	if source.ID == target.ID {
+		return target, nil
+	}
+
+	// The device account may have data in every app
+	ctx = tenant.AllApps(ctx)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := []func(context.Context, *ent.Tx, int, int) error{
+		mergeUserApps,
+		mergeProgress,
+		mergeAchievements,
+		mergeShenbiProfile,
+		mergeShenbiSettings,
+		mergeClassroomMemberships,
+	}
+	for _, step := range steps {
+		if err := step(ctx, tx, source.ID, target.ID); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+	}
+
+	_, err = tx.User.UpdateOneID(source.ID).
+		SetIsActive(false).
+		ClearDeviceID().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.sessions.RevokeEverywhere(ctx, source.ID, "merged into another account"); err != nil {
+		return nil, err
+	}
+
+	return s.client.User.Get(ctx, target.ID)
+}
+
+func mergeUserApps(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	appIDs, err := tx.App.Query().
+		Where(app.HasUserAppsWith(userapp.HasUserWith(user.ID(sourceID)))).
+		IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, appID := range appIDs {
+		exists, err := tx.UserApp.Query().
+			Where(
+				userapp.HasUserWith(user.ID(targetID)),
+				userapp.HasAppWith(app.ID(appID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if _, err := tx.UserApp.Create().SetUserID(targetID).SetAppID(appID).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeProgress(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	progress, err := tx.UserProgress.Query().
+		Where(userprogress.HasUserWith(user.ID(sourceID))).
+		WithApp().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range progress {
+		existing, err := tx.UserProgress.Query().
+			Where(
+				userprogress.HasUserWith(user.ID(targetID)),
+				userprogress.HasAppWith(app.ID(p.Edges.App.ID)),
+				userprogress.AdventureSlug(p.AdventureSlug),
+				userprogress.LevelSlug(p.LevelSlug),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			if _, err := tx.UserProgress.UpdateOne(p).SetUserID(targetID).Save(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		update := tx.UserProgress.UpdateOne(existing).
+			SetAttempts(existing.Attempts + p.Attempts).
+			SetCompleted(existing.Completed || p.Completed)
+		if p.Stars > existing.Stars {
+			update.SetStars(p.Stars).SetBestCode(p.BestCode)
+		}
+		if earlier(p.FirstCompletedAt, existing.FirstCompletedAt) {
+			update.SetFirstCompletedAt(*p.FirstCompletedAt)
+		}
+		if p.LastAttemptAt != nil && (existing.LastAttemptAt == nil || p.LastAttemptAt.After(*existing.LastAttemptAt)) {
+			update.SetLastAttemptAt(*p.LastAttemptAt)
+		}
+		if _, err := update.Save(ctx); err != nil {
+			return err
+		}
+		if err := tx.UserProgress.DeleteOne(p).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeAchievements(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	achievements, err := tx.Achievement.Query().
+		Where(achievement.HasUserWith(user.ID(sourceID))).
+		WithApp().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, a := range achievements {
+		existing, err := tx.Achievement.Query().
+			Where(
+				achievement.HasUserWith(user.ID(targetID)),
+				achievement.HasAppWith(app.ID(a.Edges.App.ID)),
+				achievement.AchievementID(a.AchievementID),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			if _, err := tx.Achievement.UpdateOne(a).SetUserID(targetID).Save(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if a.EarnedAt.Before(existing.EarnedAt) {
+			if _, err := tx.Achievement.UpdateOne(existing).SetEarnedAt(a.EarnedAt).Save(ctx); err != nil {
+				return err
+			}
+		}
+		if err := tx.Achievement.DeleteOne(a).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeShenbiProfile(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	profiles, err := tx.ShenbiProfile.Query().
+		Where(shenbiprofile.HasUserWith(user.ID(sourceID))).
+		WithApp().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range profiles {
+		exists, err := tx.ShenbiProfile.Query().
+			Where(
+				shenbiprofile.HasUserWith(user.ID(targetID)),
+				shenbiprofile.HasAppWith(app.ID(p.Edges.App.ID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if err := tx.ShenbiProfile.DeleteOne(p).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tx.ShenbiProfile.UpdateOne(p).SetUserID(targetID).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeShenbiSettings(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	settings, err := tx.ShenbiSettings.Query().
+		Where(shenbisettings.HasUserWith(user.ID(sourceID))).
+		WithApp().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, s := range settings {
+		exists, err := tx.ShenbiSettings.Query().
+			Where(
+				shenbisettings.HasUserWith(user.ID(targetID)),
+				shenbisettings.HasAppWith(app.ID(s.Edges.App.ID)),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if err := tx.ShenbiSettings.DeleteOne(s).Exec(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tx.ShenbiSettings.UpdateOne(s).SetUserID(targetID).Save(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mergeClassroomMemberships(ctx context.Context, tx *ent.Tx, sourceID, targetID int) error {
+	memberships, err := tx.ClassroomMembership.Query().
+		Where(classroommembership.HasStudentWith(user.ID(sourceID))).
+		WithClassroom().
+		All(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range memberships {
+		existing, err := tx.ClassroomMembership.Query().
+			Where(
+				classroommembership.HasStudentWith(user.ID(targetID)),
+				classroommembership.HasClassroomWith(classroom.ID(m.Edges.Classroom.ID)),
+			).
+			Only(ctx)
+		if ent.IsNotFound(err) {
+			if _, err := tx.ClassroomMembership.UpdateOne(m).SetStudentID(targetID).Save(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		// An active membership on either account keeps the student in the class
+		if m.Status == classroommembership.StatusACTIVE && existing.Status != classroommembership.StatusACTIVE {
+			_, err := tx.ClassroomMembership.UpdateOne(existing).
+				SetStatus(classroommembership.StatusACTIVE).
+				ClearLeftAt().
+				Save(ctx)
+			if err != nil {
+				return err
+			}
+		}
+		if err := tx.ClassroomMembership.DeleteOne(m).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// earlier reports whether a is set and before b (or b is unset).
+func earlier(a, b *time.Time) bool {
+	return a != nil && (b == nil || a.Before(*b))
+}
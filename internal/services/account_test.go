@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
+)
+
+func TestUpgradeIntoExistingAccount(t *testing.T) {
+	client := newTestClient(t)
+	cfg := &config.Config{}
+	sessions := NewSessionService(cfg, client, middleware.NewAuthMiddleware(cfg, client, jwtkeys.NewHMAC("test")))
+	lockout := ratelimit.NewLockout(ratelimit.NewMemoryStore(), ratelimit.LockoutPolicy{
+		MaxFailures:  3,
+		BaseDuration: time.Minute,
+		MaxDuration:  time.Hour,
+		Window:       time.Hour,
+	})
+	s := NewAccountService(cfg, client, nil, sessions, lockout)
+	ctx := context.Background()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("correct horse"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newAccount := func(email string, mfa bool) *ent.User {
+		return client.User.Create().SetEmail(email).SetPasswordHash(string(hash)).SetMfaEnabled(mfa).SaveX(ctx)
+	}
+	newDevice := func(id string) *ent.User {
+		return client.User.Create().SetEmail(id + "@device.local").SetDeviceID(id).SaveX(ctx)
+	}
+
+	t.Run("wrong passwords lock the account out", func(t *testing.T) {
+		newAccount("locked@example.com", false)
+		device := newDevice("locked")
+		for i := 1; i <= 3; i++ {
+			_, err := s.Upgrade(ctx, device, UpgradeInput{Email: "locked@example.com", Password: "wrong password"})
+			var locked *ratelimit.LockedError
+			if gotLocked := errors.As(err, &locked); err == nil || gotLocked != (i == 3) {
+				t.Fatalf("attempt %d: error = %v, want locked %t", i, err, i == 3)
+			}
+		}
+
+		// Including password logins, and the right password
+		if err := lockout.Check(ctx, loginLockoutKey("Locked@example.com")); err == nil {
+			t.Error("password login to the account isn't locked")
+		}
+		_, err := s.Upgrade(ctx, device, UpgradeInput{Email: "locked@example.com", Password: "correct horse"})
+		var locked *ratelimit.LockedError
+		if !errors.As(err, &locked) {
+			t.Errorf("error = %v with the right password, want locked", err)
+		}
+		if device := client.User.GetX(ctx, device.ID); !device.IsActive {
+			t.Error("device account was merged while locked out")
+		}
+	})
+
+	t.Run("accounts with two-factor authentication are not merged into", func(t *testing.T) {
+		newAccount("mfa@example.com", true)
+		device := newDevice("mfa")
+		if _, err := s.Upgrade(ctx, device, UpgradeInput{Email: "mfa@example.com", Password: "correct horse"}); !errors.Is(err, ErrMergeMFA) {
+			t.Errorf("error = %v, want %v", err, ErrMergeMFA)
+		}
+		if device := client.User.GetX(ctx, device.ID); !device.IsActive {
+			t.Error("device account was merged")
+		}
+	})
+
+	t.Run("right password merges", func(t *testing.T) {
+		target := newAccount("merge@example.com", false)
+		device := newDevice("merge")
+		for i := 0; i < 2; i++ {
+			s.Upgrade(ctx, device, UpgradeInput{Email: "merge@example.com", Password: "wrong password"})
+		}
+		merged, err := s.Upgrade(ctx, device, UpgradeInput{Email: "merge@example.com", Password: "correct horse"})
+		if err != nil || merged.ID != target.ID {
+			t.Fatalf("upgrade = %v, %v, want account %d", merged, err, target.ID)
+		}
+		if device := client.User.GetX(ctx, device.ID); device.IsActive {
+			t.Error("device account is still active")
+		}
+
+		// The failures before are forgotten
+		for i := 0; i < 2; i++ {
+			if err := lockout.Fail(ctx, loginLockoutKey("merge@example.com")); err != nil {
+				t.Errorf("failure %d after merging: %v, want not locked", i+1, err)
+			}
+		}
+	})
+}
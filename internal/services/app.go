@@ -0,0 +1,275 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/webhook"
+)
+
+// DefaultKeyOverlap is how long a rotated API key or secret keeps working
+// when no overlap is given.
+const DefaultKeyOverlap = 24 * time.Hour
+
+// MaxKeyOverlap is the longest a rotated API key or secret may keep working.
+const MaxKeyOverlap = 30 * 24 * time.Hour
+
+var (
+	// ErrAppSlugTaken is returned when creating an app with a slug in use.
+	ErrAppSlugTaken = errors.New("an app with this slug already exists")
+	// ErrInvalidOrigin is returned when an allowed origin is not an origin
+	// pattern.
+	ErrInvalidOrigin = errors.New("allowed origins must look like https://example.com or https://*.example.com")
+)
+
+// AppService manages apps and their credentials.
+//
+// API keys and secret keys are stored as hashes and only returned when
+// they are generated; secret keys are also stored encrypted to sign with.
+// Rotating one keeps the replaced key working for an overlap window so that
+// clients can switch without downtime.
+type AppService struct {
+	cfg    *config.Config
+	client *ent.Client
+	sealer *apikey.Sealer
+}
+
+// NewAppService creates a new app service.
+func NewAppService(cfg *config.Config, client *ent.Client) *AppService {
+	return &AppService{
+		cfg:    cfg,
+		client: client,
+		sealer: apikey.NewSealer(cfg.SecretKeyEncryptionKey()),
+	}
+}
+
+// CreateAppInput represents create app request.
+type CreateAppInput struct {
+	Name              string   `json:"name" binding:"required"`
+	Slug              string   `json:"slug" binding:"required"`
+	AllowedOrigins    []string `json:"allowed_origins"`
+	OAuthRedirectURIs []string `json:"oauth_redirect_uris"`
+	WebhookURL        string   `json:"webhook_url"`
+	StripeProductID   string   `json:"stripe_product_id"`
+	MagicLinkSignup   bool     `json:"magic_link_signup"`
+}
+
+// UpdateAppInput represents update app request. Allowed origins apply to
+// CORS within a minute. A rate limit of 0 restores the server default. The
+// slug cannot be changed since clients use it as their OpenID Connect client
+// ID.
+type UpdateAppInput struct {
+	Name               *string   `json:"name"`
+	AllowedOrigins     *[]string `json:"allowed_origins"`
+	OAuthRedirectURIs  *[]string `json:"oauth_redirect_uris"`
+	WebhookURL         *string   `json:"webhook_url"`
+	StripeProductID    *string   `json:"stripe_product_id"`
+	RateLimitPerMinute *int      `json:"rate_limit_per_minute"`
+	RateLimitBurst     *int      `json:"rate_limit_burst"`
+	MagicLinkSignup    *bool     `json:"magic_link_signup"`
+}
+
+// Create creates an app and returns it with its API key.
+func (s *AppService) Create(ctx context.Context, input CreateAppInput) (*ent.App, string, error) {
+	if err := validateOrigins(input.AllowedOrigins); err != nil {
+		return nil, "", err
+	}
+	if err := webhook.CheckURL(ctx, input.WebhookURL, s.cfg.Env != "prod"); err != nil {
+		return nil, "", err
+	}
+
+	exists, err := s.client.App.Query().
+		Where(app.Slug(input.Slug)).
+		Exist(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if exists {
+		return nil, "", ErrAppSlugTaken
+	}
+
+	key, err := apikey.NewKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	a, err := s.client.App.Create().
+		SetName(input.Name).
+		SetSlug(input.Slug).
+		SetAPIKeyHash(apikey.Hash(key)).
+		SetAPIKeyPrefix(apikey.Prefix(key)).
+		SetAllowedOrigins(input.AllowedOrigins).
+		SetOauthRedirectUris(input.OAuthRedirectURIs).
+		SetWebhookURL(input.WebhookURL).
+		SetStripeProductID(input.StripeProductID).
+		SetMagicLinkSignup(input.MagicLinkSignup).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, "", ErrAppSlugTaken
+		}
+		return nil, "", err
+	}
+	return a, key, nil
+}
+
+// Update updates an app's settings.
+func (s *AppService) Update(ctx context.Context, appID int, input UpdateAppInput) (*ent.App, error) {
+	update := s.client.App.UpdateOneID(appID)
+	if input.Name != nil {
+		update.SetName(*input.Name)
+	}
+	if input.AllowedOrigins != nil {
+		if err := validateOrigins(*input.AllowedOrigins); err != nil {
+			return nil, err
+		}
+		update.SetAllowedOrigins(*input.AllowedOrigins)
+	}
+	if input.OAuthRedirectURIs != nil {
+		update.SetOauthRedirectUris(*input.OAuthRedirectURIs)
+	}
+	if input.WebhookURL != nil {
+		if err := webhook.CheckURL(ctx, *input.WebhookURL, s.cfg.Env != "prod"); err != nil {
+			return nil, err
+		}
+		update.SetWebhookURL(*input.WebhookURL)
+	}
+	if input.StripeProductID != nil {
+		update.SetStripeProductID(*input.StripeProductID)
+	}
+	if input.RateLimitPerMinute != nil {
+		if *input.RateLimitPerMinute > 0 {
+			update.SetRateLimitPerMinute(*input.RateLimitPerMinute)
+		} else {
+			update.ClearRateLimitPerMinute()
+		}
+	}
+	if input.RateLimitBurst != nil {
+		if *input.RateLimitBurst > 0 {
+			update.SetRateLimitBurst(*input.RateLimitBurst)
+		} else {
+			update.ClearRateLimitBurst()
+		}
+	}
+	if input.MagicLinkSignup != nil {
+		update.SetMagicLinkSignup(*input.MagicLinkSignup)
+	}
+	return update.Save(ctx)
+}
+
+// validateOrigins checks that allowed origins can be matched against
+// request origins.
+func validateOrigins(origins []string) error {
+	for _, origin := range origins {
+		if !middleware.ValidOriginPattern(origin) {
+			return ErrInvalidOrigin
+		}
+	}
+	return nil
+}
+
+// ToggleStatus activates or deactivates an app. The API keys of an inactive
+// app are rejected.
+func (s *AppService) ToggleStatus(ctx context.Context, appID int) (*ent.App, error) {
+	a, err := s.client.App.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.App.UpdateOne(a).
+		SetIsActive(!a.IsActive).
+		Save(ctx)
+}
+
+// RotateAPIKey gives an app a new API key and returns it. The replaced key
+// keeps working for overlap, or stops at once if overlap is 0; a key it
+// replaced in turn stops working immediately.
+func (s *AppService) RotateAPIKey(ctx context.Context, appID int, overlap time.Duration) (*ent.App, string, error) {
+	key, err := apikey.NewKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	a, err := s.rotate(ctx, appID, func(a *ent.App, update *ent.AppUpdateOne) {
+		update.SetAPIKeyHash(apikey.Hash(key)).
+			SetAPIKeyPrefix(apikey.Prefix(key))
+		if overlap > 0 && a.APIKeyHash != nil {
+			update.SetPreviousAPIKeyHash(*a.APIKeyHash).
+				SetPreviousAPIKeyExpiresAt(time.Now().Add(overlap))
+		} else {
+			update.ClearPreviousAPIKeyHash().
+				ClearPreviousAPIKeyExpiresAt()
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return a, key, nil
+}
+
+// RotateSecret gives an app a new secret key and returns it, keeping the
+// replaced secret working for overlap like RotateAPIKey.
+func (s *AppService) RotateSecret(ctx context.Context, appID int, overlap time.Duration) (*ent.App, string, error) {
+	secret, err := apikey.NewSecret()
+	if err != nil {
+		return nil, "", err
+	}
+	sealed, err := s.sealer.Seal(secret)
+	if err != nil {
+		return nil, "", err
+	}
+
+	a, err := s.rotate(ctx, appID, func(a *ent.App, update *ent.AppUpdateOne) {
+		update.SetAPISecretHash(apikey.Hash(secret)).
+			SetAPISecretEncrypted(sealed)
+		if overlap > 0 && a.APISecretHash != "" {
+			update.SetPreviousAPISecretHash(a.APISecretHash).
+				SetPreviousAPISecretEncrypted(a.APISecretEncrypted).
+				SetPreviousAPISecretExpiresAt(time.Now().Add(overlap))
+		} else {
+			update.ClearPreviousAPISecretHash().
+				ClearPreviousAPISecretEncrypted().
+				ClearPreviousAPISecretExpiresAt()
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return a, secret, nil
+}
+
+// rotate applies a credential change to an app while holding its row lock,
+// so that concurrent rotations cannot both keep the same previous key.
+func (s *AppService) rotate(ctx context.Context, appID int, change func(*ent.App, *ent.AppUpdateOne)) (*ent.App, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := tx.App.Query().
+		Where(app.ID(appID)).
+		ForUpdate().
+		Only(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	update := tx.App.UpdateOne(a)
+	change(a, update)
+	a, err = update.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
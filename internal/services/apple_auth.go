@@ -0,0 +1,144 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// appleIssuer is the issuer of Apple identity tokens.
+const appleIssuer = "https://appleid.apple.com"
+
+// AppleAuthService handles Sign in with Apple.
+type AppleAuthService struct {
+	cfg    *config.Config
+	client *ent.Client
+	keys   *jwksCache
+}
+
+// NewAppleAuthService creates a new Apple auth service.
+func NewAppleAuthService(cfg *config.Config, client *ent.Client) *AppleAuthService {
+	return &AppleAuthService{
+		cfg:    cfg,
+		client: client,
+		keys:   newJWKSCache(cfg.AppleJWKSURL),
+	}
+}
+
+// AppleLoginInput represents Apple identity token login request.
+// Apple only shares the user's name with the client on the first sign-in,
+// so the client passes it along. Nonce is the random value whose SHA-256 hex
+// digest the client sent Apple as the authorization request's nonce; it ties
+// the token to this login so that a token issued to another client can't be
+// replayed.
+type AppleLoginInput struct {
+	IDToken string `json:"id_token" binding:"required"`
+	Nonce   string `json:"nonce" binding:"required"`
+	Name    string `json:"name"`
+}
+
+// AppleIDTokenClaims represents the claims of an Apple identity token.
+type AppleIDTokenClaims struct {
+	jwt.RegisteredClaims
+	Nonce string `json:"nonce"`
+	Email string `json:"email"`
+	// Apple sends these as either booleans or the strings "true"/"false".
+	EmailVerified  interface{} `json:"email_verified"`
+	IsPrivateEmail interface{} `json:"is_private_email"`
+}
+
+// VerifyIDToken verifies an Apple identity token and returns/creates a user.
+func (s *AppleAuthService) VerifyIDToken(ctx context.Context, input AppleLoginInput) (*ent.User, error) {
+	if len(s.cfg.AppleClientIDs) == 0 {
+		return nil, errors.New("sign in with Apple is not configured")
+	}
+
+	claims := &AppleIDTokenClaims{}
+	_, err := jwt.ParseWithClaims(input.IDToken, claims, s.keys.Keyfunc(ctx),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(appleIssuer),
+		jwt.WithAudience(s.cfg.AppleClientIDs...),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("subject not found in token")
+	}
+
+	digest := sha256.Sum256([]byte(input.Nonce))
+	if input.Nonce == "" || claims.Nonce != hex.EncodeToString(digest[:]) {
+		return nil, errors.New("invalid ID token: nonce does not match")
+	}
+
+	return s.findOrCreateUser(ctx, claims.Subject, claims.Email, isTrue(claims.EmailVerified), input.Name)
+}
+
+// EnsureUserApp ensures a user-app association exists.
+func (s *AppleAuthService) EnsureUserApp(ctx context.Context, userID, appID int) error {
+	return ensureUserApp(ctx, s.client, userID, appID)
+}
+
+func (s *AppleAuthService) findOrCreateUser(ctx context.Context, appleID, email string, emailVerified bool, name string) (*ent.User, error) {
+	// Try to find user by Apple ID
+	u, err := s.client.User.Query().
+		Where(user.AppleID(appleID)).
+		First(ctx)
+	if err == nil {
+		// Update last login
+		return s.client.User.UpdateOne(u).
+			SetLastLoginAt(time.Now()).
+			Save(ctx)
+	}
+
+	if email == "" {
+		return nil, errors.New("email not found in token")
+	}
+
+	// Try to find user by email. Only link when Apple has verified the address.
+	u, err = s.client.User.Query().
+		Where(user.Email(email)).
+		First(ctx)
+	if err == nil {
+		if !emailVerified {
+			return nil, errors.New("email is already registered")
+		}
+
+		// Link Apple account
+		return s.client.User.UpdateOne(u).
+			SetAppleID(appleID).
+			SetLastLoginAt(time.Now()).
+			Save(ctx)
+	}
+
+	// Create new user
+	return s.client.User.Create().
+		SetEmail(email).
+		SetName(name).
+		SetAppleID(appleID).
+		SetIsVerified(emailVerified).
+		SetLastLoginAt(time.Now()).
+		Save(ctx)
+}
+
+// isTrue interprets a JSON boolean that may have been encoded as a string.
+func isTrue(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	}
+	return false
+}
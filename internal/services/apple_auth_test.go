@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"gigaboo.io/lem/internal/config"
+)
+
+// testKeySet serves RSA signing keys at a JWKS URL, like Apple and OIDC
+// identity providers do.
+type testKeySet struct {
+	*httptest.Server
+	key     *rsa.PrivateKey
+	fetches atomic.Int32
+}
+
+func newTestKeySet(t *testing.T) *testKeySet {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ks := &testKeySet{key: key}
+	ks.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ks.fetches.Add(1)
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kid: "test",
+				Kty: "RSA",
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	}))
+	t.Cleanup(ks.Close)
+	return ks
+}
+
+// sign signs claims with the served key, or with another key if kid is not "test".
+func (ks *testKeySet) sign(t *testing.T, kid string, claims jwt.Claims) string {
+	t.Helper()
+	key := ks.key
+	if kid != "test" {
+		var err error
+		if key, err = rsa.GenerateKey(rand.Reader, 2048); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestAppleVerifyIDToken(t *testing.T) {
+	ks := newTestKeySet(t)
+	client := newTestClient(t)
+	s := NewAppleAuthService(&config.Config{
+		AppleClientIDs: []string{"com.example.app"},
+		AppleJWKSURL:   ks.URL,
+	}, client)
+	ctx := context.Background()
+
+	digest := sha256.Sum256([]byte("raw-nonce"))
+	claims := func(change func(c *AppleIDTokenClaims)) *AppleIDTokenClaims {
+		c := &AppleIDTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    appleIssuer,
+				Subject:   "001234.apple",
+				Audience:  jwt.ClaimStrings{"com.example.app"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+			Nonce:         hex.EncodeToString(digest[:]),
+			Email:         "user@example.com",
+			EmailVerified: "true",
+		}
+		if change != nil {
+			change(c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name  string
+		kid   string
+		token *AppleIDTokenClaims
+		nonce string
+		err   string
+	}{
+		{"other key", "other", claims(nil), "raw-nonce", "unknown signing key"},
+		{"other audience", "test", claims(func(c *AppleIDTokenClaims) { c.Audience = jwt.ClaimStrings{"com.other.app"} }), "raw-nonce", "audience"},
+		{"other issuer", "test", claims(func(c *AppleIDTokenClaims) { c.Issuer = "https://example.com" }), "raw-nonce", "issuer"},
+		{"expired", "test", claims(func(c *AppleIDTokenClaims) { c.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute)) }), "raw-nonce", "expired"},
+		{"no expiry", "test", claims(func(c *AppleIDTokenClaims) { c.ExpiresAt = nil }), "raw-nonce", "exp"},
+		{"no subject", "test", claims(func(c *AppleIDTokenClaims) { c.Subject = "" }), "raw-nonce", "subject"},
+		{"other nonce", "test", claims(nil), "other-nonce", "nonce"},
+		{"hashed nonce", "test", claims(nil), hex.EncodeToString(digest[:]), "nonce"},
+		{"no nonce", "test", claims(nil), "", "nonce"},
+		{"token without nonce", "test", claims(func(c *AppleIDTokenClaims) { c.Nonce = "" }), "raw-nonce", "nonce"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := s.VerifyIDToken(ctx, AppleLoginInput{IDToken: ks.sign(t, tt.kid, tt.token), Nonce: tt.nonce})
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error = %v, want one about %q", err, tt.err)
+			}
+		})
+	}
+
+	u, err := s.VerifyIDToken(ctx, AppleLoginInput{IDToken: ks.sign(t, "test", claims(nil)), Nonce: "raw-nonce", Name: "Ada"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Email != "user@example.com" || u.AppleID == nil || *u.AppleID != "001234.apple" || !u.IsVerified || u.Name != "Ada" {
+		t.Errorf("created user %+v", u)
+	}
+
+	again, err := s.VerifyIDToken(ctx, AppleLoginInput{IDToken: ks.sign(t, "test", claims(nil)), Nonce: "raw-nonce"})
+	if err != nil || again.ID != u.ID {
+		t.Errorf("second sign-in = %v, %v, want user %d", again, err, u.ID)
+	}
+}
+
+func TestJWKSCacheFetchesOnce(t *testing.T) {
+	ks := newTestKeySet(t)
+	cache := newJWKSCache(ks.URL)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.key(ctx, "test"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	// Unknown keys are refetched at most once per interval
+	if _, err := cache.key(ctx, "rotated"); err == nil {
+		t.Error("found a key that isn't served")
+	}
+	if n := ks.fetches.Load(); n != 1 {
+		t.Errorf("fetched the key set %d times, want 1", n)
+	}
+
+	cache.fetchedAt = time.Now().Add(-jwksRefreshInterval)
+	cache.key(ctx, "rotated")
+	if n := ks.fetches.Load(); n != 2 {
+		t.Errorf("fetched the key set %d times after the interval, want 2", n)
+	}
+}
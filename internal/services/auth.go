@@ -3,29 +3,44 @@ package services
 import (
 	"context"
 	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
 
+	"gigaboo.io/lem/internal/audit"
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/ent/userapp"
 	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/ratelimit"
 )
 
 // AuthService handles authentication operations.
 type AuthService struct {
-	cfg    *config.Config
-	client *ent.Client
-	auth   *middleware.AuthMiddleware
+	cfg      *config.Config
+	client   *ent.Client
+	auth     *middleware.AuthMiddleware
+	sessions *SessionService
+	orgs     *OrganizationService
+	lockout  *ratelimit.Lockout
+	mfa      *MFAService
 }
 
 // NewAuthService creates a new auth service.
-func NewAuthService(cfg *config.Config, client *ent.Client, auth *middleware.AuthMiddleware) *AuthService {
+func NewAuthService(cfg *config.Config, client *ent.Client, auth *middleware.AuthMiddleware, sessions *SessionService, orgs *OrganizationService, lockout *ratelimit.Lockout, mfa *MFAService) *AuthService {
 	return &AuthService{
-		cfg:    cfg,
-		client: client,
-		auth:   auth,
+		cfg:      cfg,
+		client:   client,
+		auth:     auth,
+		sessions: sessions,
+		orgs:     orgs,
+		lockout:  lockout,
+		mfa:      mfa,
 	}
 }
 
@@ -48,16 +63,46 @@ type DeviceLoginInput struct {
 }
 
 // AuthResponse represents authentication response.
+// When MFARequired is set the login still has to complete two-factor
+// authentication: no tokens are issued, and MFAToken must be exchanged
+// through VerifyMFA within ExpiresIn seconds.
 type AuthResponse struct {
-	AccessToken  string    `json:"access_token"`
-	RefreshToken string    `json:"refresh_token"`
-	TokenType    string    `json:"token_type"`
+	AccessToken  string    `json:"access_token,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
 	ExpiresIn    int       `json:"expires_in"`
-	User         *ent.User `json:"user"`
+	User         *ent.User `json:"user,omitempty"`
+	MFARequired  bool      `json:"mfa_required,omitempty"`
+	MFAToken     string    `json:"mfa_token,omitempty"`
+}
+
+// MFAVerifyInput represents the second step of a two-factor login.
+type MFAVerifyInput struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+// SwitchOrgInput represents switch organization request data.
+type SwitchOrgInput struct {
+	OrganizationID int `json:"organization_id" binding:"required"`
+}
+
+// OrgContextResponse describes an organization a user can act in.
+type OrgContextResponse struct {
+	OrganizationID   int    `json:"organization_id"`
+	OrganizationName string `json:"organization_name"`
+	Role             string `json:"role"`
+}
+
+// OrgAuthResponse represents an authentication response scoped to an organization.
+type OrgAuthResponse struct {
+	*AuthResponse
+	Organization  *OrgContextResponse  `json:"organization"`
+	Organizations []OrgContextResponse `json:"organizations"`
 }
 
 // Signup creates a new user account.
-func (s *AuthService) Signup(ctx context.Context, appID int, input SignupInput) (*AuthResponse, error) {
+func (s *AuthService) Signup(ctx context.Context, appID int, input SignupInput, info SessionInfo) (*AuthResponse, error) {
 	// Check if email already exists
 	exists, err := s.client.User.Query().
 		Where(user.Email(input.Email)).
@@ -96,46 +141,47 @@ func (s *AuthService) Signup(ctx context.Context, appID int, input SignupInput)
 	}
 
 	// Generate tokens
-	return s.generateTokens(u.ID, appID, 0, "")
+	return s.IssueTokens(ctx, u.ID, appID, 0, "", info)
 }
 
 // Login authenticates a user with email and password.
-func (s *AuthService) Login(ctx context.Context, appID int, input LoginInput) (*AuthResponse, error) {
+// Repeated failures for an email lock it out with a *ratelimit.LockedError.
+func (s *AuthService) Login(ctx context.Context, appID int, input LoginInput, info SessionInfo) (*AuthResponse, error) {
+	lockoutKey := loginLockoutKey(input.Email)
+	if err := s.lockout.Check(ctx, lockoutKey); err != nil {
+		return nil, err
+	}
+
 	// Find user by email
 	u, err := s.client.User.Query().
 		Where(user.Email(input.Email)).
 		First(ctx)
 	if err != nil {
-		return nil, errors.New("invalid credentials")
+		return nil, loginFailed(ctx, s.lockout, lockoutKey)
 	}
 
 	// Verify password
 	if u.PasswordHash == "" {
-		return nil, errors.New("invalid credentials")
+		return nil, loginFailed(ctx, s.lockout, lockoutKey)
 	}
 	err = bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(input.Password))
 	if err != nil {
-		return nil, errors.New("invalid credentials")
+		return nil, loginFailed(ctx, s.lockout, lockoutKey)
 	}
 
 	if !u.IsActive {
 		return nil, errors.New("account is disabled")
 	}
 
-	// Update last login
-	_, err = s.client.User.UpdateOne(u).
-		SetLastLoginAt(time.Now()).
-		Save(ctx)
-	if err != nil {
+	if err := s.lockout.Reset(ctx, lockoutKey); err != nil {
 		return nil, err
 	}
 
-	// Generate tokens
-	return s.generateTokens(u.ID, appID, 0, "")
+	return s.CompleteLogin(ctx, u, appID, info)
 }
 
 // DeviceLogin authenticates a user with device ID.
-func (s *AuthService) DeviceLogin(ctx context.Context, appID int, input DeviceLoginInput) (*AuthResponse, error) {
+func (s *AuthService) DeviceLogin(ctx context.Context, appID int, input DeviceLoginInput, info SessionInfo) (*AuthResponse, error) {
 	// Find or create user by device ID
 	u, err := s.client.User.Query().
 		Where(user.DeviceID(input.DeviceID)).
@@ -160,22 +206,91 @@ func (s *AuthService) DeviceLogin(ctx context.Context, appID int, input DeviceLo
 		if err != nil {
 			return nil, err
 		}
-	} else {
-		// Update last login
-		_, err = s.client.User.UpdateOne(u).
-			SetLastLoginAt(time.Now()).
-			Save(ctx)
+	}
+
+	if !u.IsActive {
+		return nil, errors.New("account is disabled")
+	}
+
+	return s.CompleteLogin(ctx, u, appID, info)
+}
+
+// CompleteLogin finishes a login whose first factor has been verified. Users
+// with two-factor authentication enabled get an MFA challenge instead of tokens.
+func (s *AuthService) CompleteLogin(ctx context.Context, u *ent.User, appID int, info SessionInfo) (*AuthResponse, error) {
+	if u.MfaEnabled {
+		mfaToken, err := s.auth.GenerateMFAToken(u.ID, appID)
 		if err != nil {
 			return nil, err
 		}
+
+		return &AuthResponse{
+			ExpiresIn:   int(middleware.MFATokenDuration.Seconds()),
+			MFARequired: true,
+			MFAToken:    mfaToken,
+		}, nil
+	}
+
+	// Update last login
+	_, err := s.client.User.UpdateOne(u).
+		SetLastLoginAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.IssueTokens(ctx, u.ID, appID, 0, "", info)
+}
+
+// VerifyMFA completes a two-factor login with a TOTP or recovery code.
+// Repeated failures lock the account out like failed password logins.
+func (s *AuthService) VerifyMFA(ctx context.Context, appID int, input MFAVerifyInput, info SessionInfo) (*AuthResponse, error) {
+	claims, err := s.auth.ValidateToken(input.MFAToken)
+	if err != nil || claims.Type != "mfa" || claims.AppID != appID {
+		return nil, errors.New("invalid or expired MFA token")
+	}
+
+	lockoutKey := "mfa:" + strconv.Itoa(claims.UserID)
+	if err := s.lockout.Check(ctx, lockoutKey); err != nil {
+		return nil, err
+	}
+
+	u, err := s.client.User.Get(ctx, claims.UserID)
+	if err != nil {
+		return nil, errors.New("user not found")
 	}
 
 	if !u.IsActive {
 		return nil, errors.New("account is disabled")
 	}
 
-	// Generate tokens
-	return s.generateTokens(u.ID, appID, 0, "")
+	if !u.MfaEnabled {
+		return nil, errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.mfa.Verify(ctx, u, input.Code); err != nil {
+		if lockErr := s.lockout.Fail(ctx, lockoutKey); lockErr != nil {
+			var locked *ratelimit.LockedError
+			if errors.As(lockErr, &locked) {
+				return nil, lockErr
+			}
+		}
+		return nil, err
+	}
+
+	if err := s.lockout.Reset(ctx, lockoutKey); err != nil {
+		return nil, err
+	}
+
+	// Update last login
+	_, err = s.client.User.UpdateOne(u).
+		SetLastLoginAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.issueTokens(ctx, u.ID, appID, 0, "", true, info)
 }
 
 // RefreshTokenInput represents refresh token request data.
@@ -183,7 +298,7 @@ type RefreshTokenInput struct {
 	RefreshToken string `json:"refresh_token" binding:"required"`
 }
 
-// RefreshToken generates new tokens from a refresh token.
+// RefreshToken rotates a refresh token and generates new tokens for its session.
 func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput) (*AuthResponse, error) {
 	// Validate refresh token
 	claims, err := s.auth.ValidateToken(input.RefreshToken)
@@ -205,8 +320,99 @@ func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput)
 		return nil, errors.New("account is disabled")
 	}
 
-	// Generate new tokens
-	return s.generateTokens(u.ID, claims.AppID, claims.OrgID, claims.OrgRole)
+	// Re-check the organization context; membership or role may have changed
+	// since the token was issued. A lost membership drops the org context.
+	orgRole := ""
+	if claims.OrgID != 0 {
+		org, member, err := s.orgMembership(ctx, u.ID, claims.AppID, claims.OrgID)
+		if err != nil || s.requireOrgMFA(ctx, org, member, claims.SessionID) != nil {
+			claims.OrgID = 0
+		} else {
+			orgRole = string(member.Role)
+		}
+	}
+
+	// Exchange the refresh token for its successor
+	sess, refreshToken, err := s.sessions.Rotate(ctx, input.RefreshToken, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.auth.GenerateAccessToken(u.ID, claims.AppID, claims.OrgID, orgRole, sess.ID, sess.MfaVerified)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    s.cfg.AccessTokenExpireMinutes * 60,
+		User:         u,
+	}, nil
+}
+
+// SwitchOrg reissues the tokens of the current session scoped to an organization
+// the user is a member of.
+func (s *AuthService) SwitchOrg(ctx context.Context, userID, appID, sessionID int, input SwitchOrgInput, info SessionInfo) (*OrgAuthResponse, error) {
+	org, member, err := s.orgMembership(ctx, userID, appID, input.OrganizationID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.requireOrgMFA(ctx, org, member, sessionID); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.reissueTokens(ctx, userID, appID, org.ID, string(member.Role), sessionID, info)
+	if err != nil {
+		return nil, err
+	}
+
+	memberships, err := s.orgs.ListMemberships(ctx, userID, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	organizations := make([]OrgContextResponse, 0, len(memberships))
+	for _, m := range memberships {
+		organizations = append(organizations, OrgContextResponse{
+			OrganizationID:   m.Edges.Organization.ID,
+			OrganizationName: m.Edges.Organization.Name,
+			Role:             string(m.Role),
+		})
+	}
+
+	return &OrgAuthResponse{
+		AuthResponse: tokens,
+		Organization: &OrgContextResponse{
+			OrganizationID:   org.ID,
+			OrganizationName: org.Name,
+			Role:             string(member.Role),
+		},
+		Organizations: organizations,
+	}, nil
+}
+
+// ClearOrg reissues the tokens of the current session without an organization context.
+func (s *AuthService) ClearOrg(ctx context.Context, userID, appID, sessionID int, info SessionInfo) (*AuthResponse, error) {
+	return s.reissueTokens(ctx, userID, appID, 0, "", sessionID, info)
+}
+
+// ListSessions returns the user's active sessions in an app.
+func (s *AuthService) ListSessions(ctx context.Context, userID, appID int) ([]*ent.AuthSession, error) {
+	return s.sessions.List(ctx, userID, appID)
+}
+
+// RevokeSession revokes one of the user's sessions.
+func (s *AuthService) RevokeSession(ctx context.Context, userID, sessionID int) error {
+	return s.sessions.RevokeForUser(ctx, userID, sessionID)
+}
+
+// RevokeOtherSessions revokes all of the user's sessions in an app except the
+// current one.
+func (s *AuthService) RevokeOtherSessions(ctx context.Context, userID, appID, currentSessionID int) (int, error) {
+	return s.sessions.RevokeAll(ctx, userID, appID, currentSessionID, "revoked by user")
 }
 
 // GetMe returns the current user.
@@ -214,18 +420,78 @@ func (s *AuthService) GetMe(ctx context.Context, userID int) (*ent.User, error)
 	return s.client.User.Get(ctx, userID)
 }
 
-func (s *AuthService) generateTokens(userID, appID, orgID int, orgRole string) (*AuthResponse, error) {
-	accessToken, err := s.auth.GenerateAccessToken(userID, appID, orgID, orgRole)
+// Impersonate starts an impersonation session in which the admin identified
+// by actor acts as the user, and returns its access token. No refresh token
+// is issued, and the start is recorded in the audit log.
+func (s *AuthService) Impersonate(ctx context.Context, actor string, userID, appID int, info SessionInfo) (*AuthResponse, error) {
+	// Only users of the app can be impersonated in it
+	u, err := s.client.User.Query().
+		Where(
+			user.ID(userID),
+			user.HasUserAppsWith(userapp.HasAppWith(app.ID(appID))),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !u.IsActive {
+		return nil, errors.New("account is disabled")
+	}
+
+	sess, err := s.sessions.CreateImpersonation(ctx, u.ID, appID, actor, info)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = audit.NewContext(ctx, audit.Actor{
+		Name:      actor,
+		Type:      audit.ActorAdmin,
+		IPAddress: info.IPAddress,
+		UserAgent: info.UserAgent,
+		AppID:     appID,
+	})
+	err = audit.Record(ctx, s.client, audit.Event{
+		Action:     "impersonation.start",
+		TargetType: "user",
+		TargetID:   strconv.Itoa(u.ID),
+		Metadata:   map[string]interface{}{"session_id": sess.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.auth.GenerateImpersonationToken(u.ID, appID, sess.ID, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int(middleware.ImpersonationTokenDuration.Seconds()),
+		User:        u,
+	}, nil
+}
+
+// IssueTokens starts a new session for the user and returns its tokens.
+// It skips two-factor authentication; logins go through CompleteLogin.
+func (s *AuthService) IssueTokens(ctx context.Context, userID, appID, orgID int, orgRole string, info SessionInfo) (*AuthResponse, error) {
+	return s.issueTokens(ctx, userID, appID, orgID, orgRole, false, info)
+}
+
+func (s *AuthService) issueTokens(ctx context.Context, userID, appID, orgID int, orgRole string, mfaVerified bool, info SessionInfo) (*AuthResponse, error) {
+	sess, refreshToken, err := s.sessions.Create(ctx, userID, appID, orgID, mfaVerified, info)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := s.auth.GenerateRefreshToken(userID, appID, orgID)
+	accessToken, err := s.auth.GenerateAccessToken(userID, appID, orgID, orgRole, sess.ID, sess.MfaVerified)
 	if err != nil {
 		return nil, err
 	}
 
-	user, err := s.client.User.Get(context.Background(), userID)
+	user, err := s.client.User.Get(ctx, userID)
 	if err != nil {
 		return nil, err
 	}
@@ -238,3 +504,86 @@ func (s *AuthService) generateTokens(userID, appID, orgID int, orgRole string) (
 		User:         user,
 	}, nil
 }
+
+// reissueTokens issues new tokens for an existing session. Tokens that are not
+// bound to a session get a new one.
+func (s *AuthService) reissueTokens(ctx context.Context, userID, appID, orgID int, orgRole string, sessionID int, info SessionInfo) (*AuthResponse, error) {
+	if sessionID == 0 {
+		return s.IssueTokens(ctx, userID, appID, orgID, orgRole, info)
+	}
+
+	sess, refreshToken, err := s.sessions.Reissue(ctx, sessionID, userID, appID, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.auth.GenerateAccessToken(userID, appID, orgID, orgRole, sess.ID, sess.MfaVerified)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuthResponse{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    s.cfg.AccessTokenExpireMinutes * 60,
+		User:         user,
+	}, nil
+}
+
+// loginLockoutKey returns the lockout key of password logins to an email.
+func loginLockoutKey(email string) string {
+	return "login:" + strings.ToLower(email)
+}
+
+// loginFailed records a failed login and returns the error to report.
+func loginFailed(ctx context.Context, lockout *ratelimit.Lockout, lockoutKey string) error {
+	if err := lockout.Fail(ctx, lockoutKey); err != nil {
+		var locked *ratelimit.LockedError
+		if errors.As(err, &locked) {
+			return err
+		}
+	}
+	return errors.New("invalid credentials")
+}
+
+// requireOrgMFA returns an error if the organization requires two-factor
+// authentication for the member's role and the session has not completed it.
+func (s *AuthService) requireOrgMFA(ctx context.Context, org *ent.Organization, member *ent.OrganizationMember, sessionID int) error {
+	if !middleware.OrgRequiresMFA(org.Settings, member.Role) {
+		return nil
+	}
+	if !s.sessions.IsMFAVerified(ctx, sessionID) {
+		return errors.New("organization requires two-factor authentication")
+	}
+	return nil
+}
+
+// orgMembership returns an active organization of the app and the user's membership in it.
+func (s *AuthService) orgMembership(ctx context.Context, userID, appID, orgID int) (*ent.Organization, *ent.OrganizationMember, error) {
+	org, err := s.client.Organization.Query().
+		Where(
+			organization.ID(orgID),
+			organization.HasAppWith(app.ID(appID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, errors.New("organization not found")
+	}
+
+	if !org.IsActive {
+		return nil, nil, errors.New("organization is disabled")
+	}
+
+	member, err := s.orgs.GetMember(ctx, org.ID, userID)
+	if err != nil {
+		return nil, nil, errors.New("not a member of this organization")
+	}
+
+	return org, member, nil
+}
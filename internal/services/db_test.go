@@ -0,0 +1,23 @@
+package services
+
+import (
+	"net/url"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/enttest"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// newTestClient returns a client for an empty in-memory database, limited to
+// app data the way the server's client is.
+func newTestClient(t *testing.T) *ent.Client {
+	t.Helper()
+	client := enttest.Open(t, dialect.SQLite, "file:"+url.PathEscape(t.Name())+"?mode=memory&cache=shared&_fk=1")
+	t.Cleanup(func() { client.Close() })
+	tenant.Register(client)
+	return client
+}
@@ -38,15 +38,38 @@ type SendEmailInput struct {
 	Variables map[string]string `json:"variables"`
 }
 
+// builtinTemplates are used for the emails lem sends itself when the app
+// has no template of that name.
+var builtinTemplates = map[string]*ent.EmailTemplate{
+	"password_reset": {
+		Subject:  "Reset your password",
+		BodyHTML: `<p>Someone asked to reset the password of {{.email}}.</p><p><a href="{{.link}}">Reset your password</a></p><p>If it wasn't you, ignore this email.</p>`,
+	},
+	"verify_email": {
+		Subject:  "Verify your email address",
+		BodyHTML: `<p>Confirm that {{.email}} is your email address.</p><p><a href="{{.link}}">Verify your email address</a></p>`,
+	},
+	"magic_link": {
+		Subject:  "Your login link",
+		BodyHTML: `<p>Use this link to log in as {{.email}}. It expires in 15 minutes.</p><p><a href="{{.link}}">Log in</a></p><p>If you didn't ask for it, ignore this email.</p>`,
+	},
+}
+
 // SendEmail sends an email.
 func (s *EmailService) SendEmail(ctx context.Context, appID int, input SendEmailInput) error {
 	var subject, body string
 
 	if input.Template != "" {
-		// Load template from database
+		// Load the app's template from database
 		tmpl, err := s.client.EmailTemplate.Query().
-			Where(emailtemplate.Name(input.Template)).
+			Where(
+				emailtemplate.Name(input.Template),
+				emailtemplate.HasAppWith(app.ID(appID)),
+			).
 			First(ctx)
+		if ent.IsNotFound(err) && builtinTemplates[input.Template] != nil {
+			tmpl, err = builtinTemplates[input.Template], nil
+		}
 		if err != nil {
 			return fmt.Errorf("template not found: %s", input.Template)
 		}
@@ -85,6 +108,30 @@ func (s *EmailService) SendPasswordReset(ctx context.Context, appID int, email,
 	})
 }
 
+// SendEmailVerification sends an email address verification email.
+func (s *EmailService) SendEmailVerification(ctx context.Context, appID int, email, verifyLink string) error {
+	return s.SendEmail(ctx, appID, SendEmailInput{
+		To:       email,
+		Template: "verify_email",
+		Variables: map[string]string{
+			"email": email,
+			"link":  verifyLink,
+		},
+	})
+}
+
+// SendMagicLink sends a passwordless login link.
+func (s *EmailService) SendMagicLink(ctx context.Context, appID int, email, loginLink string) error {
+	return s.SendEmail(ctx, appID, SendEmailInput{
+		To:       email,
+		Template: "magic_link",
+		Variables: map[string]string{
+			"email": email,
+			"link":  loginLink,
+		},
+	})
+}
+
 // SendWelcome sends a welcome email.
 func (s *EmailService) SendWelcome(ctx context.Context, appID int, email, name string) error {
 	return s.SendEmail(ctx, appID, SendEmailInput{
@@ -286,7 +333,10 @@ func (s *EmailService) UpdateTemplate(ctx context.Context, appID int, name strin
 // DeleteTemplate deletes an email template.
 func (s *EmailService) DeleteTemplate(ctx context.Context, appID int, name string) error {
 	_, err := s.client.EmailTemplate.Delete().
-		Where(emailtemplate.Name(name)).
+		Where(
+			emailtemplate.Name(name),
+			emailtemplate.HasAppWith(app.ID(appID)),
+		).
 		Exec(ctx)
 	return err
 }
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 	"time"
 
 	"golang.org/x/oauth2"
@@ -156,6 +158,28 @@ func (s *GoogleOAuthService) GetValidToken(ctx context.Context, userID int) (str
 	return u.GoogleAccessToken, nil
 }
 
+// RevokeToken revokes a Google access or refresh token.
+func (s *GoogleOAuthService) RevokeToken(ctx context.Context, token string) error {
+	form := url.Values{"token": {token}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://oauth2.googleapis.com/revoke", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to revoke Google token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to revoke Google token: %s", resp.Status)
+	}
+
+	return nil
+}
+
 func (s *GoogleOAuthService) getUserInfo(ctx context.Context, token *oauth2.Token) (*GoogleUserInfo, error) {
 	client := s.oauthConfig.Client(ctx, token)
 	resp, err := client.Get("https://www.googleapis.com/oauth2/v2/userinfo")
@@ -222,6 +246,16 @@ func (s *GoogleOAuthService) findOrCreateUser(ctx context.Context, info *GoogleU
 
 // VerifyIDToken verifies a Google ID token and returns/creates a user.
 func (s *GoogleOAuthService) VerifyIDToken(ctx context.Context, idTokenStr string) (*ent.User, error) {
+	userInfo, err := s.ValidateIDToken(ctx, idTokenStr)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.findOrCreateUserFromIDToken(ctx, userInfo)
+}
+
+// ValidateIDToken verifies a Google ID token and returns the identity it carries.
+func (s *GoogleOAuthService) ValidateIDToken(ctx context.Context, idTokenStr string) (*GoogleUserInfo, error) {
 	payload, err := idtoken.Validate(ctx, idTokenStr, s.cfg.GoogleClientID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid ID token: %w", err)
@@ -243,22 +277,24 @@ func (s *GoogleOAuthService) VerifyIDToken(ctx context.Context, idTokenStr strin
 		return nil, errors.New("email not found in token")
 	}
 
-	// Find or create user
-	userInfo := &GoogleUserInfo{
+	return &GoogleUserInfo{
 		ID:            sub,
 		Email:         email,
 		Name:          name,
 		Picture:       picture,
 		VerifiedEmail: emailVerified,
-	}
-
-	return s.findOrCreateUserFromIDToken(ctx, userInfo)
+	}, nil
 }
 
 // EnsureUserApp ensures a user-app association exists.
 func (s *GoogleOAuthService) EnsureUserApp(ctx context.Context, userID, appID int) error {
+	return ensureUserApp(ctx, s.client, userID, appID)
+}
+
+// ensureUserApp creates the user-app association unless it already exists.
+func ensureUserApp(ctx context.Context, client *ent.Client, userID, appID int) error {
 	// Check if association already exists
-	exists, err := s.client.UserApp.Query().
+	exists, err := client.UserApp.Query().
 		Where(
 			userapp.HasUserWith(user.ID(userID)),
 			userapp.HasAppWith(app.ID(appID)),
@@ -272,7 +308,7 @@ func (s *GoogleOAuthService) EnsureUserApp(ctx context.Context, userID, appID in
 	}
 
 	// Create association
-	_, err = s.client.UserApp.Create().
+	_, err = client.UserApp.Create().
 		SetUserID(userID).
 		SetAppID(appID).
 		Save(ctx)
@@ -0,0 +1,285 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/user"
+)
+
+// recoveryCodeCount is the number of recovery codes issued at a time.
+const recoveryCodeCount = 10
+
+// MFAService handles TOTP two-factor authentication.
+//
+// Enrollment is two steps: SetupTOTP stores a pending secret, and EnableTOTP
+// turns it on once the user proves their authenticator produces valid codes.
+// Secrets are stored encrypted, and recovery codes are single-use and stored
+// hashed.
+type MFAService struct {
+	cfg      *config.Config
+	client   *ent.Client
+	sessions *SessionService
+	sealer   *apikey.Sealer
+}
+
+// NewMFAService creates a new MFA service.
+func NewMFAService(cfg *config.Config, client *ent.Client, sessions *SessionService) *MFAService {
+	return &MFAService{
+		cfg:      cfg,
+		client:   client,
+		sessions: sessions,
+		sealer:   apikey.NewSealer(cfg.SecretKeyEncryptionKey()),
+	}
+}
+
+// MFACodeInput represents a request carrying a TOTP or recovery code.
+type MFACodeInput struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// TOTPSetupResponse contains the secret to add to an authenticator app.
+type TOTPSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+// RecoveryCodesResponse contains newly issued recovery codes. They are shown
+// once and cannot be retrieved again.
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+// SetupTOTP generates a new pending TOTP secret for the user. issuer is the
+// name shown in the authenticator app.
+func (s *MFAService) SetupTOTP(ctx context.Context, userID int, issuer string) (*TOTPSetupResponse, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if u.MfaEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := s.sealer.Seal(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.User.UpdateOne(u).
+		SetTotpSecretEncrypted(sealed).
+		SetTotpLastCounter(0).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TOTPSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: totpProvisioningURI(issuer, u.Email, secret),
+	}, nil
+}
+
+// EnableTOTP turns on two-factor authentication after checking a code for the
+// pending secret, and returns the user's recovery codes. The current session
+// counts as verified since the user just proved possession of the secret.
+func (s *MFAService) EnableTOTP(ctx context.Context, userID, sessionID int, input MFACodeInput) (*RecoveryCodesResponse, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if u.MfaEnabled {
+		return nil, errors.New("two-factor authentication is already enabled")
+	}
+	if u.TotpSecretEncrypted == "" {
+		return nil, errors.New("two-factor authentication setup has not been started")
+	}
+	secret, err := s.sealer.Open(u.TotpSecretEncrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	counter, ok := validateTOTP(secret, input.Code, time.Now(), u.TotpLastCounter)
+	if !ok {
+		return nil, errors.New("invalid verification code")
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.User.UpdateOne(u).
+		SetMfaEnabled(true).
+		SetTotpLastCounter(counter).
+		SetMfaRecoveryCodes(hashes).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if sessionID != 0 {
+		if err := s.sessions.MarkMFAVerified(ctx, sessionID); err != nil {
+			return nil, err
+		}
+	}
+
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// DisableTOTP turns off two-factor authentication after checking a code.
+func (s *MFAService) DisableTOTP(ctx context.Context, userID int, input MFACodeInput) error {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	if !u.MfaEnabled {
+		return errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.Verify(ctx, u, input.Code); err != nil {
+		return err
+	}
+
+	_, err = s.client.User.UpdateOneID(u.ID).
+		SetMfaEnabled(false).
+		ClearTotpSecretEncrypted().
+		SetTotpLastCounter(0).
+		ClearMfaRecoveryCodes().
+		Save(ctx)
+	return err
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes after checking a code.
+func (s *MFAService) RegenerateRecoveryCodes(ctx context.Context, userID int, input MFACodeInput) (*RecoveryCodesResponse, error) {
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !u.MfaEnabled {
+		return nil, errors.New("two-factor authentication is not enabled")
+	}
+
+	if err := s.Verify(ctx, u, input.Code); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.client.User.UpdateOneID(u.ID).
+		SetMfaRecoveryCodes(hashes).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Verify checks a TOTP code or, failing that, consumes a recovery code.
+func (s *MFAService) Verify(ctx context.Context, u *ent.User, code string) error {
+	code = strings.TrimSpace(code)
+
+	secret := ""
+	if u.TotpSecretEncrypted != "" {
+		var err error
+		if secret, err = s.sealer.Open(u.TotpSecretEncrypted); err != nil {
+			return err
+		}
+	}
+
+	if counter, ok := validateTOTP(secret, code, time.Now(), u.TotpLastCounter); ok {
+		// Only the first request to use a code may advance the counter.
+		n, err := s.client.User.Update().
+			Where(
+				user.ID(u.ID),
+				user.TotpLastCounterLT(counter),
+			).
+			SetTotpLastCounter(counter).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.New("invalid verification code")
+		}
+		return nil
+	}
+
+	hash := hashToken(normalizeRecoveryCode(code))
+	for i, stored := range u.MfaRecoveryCodes {
+		if stored != hash {
+			continue
+		}
+
+		// Only write if the codes are still the ones read, so that a code
+		// can't be used twice and a code used meanwhile isn't restored.
+		read, err := json.Marshal(u.MfaRecoveryCodes)
+		if err != nil {
+			return err
+		}
+		remaining := append(append([]string{}, u.MfaRecoveryCodes[:i]...), u.MfaRecoveryCodes[i+1:]...)
+		n, err := s.client.User.Update().
+			Where(
+				user.ID(u.ID),
+				predicate.User(sql.FieldEQ(user.FieldMfaRecoveryCodes, read)),
+			).
+			SetMfaRecoveryCodes(remaining).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return errors.New("invalid verification code")
+		}
+		return nil
+	}
+
+	return errors.New("invalid verification code")
+}
+
+// generateRecoveryCodes returns new recovery codes and their hashes.
+func generateRecoveryCodes() ([]string, []string, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashes := make([]string, 0, recoveryCodeCount)
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 5)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, err
+		}
+		code := strings.ToLower(totpEncoding.EncodeToString(raw))
+		code = code[:4] + "-" + code[4:]
+
+		codes = append(codes, code)
+		hashes = append(hashes, hashToken(normalizeRecoveryCode(code)))
+	}
+	return codes, hashes, nil
+}
+
+// normalizeRecoveryCode ignores case and separators in recovery codes.
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.ReplaceAll(code, "-", "")
+	return strings.ReplaceAll(code, " ", "")
+}
@@ -0,0 +1,81 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/middleware"
+)
+
+func TestMFA(t *testing.T) {
+	client := newTestClient(t)
+	cfg := &config.Config{JWTSecretKey: "test"}
+	sessions := NewSessionService(cfg, client, middleware.NewAuthMiddleware(cfg, client, jwtkeys.NewHMAC("test")))
+	s := NewMFAService(cfg, client, sessions)
+	ctx := context.Background()
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+
+	setup, err := s.SetupTOTP(ctx, u.ID, "LEM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored := client.User.GetX(ctx, u.ID).TotpSecretEncrypted; stored == "" || strings.Contains(stored, setup.Secret) {
+		t.Errorf("stored secret %q, want it encrypted", stored)
+	}
+
+	key, err := totpEncoding.DecodeString(setup.Secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	code := totpCode(key, time.Now().Unix()/totpPeriod)
+	recovery, err := s.EnableTOTP(ctx, u.ID, 0, MFACodeInput{Code: code})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("TOTP codes are single-use", func(t *testing.T) {
+		u := client.User.GetX(ctx, u.ID)
+		if err := s.Verify(ctx, u, totpCode(key, time.Now().Unix()/totpPeriod+1)); err != nil {
+			t.Fatalf("verifying the next code: %v", err)
+		}
+		if err := s.Verify(ctx, u, totpCode(key, time.Now().Unix()/totpPeriod+1)); err == nil {
+			t.Error("the code was accepted twice")
+		}
+	})
+
+	t.Run("recovery codes are single-use", func(t *testing.T) {
+		code := recovery.RecoveryCodes[0]
+		if err := s.Verify(ctx, client.User.GetX(ctx, u.ID), code); err != nil {
+			t.Fatalf("verifying a recovery code: %v", err)
+		}
+		if err := s.Verify(ctx, client.User.GetX(ctx, u.ID), code); err == nil {
+			t.Error("the recovery code was accepted twice")
+		}
+	})
+
+	t.Run("concurrent recovery codes", func(t *testing.T) {
+		// Both requests read the user before either spends its code.
+		read := client.User.GetX(ctx, u.ID)
+		if err := s.Verify(ctx, read, recovery.RecoveryCodes[1]); err != nil {
+			t.Fatalf("verifying a recovery code: %v", err)
+		}
+		if err := s.Verify(ctx, read, recovery.RecoveryCodes[1]); err == nil {
+			t.Error("the recovery code was accepted twice")
+		}
+		if err := s.Verify(ctx, read, recovery.RecoveryCodes[2]); err == nil {
+			t.Error("a code was spent with codes that were out of date")
+		}
+
+		codes := client.User.GetX(ctx, u.ID).MfaRecoveryCodes
+		if len(codes) != len(recovery.RecoveryCodes)-2 {
+			t.Errorf("%d recovery codes left, want %d", len(codes), len(recovery.RecoveryCodes)-2)
+		}
+		if err := s.Verify(ctx, client.User.GetX(ctx, u.ID), recovery.RecoveryCodes[2]); err != nil {
+			t.Errorf("verifying an unspent recovery code: %v", err)
+		}
+	})
+}
@@ -9,10 +9,13 @@ import (
 
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
 	"gigaboo.io/lem/internal/ent/organization"
 	"gigaboo.io/lem/internal/ent/organizationinvitation"
 	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/subscription"
 	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/entitlements"
 )
 
 // OrganizationService handles organization operations.
@@ -38,10 +41,12 @@ type CreateOrganizationInput struct {
 }
 
 // UpdateOrganizationInput represents update organization request.
+// RequireMFA requires OWNER and ADMIN members to use two-factor authentication.
 type UpdateOrganizationInput struct {
 	Name        *string `json:"name"`
 	Description *string `json:"description"`
 	LogoURL     *string `json:"logo_url"`
+	RequireMFA  *bool   `json:"require_mfa"`
 }
 
 // CreateInvitationInput represents invitation creation request.
@@ -79,6 +84,21 @@ func (s *OrganizationService) ListByUser(ctx context.Context, userID, appID int)
 	return orgs, nil
 }
 
+// ListMemberships returns the user's memberships in active organizations of an app,
+// with each membership's organization loaded.
+func (s *OrganizationService) ListMemberships(ctx context.Context, userID, appID int) ([]*ent.OrganizationMember, error) {
+	return s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.HasUserWith(user.ID(userID)),
+			organizationmember.HasOrganizationWith(
+				organization.HasAppWith(app.ID(appID)),
+				organization.IsActive(true),
+			),
+		).
+		WithOrganization().
+		All(ctx)
+}
+
 // GetByID returns an organization by ID.
 func (s *OrganizationService) GetByID(ctx context.Context, orgID int) (*ent.Organization, error) {
 	return s.client.Organization.Get(ctx, orgID)
@@ -136,6 +156,18 @@ func (s *OrganizationService) Update(ctx context.Context, orgID int, input Updat
 	if input.LogoURL != nil {
 		update.SetLogoURL(*input.LogoURL)
 	}
+	if input.RequireMFA != nil {
+		org, err := s.client.Organization.Get(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		settings := org.Settings
+		if settings == nil {
+			settings = map[string]interface{}{}
+		}
+		settings["require_mfa"] = *input.RequireMFA
+		update.SetSettings(settings)
+	}
 
 	return update.Save(ctx)
 }
@@ -164,13 +196,65 @@ func (s *OrganizationService) GetMember(ctx context.Context, orgID, userID int)
 }
 
 // RemoveMember removes a member from organization.
-func (s *OrganizationService) RemoveMember(ctx context.Context, memberID int) error {
-	return s.client.OrganizationMember.DeleteOneID(memberID).Exec(ctx)
+func (s *OrganizationService) RemoveMember(ctx context.Context, orgID, memberID int) error {
+	n, err := s.client.OrganizationMember.Delete().
+		Where(
+			organizationmember.ID(memberID),
+			organizationmember.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("member not found")
+	}
+	return nil
+}
+
+// SetMemberSeat gives a member one of the seats of the organization's
+// subscription, or takes it back. The subscription's quantity is then out of
+// date until StripeService.SyncSeats.
+func (s *OrganizationService) SetMemberSeat(ctx context.Context, orgID, memberID int, hasSeat bool) (*ent.OrganizationMember, error) {
+	member, err := s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.ID(memberID),
+			organizationmember.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("member not found")
+	}
+
+	return s.client.OrganizationMember.UpdateOne(member).
+		SetHasSeat(hasSeat).
+		Save(ctx)
+}
+
+// newMemberHasSeat reports whether members joining an organization get a
+// seat, which they do while it has a live subscription.
+func newMemberHasSeat(ctx context.Context, client *ent.Client, orgID int) (bool, error) {
+	return client.Subscription.Query().
+		Where(
+			subscription.HasOrganizationWith(organization.ID(orgID)),
+			subscription.StatusIn(entitlements.LiveStatuses...),
+		).
+		Exist(ctx)
 }
 
 // UpdateMemberRole updates a member's role.
-func (s *OrganizationService) UpdateMemberRole(ctx context.Context, memberID int, role string) (*ent.OrganizationMember, error) {
-	return s.client.OrganizationMember.UpdateOneID(memberID).
+func (s *OrganizationService) UpdateMemberRole(ctx context.Context, orgID, memberID int, role string) (*ent.OrganizationMember, error) {
+	member, err := s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.ID(memberID),
+			organizationmember.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("member not found")
+	}
+
+	return s.client.OrganizationMember.UpdateOne(member).
 		SetRole(organizationmember.Role(role)).
 		Save(ctx)
 }
@@ -224,6 +308,11 @@ func (s *OrganizationService) AcceptInvitation(ctx context.Context, userID int,
 		return nil, errors.New("invitation has expired")
 	}
 
+	hasSeat, err := newMemberHasSeat(ctx, s.client, inv.Edges.Organization.ID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Start transaction
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
@@ -235,6 +324,7 @@ func (s *OrganizationService) AcceptInvitation(ctx context.Context, userID int,
 		SetOrganizationID(inv.Edges.Organization.ID).
 		SetUserID(userID).
 		SetRole(organizationmember.Role(string(inv.Role))).
+		SetHasSeat(hasSeat).
 		Save(ctx)
 	if err != nil {
 		tx.Rollback()
@@ -260,11 +350,21 @@ func (s *OrganizationService) AcceptInvitation(ctx context.Context, userID int,
 }
 
 // RevokeInvitation revokes an invitation.
-func (s *OrganizationService) RevokeInvitation(ctx context.Context, invitationID int) error {
-	_, err := s.client.OrganizationInvitation.UpdateOneID(invitationID).
+func (s *OrganizationService) RevokeInvitation(ctx context.Context, orgID, invitationID int) error {
+	n, err := s.client.OrganizationInvitation.Update().
+		Where(
+			organizationinvitation.ID(invitationID),
+			organizationinvitation.HasOrganizationWith(organization.ID(orgID)),
+		).
 		SetStatus(organizationinvitation.StatusREVOKED).
 		Save(ctx)
-	return err
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("invitation not found")
+	}
+	return nil
 }
 
 // IsOwner checks if user is owner of organization.
@@ -0,0 +1,386 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/authsession"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// SessionService manages server-side login sessions and their refresh tokens.
+//
+// Every login creates an AuthSession. Refresh tokens issued for the session
+// form a family: each token can be exchanged exactly once for its successor.
+// Presenting a token that was already exchanged means it has leaked, so the
+// whole session is revoked.
+type SessionService struct {
+	cfg    *config.Config
+	client *ent.Client
+	auth   *middleware.AuthMiddleware
+}
+
+// NewSessionService creates a new session service.
+func NewSessionService(cfg *config.Config, client *ent.Client, auth *middleware.AuthMiddleware) *SessionService {
+	return &SessionService{
+		cfg:    cfg,
+		client: client,
+		auth:   auth,
+	}
+}
+
+// SessionInfo describes the device a session was created from.
+type SessionInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Create starts a new session and returns it together with its first refresh token.
+// mfaVerified records whether the login completed two-factor authentication.
+func (s *SessionService) Create(ctx context.Context, userID, appID, orgID int, mfaVerified bool, info SessionInfo) (*ent.AuthSession, string, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	sess, err := tx.AuthSession.Create().
+		SetUserID(userID).
+		SetAppID(appID).
+		SetUserAgent(info.UserAgent).
+		SetIPAddress(info.IPAddress).
+		SetMfaVerified(mfaVerified).
+		SetExpiresAt(time.Now().Add(s.cfg.RefreshTokenDuration())).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	refreshToken, err := s.issueRefreshToken(ctx, tx, sess.ID, userID, appID, orgID)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	return sess, refreshToken, nil
+}
+
+// CreateImpersonation starts a session in which the admin identified by actor
+// acts as the user. It has no refresh token and ends when its access token
+// expires.
+func (s *SessionService) CreateImpersonation(ctx context.Context, userID, appID int, actor string, info SessionInfo) (*ent.AuthSession, error) {
+	return s.client.AuthSession.Create().
+		SetUserID(userID).
+		SetAppID(appID).
+		SetUserAgent(info.UserAgent).
+		SetIPAddress(info.IPAddress).
+		SetImpersonator(actor).
+		SetExpiresAt(time.Now().Add(middleware.ImpersonationTokenDuration)).
+		Save(ctx)
+}
+
+// Rotate exchanges a refresh token for its successor. The presented token
+// must already have been validated; claims are the claims it carries.
+func (s *SessionService) Rotate(ctx context.Context, refreshToken string, claims *middleware.TokenClaims) (*ent.AuthSession, string, error) {
+	rt, err := s.client.RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hashToken(refreshToken))).
+		WithSession().
+		First(ctx)
+	if err != nil || rt.Edges.Session == nil {
+		return nil, "", errors.New("invalid refresh token")
+	}
+
+	sess := rt.Edges.Session
+	now := time.Now()
+
+	if rt.UsedAt != nil {
+		s.revokeReused(ctx, sess)
+		return nil, "", errors.New("refresh token reuse detected")
+	}
+	if rt.RevokedAt != nil {
+		return nil, "", errors.New("refresh token has been revoked")
+	}
+
+	if sess.RevokedAt != nil {
+		return nil, "", errors.New("session has been revoked")
+	}
+	if now.After(sess.ExpiresAt) || now.After(rt.ExpiresAt) {
+		return nil, "", errors.New("session has expired")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// Mark the token as used only if nobody else did in the meantime, so two
+	// concurrent refreshes with the same token cannot both succeed.
+	n, err := tx.RefreshToken.Update().
+		Where(
+			refreshtoken.ID(rt.ID),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+	if n == 0 {
+		tx.Rollback()
+		s.revokeReused(ctx, sess)
+		return nil, "", errors.New("refresh token reuse detected")
+	}
+
+	sess, err = tx.AuthSession.UpdateOneID(sess.ID).
+		SetLastUsedAt(now).
+		SetExpiresAt(now.Add(s.cfg.RefreshTokenDuration())).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	newToken, err := s.issueRefreshToken(ctx, tx, sess.ID, claims.UserID, claims.AppID, claims.OrgID)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	return sess, newToken, nil
+}
+
+// Reissue replaces the outstanding refresh token of an active session with a
+// new one, e.g. after the session switched organization context.
+func (s *SessionService) Reissue(ctx context.Context, sessionID, userID, appID, orgID int) (*ent.AuthSession, string, error) {
+	now := time.Now()
+
+	sess, err := s.client.AuthSession.Query().
+		Where(
+			authsession.ID(sessionID),
+			authsession.HasUserWith(user.ID(userID)),
+			authsession.RevokedAtIsNil(),
+			authsession.ExpiresAtGT(now),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, "", errors.New("session not found")
+	}
+
+	// Impersonation sessions must not turn into regular, refreshable ones
+	if sess.Impersonator != "" {
+		return nil, "", errors.New("not allowed while impersonating a user")
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	_, err = tx.RefreshToken.Update().
+		Where(
+			refreshtoken.HasSessionWith(authsession.ID(sess.ID)),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	sess, err = tx.AuthSession.UpdateOneID(sess.ID).
+		SetLastUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	refreshToken, err := s.issueRefreshToken(ctx, tx, sess.ID, userID, appID, orgID)
+	if err != nil {
+		tx.Rollback()
+		return nil, "", err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, "", err
+	}
+
+	return sess, refreshToken, nil
+}
+
+// MarkMFAVerified records that a session completed two-factor authentication.
+// Tokens issued for it from then on carry the mfa claim.
+func (s *SessionService) MarkMFAVerified(ctx context.Context, sessionID int) error {
+	return s.client.AuthSession.UpdateOneID(sessionID).
+		SetMfaVerified(true).
+		Exec(ctx)
+}
+
+// IsMFAVerified reports whether a session completed two-factor authentication.
+// Tokens that are not bound to a session never are.
+func (s *SessionService) IsMFAVerified(ctx context.Context, sessionID int) bool {
+	if sessionID == 0 {
+		return false
+	}
+	sess, err := s.client.AuthSession.Get(ctx, sessionID)
+	return err == nil && sess.MfaVerified
+}
+
+// List returns the active sessions of a user in an app, most recent first.
+func (s *SessionService) List(ctx context.Context, userID, appID int) ([]*ent.AuthSession, error) {
+	return s.client.AuthSession.Query().
+		Where(
+			authsession.HasUserWith(user.ID(userID)),
+			authsession.HasAppWith(app.ID(appID)),
+			authsession.RevokedAtIsNil(),
+			authsession.ExpiresAtGT(time.Now()),
+		).
+		Order(ent.Desc(authsession.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Revoke revokes a session and every refresh token issued for it.
+func (s *SessionService) Revoke(ctx context.Context, sessionID int, reason string) error {
+	now := time.Now()
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.AuthSession.Update().
+		Where(
+			authsession.ID(sessionID),
+			authsession.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		SetRevokedReason(reason).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	_, err = tx.RefreshToken.Update().
+		Where(
+			refreshtoken.HasSessionWith(authsession.ID(sessionID)),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(now).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// RevokeForUser revokes one of the user's own sessions.
+func (s *SessionService) RevokeForUser(ctx context.Context, userID, sessionID int) error {
+	exists, err := s.client.AuthSession.Query().
+		Where(
+			authsession.ID(sessionID),
+			authsession.HasUserWith(user.ID(userID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.New("session not found")
+	}
+
+	return s.Revoke(ctx, sessionID, "revoked by user")
+}
+
+// RevokeAll revokes all active sessions of a user in an app except
+// exceptSessionID (pass 0 to revoke every session in the app). It returns the
+// number of sessions revoked.
+func (s *SessionService) RevokeAll(ctx context.Context, userID, appID, exceptSessionID int, reason string) (int, error) {
+	return s.revokeWhere(ctx, reason,
+		authsession.HasUserWith(user.ID(userID)),
+		authsession.HasAppWith(app.ID(appID)),
+		authsession.IDNEQ(exceptSessionID),
+	)
+}
+
+// RevokeEverywhere revokes all active sessions of a user in every app, for
+// changes to the credentials and account that all apps share. It returns the
+// number of sessions revoked.
+func (s *SessionService) RevokeEverywhere(ctx context.Context, userID int, reason string) (int, error) {
+	return s.revokeWhere(tenant.AllApps(ctx), reason,
+		authsession.HasUserWith(user.ID(userID)),
+	)
+}
+
+func (s *SessionService) revokeWhere(ctx context.Context, reason string, where ...predicate.AuthSession) (int, error) {
+	ids, err := s.client.AuthSession.Query().
+		Where(where...).
+		Where(authsession.RevokedAtIsNil()).
+		IDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, id := range ids {
+		if err := s.Revoke(ctx, id, reason); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(ids), nil
+}
+
+func (s *SessionService) issueRefreshToken(ctx context.Context, tx *ent.Tx, sessionID, userID, appID, orgID int) (string, error) {
+	token, err := s.auth.GenerateRefreshToken(userID, appID, orgID, sessionID)
+	if err != nil {
+		return "", err
+	}
+
+	_, err = tx.RefreshToken.Create().
+		SetSessionID(sessionID).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(time.Now().Add(s.cfg.RefreshTokenDuration())).
+		Save(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+func (s *SessionService) revokeReused(ctx context.Context, sess *ent.AuthSession) {
+	if sess.RevokedAt != nil {
+		return
+	}
+	_ = s.Revoke(ctx, sess.ID, "refresh token reuse detected")
+}
+
+// hashToken returns the hex-encoded SHA-256 hash of a token for storage.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,124 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
+	"gigaboo.io/lem/internal/jwtkeys"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+func newTestSessions(t *testing.T) (*SessionService, *middleware.AuthMiddleware, *ent.Client) {
+	t.Helper()
+	client := newTestClient(t)
+	cfg := &config.Config{RefreshTokenExpireDays: 30, AccessTokenExpireMinutes: 15}
+	auth := middleware.NewAuthMiddleware(cfg, client, jwtkeys.NewHMAC("test"))
+	return NewSessionService(cfg, client, auth), auth, client
+}
+
+func TestRevokeSessions(t *testing.T) {
+	sessions, auth, client := newTestSessions(t)
+	s := NewAuthService(sessions.cfg, client, auth, sessions, nil, nil, nil)
+	all := tenant.AllApps(context.Background())
+	app1 := client.App.Create().SetName("One").SetSlug("one").SaveX(all)
+	app2 := client.App.Create().SetName("Two").SetSlug("two").SaveX(all)
+	ctx1 := tenant.NewContext(context.Background(), app1.ID)
+	ctx2 := tenant.NewContext(context.Background(), app2.ID)
+
+	login := func(ctx context.Context, userID, appID int) *ent.AuthSession {
+		t.Helper()
+		sess, _, err := sessions.Create(ctx, userID, appID, 0, false, SessionInfo{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sess
+	}
+	active := func(sess *ent.AuthSession) bool {
+		return client.AuthSession.GetX(all, sess.ID).RevokedAt == nil
+	}
+
+	u := client.User.Create().SetEmail("user@example.com").SaveX(all)
+	other := client.User.Create().SetEmail("other@example.com").SaveX(all)
+	current := login(ctx1, u.ID, app1.ID)
+	sameApp := login(ctx1, u.ID, app1.ID)
+	otherApp := login(ctx2, u.ID, app2.ID)
+	otherUser := login(ctx1, other.ID, app1.ID)
+
+	n, err := s.RevokeOtherSessions(ctx1, u.ID, app1.ID, current.ID)
+	if err != nil || n != 1 {
+		t.Fatalf("RevokeOtherSessions = %d, %v, want 1 session", n, err)
+	}
+	if !active(current) || active(sameApp) {
+		t.Error("RevokeOtherSessions didn't revoke exactly the user's other session in the app")
+	}
+	if !active(otherApp) || !active(otherUser) {
+		t.Error("RevokeOtherSessions revoked sessions in another app or of another user")
+	}
+	if tokens := client.RefreshToken.Query().CountX(all); tokens != 4 {
+		t.Fatalf("%d refresh tokens, want 4", tokens)
+	}
+
+	if n, err := sessions.RevokeAll(ctx1, u.ID, app1.ID, 0, "test"); err != nil || n != 1 {
+		t.Errorf("RevokeAll = %d, %v, want 1 session", n, err)
+	}
+	if active(current) || !active(otherApp) {
+		t.Error("RevokeAll didn't revoke exactly the user's sessions in the app")
+	}
+
+	if n, err := sessions.RevokeEverywhere(ctx1, u.ID, "test"); err != nil || n != 1 {
+		t.Errorf("RevokeEverywhere = %d, %v, want 1 session", n, err)
+	}
+	if active(otherApp) || !active(otherUser) {
+		t.Error("RevokeEverywhere didn't revoke exactly the user's sessions in every app")
+	}
+}
+
+func TestRotateReuse(t *testing.T) {
+	sessions, auth, client := newTestSessions(t)
+	all := tenant.AllApps(context.Background())
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(all)
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+
+	sess, first, err := sessions.Create(ctx, u.ID, a.ID, 0, false, SessionInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rotate := func(token string) (string, error) {
+		t.Helper()
+		claims, err := auth.ValidateToken(token)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, next, err := sessions.Rotate(ctx, token, claims)
+		return next, err
+	}
+
+	second, err := rotate(first)
+	if err != nil {
+		t.Fatalf("rotating the first token: %v", err)
+	}
+	third, err := rotate(second)
+	if err != nil {
+		t.Fatalf("rotating the second token: %v", err)
+	}
+
+	// The first token was exchanged already, so it leaked: the whole
+	// family stops working, including the token the user holds now.
+	if _, err := rotate(first); err == nil {
+		t.Fatal("a used refresh token was exchanged again")
+	}
+	if sess := client.AuthSession.GetX(ctx, sess.ID); sess.RevokedAt == nil {
+		t.Error("the session wasn't revoked")
+	}
+	if _, err := rotate(third); err == nil {
+		t.Error("the latest refresh token still works after reuse")
+	}
+	if n := client.RefreshToken.Query().Where(refreshtoken.RevokedAtIsNil()).CountX(ctx); n != 0 {
+		t.Errorf("%d refresh tokens not revoked, want 0", n)
+	}
+}
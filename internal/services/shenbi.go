@@ -81,8 +81,13 @@ func (s *ShenbiService) GetOrCreateProfile(ctx context.Context, appID, userID in
 		Save(ctx)
 }
 
-// CreateProfile creates a new profile.
+// CreateProfile creates a new profile. Users choose between STUDENT and
+// TEACHER; the ADMIN role is granted from the admin console.
 func (s *ShenbiService) CreateProfile(ctx context.Context, appID, userID int, input ProfileInput) (*ent.ShenbiProfile, error) {
+	if shenbiprofile.Role(input.Role) == shenbiprofile.RoleADMIN {
+		return nil, errors.New("the ADMIN role can only be granted by an administrator")
+	}
+
 	return s.client.ShenbiProfile.Create().
 		SetAppID(appID).
 		SetUserID(userID).
@@ -0,0 +1,1127 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/golang-jwt/jwt/v5"
+	xrv "github.com/mattermost/xml-roundtrip-validator"
+	"golang.org/x/oauth2"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/middleware"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// ssoLoginDuration is how long a user has to sign in at their identity
+// provider, and the app to exchange the resulting code.
+const ssoLoginDuration = 10 * time.Minute
+
+// oidcDiscoveryInterval is how long the discovered configuration of an
+// external OpenID provider is cached.
+const oidcDiscoveryInterval = time.Hour
+
+// domainVerificationPrefix is the DNS label under which organizations publish
+// their domain verification token in a TXT record.
+const domainVerificationPrefix = "_lem-verification."
+
+// SAML attributes commonly used by IdPs for the user's email and name.
+var (
+	samlEmailAttributes = []string{
+		"email",
+		"mail",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress",
+		"urn:oid:0.9.2342.19200300.100.1.3",
+	}
+	samlNameAttributes = []string{
+		"name",
+		"displayName",
+		"cn",
+		"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name",
+		"urn:oid:2.16.840.1.113730.3.1.241",
+		"urn:oid:2.5.4.3",
+	}
+)
+
+// SSOService signs users in through their organization's own identity
+// provider, over SAML 2.0 or OpenID Connect.
+//
+// Organizations verify the email domains their IdP vouches for, and only
+// addresses on those domains are accepted from it. Users are created on their
+// first sign-in and join the organization with the domain's default role.
+// The browser is then sent back to the app with a single-use code that the
+// app exchanges for a regular session in the organization.
+type SSOService struct {
+	cfg    *config.Config
+	client *ent.Client
+	auth   *AuthService
+
+	// Key and certificate lem signs SAML requests with and IdPs encrypt
+	// assertions to. Both are nil when not configured.
+	spKey  crypto.Signer
+	spCert *x509.Certificate
+
+	mu        sync.Mutex
+	providers map[string]*oidcProvider
+}
+
+// oidcProvider is the discovered configuration of an external OpenID provider.
+type oidcProvider struct {
+	issuer    string
+	authURL   string
+	tokenURL  string
+	jwks      *jwksCache
+	fetchedAt time.Time
+}
+
+// NewSSOService creates a new SSO service.
+func NewSSOService(cfg *config.Config, client *ent.Client, auth *AuthService) (*SSOService, error) {
+	s := &SSOService{
+		cfg:       cfg,
+		client:    client,
+		auth:      auth,
+		providers: make(map[string]*oidcProvider),
+	}
+
+	if cfg.SAMLSPKeyPath != "" || cfg.SAMLSPCertPath != "" {
+		pair, err := tls.LoadX509KeyPair(cfg.SAMLSPCertPath, cfg.SAMLSPKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load SAML certificate: %w", err)
+		}
+		signer, ok := pair.PrivateKey.(crypto.Signer)
+		if !ok {
+			return nil, errors.New("unsupported SAML private key type")
+		}
+		cert, err := x509.ParseCertificate(pair.Certificate[0])
+		if err != nil {
+			return nil, err
+		}
+		s.spKey, s.spCert = signer, cert
+	}
+
+	return s, nil
+}
+
+// SSOConnectionInput represents an SSO connection configuration. SAML
+// connections need the IdP metadata, either inline or as a URL it is fetched
+// from; OIDC connections need the issuer and client ID. An empty client
+// secret keeps the current one.
+type SSOConnectionInput struct {
+	Protocol           string `json:"protocol" binding:"required,oneof=SAML OIDC"`
+	Enabled            *bool  `json:"enabled"`
+	SAMLIdPMetadata    string `json:"saml_idp_metadata"`
+	SAMLIdPMetadataURL string `json:"saml_idp_metadata_url"`
+	OIDCIssuer         string `json:"oidc_issuer"`
+	OIDCClientID       string `json:"oidc_client_id"`
+	OIDCClientSecret   string `json:"oidc_client_secret"`
+}
+
+// SSOConnectionResponse is an organization's SSO connection along with the
+// values to register at the identity provider.
+type SSOConnectionResponse struct {
+	Connection      *ent.SSOConnection `json:"connection"`
+	LoginURL        string             `json:"login_url"`
+	SAMLEntityID    string             `json:"saml_entity_id,omitempty"`
+	SAMLACSURL      string             `json:"saml_acs_url,omitempty"`
+	OIDCRedirectURI string             `json:"oidc_redirect_uri,omitempty"`
+}
+
+// AddDomainInput represents a request to claim an email domain.
+type AddDomainInput struct {
+	Domain      string `json:"domain" binding:"required,fqdn"`
+	DefaultRole string `json:"default_role" binding:"omitempty,oneof=ADMIN MEMBER"`
+	AutoJoin    *bool  `json:"auto_join"`
+}
+
+// DomainResponse is a claimed domain with the DNS record that verifies it.
+type DomainResponse struct {
+	*ent.OrganizationDomain
+	RecordName  string `json:"record_name"`
+	RecordType  string `json:"record_type"`
+	RecordValue string `json:"record_value"`
+}
+
+// SSODiscoverInput represents a request to find the SSO login for an email.
+type SSODiscoverInput struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+// SSODiscoverResponse tells the app whether to send the user to their
+// organization's identity provider.
+type SSODiscoverResponse struct {
+	SSO              bool   `json:"sso"`
+	OrganizationID   int    `json:"organization_id,omitempty"`
+	OrganizationName string `json:"organization_name,omitempty"`
+	LoginURL         string `json:"login_url,omitempty"`
+}
+
+// SSOExchangeInput represents a request to exchange an SSO login code.
+type SSOExchangeInput struct {
+	Code string `json:"code" binding:"required"`
+}
+
+// ssoIdentity is the user an identity provider vouched for.
+type ssoIdentity struct {
+	Email string
+	Name  string
+}
+
+// GetConnection returns the organization's SSO connection.
+func (s *SSOService) GetConnection(ctx context.Context, orgID int) (*SSOConnectionResponse, error) {
+	conn, err := s.client.SSOConnection.Query().
+		Where(ssoconnection.HasOrganizationWith(organization.ID(orgID))).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("single sign-on is not configured")
+	}
+	return s.connectionResponse(orgID, conn), nil
+}
+
+// SaveConnection creates or replaces the organization's SSO connection. The
+// IdP configuration is checked before it is saved.
+func (s *SSOService) SaveConnection(ctx context.Context, orgID int, input SSOConnectionInput) (*SSOConnectionResponse, error) {
+	existing, err := s.client.SSOConnection.Query().
+		Where(ssoconnection.HasOrganizationWith(organization.ID(orgID))).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return nil, err
+	}
+
+	metadata := input.SAMLIdPMetadata
+	clientSecret := input.OIDCClientSecret
+	switch input.Protocol {
+	case "SAML":
+		if metadata == "" && input.SAMLIdPMetadataURL != "" {
+			data, err := s.fetchMetadata(ctx, input.SAMLIdPMetadataURL)
+			if err != nil {
+				return nil, err
+			}
+			metadata = string(data)
+		}
+		if metadata == "" {
+			return nil, errors.New("saml_idp_metadata or saml_idp_metadata_url is required")
+		}
+		if _, err := parseIdPMetadata([]byte(metadata)); err != nil {
+			return nil, fmt.Errorf("invalid IdP metadata: %w", err)
+		}
+	case "OIDC":
+		if input.OIDCIssuer == "" || input.OIDCClientID == "" {
+			return nil, errors.New("oidc_issuer and oidc_client_id are required")
+		}
+		if clientSecret == "" && existing != nil {
+			clientSecret = existing.OidcClientSecret
+		}
+		if _, err := s.provider(ctx, input.OIDCIssuer); err != nil {
+			return nil, err
+		}
+	}
+
+	enabled := true
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	var conn *ent.SSOConnection
+	if existing == nil {
+		conn, err = s.client.SSOConnection.Create().
+			SetOrganizationID(orgID).
+			SetProtocol(ssoconnection.Protocol(input.Protocol)).
+			SetEnabled(enabled).
+			SetSamlIdpMetadata(metadata).
+			SetSamlIdpMetadataURL(input.SAMLIdPMetadataURL).
+			SetOidcIssuer(input.OIDCIssuer).
+			SetOidcClientID(input.OIDCClientID).
+			SetOidcClientSecret(clientSecret).
+			Save(ctx)
+	} else {
+		conn, err = s.client.SSOConnection.UpdateOne(existing).
+			SetProtocol(ssoconnection.Protocol(input.Protocol)).
+			SetEnabled(enabled).
+			SetSamlIdpMetadata(metadata).
+			SetSamlIdpMetadataURL(input.SAMLIdPMetadataURL).
+			SetOidcIssuer(input.OIDCIssuer).
+			SetOidcClientID(input.OIDCClientID).
+			SetOidcClientSecret(clientSecret).
+			Save(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return s.connectionResponse(orgID, conn), nil
+}
+
+// DeleteConnection removes the organization's SSO connection.
+func (s *SSOService) DeleteConnection(ctx context.Context, orgID int) error {
+	n, err := s.client.SSOConnection.Delete().
+		Where(ssoconnection.HasOrganizationWith(organization.ID(orgID))).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("single sign-on is not configured")
+	}
+	return nil
+}
+
+// ListDomains returns the email domains claimed by the organization.
+func (s *SSOService) ListDomains(ctx context.Context, orgID int) ([]*DomainResponse, error) {
+	domains, err := s.client.OrganizationDomain.Query().
+		Where(organizationdomain.HasOrganizationWith(organization.ID(orgID))).
+		Order(ent.Asc(organizationdomain.FieldDomain)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*DomainResponse, 0, len(domains))
+	for _, d := range domains {
+		resp = append(resp, domainResponse(d))
+	}
+	return resp, nil
+}
+
+// AddDomain claims an email domain for the organization. It has to be
+// verified before users on it are accepted.
+func (s *SSOService) AddDomain(ctx context.Context, orgID int, input AddDomainInput) (*DomainResponse, error) {
+	domain := strings.TrimSuffix(strings.ToLower(input.Domain), ".")
+
+	exists, err := s.client.OrganizationDomain.Query().
+		Where(
+			organizationdomain.Domain(domain),
+			organizationdomain.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("domain already added")
+	}
+
+	token, err := generateInviteToken(16)
+	if err != nil {
+		return nil, err
+	}
+
+	create := s.client.OrganizationDomain.Create().
+		SetOrganizationID(orgID).
+		SetDomain(domain).
+		SetVerificationToken(token)
+	if input.DefaultRole != "" {
+		create.SetDefaultRole(organizationdomain.DefaultRole(input.DefaultRole))
+	}
+	if input.AutoJoin != nil {
+		create.SetAutoJoin(*input.AutoJoin)
+	}
+
+	d, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return domainResponse(d), nil
+}
+
+// VerifyDomain checks the domain's DNS TXT record for its verification token.
+func (s *SSOService) VerifyDomain(ctx context.Context, orgID, domainID int) (*DomainResponse, error) {
+	d, err := s.orgDomain(ctx, orgID, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if d.VerifiedAt == nil {
+		records, err := net.DefaultResolver.LookupTXT(ctx, domainVerificationPrefix+d.Domain)
+		if err != nil {
+			return nil, errors.New("verification record not found")
+		}
+
+		found := false
+		for _, record := range records {
+			if strings.TrimSpace(record) == d.VerificationToken {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.New("verification record not found")
+		}
+	}
+
+	return s.MarkDomainVerified(ctx, orgID, domainID)
+}
+
+// MarkDomainVerified verifies a domain without checking DNS. A domain can
+// only be verified by one organization of an app.
+func (s *SSOService) MarkDomainVerified(ctx context.Context, orgID, domainID int) (*DomainResponse, error) {
+	d, err := s.orgDomain(ctx, orgID, domainID)
+	if err != nil {
+		return nil, err
+	}
+	if d.VerifiedAt != nil {
+		return domainResponse(d), nil
+	}
+
+	org, err := s.client.Organization.Query().
+		Where(organization.ID(orgID)).
+		WithApp().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+
+	taken, err := s.client.OrganizationDomain.Query().
+		Where(
+			organizationdomain.Domain(d.Domain),
+			organizationdomain.VerifiedAtNotNil(),
+			organizationdomain.HasOrganizationWith(
+				organization.IDNEQ(orgID),
+				organization.HasAppWith(app.ID(org.Edges.App.ID)),
+			),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if taken {
+		return nil, errors.New("domain is verified by another organization")
+	}
+
+	d, err = s.client.OrganizationDomain.UpdateOne(d).
+		SetVerifiedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return domainResponse(d), nil
+}
+
+// DeleteDomain removes a domain from the organization. Members who joined
+// through it stay members.
+func (s *SSOService) DeleteDomain(ctx context.Context, orgID, domainID int) error {
+	n, err := s.client.OrganizationDomain.Delete().
+		Where(
+			organizationdomain.ID(domainID),
+			organizationdomain.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return errors.New("domain not found")
+	}
+	return nil
+}
+
+// Discover finds the organization whose identity provider signs in email,
+// so that apps can skip the password prompt for its users.
+func (s *SSOService) Discover(ctx context.Context, appID int, input SSODiscoverInput) (*SSODiscoverResponse, error) {
+	_, domain, ok := strings.Cut(strings.ToLower(input.Email), "@")
+	if !ok {
+		return &SSODiscoverResponse{}, nil
+	}
+
+	org, err := s.client.Organization.Query().
+		Where(
+			organization.IsActive(true),
+			organization.HasAppWith(app.ID(appID)),
+			organization.HasDomainsWith(
+				organizationdomain.Domain(domain),
+				organizationdomain.VerifiedAtNotNil(),
+			),
+			organization.HasSSOConnectionWith(ssoconnection.Enabled(true)),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return &SSODiscoverResponse{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &SSODiscoverResponse{
+		SSO:              true,
+		OrganizationID:   org.ID,
+		OrganizationName: org.Name,
+		LoginURL:         s.ssoURL(org.ID, "/login"),
+	}, nil
+}
+
+// SAMLMetadata returns lem's service provider metadata for the organization.
+func (s *SSOService) SAMLMetadata(ctx context.Context, orgID int) ([]byte, error) {
+	if _, err := s.orgContext(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	metadata := s.serviceProvider(orgID, nil).Metadata()
+
+	// Responses are only accepted through the HTTP-POST binding
+	for i := range metadata.SPSSODescriptors {
+		descriptor := &metadata.SPSSODescriptors[i]
+		descriptor.AssertionConsumerServices = descriptor.AssertionConsumerServices[:1]
+	}
+
+	data, err := xml.MarshalIndent(metadata, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// StartLogin begins a sign-in at the organization's identity provider and
+// returns the URL to send the browser to. redirectURL is where the browser
+// returns with the login code; it must be on one of the app's allowed origins.
+func (s *SSOService) StartLogin(ctx context.Context, orgID int, redirectURL string) (string, error) {
+	ctx, err := s.orgContext(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+
+	org, err := s.client.Organization.Query().
+		Where(
+			organization.ID(orgID),
+			organization.IsActive(true),
+		).
+		WithApp().
+		WithSSOConnection().
+		Only(ctx)
+	if err != nil {
+		return "", errors.New("organization not found")
+	}
+
+	conn := org.Edges.SSOConnection
+	if conn == nil || !conn.Enabled {
+		return "", errors.New("single sign-on is not configured")
+	}
+
+	if !isAllowedRedirect(s.cfg, org.Edges.App, redirectURL) {
+		return "", errors.New("redirect_url is not allowed")
+	}
+
+	state, err := generateInviteToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	login := s.client.SSOLogin.Create().
+		SetOrganizationID(org.ID).
+		SetStateHash(hashToken(state)).
+		SetRedirectURL(redirectURL).
+		SetExpiresAt(time.Now().Add(ssoLoginDuration))
+
+	var authURL string
+	switch conn.Protocol {
+	case ssoconnection.ProtocolSAML:
+		metadata, err := parseIdPMetadata([]byte(conn.SamlIdpMetadata))
+		if err != nil {
+			return "", err
+		}
+		sp := s.serviceProvider(org.ID, metadata)
+
+		req, err := sp.MakeAuthenticationRequest(sp.GetSSOBindingLocation(saml.HTTPRedirectBinding), saml.HTTPRedirectBinding, saml.HTTPPostBinding)
+		if err != nil {
+			return "", err
+		}
+		redirect, err := req.Redirect(state, sp)
+		if err != nil {
+			return "", err
+		}
+
+		login.SetRequestID(req.ID)
+		authURL = redirect.String()
+
+	case ssoconnection.ProtocolOIDC:
+		provider, err := s.provider(ctx, conn.OidcIssuer)
+		if err != nil {
+			return "", err
+		}
+
+		nonce, err := generateInviteToken(16)
+		if err != nil {
+			return "", err
+		}
+		verifier := oauth2.GenerateVerifier()
+
+		login.SetRequestID(nonce).SetCodeVerifier(verifier)
+		authURL = s.oauthConfig(org.ID, conn, provider).AuthCodeURL(state,
+			oauth2.S256ChallengeOption(verifier),
+			oauth2.SetAuthURLParam("nonce", nonce),
+		)
+	}
+
+	if _, err := login.Save(ctx); err != nil {
+		return "", err
+	}
+	return authURL, nil
+}
+
+// HandleSAMLResponse processes a SAML response posted to the assertion
+// consumer service and returns the URL to send the browser back to the app.
+// Only responses to requests made by StartLogin are accepted.
+func (s *SSOService) HandleSAMLResponse(ctx context.Context, orgID int, samlResponse, relayState string) (string, error) {
+	ctx, err := s.orgContext(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+
+	login, conn, err := s.pendingLogin(ctx, orgID, relayState, ssoconnection.ProtocolSAML)
+	if err != nil {
+		return "", err
+	}
+
+	identity, err := s.samlIdentity(orgID, conn, samlResponse, login.RequestID)
+	if err != nil {
+		return ssoErrorRedirect(login, err), nil
+	}
+	return s.finishLogin(ctx, login, identity), nil
+}
+
+// HandleOIDCCallback processes the identity provider's redirect back to lem
+// and returns the URL to send the browser back to the app.
+func (s *SSOService) HandleOIDCCallback(ctx context.Context, orgID int, state, code, idpError string) (string, error) {
+	ctx, err := s.orgContext(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+
+	login, conn, err := s.pendingLogin(ctx, orgID, state, ssoconnection.ProtocolOIDC)
+	if err != nil {
+		return "", err
+	}
+
+	if idpError != "" {
+		return ssoErrorRedirect(login, errors.New("the identity provider returned "+idpError)), nil
+	}
+
+	identity, err := s.oidcIdentity(ctx, orgID, conn, code, login)
+	if err != nil {
+		return ssoErrorRedirect(login, err), nil
+	}
+	return s.finishLogin(ctx, login, identity), nil
+}
+
+// Exchange trades an SSO login code for tokens scoped to the organization.
+// Users with two-factor authentication enabled still get an MFA challenge.
+func (s *SSOService) Exchange(ctx context.Context, appID int, input SSOExchangeInput, info SessionInfo) (*AuthResponse, error) {
+	login, err := s.client.SSOLogin.Query().
+		Where(
+			ssologin.CodeHash(hashToken(input.Code)),
+			ssologin.UsedAtIsNil(),
+			ssologin.ExpiresAtGT(time.Now()),
+			ssologin.HasOrganizationWith(organization.HasAppWith(app.ID(appID))),
+		).
+		WithOrganization().
+		WithUser().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("invalid or expired code")
+	}
+
+	// Only the first request to use a code may log in
+	n, err := s.client.SSOLogin.Update().
+		Where(
+			ssologin.ID(login.ID),
+			ssologin.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("invalid or expired code")
+	}
+
+	u := login.Edges.User
+	if !u.IsActive {
+		return nil, errors.New("account is disabled")
+	}
+
+	if u.MfaEnabled {
+		return s.auth.CompleteLogin(ctx, u, appID, info)
+	}
+
+	_, err = s.client.User.UpdateOne(u).
+		SetLastLoginAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Roles the organization requires two factors for start without it
+	org, member, err := s.auth.orgMembership(ctx, u.ID, appID, login.Edges.Organization.ID)
+	if err != nil || middleware.OrgRequiresMFA(org.Settings, member.Role) {
+		return s.auth.IssueTokens(ctx, u.ID, appID, 0, "", info)
+	}
+	return s.auth.IssueTokens(ctx, u.ID, appID, org.ID, string(member.Role), info)
+}
+
+// pendingLogin returns the unfinished login for state and the connection it
+// was started with.
+func (s *SSOService) pendingLogin(ctx context.Context, orgID int, state string, protocol ssoconnection.Protocol) (*ent.SSOLogin, *ent.SSOConnection, error) {
+	if state == "" {
+		return nil, nil, errors.New("missing state")
+	}
+
+	login, err := s.client.SSOLogin.Query().
+		Where(
+			ssologin.StateHash(hashToken(state)),
+			ssologin.CompletedAtIsNil(),
+			ssologin.ExpiresAtGT(time.Now()),
+			ssologin.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, errors.New("invalid or expired login")
+	}
+
+	conn, err := s.client.SSOConnection.Query().
+		Where(
+			ssoconnection.HasOrganizationWith(organization.ID(orgID)),
+			ssoconnection.Enabled(true),
+			ssoconnection.ProtocolEQ(protocol),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, nil, errors.New("single sign-on is not configured")
+	}
+
+	return login, conn, nil
+}
+
+// samlIdentity verifies a SAML response and reads the user from its assertion.
+func (s *SSOService) samlIdentity(orgID int, conn *ent.SSOConnection, samlResponse, requestID string) (*ssoIdentity, error) {
+	metadata, err := parseIdPMetadata([]byte(conn.SamlIdpMetadata))
+	if err != nil {
+		return nil, err
+	}
+	sp := s.serviceProvider(orgID, metadata)
+
+	raw, err := base64.StdEncoding.DecodeString(samlResponse)
+	if err != nil {
+		return nil, errors.New("invalid SAML response")
+	}
+	assertion, err := sp.ParseXMLResponse(raw, []string{requestID}, sp.AcsURL)
+	if err != nil {
+		return nil, errors.New("invalid SAML response")
+	}
+
+	identity := &ssoIdentity{}
+	if assertion.Subject != nil && assertion.Subject.NameID != nil && strings.Contains(assertion.Subject.NameID.Value, "@") {
+		identity.Email = assertion.Subject.NameID.Value
+	}
+	if identity.Email == "" {
+		identity.Email = samlAttribute(assertion, samlEmailAttributes)
+	}
+	identity.Name = samlAttribute(assertion, samlNameAttributes)
+	if identity.Name == "" {
+		identity.Name = strings.TrimSpace(samlAttribute(assertion, []string{"givenName"}) + " " + samlAttribute(assertion, []string{"sn", "surname"}))
+	}
+
+	if identity.Email == "" {
+		return nil, errors.New("the identity provider did not return an email address")
+	}
+	return identity, nil
+}
+
+// oidcIdentity exchanges an authorization code at the organization's OpenID
+// provider and reads the user from the verified ID token.
+func (s *SSOService) oidcIdentity(ctx context.Context, orgID int, conn *ent.SSOConnection, code string, login *ent.SSOLogin) (*ssoIdentity, error) {
+	provider, err := s.provider(ctx, conn.OidcIssuer)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.oauthConfig(orgID, conn, provider).Exchange(ctx, code, oauth2.VerifierOption(login.CodeVerifier))
+	if err != nil {
+		return nil, errors.New("failed to exchange authorization code")
+	}
+
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, errors.New("the identity provider did not return an ID token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawIDToken, claims, provider.jwks.Keyfunc(ctx),
+		jwt.WithValidMethods([]string{"RS256"}),
+		jwt.WithIssuer(provider.issuer),
+		jwt.WithAudience(conn.OidcClientID),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, errors.New("invalid ID token")
+	}
+
+	if nonce, _ := claims["nonce"].(string); nonce != login.RequestID {
+		return nil, errors.New("invalid ID token")
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return nil, errors.New("email address is not verified")
+	}
+
+	identity := &ssoIdentity{}
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+	if identity.Email == "" {
+		return nil, errors.New("the identity provider did not return an email address")
+	}
+	return identity, nil
+}
+
+// finishLogin provisions the user the IdP vouched for and returns the URL to
+// send the browser back to the app with a login code.
+func (s *SSOService) finishLogin(ctx context.Context, login *ent.SSOLogin, identity *ssoIdentity) string {
+	u, err := s.provisionUser(ctx, login, identity)
+	if err != nil {
+		return ssoErrorRedirect(login, err)
+	}
+
+	code, err := generateInviteToken(32)
+	if err != nil {
+		return ssoErrorRedirect(login, err)
+	}
+
+	n, err := s.client.SSOLogin.Update().
+		Where(
+			ssologin.ID(login.ID),
+			ssologin.CompletedAtIsNil(),
+		).
+		SetUserID(u.ID).
+		SetCodeHash(hashToken(code)).
+		SetCompletedAt(time.Now()).
+		SetExpiresAt(time.Now().Add(ssoLoginDuration)).
+		Save(ctx)
+	if err != nil {
+		return ssoErrorRedirect(login, err)
+	}
+	if n == 0 {
+		return ssoErrorRedirect(login, errors.New("login already completed"))
+	}
+
+	params := url.Values{}
+	params.Set("code", code)
+	return redirectWithParams(login.RedirectURL, "", params)
+}
+
+// provisionUser finds or creates the user for identity and makes sure they
+// are a member of the organization. The email must be on one of the
+// organization's verified domains, or its IdP could sign in any account.
+func (s *SSOService) provisionUser(ctx context.Context, login *ent.SSOLogin, identity *ssoIdentity) (*ent.User, error) {
+	org, err := s.client.Organization.Query().
+		Where(organization.HasSSOLoginsWith(ssologin.ID(login.ID))).
+		WithApp().
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+
+	email := strings.ToLower(strings.TrimSpace(identity.Email))
+	_, domainName, _ := strings.Cut(email, "@")
+	domain, err := s.client.OrganizationDomain.Query().
+		Where(
+			organizationdomain.Domain(domainName),
+			organizationdomain.VerifiedAtNotNil(),
+			organizationdomain.HasOrganizationWith(organization.ID(org.ID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("email domain is not verified for this organization")
+	}
+
+	u, err := s.client.User.Query().
+		Where(user.EmailEqualFold(email)).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		u, err = s.client.User.Create().
+			SetEmail(email).
+			SetName(identity.Name).
+			SetIsVerified(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	case !u.IsActive:
+		return nil, errors.New("account is disabled")
+	case !u.IsVerified:
+		u, err = s.client.User.UpdateOne(u).
+			SetIsVerified(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ensureUserApp(ctx, s.client, u.ID, org.Edges.App.ID); err != nil {
+		return nil, err
+	}
+
+	isMember, err := s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.HasOrganizationWith(organization.ID(org.ID)),
+			organizationmember.HasUserWith(user.ID(u.ID)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		if !domain.AutoJoin {
+			return nil, errors.New("not a member of this organization")
+		}
+		hasSeat, err := newMemberHasSeat(ctx, s.client, org.ID)
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.client.OrganizationMember.Create().
+			SetOrganizationID(org.ID).
+			SetUserID(u.ID).
+			SetRole(organizationmember.Role(domain.DefaultRole)).
+			SetHasSeat(hasSeat).
+			Save(ctx)
+		if err != nil && !ent.IsConstraintError(err) {
+			return nil, err
+		}
+	}
+
+	return u, nil
+}
+
+// serviceProvider returns lem's SAML service provider for an organization.
+func (s *SSOService) serviceProvider(orgID int, idpMetadata *saml.EntityDescriptor) *saml.ServiceProvider {
+	metadataURL, _ := url.Parse(s.ssoURL(orgID, "/saml/metadata"))
+	acsURL, _ := url.Parse(s.ssoURL(orgID, "/saml/acs"))
+
+	return &saml.ServiceProvider{
+		Key:               s.spKey,
+		Certificate:       s.spCert,
+		MetadataURL:       *metadataURL,
+		AcsURL:            *acsURL,
+		IDPMetadata:       idpMetadata,
+		AuthnNameIDFormat: saml.EmailAddressNameIDFormat,
+	}
+}
+
+// oauthConfig returns the OAuth client configuration for an OIDC connection.
+func (s *SSOService) oauthConfig(orgID int, conn *ent.SSOConnection, provider *oidcProvider) *oauth2.Config {
+	return &oauth2.Config{
+		ClientID:     conn.OidcClientID,
+		ClientSecret: conn.OidcClientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  provider.authURL,
+			TokenURL: provider.tokenURL,
+		},
+		RedirectURL: s.ssoURL(orgID, "/oidc/callback"),
+		Scopes:      []string{"openid", "email", "profile"},
+	}
+}
+
+// provider returns the configuration of the OpenID provider at issuer,
+// discovering it if it is not cached.
+func (s *SSOService) provider(ctx context.Context, issuer string) (*oidcProvider, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p, ok := s.providers[issuer]; ok && time.Since(p.fetchedAt) < oidcDiscoveryInterval {
+		return p, nil
+	}
+
+	discoveryURL := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, discoveryURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to discover OpenID provider: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to discover OpenID provider: %s", resp.Status)
+	}
+
+	var doc struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+		TokenEndpoint         string `json:"token_endpoint"`
+		JWKSURI               string `json:"jwks_uri"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid OpenID provider configuration: %w", err)
+	}
+	if doc.Issuer != issuer {
+		return nil, fmt.Errorf("OpenID provider issuer %q does not match %q", doc.Issuer, issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, errors.New("incomplete OpenID provider configuration")
+	}
+
+	p := &oidcProvider{
+		issuer:    doc.Issuer,
+		authURL:   doc.AuthorizationEndpoint,
+		tokenURL:  doc.TokenEndpoint,
+		jwks:      newJWKSCache(doc.JWKSURI),
+		fetchedAt: time.Now(),
+	}
+	s.providers[issuer] = p
+	return p, nil
+}
+
+// fetchMetadata downloads IdP metadata.
+func (s *SSOService) fetchMetadata(ctx context.Context, metadataURL string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return nil, errors.New("invalid saml_idp_metadata_url")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch IdP metadata: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch IdP metadata: %s", resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
+
+// orgContext returns ctx limited to the organization's app. The endpoints
+// the browser and identity provider call during a login are not made for an
+// app.
+func (s *SSOService) orgContext(ctx context.Context, orgID int) (context.Context, error) {
+	appID, err := s.client.Organization.Query().
+		Where(organization.ID(orgID)).
+		QueryApp().
+		OnlyID(tenant.AllApps(ctx))
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+	return tenant.NewContext(ctx, appID), nil
+}
+
+// orgDomain returns one of the organization's domains.
+func (s *SSOService) orgDomain(ctx context.Context, orgID, domainID int) (*ent.OrganizationDomain, error) {
+	d, err := s.client.OrganizationDomain.Query().
+		Where(
+			organizationdomain.ID(domainID),
+			organizationdomain.HasOrganizationWith(organization.ID(orgID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, errors.New("domain not found")
+	}
+	return d, nil
+}
+
+func (s *SSOService) connectionResponse(orgID int, conn *ent.SSOConnection) *SSOConnectionResponse {
+	resp := &SSOConnectionResponse{
+		Connection: conn,
+		LoginURL:   s.ssoURL(orgID, "/login"),
+	}
+	switch conn.Protocol {
+	case ssoconnection.ProtocolSAML:
+		resp.SAMLEntityID = s.ssoURL(orgID, "/saml/metadata")
+		resp.SAMLACSURL = s.ssoURL(orgID, "/saml/acs")
+	case ssoconnection.ProtocolOIDC:
+		resp.OIDCRedirectURI = s.ssoURL(orgID, "/oidc/callback")
+	}
+	return resp
+}
+
+// ssoURL returns the URL of an SSO endpoint of an organization.
+func (s *SSOService) ssoURL(orgID int, path string) string {
+	return strings.TrimSuffix(s.cfg.BaseURL, "/") + "/sso/" + strconv.Itoa(orgID) + path
+}
+
+func domainResponse(d *ent.OrganizationDomain) *DomainResponse {
+	return &DomainResponse{
+		OrganizationDomain: d,
+		RecordName:         domainVerificationPrefix + d.Domain,
+		RecordType:         "TXT",
+		RecordValue:        d.VerificationToken,
+	}
+}
+
+// ssoErrorRedirect returns the URL to send the browser back to the app with
+// when a login failed.
+func ssoErrorRedirect(login *ent.SSOLogin, err error) string {
+	params := url.Values{}
+	params.Set("error", "sso_failed")
+	params.Set("error_description", err.Error())
+	return redirectWithParams(login.RedirectURL, "", params)
+}
+
+// parseIdPMetadata parses SAML IdP metadata, which is either an
+// EntityDescriptor or an EntitiesDescriptor containing one.
+func parseIdPMetadata(data []byte) (*saml.EntityDescriptor, error) {
+	if err := xrv.Validate(bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+
+	entity := &saml.EntityDescriptor{}
+	if err := xml.Unmarshal(data, entity); err != nil {
+		entities := &saml.EntitiesDescriptor{}
+		if xml.Unmarshal(data, entities) != nil {
+			return nil, err
+		}
+		entity = nil
+		for i, e := range entities.EntityDescriptors {
+			if len(e.IDPSSODescriptors) > 0 {
+				entity = &entities.EntityDescriptors[i]
+				break
+			}
+		}
+	}
+
+	if entity == nil || len(entity.IDPSSODescriptors) == 0 {
+		return nil, errors.New("no identity provider found in metadata")
+	}
+	return entity, nil
+}
+
+// samlAttribute returns the first value of the first of names present in the
+// assertion, matching either the attribute's name or friendly name.
+func samlAttribute(assertion *saml.Assertion, names []string) string {
+	for _, name := range names {
+		for _, statement := range assertion.AttributeStatements {
+			for _, attr := range statement.Attributes {
+				if (attr.Name == name || attr.FriendlyName == name) && len(attr.Values) > 0 {
+					return strings.TrimSpace(attr.Values[0].Value)
+				}
+			}
+		}
+	}
+	return ""
+}
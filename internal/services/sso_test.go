@@ -0,0 +1,369 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/xml"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/crewjam/saml"
+	"github.com/golang-jwt/jwt/v5"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// ssoProvisionTests are the users an identity provider vouches for, checked
+// against the domains newSSOFixture claims.
+var ssoProvisionTests = []struct {
+	name  string
+	email string
+	role  organizationmember.Role
+	err   string
+}{
+	{"auto-join domain", "new@open.test", organizationmember.RoleADMIN, ""},
+	{"email case is ignored", "Mixed.Case@OPEN.test", organizationmember.RoleADMIN, ""},
+	{"domain without auto-join", "new@closed.test", "", "not a member"},
+	{"member on domain without auto-join", "member@closed.test", organizationmember.RoleMEMBER, ""},
+	{"unverified domain", "new@pending.test", "", "not verified"},
+	{"other organization's domain", "new@other.test", "", "not verified"},
+}
+
+// ssoFixture is an organization that has claimed open.test, verified with
+// auto-join as ADMIN, closed.test, verified without auto-join and with one
+// member, and pending.test, unverified. Another organization of the app has
+// verified other.test.
+type ssoFixture struct {
+	client *ent.Client
+	sso    *SSOService
+	ctx    context.Context
+	orgID  int
+}
+
+func newSSOFixture(t *testing.T) *ssoFixture {
+	t.Helper()
+	client := newTestClient(t)
+
+	a := client.App.Create().
+		SetName("App").
+		SetSlug("app").
+		SetAllowedOrigins([]string{"https://app.test"}).
+		SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+
+	org := client.Organization.Create().SetName("Org").SetSlug("org").SaveX(ctx)
+	other := client.Organization.Create().SetName("Other").SetSlug("other").SaveX(ctx)
+	now := time.Now()
+	domain := func(org *ent.Organization, name string, verifiedAt *time.Time, autoJoin bool, role organizationdomain.DefaultRole) {
+		client.OrganizationDomain.Create().
+			SetOrganization(org).
+			SetDomain(name).
+			SetVerificationToken("token").
+			SetNillableVerifiedAt(verifiedAt).
+			SetAutoJoin(autoJoin).
+			SetDefaultRole(role).
+			SaveX(ctx)
+	}
+	domain(org, "open.test", &now, true, organizationdomain.DefaultRoleADMIN)
+	domain(org, "closed.test", &now, false, organizationdomain.DefaultRoleMEMBER)
+	domain(org, "pending.test", nil, true, organizationdomain.DefaultRoleMEMBER)
+	domain(other, "other.test", &now, true, organizationdomain.DefaultRoleMEMBER)
+
+	member := client.User.Create().SetEmail("member@closed.test").SaveX(ctx)
+	client.OrganizationMember.Create().
+		SetOrganization(org).
+		SetUser(member).
+		SetRole(organizationmember.RoleMEMBER).
+		SaveX(ctx)
+
+	sso, err := NewSSOService(&config.Config{BaseURL: "https://lem.test"}, client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &ssoFixture{client: client, sso: sso, ctx: ctx, orgID: org.ID}
+}
+
+// checkLogin checks the redirect back to the app after a login as email, and
+// the membership it left behind.
+func (f *ssoFixture) checkLogin(t *testing.T, redirect, email string, role organizationmember.Role, wantErr string) {
+	t.Helper()
+	u, err := url.Parse(redirect)
+	if err != nil || u.Host != "app.test" {
+		t.Fatalf("redirect = %q, want one to the app", redirect)
+	}
+	q := u.Query()
+
+	member, _ := f.client.OrganizationMember.Query().
+		Where(
+			organizationmember.HasOrganizationWith(organization.ID(f.orgID)),
+			organizationmember.HasUserWith(user.Email(strings.ToLower(email))),
+		).
+		Only(f.ctx)
+
+	if wantErr != "" {
+		if q.Get("code") != "" || !strings.Contains(q.Get("error_description"), wantErr) {
+			t.Errorf("redirect = %q, want error %q", redirect, wantErr)
+		}
+		if member != nil {
+			t.Errorf("%s became a member", email)
+		}
+		return
+	}
+
+	if q.Get("code") == "" {
+		t.Errorf("redirect = %q, want a login code", redirect)
+	}
+	if member == nil || member.Role != role {
+		t.Errorf("membership = %+v, want role %s", member, role)
+	}
+}
+
+// newTestIdP returns a SAML identity provider with its own key and
+// certificate that serves metadata for the fixture's service provider.
+func newTestIdP(t *testing.T, f *ssoFixture) *saml.IdentityProvider {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.test"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	metadataURL, _ := url.Parse("https://idp.test/metadata")
+	ssoURL, _ := url.Parse("https://idp.test/sso")
+	return &saml.IdentityProvider{
+		Key:         key,
+		Certificate: cert,
+		MetadataURL: *metadataURL,
+		SSOURL:      *ssoURL,
+		ServiceProviderProvider: spProviderFunc(func(r *http.Request, id string) (*saml.EntityDescriptor, error) {
+			return f.sso.serviceProvider(f.orgID, nil).Metadata(), nil
+		}),
+	}
+}
+
+type spProviderFunc func(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error)
+
+func (f spProviderFunc) GetServiceProvider(r *http.Request, serviceProviderID string) (*saml.EntityDescriptor, error) {
+	return f(r, serviceProviderID)
+}
+
+// samlLogin starts a login and has idp answer it with an assertion for email.
+func samlLogin(t *testing.T, f *ssoFixture, idp *saml.IdentityProvider, email string) (string, error) {
+	t.Helper()
+	authURL, err := f.sso.StartLogin(f.ctx, f.orgID, "https://app.test/sso/done")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req, err := saml.NewIdpAuthnRequest(idp, httptest.NewRequest(http.MethodGet, authURL, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := req.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	session := &saml.Session{
+		ID:             "session",
+		CreateTime:     time.Now(),
+		ExpireTime:     time.Now().Add(time.Hour),
+		Index:          "1",
+		NameID:         email,
+		UserEmail:      email,
+		UserCommonName: "Test User",
+	}
+	if err := (saml.DefaultAssertionMaker{}).MakeAssertion(req, session); err != nil {
+		t.Fatal(err)
+	}
+	form, err := req.PostBinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return f.sso.HandleSAMLResponse(context.Background(), f.orgID, form.SAMLResponse, form.RelayState)
+}
+
+func TestSSOSAMLLogin(t *testing.T) {
+	f := newSSOFixture(t)
+	idp := newTestIdP(t, f)
+	metadata, err := xml.Marshal(idp.Metadata())
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.client.SSOConnection.Create().
+		SetOrganizationID(f.orgID).
+		SetProtocol(ssoconnection.ProtocolSAML).
+		SetSamlIdpMetadata(string(metadata)).
+		SaveX(f.ctx)
+
+	for _, tt := range ssoProvisionTests {
+		t.Run(tt.name, func(t *testing.T) {
+			redirect, err := samlLogin(t, f, idp, tt.email)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.checkLogin(t, redirect, tt.email, tt.role, tt.err)
+		})
+	}
+
+	t.Run("assertion signed by another IdP", func(t *testing.T) {
+		redirect, err := samlLogin(t, f, newTestIdP(t, f), "forged@open.test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		f.checkLogin(t, redirect, "forged@open.test", "", "invalid SAML response")
+	})
+}
+
+// testOIDCIssuer is an OpenID provider whose token endpoint returns the ID
+// token set with next.
+type testOIDCIssuer struct {
+	*httptest.Server
+	keys *testKeySet
+
+	mu      sync.Mutex
+	idToken string
+}
+
+func newTestOIDCIssuer(t *testing.T) *testOIDCIssuer {
+	t.Helper()
+	issuer := &testOIDCIssuer{keys: newTestKeySet(t)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 issuer.URL,
+			"authorization_endpoint": issuer.URL + "/authorize",
+			"token_endpoint":         issuer.URL + "/token",
+			"jwks_uri":               issuer.keys.URL,
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("code") != "code" || r.FormValue("code_verifier") == "" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		issuer.mu.Lock()
+		defer issuer.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"id_token":     issuer.idToken,
+		})
+	})
+	issuer.Server = httptest.NewServer(mux)
+	t.Cleanup(issuer.Close)
+	return issuer
+}
+
+// next sets the ID token the next code exchange returns.
+func (i *testOIDCIssuer) next(t *testing.T, claims jwt.MapClaims) {
+	token := i.keys.sign(t, "test", claims)
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.idToken = token
+}
+
+// oidcLogin starts a login and has the issuer answer it with an ID token for
+// email, changed by change.
+func oidcLogin(t *testing.T, f *ssoFixture, issuer *testOIDCIssuer, email string, change func(jwt.MapClaims)) (string, error) {
+	t.Helper()
+	authURL, err := f.sso.StartLogin(f.ctx, f.orgID, "https://app.test/sso/done")
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := url.Parse(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := u.Query()
+
+	claims := jwt.MapClaims{
+		"iss":            issuer.URL,
+		"sub":            "subject",
+		"aud":            "lem",
+		"exp":            time.Now().Add(time.Minute).Unix(),
+		"nonce":          q.Get("nonce"),
+		"email":          email,
+		"email_verified": true,
+		"name":           "Test User",
+	}
+	if change != nil {
+		change(claims)
+	}
+	issuer.next(t, claims)
+
+	return f.sso.HandleOIDCCallback(context.Background(), f.orgID, q.Get("state"), "code", "")
+}
+
+func TestSSOOIDCLogin(t *testing.T) {
+	f := newSSOFixture(t)
+	issuer := newTestOIDCIssuer(t)
+	f.client.SSOConnection.Create().
+		SetOrganizationID(f.orgID).
+		SetProtocol(ssoconnection.ProtocolOIDC).
+		SetOidcIssuer(issuer.URL).
+		SetOidcClientID("lem").
+		SetOidcClientSecret("secret").
+		SaveX(f.ctx)
+
+	for _, tt := range ssoProvisionTests {
+		t.Run(tt.name, func(t *testing.T) {
+			redirect, err := oidcLogin(t, f, issuer, tt.email, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.checkLogin(t, redirect, tt.email, tt.role, tt.err)
+		})
+	}
+
+	rejected := []struct {
+		name   string
+		change func(jwt.MapClaims)
+		err    string
+	}{
+		{"other nonce", func(c jwt.MapClaims) { c["nonce"] = "other" }, "invalid ID token"},
+		{"other audience", func(c jwt.MapClaims) { c["aud"] = "other" }, "invalid ID token"},
+		{"other issuer", func(c jwt.MapClaims) { c["iss"] = "https://idp.test" }, "invalid ID token"},
+		{"unverified email", func(c jwt.MapClaims) { c["email_verified"] = false }, "not verified"},
+	}
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			redirect, err := oidcLogin(t, f, issuer, "rejected@open.test", tt.change)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.checkLogin(t, redirect, "rejected@open.test", "", tt.err)
+		})
+	}
+}
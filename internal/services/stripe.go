@@ -6,39 +6,96 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"log"
+	"strconv"
+	"time"
 
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/stripe/stripe-go/v81"
 	billingSession "github.com/stripe/stripe-go/v81/billingportal/session"
 	"github.com/stripe/stripe-go/v81/checkout/session"
 	"github.com/stripe/stripe-go/v81/customer"
+	stripeSubscription "github.com/stripe/stripe-go/v81/subscription"
+	"github.com/stripe/stripe-go/v81/subscriptionitem"
 	"github.com/stripe/stripe-go/v81/webhook"
 
 	"gigaboo.io/lem/internal/config"
 	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationmember"
 	"gigaboo.io/lem/internal/ent/plan"
 	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/ent/userapp"
+	"gigaboo.io/lem/internal/ent/webhookevent"
+	"gigaboo.io/lem/internal/entitlements"
+	"gigaboo.io/lem/internal/tenant"
+	lemWebhook "gigaboo.io/lem/internal/webhook"
 )
 
+const (
+	// maxEventAttempts is the number of times a Stripe event is processed
+	// before it is FAILED.
+	maxEventAttempts = 10
+	// eventPollInterval is how often stored Stripe events are checked for.
+	// It is short since apps wait for checkouts to be processed.
+	eventPollInterval = 2 * time.Second
+	// firstEventRetryDelay is the wait after an event's first failure. It
+	// doubles after every further failure, up to maxEventRetryDelay.
+	firstEventRetryDelay = time.Minute
+	maxEventRetryDelay   = time.Hour
+)
+
+// ErrEventNotFailed is returned when replaying a Stripe event that has not
+// failed.
+var ErrEventNotFailed = errors.New("only failed events can be replayed")
+
+// errSubscriptionCreated is returned when creating a subscription that a
+// concurrent event or reconciliation created meanwhile. Syncing it again
+// updates it instead, but not in the same transaction: on Postgres the
+// failed insert aborts it.
+var errSubscriptionCreated = errors.New("subscription was created concurrently")
+
 // StripeService handles Stripe operations.
+//
+// Subscriptions are kept in sync with Stripe from its webhook events, which
+// may arrive in any order, and by ReconcileSubscriptions for events that were
+// missed. Either way the subscription is read from Stripe as a whole and
+// copied over, so the latest state wins.
+//
+// Webhook events are stored when received and processed in the background,
+// in the order Stripe created them. An event that fails does not hold back
+// later ones: it is retried on its own, which is safe since processing it
+// reads the current state from Stripe.
 type StripeService struct {
-	cfg    *config.Config
-	client *ent.Client
+	cfg          *config.Config
+	client       *ent.Client
+	entitlements *entitlements.Resolver
 }
 
 // NewStripeService creates a new Stripe service.
 func NewStripeService(cfg *config.Config, client *ent.Client) *StripeService {
 	stripe.Key = cfg.StripeSecretKey
+	if cfg.StripeAPIURL != "" {
+		stripe.SetBackend(stripe.APIBackend, stripe.GetBackendWithConfig(stripe.APIBackend, &stripe.BackendConfig{
+			URL: stripe.String(cfg.StripeAPIURL),
+		}))
+	}
 	return &StripeService{
-		cfg:    cfg,
-		client: client,
+		cfg:          cfg,
+		client:       client,
+		entitlements: entitlements.NewResolver(client),
 	}
 }
 
 // CreateCheckoutInput represents checkout session request.
 type CreateCheckoutInput struct {
-	PlanID     int    `json:"plan_id" binding:"required"`
-	SuccessURL string `json:"success_url" binding:"required"`
-	CancelURL  string `json:"cancel_url" binding:"required"`
+	PlanID     int    `json:"plan_id" form:"plan_id" binding:"required"`
+	SuccessURL string `json:"success_url" form:"success_url" binding:"required"`
+	CancelURL  string `json:"cancel_url" form:"cancel_url" binding:"required"`
 }
 
 // CreateCheckoutSession creates a Stripe checkout session.
@@ -59,6 +116,14 @@ func (s *StripeService) CreateCheckoutSession(ctx context.Context, appID, userID
 		return nil, err
 	}
 
+	// The subscription carries the metadata too, so that its own events
+	// can create it whichever arrives first
+	metadata := map[string]string{
+		"app_id":  fmt.Sprintf("%d", appID),
+		"user_id": fmt.Sprintf("%d", userID),
+		"plan_id": fmt.Sprintf("%d", input.PlanID),
+	}
+
 	// Create checkout session
 	params := &stripe.CheckoutSessionParams{
 		Customer: stripe.String(customerID),
@@ -69,13 +134,12 @@ func (s *StripeService) CreateCheckoutSession(ctx context.Context, appID, userID
 				Quantity: stripe.Int64(1),
 			},
 		},
+		SubscriptionData: &stripe.CheckoutSessionSubscriptionDataParams{
+			Metadata: metadata,
+		},
 		SuccessURL: stripe.String(input.SuccessURL),
 		CancelURL:  stripe.String(input.CancelURL),
-		Metadata: map[string]string{
-			"app_id":  fmt.Sprintf("%d", appID),
-			"user_id": fmt.Sprintf("%d", userID),
-			"plan_id": fmt.Sprintf("%d", input.PlanID),
-		},
+		Metadata:   metadata,
 	}
 
 	return session.New(params)
@@ -84,35 +148,206 @@ func (s *StripeService) CreateCheckoutSession(ctx context.Context, appID, userID
 // CreatePortalSession creates a Stripe billing portal session.
 func (s *StripeService) CreatePortalSession(ctx context.Context, appID, userID int, returnURL string) (*stripe.BillingPortalSession, error) {
 	// Get Stripe customer ID from user app
-	userApps, err := s.client.UserApp.Query().
-		Where().
-		WithUser().
-		All(ctx)
+	ua, err := s.client.UserApp.Query().
+		Where(
+			userapp.HasUserWith(user.ID(userID)),
+			userapp.HasAppWith(app.ID(appID)),
+		).
+		Only(ctx)
 	if err != nil {
 		return nil, errors.New("user app not found")
 	}
 
-	var customerID string
-	for _, ua := range userApps {
-		if ua.Edges.User != nil && ua.Edges.User.ID == userID && ua.StripeCustomerID != "" {
-			customerID = ua.StripeCustomerID
-			break
-		}
+	if ua.StripeCustomerID == "" {
+		return nil, errors.New("no Stripe customer found")
+	}
+
+	params := &stripe.BillingPortalSessionParams{
+		Customer:  stripe.String(ua.StripeCustomerID),
+		ReturnURL: stripe.String(returnURL),
+	}
+
+	return billingSession.New(params)
+}
+
+// CreateOrganizationCheckoutSession creates a Stripe checkout session for an
+// organization's subscription, with a seat for each of its members. userID is
+// the member paying, whose email the organization's Stripe customer gets.
+func (s *StripeService) CreateOrganizationCheckoutSession(ctx context.Context, appID, orgID, userID int, input CreateCheckoutInput) (*stripe.CheckoutSession, error) {
+	p, err := s.client.Plan.Get(ctx, input.PlanID)
+	if err != nil {
+		return nil, errors.New("plan not found")
+	}
+
+	if p.StripePriceID == "" {
+		return nil, errors.New("plan has no Stripe price ID")
+	}
+
+	subscribed, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasOrganizationWith(organization.ID(orgID)),
+			subscription.StatusIn(entitlements.LiveStatuses...),
+		).
+		Exist(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if subscribed {
+		return nil, errors.New("organization already has a subscription")
+	}
+
+	seats, err := s.client.OrganizationMember.Query().
+		Where(organizationmember.HasOrganizationWith(organization.ID(orgID))).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customerID, err := s.getOrCreateOrganizationCustomer(ctx, appID, orgID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := map[string]string{
+		"app_id":          fmt.Sprintf("%d", appID),
+		"organization_id": fmt.Sprintf("%d", orgID),
+		"plan_id":         fmt.Sprintf("%d", input.PlanID),
+	}
+
+	params := &stripe.CheckoutSessionParams{
+		Customer: stripe.String(customerID),
+		Mode:     stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				Price:    stripe.String(p.StripePriceID),
+				Quantity: stripe.Int64(int64(max(seats, 1))),
+			},
+		},
+		SubscriptionData: &stripe.CheckoutSessionSubscriptionDataParams{
+			Metadata: metadata,
+		},
+		SuccessURL: stripe.String(input.SuccessURL),
+		CancelURL:  stripe.String(input.CancelURL),
+		Metadata:   metadata,
 	}
 
-	if customerID == "" {
+	return session.New(params)
+}
+
+// CreateOrganizationPortalSession creates a Stripe billing portal session for
+// an organization.
+func (s *StripeService) CreateOrganizationPortalSession(ctx context.Context, orgID int, returnURL string) (*stripe.BillingPortalSession, error) {
+	org, err := s.client.Organization.Get(ctx, orgID)
+	if err != nil {
+		return nil, errors.New("organization not found")
+	}
+
+	if org.StripeCustomerID == "" {
 		return nil, errors.New("no Stripe customer found")
 	}
 
 	params := &stripe.BillingPortalSessionParams{
-		Customer:  stripe.String(customerID),
+		Customer:  stripe.String(org.StripeCustomerID),
 		ReturnURL: stripe.String(returnURL),
 	}
 
 	return billingSession.New(params)
 }
 
-// HandleWebhook processes Stripe webhook events.
+// GetOrganizationSubscription returns an organization's live subscription and
+// how many of its seats are assigned.
+func (s *StripeService) GetOrganizationSubscription(ctx context.Context, orgID int) (*ent.Subscription, int, error) {
+	sub, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasOrganizationWith(organization.ID(orgID)),
+			subscription.StatusIn(entitlements.LiveStatuses...),
+		).
+		WithPlan().
+		First(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	seated, err := s.seatedMembers(ctx, orgID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sub, seated, nil
+}
+
+// SyncSeats sets the quantity of an organization's subscription in Stripe
+// to its number of seated members, prorated, and reports whether it changed.
+func (s *StripeService) SyncSeats(ctx context.Context, orgID int) (bool, error) {
+	sub, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasOrganizationWith(organization.ID(orgID)),
+			subscription.StatusIn(entitlements.LiveStatuses...),
+			subscription.StripeSubscriptionIDNEQ(""),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil // Nothing to bill
+		}
+		return false, err
+	}
+
+	seated, err := s.seatedMembers(ctx, orgID)
+	if err != nil {
+		return false, err
+	}
+	// Stripe needs a quantity of at least 1
+	seats := max(seated, 1)
+	if seats == sub.Quantity {
+		return false, nil
+	}
+
+	stripeSub, err := stripeSubscription.Get(sub.StripeSubscriptionID, nil)
+	if err != nil {
+		return false, err
+	}
+	if stripeSub.Items == nil || len(stripeSub.Items.Data) == 0 {
+		return false, errors.New("subscription has no items in Stripe")
+	}
+	if item := stripeSub.Items.Data[0]; item.Quantity != int64(seats) {
+		_, err := subscriptionitem.Update(item.ID, &stripe.SubscriptionItemParams{
+			Quantity: stripe.Int64(int64(seats)),
+		})
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = s.client.Subscription.UpdateOne(sub).
+		SetQuantity(seats).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// SeatsChanged syncs an organization's seats after members were added,
+// removed or given seats. Failures are logged and left to
+// ReconcileSubscriptions, since the change itself has been made.
+func (s *StripeService) SeatsChanged(ctx context.Context, orgID int) {
+	if _, err := s.SyncSeats(ctx, orgID); err != nil {
+		log.Printf("Failed to sync seats of organization %d: %v", orgID, err)
+	}
+}
+
+func (s *StripeService) seatedMembers(ctx context.Context, orgID int) (int, error) {
+	return s.client.OrganizationMember.Query().
+		Where(
+			organizationmember.HasOrganizationWith(organization.ID(orgID)),
+			organizationmember.HasSeat(true),
+		).
+		Count(ctx)
+}
+
+// HandleWebhook stores a Stripe webhook event to be processed by
+// RunWebhookProcessor. Events that were already received, which Stripe sends
+// again when it misses a response, are ignored.
 func (s *StripeService) HandleWebhook(ctx context.Context, body io.Reader, signature string) error {
 	payload, err := io.ReadAll(body)
 	if err != nil {
@@ -124,6 +359,155 @@ func (s *StripeService) HandleWebhook(ctx context.Context, body io.Reader, signa
 		return err
 	}
 
+	err = s.client.WebhookEvent.Create().
+		SetEventID(event.ID).
+		SetType(string(event.Type)).
+		SetPayload(string(payload)).
+		SetEventCreatedAt(time.Unix(event.Created, 0)).
+		Exec(ctx)
+	if ent.IsConstraintError(err) {
+		return nil // Already received
+	}
+	return err
+}
+
+// RunWebhookProcessor processes stored Stripe webhook events until ctx is
+// done. An event being processed when ctx is done is finished first.
+func (s *StripeService) RunWebhookProcessor(ctx context.Context) {
+	ticker := time.NewTicker(eventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := s.ProcessNextWebhookEvent(context.WithoutCancel(ctx))
+			if err != nil {
+				log.Printf("Failed to process Stripe events: %v", err)
+			}
+			if err != nil || !processed {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessNextWebhookEvent processes the oldest due Stripe event that is not
+// being processed elsewhere, and reports whether there was one.
+//
+// The event's changes are committed in the same transaction that marks it
+// processed, so they are made at most once. A failed event is retried with
+// backoff until maxEventAttempts, after which it is FAILED until replayed.
+func (s *StripeService) ProcessNextWebhookEvent(ctx context.Context) (bool, error) {
+	ctx = tenant.AllApps(ctx)
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	evt, err := tx.WebhookEvent.Query().
+		Where(
+			webhookevent.StatusEQ(webhookevent.StatusPENDING),
+			webhookevent.NextAttemptAtLTE(time.Now()),
+		).
+		Where(skipLocked).
+		Order(ent.Asc(webhookevent.FieldEventCreatedAt), ent.Asc(webhookevent.FieldID)).
+		First(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	// Everything the event changes goes through the transaction
+	txService := &StripeService{cfg: s.cfg, client: tx.Client()}
+	err = txService.processEvent(ctx, evt)
+	if err == nil {
+		err = tx.WebhookEvent.UpdateOne(evt).
+			SetStatus(webhookevent.StatusPROCESSED).
+			AddAttempts(1).
+			SetProcessedAt(time.Now()).
+			SetLastError("").
+			Exec(ctx)
+	}
+	if err == nil {
+		if err = tx.Commit(); err == nil {
+			return true, nil
+		}
+	} else {
+		tx.Rollback()
+	}
+
+	// The event is still due, so it is processed again right away
+	if errors.Is(err, errSubscriptionCreated) {
+		return true, nil
+	}
+
+	log.Printf("Failed to process Stripe event %s: %v", evt.EventID, err)
+
+	attempts := evt.Attempts + 1
+	update := s.client.WebhookEvent.UpdateOneID(evt.ID).
+		SetAttempts(attempts).
+		SetLastError(err.Error())
+	if attempts >= maxEventAttempts {
+		update.SetStatus(webhookevent.StatusFAILED)
+	} else {
+		update.SetNextAttemptAt(time.Now().Add(eventRetryDelay(attempts)))
+	}
+	return true, update.Exec(ctx)
+}
+
+// skipLocked locks the rows a query selects until its transaction ends,
+// skipping rows that other transactions locked. SQLite, used in tests, has
+// no row locks and runs one write transaction at a time instead.
+func skipLocked(s *sql.Selector) {
+	if s.Dialect() != dialect.SQLite {
+		s.ForUpdate(sql.WithLockAction(sql.SkipLocked))
+	}
+}
+
+// eventRetryDelay returns how long to wait before retrying an event that has
+// failed attempts times.
+func eventRetryDelay(attempts int) time.Duration {
+	delay := firstEventRetryDelay << (attempts - 1)
+	if delay <= 0 || delay > maxEventRetryDelay {
+		delay = maxEventRetryDelay
+	}
+	return delay
+}
+
+// ReplayWebhookEvent queues a FAILED Stripe event to be processed again with
+// a fresh set of attempts.
+func (s *StripeService) ReplayWebhookEvent(ctx context.Context, id int) (*ent.WebhookEvent, error) {
+	evt, err := s.client.WebhookEvent.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if evt.Status != webhookevent.StatusFAILED {
+		return nil, ErrEventNotFailed
+	}
+
+	return s.client.WebhookEvent.UpdateOne(evt).
+		SetStatus(webhookevent.StatusPENDING).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+}
+
+// processEvent applies a stored Stripe event.
+func (s *StripeService) processEvent(ctx context.Context, evt *ent.WebhookEvent) error {
+	var event stripe.Event
+	if err := json.Unmarshal([]byte(evt.Payload), &event); err != nil {
+		return err
+	}
+
 	switch event.Type {
 	case "checkout.session.completed":
 		var cs stripe.CheckoutSession
@@ -132,19 +516,40 @@ func (s *StripeService) HandleWebhook(ctx context.Context, body io.Reader, signa
 		}
 		return s.handleCheckoutCompleted(ctx, &cs)
 
-	case "customer.subscription.updated":
+	case "customer.subscription.created", "customer.subscription.updated", "customer.subscription.deleted":
 		var sub stripe.Subscription
 		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
 			return err
 		}
-		return s.handleSubscriptionUpdated(ctx, &sub)
+		// The event's copy may be older than another event already
+		// handled, so the current state is read from Stripe
+		_, err := s.syncSubscriptionByID(ctx, sub.ID, nil)
+		return err
 
-	case "customer.subscription.deleted":
+	case "customer.subscription.trial_will_end":
 		var sub stripe.Subscription
 		if err := json.Unmarshal(event.Data.Raw, &sub); err != nil {
 			return err
 		}
-		return s.handleSubscriptionDeleted(ctx, &sub)
+		return s.forwardSubscriptionEvent(ctx, sub.ID, lemWebhook.EventSubscriptionTrialWillEnd)
+
+	case "invoice.paid", "invoice.payment_failed":
+		var inv stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &inv); err != nil {
+			return err
+		}
+		if inv.Subscription == nil {
+			return nil // Not a subscription invoice
+		}
+		// Paying renews the period and recovers past due subscriptions;
+		// failing makes them past due
+		if _, err := s.syncSubscriptionByID(ctx, inv.Subscription.ID, nil); err != nil {
+			return err
+		}
+		if event.Type == "invoice.payment_failed" {
+			return s.forwardSubscriptionEvent(ctx, inv.Subscription.ID, lemWebhook.EventSubscriptionPaymentFailed)
+		}
+		return nil
 	}
 
 	return nil
@@ -152,15 +557,17 @@ func (s *StripeService) HandleWebhook(ctx context.Context, body io.Reader, signa
 
 func (s *StripeService) getOrCreateCustomer(ctx context.Context, appID, userID int) (string, error) {
 	// Check if user already has a Stripe customer ID
-	userApps, err := s.client.UserApp.Query().
-		WithUser().
-		All(ctx)
-	if err == nil {
-		for _, ua := range userApps {
-			if ua.Edges.User != nil && ua.Edges.User.ID == userID && ua.StripeCustomerID != "" {
-				return ua.StripeCustomerID, nil
-			}
-		}
+	ua, err := s.client.UserApp.Query().
+		Where(
+			userapp.HasUserWith(user.ID(userID)),
+			userapp.HasAppWith(app.ID(appID)),
+		).
+		Only(ctx)
+	if err != nil {
+		return "", err
+	}
+	if ua.StripeCustomerID != "" {
+		return ua.StripeCustomerID, nil
 	}
 
 	// Get user
@@ -184,89 +591,448 @@ func (s *StripeService) getOrCreateCustomer(ctx context.Context, appID, userID i
 		return "", err
 	}
 
+	// Keep it for the next checkout and the billing portal
+	err = s.client.UserApp.UpdateOne(ua).
+		SetStripeCustomerID(cust.ID).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return cust.ID, nil
+}
+
+func (s *StripeService) getOrCreateOrganizationCustomer(ctx context.Context, appID, orgID, userID int) (string, error) {
+	org, err := s.client.Organization.Get(ctx, orgID)
+	if err != nil {
+		return "", err
+	}
+	if org.StripeCustomerID != "" {
+		return org.StripeCustomerID, nil
+	}
+
+	u, err := s.client.User.Get(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	params := &stripe.CustomerParams{
+		Email: stripe.String(u.Email),
+		Name:  stripe.String(org.Name),
+		Metadata: map[string]string{
+			"app_id":          fmt.Sprintf("%d", appID),
+			"organization_id": fmt.Sprintf("%d", orgID),
+		},
+	}
+
+	cust, err := customer.New(params)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.client.Organization.UpdateOne(org).
+		SetStripeCustomerID(cust.ID).
+		Exec(ctx)
+	if err != nil {
+		return "", err
+	}
+
 	return cust.ID, nil
 }
 
 func (s *StripeService) handleCheckoutCompleted(ctx context.Context, cs *stripe.CheckoutSession) error {
-	// Parse metadata
-	var appID, userID, planID int
-	fmt.Sscanf(cs.Metadata["app_id"], "%d", &appID)
-	fmt.Sscanf(cs.Metadata["user_id"], "%d", &userID)
-	fmt.Sscanf(cs.Metadata["plan_id"], "%d", &planID)
+	if cs.Subscription == nil {
+		return nil // Not a subscription checkout
+	}
 
-	// Get plan
-	p, err := s.client.Plan.Get(ctx, planID)
+	// Subscriptions from checkouts started before their metadata was set
+	// on the subscription itself are created from the checkout's
+	_, err := s.syncSubscriptionByID(ctx, cs.Subscription.ID, cs.Metadata)
+	return err
+}
+
+// forwardSubscriptionEvent sends an app a webhook about one of its
+// subscriptions.
+func (s *StripeService) forwardSubscriptionEvent(ctx context.Context, stripeSubscriptionID, eventType string) error {
+	id, err := s.client.Subscription.Query().
+		Where(subscription.StripeSubscriptionID(stripeSubscriptionID)).
+		OnlyID(ctx)
 	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil // Subscription not found, ignore
+		}
 		return err
 	}
+	return lemWebhook.Emit(ctx, s.client, ent.TypeSubscription, id, eventType)
+}
 
-	// Create subscription record
-	_, err = s.client.Subscription.Create().
-		SetUserID(userID).
-		SetAppID(appID).
-		SetPlanID(p.ID).
-		SetStripeSubscriptionID(cs.Subscription.ID).
-		SetStatus(subscription.StatusACTIVE).
-		Save(ctx)
+// SyncOutcome is what syncing a subscription from Stripe did.
+type SyncOutcome string
 
-	return err
+// Sync outcomes.
+const (
+	SyncUnchanged SyncOutcome = "unchanged"
+	SyncUpdated   SyncOutcome = "updated"
+	SyncCreated   SyncOutcome = "created"
+	// SyncSkipped is for subscriptions that are not lem's, e.g. of another
+	// product on the same Stripe account.
+	SyncSkipped SyncOutcome = "skipped"
+)
+
+// syncSubscriptionByID reads a subscription from Stripe and syncs it.
+func (s *StripeService) syncSubscriptionByID(ctx context.Context, id string, fallbackMetadata map[string]string) (SyncOutcome, error) {
+	sub, err := stripeSubscription.Get(id, nil)
+	if err != nil {
+		return "", err
+	}
+	return s.syncSubscription(ctx, sub, fallbackMetadata)
 }
 
-func (s *StripeService) handleSubscriptionUpdated(ctx context.Context, sub *stripe.Subscription) error {
-	// Find subscription by Stripe ID
+// syncSubscription copies a Stripe subscription's status, billing period,
+// trial, cancellation and plan to its subscription, creating it from the
+// app_id, user_id and plan_id in its metadata, or else fallbackMetadata, if
+// there is none yet. Nothing is written if nothing changed, so that apps are
+// only sent subscription webhooks for actual changes.
+func (s *StripeService) syncSubscription(ctx context.Context, sub *stripe.Subscription, fallbackMetadata map[string]string) (SyncOutcome, error) {
 	existing, err := s.client.Subscription.Query().
 		Where(subscription.StripeSubscriptionID(sub.ID)).
-		First(ctx)
+		WithApp().
+		WithPlan().
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return "", err
+	}
+
+	if existing == nil {
+		return s.createSubscription(ctx, sub, fallbackMetadata)
+	}
+
+	status, ok := subscriptionStatus(sub.Status)
+	if !ok {
+		status = existing.Status
+	}
+
+	update := s.client.Subscription.UpdateOne(existing)
+	changed := false
+	if status != existing.Status {
+		update.SetStatus(status)
+		changed = true
+	}
+	if setTime(existing.CurrentPeriodStart, sub.CurrentPeriodStart, update.SetCurrentPeriodStart, update.ClearCurrentPeriodStart) {
+		changed = true
+	}
+	if setTime(existing.CurrentPeriodEnd, sub.CurrentPeriodEnd, update.SetCurrentPeriodEnd, update.ClearCurrentPeriodEnd) {
+		changed = true
+	}
+	if setTime(existing.CanceledAt, canceledAt(sub), update.SetCanceledAt, update.ClearCanceledAt) {
+		changed = true
+	}
+	if setTime(existing.TrialEnd, sub.TrialEnd, update.SetTrialEnd, update.ClearTrialEnd) {
+		changed = true
+	}
+	if sub.CancelAtPeriodEnd != existing.CancelAtPeriodEnd {
+		update.SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd)
+		changed = true
+	}
+	if quantity := subscriptionQuantity(sub); quantity != existing.Quantity {
+		update.SetQuantity(quantity)
+		changed = true
+	}
+
+	// Plan changes made in the billing portal change the price
+	p, err := s.planForPrice(ctx, existing.Edges.App.ID, sub)
 	if err != nil {
-		return nil // Subscription not found, ignore
+		return "", err
+	}
+	if p != nil && p.ID != existing.Edges.Plan.ID {
+		update.SetPlanID(p.ID)
+		changed = true
 	}
 
-	// Map Stripe status to our status
-	var status subscription.Status
-	switch sub.Status {
+	if !changed {
+		return SyncUnchanged, nil
+	}
+	if err := update.Exec(ctx); err != nil {
+		return "", err
+	}
+	return SyncUpdated, nil
+}
+
+func (s *StripeService) createSubscription(ctx context.Context, sub *stripe.Subscription, fallbackMetadata map[string]string) (SyncOutcome, error) {
+	metadata := sub.Metadata
+	if metadata["app_id"] == "" {
+		metadata = fallbackMetadata
+	}
+	// Subscriptions belong to a user, or to an organization when bought
+	// with CreateOrganizationCheckoutSession
+	appID, errApp := strconv.Atoi(metadata["app_id"])
+	userID, errUser := strconv.Atoi(metadata["user_id"])
+	orgID, errOrg := strconv.Atoi(metadata["organization_id"])
+	if errApp != nil || (errUser != nil && errOrg != nil) {
+		return SyncSkipped, nil
+	}
+
+	// Create the subscription in its app, on the plan of its price or else
+	// the one it was bought for
+	ctx = tenant.NewContext(ctx, appID)
+	p, err := s.planForPrice(ctx, appID, sub)
+	if err != nil {
+		return "", err
+	}
+	if p == nil {
+		planID, err := strconv.Atoi(metadata["plan_id"])
+		if err != nil {
+			return SyncSkipped, nil
+		}
+		if p, err = s.client.Plan.Get(ctx, planID); err != nil {
+			return "", err
+		}
+	}
+
+	status, ok := subscriptionStatus(sub.Status)
+	if !ok {
+		status = subscription.StatusINCOMPLETE
+	}
+
+	create := s.client.Subscription.Create().
+		SetAppID(appID).
+		SetPlanID(p.ID).
+		SetStripeSubscriptionID(sub.ID).
+		SetStatus(status).
+		SetQuantity(subscriptionQuantity(sub)).
+		SetNillableCurrentPeriodStart(unixTime(sub.CurrentPeriodStart)).
+		SetNillableCurrentPeriodEnd(unixTime(sub.CurrentPeriodEnd)).
+		SetNillableCanceledAt(unixTime(canceledAt(sub))).
+		SetNillableTrialEnd(unixTime(sub.TrialEnd)).
+		SetCancelAtPeriodEnd(sub.CancelAtPeriodEnd)
+	if errOrg == nil {
+		create.SetOrganizationID(orgID)
+	} else {
+		create.SetUserID(userID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		if sqlgraph.IsUniqueConstraintError(err) {
+			return "", fmt.Errorf("%w: %v", errSubscriptionCreated, err)
+		}
+		return "", err
+	}
+
+	// The checkout paid for a seat for every member
+	if errOrg == nil {
+		err = s.client.OrganizationMember.Update().
+			Where(organizationmember.HasOrganizationWith(organization.ID(orgID))).
+			SetHasSeat(true).
+			Exec(ctx)
+		if err != nil {
+			return "", err
+		}
+	}
+	return SyncCreated, nil
+}
+
+// subscriptionQuantity returns the quantity of a subscription's first item,
+// which is the number of seats for organizations.
+func subscriptionQuantity(sub *stripe.Subscription) int {
+	if sub.Items == nil || len(sub.Items.Data) == 0 || sub.Items.Data[0].Quantity < 1 {
+		return 1
+	}
+	return int(sub.Items.Data[0].Quantity)
+}
+
+// planForPrice returns the app's plan with the price of a subscription's
+// first item, or nil if there is none. Prices a plan had before its price
+// changed name it in their metadata.
+func (s *StripeService) planForPrice(ctx context.Context, appID int, sub *stripe.Subscription) (*ent.Plan, error) {
+	if sub.Items == nil || len(sub.Items.Data) == 0 || sub.Items.Data[0].Price == nil {
+		return nil, nil
+	}
+	pr := sub.Items.Data[0].Price
+	byPrice := plan.StripePriceID(pr.ID)
+	if planID, err := strconv.Atoi(pr.Metadata["plan_id"]); err == nil {
+		byPrice = plan.Or(byPrice, plan.ID(planID))
+	}
+	p, err := s.client.Plan.Query().
+		Where(
+			plan.HasAppWith(app.ID(appID)),
+			byPrice,
+		).
+		Order(ent.Asc(plan.FieldID)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return p, err
+}
+
+// subscriptionStatus maps a Stripe subscription status to ours. ok is false
+// for unknown statuses.
+func subscriptionStatus(status stripe.SubscriptionStatus) (subscription.Status, bool) {
+	switch status {
 	case stripe.SubscriptionStatusActive:
-		status = subscription.StatusACTIVE
+		return subscription.StatusACTIVE, true
 	case stripe.SubscriptionStatusCanceled:
-		status = subscription.StatusCANCELED
-	case stripe.SubscriptionStatusPastDue:
-		status = subscription.StatusPAST_DUE
+		return subscription.StatusCANCELED, true
+	case stripe.SubscriptionStatusPastDue, stripe.SubscriptionStatusUnpaid:
+		return subscription.StatusPAST_DUE, true
 	case stripe.SubscriptionStatusTrialing:
-		status = subscription.StatusTRIALING
+		return subscription.StatusTRIALING, true
 	case stripe.SubscriptionStatusIncomplete:
-		status = subscription.StatusINCOMPLETE
+		return subscription.StatusINCOMPLETE, true
+	case stripe.SubscriptionStatusIncompleteExpired, stripe.SubscriptionStatusPaused:
+		return subscription.StatusEXPIRED, true
 	default:
-		status = subscription.StatusACTIVE
+		return "", false
 	}
+}
 
-	// Update subscription
-	_, err = s.client.Subscription.UpdateOne(existing).
-		SetStatus(status).
-		Save(ctx)
+// canceledAt returns when a subscription was canceled, or when it ended for
+// subscriptions that ended without being canceled first.
+func canceledAt(sub *stripe.Subscription) int64 {
+	if sub.CanceledAt != 0 {
+		return sub.CanceledAt
+	}
+	return sub.EndedAt
+}
 
-	return err
+// unixTime converts a Stripe timestamp, where 0 means unset.
+func unixTime(t int64) *time.Time {
+	if t == 0 {
+		return nil
+	}
+	v := time.Unix(t, 0)
+	return &v
 }
 
-func (s *StripeService) handleSubscriptionDeleted(ctx context.Context, sub *stripe.Subscription) error {
-	// Find subscription by Stripe ID
-	existing, err := s.client.Subscription.Query().
-		Where(subscription.StripeSubscriptionID(sub.ID)).
-		First(ctx)
+// setTime sets a time field to a Stripe timestamp with set or clear, and
+// reports whether that changes its current value.
+func setTime[U any](current *time.Time, t int64, set func(time.Time) U, clear func() U) bool {
+	next := unixTime(t)
+	switch {
+	case next == nil && current == nil:
+		return false
+	case next == nil:
+		clear()
+		return true
+	case current != nil && current.Equal(*next):
+		return false
+	default:
+		set(*next)
+		return true
+	}
+}
+
+// ReconcileResult counts what reconciling subscriptions did.
+type ReconcileResult struct {
+	Checked   int `json:"checked"`
+	Unchanged int `json:"unchanged"`
+	Updated   int `json:"updated"`
+	Created   int `json:"created"`
+	Skipped   int `json:"skipped"`
+	Failed    int `json:"failed"`
+	// Organizations whose seat count was out of date
+	SeatsSynced int `json:"seats_synced"`
+}
+
+// ReconcileSubscriptions re-syncs every subscription on the Stripe account,
+// including canceled ones, to correct for webhook events that were missed
+// or failed. Subscriptions that fail to sync are logged and counted.
+func (s *StripeService) ReconcileSubscriptions(ctx context.Context) (*ReconcileResult, error) {
+	ctx = tenant.AllApps(ctx)
+	result := &ReconcileResult{}
+
+	params := &stripe.SubscriptionListParams{
+		Status: stripe.String("all"),
+	}
+	params.Context = ctx
+	params.Filters.AddFilter("limit", "", "100")
+
+	iter := stripeSubscription.List(params)
+	for iter.Next() {
+		sub := iter.Subscription()
+		result.Checked++
+
+		outcome, err := s.syncSubscription(ctx, sub, nil)
+		if errors.Is(err, errSubscriptionCreated) {
+			outcome, err = s.syncSubscription(ctx, sub, nil)
+		}
+		if err != nil {
+			log.Printf("Failed to reconcile Stripe subscription %s: %v", sub.ID, err)
+			result.Failed++
+			continue
+		}
+		switch outcome {
+		case SyncUpdated:
+			result.Updated++
+		case SyncCreated:
+			result.Created++
+		case SyncSkipped:
+			result.Skipped++
+		default:
+			result.Unchanged++
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return result, err
+	}
+
+	// Catch seat changes whose sync failed or that were made without one,
+	// e.g. members joining through SSO
+	orgIDs, err := s.client.Subscription.Query().
+		Where(
+			subscription.HasOrganization(),
+			subscription.StatusIn(entitlements.LiveStatuses...),
+		).
+		QueryOrganization().
+		IDs(ctx)
 	if err != nil {
-		return nil // Subscription not found, ignore
+		return result, err
+	}
+	for _, orgID := range orgIDs {
+		synced, err := s.SyncSeats(ctx, orgID)
+		if err != nil {
+			log.Printf("Failed to sync seats of organization %d: %v", orgID, err)
+			result.Failed++
+			continue
+		}
+		if synced {
+			result.SeatsSynced++
+		}
 	}
+	return result, nil
+}
 
-	// Update status to canceled
-	_, err = s.client.Subscription.UpdateOne(existing).
-		SetStatus(subscription.StatusCANCELED).
-		Save(ctx)
+// RunReconciliation reconciles subscriptions every interval until ctx is
+// done.
+func (s *StripeService) RunReconciliation(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
 
-	return err
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		result, err := s.ReconcileSubscriptions(ctx)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("Failed to reconcile Stripe subscriptions: %v", err)
+			}
+			continue
+		}
+		log.Printf("Reconciled %d Stripe subscriptions: %d updated, %d created, %d failed",
+			result.Checked, result.Updated, result.Created, result.Failed)
+	}
 }
 
 // GetPlans returns all active plans for an app.
 func (s *StripeService) GetPlans(ctx context.Context, appID int) ([]*ent.Plan, error) {
 	return s.client.Plan.Query().
-		Where(plan.IsActive(true)).
+		Where(
+			plan.HasAppWith(app.ID(appID)),
+			plan.IsActive(true),
+		).
 		All(ctx)
 }
 
@@ -274,6 +1040,8 @@ func (s *StripeService) GetPlans(ctx context.Context, appID int) ([]*ent.Plan, e
 func (s *StripeService) GetCurrentSubscription(ctx context.Context, appID, userID int) (*ent.Subscription, error) {
 	return s.client.Subscription.Query().
 		Where(
+			subscription.HasAppWith(app.ID(appID)),
+			subscription.HasUserWith(user.ID(userID)),
 			subscription.StatusIn(
 				subscription.StatusACTIVE,
 				subscription.StatusTRIALING,
@@ -283,3 +1051,14 @@ func (s *StripeService) GetCurrentSubscription(ctx context.Context, appID, userI
 		WithUser().
 		First(ctx)
 }
+
+// GetEntitlements returns the features and limits of a user of an app.
+func (s *StripeService) GetEntitlements(ctx context.Context, appID, userID int) (*entitlements.Entitlements, error) {
+	return s.entitlements.ForUser(ctx, appID, userID)
+}
+
+// GetOrganizationEntitlements returns the features and limits of an
+// organization.
+func (s *StripeService) GetOrganizationEntitlements(ctx context.Context, appID, orgID int) (*entitlements.Entitlements, error) {
+	return s.entitlements.ForOrganization(ctx, appID, orgID)
+}
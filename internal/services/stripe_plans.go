@@ -0,0 +1,426 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/price"
+	"github.com/stripe/stripe-go/v81/product"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/predicate"
+)
+
+// Plans are published to Stripe as one product per app, with a price for
+// each of its active paid plans. Stripe prices cannot be changed, so a plan
+// whose price, currency or billing interval changed gets a new price and the
+// old one is archived. Subscriptions on archived prices keep them until they
+// change plan.
+
+// What publishing does to a Stripe product or price.
+const (
+	PlanSyncCreate  = "create"
+	PlanSyncUpdate  = "update"
+	PlanSyncReplace = "replace"
+	PlanSyncArchive = "archive"
+)
+
+// PlanSyncChange is a difference between an app's plans and Stripe.
+type PlanSyncChange struct {
+	// Object is "product" or "price"
+	Object   string `json:"object"`
+	Action   string `json:"action"`
+	PlanID   int    `json:"plan_id,omitempty"`
+	PlanSlug string `json:"plan_slug,omitempty"`
+	// StripeID is the product or price in Stripe now, if any
+	StripeID string `json:"stripe_id,omitempty"`
+	// NewStripeID is the product or price created when publishing
+	NewStripeID string   `json:"new_stripe_id,omitempty"`
+	Reasons     []string `json:"reasons"`
+}
+
+// PlanSyncResult lists the changes publishing an app's plans makes, or made.
+type PlanSyncResult struct {
+	DryRun    bool             `json:"dry_run"`
+	ProductID string           `json:"product_id"`
+	Changes   []PlanSyncChange `json:"changes"`
+}
+
+// ErrPlanChanged is returned when a plan's price is changed by someone else
+// while it is being published.
+var ErrPlanChanged = errors.New("plan changed while publishing, try again")
+
+// SyncPlans publishes an app's plans to Stripe, or with dryRun only lists the
+// changes it would make. Publishing stops at the first error, and since it
+// compares against Stripe every time it can simply be run again.
+func (s *StripeService) SyncPlans(ctx context.Context, appID int, dryRun bool) (*PlanSyncResult, error) {
+	a, err := s.client.App.Get(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	plans, err := s.client.Plan.Query().
+		Where(plan.HasAppWith(app.ID(appID))).
+		Order(ent.Asc(plan.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &PlanSyncResult{
+		DryRun:  dryRun,
+		Changes: []PlanSyncChange{},
+	}
+
+	// The app's product
+	var prod *stripe.Product
+	if a.StripeProductID != "" {
+		if prod, err = getStripeProduct(a.StripeProductID); err != nil {
+			return nil, err
+		}
+	}
+	prodChange := productChange(a, prod)
+	if prodChange.Action != "" && !dryRun {
+		if err := s.applyProductChange(ctx, a, &prodChange); err != nil {
+			return nil, err
+		}
+	}
+	if prodChange.Action != "" {
+		result.Changes = append(result.Changes, prodChange)
+	}
+	switch {
+	case prodChange.NewStripeID != "":
+		result.ProductID = prodChange.NewStripeID
+	case prodChange.Action != PlanSyncCreate:
+		result.ProductID = a.StripeProductID
+	}
+
+	// A price for each plan
+	used := make(map[string]bool)
+	for _, p := range plans {
+		used[p.StripePriceID] = true
+
+		var current *stripe.Price
+		if p.StripePriceID != "" {
+			if current, err = getStripePrice(p.StripePriceID); err != nil {
+				return nil, err
+			}
+		}
+		change := planPriceChange(p, current, result.ProductID)
+		if change.Action == "" {
+			continue
+		}
+		if !dryRun {
+			if err := s.applyPriceChange(ctx, p, current, result.ProductID, &change); err != nil {
+				return nil, err
+			}
+			used[change.NewStripeID] = true
+		}
+		result.Changes = append(result.Changes, change)
+	}
+
+	// Prices of the product no plan uses any more
+	if prod != nil && result.ProductID == prod.ID {
+		iter := price.List(&stripe.PriceListParams{
+			Product: stripe.String(prod.ID),
+			Active:  stripe.Bool(true),
+		})
+		for iter.Next() {
+			pr := iter.Price()
+			if used[pr.ID] {
+				continue
+			}
+			change := PlanSyncChange{
+				Object:   "price",
+				Action:   PlanSyncArchive,
+				StripeID: pr.ID,
+				Reasons:  []string{"no plan uses this price"},
+			}
+			if !dryRun {
+				if err := archivePrice(pr.ID); err != nil {
+					return nil, err
+				}
+			}
+			result.Changes = append(result.Changes, change)
+		}
+		if err := iter.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// productChange compares an app with its product in Stripe, which is nil if
+// it has none. The action is empty if they match.
+func productChange(a *ent.App, prod *stripe.Product) PlanSyncChange {
+	change := PlanSyncChange{
+		Object:   "product",
+		StripeID: a.StripeProductID,
+		Reasons:  []string{},
+	}
+	switch {
+	case prod == nil && a.StripeProductID == "":
+		change.Action = PlanSyncCreate
+		change.Reasons = append(change.Reasons, "app has no product")
+	case prod == nil:
+		change.Action = PlanSyncCreate
+		change.Reasons = append(change.Reasons, "product not found in Stripe")
+	default:
+		if prod.Name != a.Name {
+			change.Reasons = append(change.Reasons, fmt.Sprintf("name: %q -> %q", prod.Name, a.Name))
+		}
+		if !prod.Active {
+			change.Reasons = append(change.Reasons, "product is archived")
+		}
+		if len(change.Reasons) > 0 {
+			change.Action = PlanSyncUpdate
+		}
+	}
+	return change
+}
+
+// applyProductChange creates or updates an app's product.
+func (s *StripeService) applyProductChange(ctx context.Context, a *ent.App, change *PlanSyncChange) error {
+	if change.Action == PlanSyncUpdate {
+		_, err := product.Update(a.StripeProductID, &stripe.ProductParams{
+			Name:   stripe.String(a.Name),
+			Active: stripe.Bool(true),
+		})
+		return err
+	}
+
+	prod, err := product.New(&stripe.ProductParams{
+		Name: stripe.String(a.Name),
+		Metadata: map[string]string{
+			"app_id": fmt.Sprintf("%d", a.ID),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Only keep the product if the app's is still the one compared
+	n, err := s.client.App.Update().
+		Where(app.ID(a.ID), appProductIs(a.StripeProductID)).
+		SetStripeProductID(prod.ID).
+		Save(ctx)
+	if err == nil && n == 0 {
+		err = errors.New("app's product changed while publishing, try again")
+	}
+	if err != nil {
+		if _, archiveErr := product.Update(prod.ID, &stripe.ProductParams{Active: stripe.Bool(false)}); archiveErr != nil {
+			return fmt.Errorf("%w (and failed to archive product %s: %v)", err, prod.ID, archiveErr)
+		}
+		return err
+	}
+	change.NewStripeID = prod.ID
+	return nil
+}
+
+// planPriceChange compares a plan with its price in Stripe, which is nil if
+// it has none. Active plans that cost something have a price on productID.
+// The action is empty if they match.
+func planPriceChange(p *ent.Plan, current *stripe.Price, productID string) PlanSyncChange {
+	change := PlanSyncChange{
+		Object:   "price",
+		PlanID:   p.ID,
+		PlanSlug: p.Slug,
+		StripeID: p.StripePriceID,
+		Reasons:  []string{},
+	}
+
+	sold := p.IsActive && p.PriceCents > 0
+	if !sold {
+		// Unsold plans keep their price, archived, so that subscriptions on
+		// it still have the plan
+		if current != nil && current.Active && ownPrice(current, productID) {
+			change.Action = PlanSyncArchive
+			if !p.IsActive {
+				change.Reasons = append(change.Reasons, "plan is inactive")
+			} else {
+				change.Reasons = append(change.Reasons, "plan is free")
+			}
+		}
+		return change
+	}
+
+	if current == nil {
+		change.Action = PlanSyncCreate
+		if p.StripePriceID == "" {
+			change.Reasons = append(change.Reasons, "plan has no price")
+		} else {
+			change.Reasons = append(change.Reasons, "price not found in Stripe")
+		}
+		return change
+	}
+
+	// What a price cannot change
+	if !ownPrice(current, productID) {
+		change.Reasons = append(change.Reasons, "price is not on the app's product")
+	}
+	if current.UnitAmount != int64(p.PriceCents) {
+		change.Reasons = append(change.Reasons, fmt.Sprintf("price_cents: %d -> %d", current.UnitAmount, p.PriceCents))
+	}
+	if currency := strings.ToLower(p.Currency); string(current.Currency) != currency {
+		change.Reasons = append(change.Reasons, fmt.Sprintf("currency: %s -> %s", current.Currency, currency))
+	}
+	if interval := priceInterval(current); interval != planInterval(p.BillingInterval) {
+		change.Reasons = append(change.Reasons, fmt.Sprintf("billing_interval: %s -> %s", interval, planInterval(p.BillingInterval)))
+	}
+	if len(change.Reasons) > 0 {
+		change.Action = PlanSyncReplace
+		return change
+	}
+
+	// What it can
+	if !current.Active {
+		change.Reasons = append(change.Reasons, "price is archived")
+	}
+	if current.Nickname != p.Name {
+		change.Reasons = append(change.Reasons, fmt.Sprintf("name: %q -> %q", current.Nickname, p.Name))
+	}
+	if len(change.Reasons) > 0 {
+		change.Action = PlanSyncUpdate
+	}
+	return change
+}
+
+// applyPriceChange creates, replaces, updates or archives a plan's price.
+func (s *StripeService) applyPriceChange(ctx context.Context, p *ent.Plan, current *stripe.Price, productID string, change *PlanSyncChange) error {
+	switch change.Action {
+	case PlanSyncArchive:
+		return archivePrice(current.ID)
+	case PlanSyncUpdate:
+		_, err := price.Update(current.ID, &stripe.PriceParams{
+			Active:   stripe.Bool(true),
+			Nickname: stripe.String(p.Name),
+		})
+		return err
+	}
+
+	params := &stripe.PriceParams{
+		Product:    stripe.String(productID),
+		UnitAmount: stripe.Int64(int64(p.PriceCents)),
+		Currency:   stripe.String(strings.ToLower(p.Currency)),
+		Nickname:   stripe.String(p.Name),
+		Metadata: map[string]string{
+			"plan_id":   fmt.Sprintf("%d", p.ID),
+			"plan_slug": p.Slug,
+		},
+	}
+	if interval := planInterval(p.BillingInterval); interval != "" {
+		params.Recurring = &stripe.PriceRecurringParams{
+			Interval: stripe.String(interval),
+		}
+	}
+	created, err := price.New(params)
+	if err != nil {
+		return err
+	}
+
+	// Only keep the price if the plan's is still the one compared
+	n, err := s.client.Plan.Update().
+		Where(plan.ID(p.ID), planPriceIs(p.StripePriceID)).
+		SetStripePriceID(created.ID).
+		Save(ctx)
+	if err == nil && n == 0 {
+		err = ErrPlanChanged
+	}
+	if err != nil {
+		if archiveErr := archivePrice(created.ID); archiveErr != nil {
+			return fmt.Errorf("%w (and failed to archive price %s: %v)", err, created.ID, archiveErr)
+		}
+		return err
+	}
+	change.NewStripeID = created.ID
+
+	// Prices of other products, e.g. pasted in by hand before plans were
+	// published, are left alone
+	if current != nil && current.Active && ownPrice(current, productID) {
+		return archivePrice(current.ID)
+	}
+	return nil
+}
+
+// ownPrice reports whether a price is on the app's product.
+func ownPrice(pr *stripe.Price, productID string) bool {
+	return productID != "" && pr.Product != nil && pr.Product.ID == productID
+}
+
+// planInterval returns the Stripe recurring interval of a billing interval,
+// or "" for one-time prices.
+func planInterval(interval plan.BillingInterval) string {
+	switch interval {
+	case plan.BillingIntervalMONTHLY:
+		return string(stripe.PriceRecurringIntervalMonth)
+	case plan.BillingIntervalYEARLY:
+		return string(stripe.PriceRecurringIntervalYear)
+	default:
+		return ""
+	}
+}
+
+// priceInterval returns the recurring interval of a price, or "" for
+// one-time prices. Intervals other than every month or year are never
+// created here, so they are returned as they are to not match.
+func priceInterval(pr *stripe.Price) string {
+	if pr.Recurring == nil {
+		return ""
+	}
+	if pr.Recurring.IntervalCount > 1 {
+		return fmt.Sprintf("%d %s", pr.Recurring.IntervalCount, pr.Recurring.Interval)
+	}
+	return string(pr.Recurring.Interval)
+}
+
+func archivePrice(id string) error {
+	_, err := price.Update(id, &stripe.PriceParams{Active: stripe.Bool(false)})
+	return err
+}
+
+// getStripeProduct returns a product, or nil if Stripe has none with id.
+func getStripeProduct(id string) (*stripe.Product, error) {
+	prod, err := product.Get(id, nil)
+	if isStripeNotFound(err) {
+		return nil, nil
+	}
+	return prod, err
+}
+
+// getStripePrice returns a price, or nil if Stripe has none with id.
+func getStripePrice(id string) (*stripe.Price, error) {
+	pr, err := price.Get(id, nil)
+	if isStripeNotFound(err) {
+		return nil, nil
+	}
+	return pr, err
+}
+
+func isStripeNotFound(err error) bool {
+	var stripeErr *stripe.Error
+	return errors.As(err, &stripeErr) && stripeErr.HTTPStatusCode == http.StatusNotFound
+}
+
+// appProductIs matches apps whose product is id, where no product may also
+// be NULL.
+func appProductIs(id string) predicate.App {
+	if id == "" {
+		return app.Or(app.StripeProductIDIsNil(), app.StripeProductID(""))
+	}
+	return app.StripeProductID(id)
+}
+
+// planPriceIs matches plans whose price is id, where no price may also be
+// NULL.
+func planPriceIs(id string) predicate.Plan {
+	if id == "" {
+		return plan.Or(plan.StripePriceIDIsNil(), plan.StripePriceID(""))
+	}
+	return plan.StripePriceID(id)
+}
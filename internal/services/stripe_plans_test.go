@@ -0,0 +1,214 @@
+package services
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stripe/stripe-go/v81"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+func TestPlanPriceChange(t *testing.T) {
+	pro := &ent.Plan{
+		ID:              1,
+		Name:            "Pro",
+		Slug:            "pro",
+		PriceCents:      999,
+		Currency:        "USD",
+		BillingInterval: plan.BillingIntervalMONTHLY,
+		StripePriceID:   "price_pro",
+		IsActive:        true,
+	}
+	with := func(change func(p *ent.Plan)) *ent.Plan {
+		p := *pro
+		change(&p)
+		return &p
+	}
+	monthly := func(change func(pr *stripe.Price)) *stripe.Price {
+		pr := &stripe.Price{
+			ID:         "price_pro",
+			Active:     true,
+			Nickname:   "Pro",
+			Product:    &stripe.Product{ID: "prod_app"},
+			UnitAmount: 999,
+			Currency:   "usd",
+			Recurring: &stripe.PriceRecurring{
+				Interval:      stripe.PriceRecurringIntervalMonth,
+				IntervalCount: 1,
+			},
+		}
+		if change != nil {
+			change(pr)
+		}
+		return pr
+	}
+
+	tests := []struct {
+		name      string
+		plan      *ent.Plan
+		price     *stripe.Price
+		productID string
+		action    string
+	}{
+		{"matching price is kept", pro, monthly(nil), "prod_app", ""},
+		{"plan without price gets one", with(func(p *ent.Plan) { p.StripePriceID = "" }), nil, "prod_app", PlanSyncCreate},
+		{"missing price is created", pro, nil, "prod_app", PlanSyncCreate},
+		{"new amount replaces price", with(func(p *ent.Plan) { p.PriceCents = 1299 }), monthly(nil), "prod_app", PlanSyncReplace},
+		{"new currency replaces price", with(func(p *ent.Plan) { p.Currency = "SGD" }), monthly(nil), "prod_app", PlanSyncReplace},
+		{"new interval replaces price", with(func(p *ent.Plan) { p.BillingInterval = plan.BillingIntervalYEARLY }), monthly(nil), "prod_app", PlanSyncReplace},
+		{"lifetime plan has one-time price", with(func(p *ent.Plan) { p.BillingInterval = plan.BillingIntervalLIFETIME }), monthly(nil), "prod_app", PlanSyncReplace},
+		{"other interval count replaces price", pro, monthly(func(pr *stripe.Price) { pr.Recurring.IntervalCount = 3 }), "prod_app", PlanSyncReplace},
+		{"price of other product is replaced", pro, monthly(func(pr *stripe.Price) { pr.Product.ID = "prod_other" }), "prod_app", PlanSyncReplace},
+		{"product yet to be created replaces price", pro, monthly(nil), "", PlanSyncReplace},
+		{"new name updates price", with(func(p *ent.Plan) { p.Name = "Professional" }), monthly(nil), "prod_app", PlanSyncUpdate},
+		{"archived price of active plan is restored", pro, monthly(func(pr *stripe.Price) { pr.Active = false }), "prod_app", PlanSyncUpdate},
+		{"inactive plan's price is archived", with(func(p *ent.Plan) { p.IsActive = false }), monthly(nil), "prod_app", PlanSyncArchive},
+		{"free plan's price is archived", with(func(p *ent.Plan) { p.PriceCents = 0 }), monthly(nil), "prod_app", PlanSyncArchive},
+		{"archived price of inactive plan is kept", with(func(p *ent.Plan) { p.IsActive = false }), monthly(func(pr *stripe.Price) { pr.Active = false }), "prod_app", ""},
+		{"other product's price of inactive plan is kept", with(func(p *ent.Plan) { p.IsActive = false }), monthly(func(pr *stripe.Price) { pr.Product.ID = "prod_other" }), "prod_app", ""},
+		{"free plan gets no price", with(func(p *ent.Plan) { p.PriceCents = 0; p.StripePriceID = "" }), nil, "prod_app", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			change := planPriceChange(tt.plan, tt.price, tt.productID)
+			if change.Action != tt.action {
+				t.Errorf("action = %q, want %q (reasons %v)", change.Action, tt.action, change.Reasons)
+			}
+			if change.Action != "" && len(change.Reasons) == 0 {
+				t.Errorf("action %q has no reasons", change.Action)
+			}
+		})
+	}
+}
+
+func TestProductChange(t *testing.T) {
+	a := &ent.App{ID: 1, Name: "Shenbi", StripeProductID: "prod_app"}
+
+	tests := []struct {
+		name   string
+		app    *ent.App
+		prod   *stripe.Product
+		action string
+	}{
+		{"matching product is kept", a, &stripe.Product{ID: "prod_app", Name: "Shenbi", Active: true}, ""},
+		{"app without product gets one", &ent.App{ID: 1, Name: "Shenbi"}, nil, PlanSyncCreate},
+		{"missing product is created", a, nil, PlanSyncCreate},
+		{"renamed app updates product", a, &stripe.Product{ID: "prod_app", Name: "Old", Active: true}, PlanSyncUpdate},
+		{"archived product is restored", a, &stripe.Product{ID: "prod_app", Name: "Shenbi"}, PlanSyncUpdate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if change := productChange(tt.app, tt.prod); change.Action != tt.action {
+				t.Errorf("action = %q, want %q (reasons %v)", change.Action, tt.action, change.Reasons)
+			}
+		})
+	}
+}
+
+// stripeMockURL returns the URL of stripe-mock, from STRIPE_MOCK_URL or its
+// default port, and skips the test if it isn't running. stripe-mock answers
+// every request from fixtures and keeps no state, so objects read back from
+// it never reflect earlier changes.
+func stripeMockURL(t *testing.T) string {
+	t.Helper()
+	url := os.Getenv("STRIPE_MOCK_URL")
+	if url == "" {
+		url = "http://localhost:12111"
+	}
+	resp, err := (&http.Client{Timeout: time.Second}).Get(url)
+	if err != nil {
+		t.Skipf("stripe-mock is not running at %s: %v", url, err)
+	}
+	resp.Body.Close()
+	return url
+}
+
+func TestSyncPlans(t *testing.T) {
+	client := newTestClient(t)
+	s := NewStripeService(&config.Config{
+		StripeSecretKey: "sk_test_myTestKey",
+		StripeAPIURL:    stripeMockURL(t),
+	}, client)
+
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	pro := client.Plan.Create().
+		SetName("Pro").
+		SetSlug("pro").
+		SetPriceCents(999).
+		SaveX(ctx)
+	client.Plan.Create().SetName("Free").SetSlug("free").SaveX(ctx)
+
+	// A dry run lists the product and price to create, and creates neither
+	result, err := s.SyncPlans(ctx, a.ID, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !result.DryRun || result.ProductID != "" || len(result.Changes) != 2 {
+		t.Fatalf("dry run = %+v, want the product and Pro's price to create", result)
+	}
+	for i, want := range []PlanSyncChange{
+		{Object: "product", Action: PlanSyncCreate},
+		{Object: "price", Action: PlanSyncCreate, PlanID: pro.ID},
+	} {
+		if got := result.Changes[i]; got.Object != want.Object || got.Action != want.Action || got.PlanID != want.PlanID || got.NewStripeID != "" {
+			t.Errorf("change %d = %+v, want %s %s of plan %d", i, got, want.Action, want.Object, want.PlanID)
+		}
+	}
+	if a := client.App.GetX(ctx, a.ID); a.StripeProductID != "" {
+		t.Errorf("dry run set the app's product to %s", a.StripeProductID)
+	}
+	if pro := client.Plan.GetX(ctx, pro.ID); pro.StripePriceID != "" {
+		t.Errorf("dry run set Pro's price to %s", pro.StripePriceID)
+	}
+
+	// Publishing creates them
+	result, err = s.SyncPlans(ctx, a.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.DryRun || len(result.Changes) != 2 {
+		t.Fatalf("publish = %+v, want the product and Pro's price created", result)
+	}
+	a = client.App.GetX(ctx, a.ID)
+	if a.StripeProductID == "" || a.StripeProductID != result.ProductID || result.Changes[0].NewStripeID != a.StripeProductID {
+		t.Errorf("app's product = %q, want the created %+v", a.StripeProductID, result.Changes[0])
+	}
+	pro = client.Plan.GetX(ctx, pro.ID)
+	if pro.StripePriceID == "" || result.Changes[1].NewStripeID != pro.StripePriceID {
+		t.Errorf("Pro's price = %q, want the created %+v", pro.StripePriceID, result.Changes[1])
+	}
+
+	// Publishing again archives the product's prices no plan uses, which
+	// are all of stripe-mock's since it didn't keep Pro's
+	result, err = s.SyncPlans(ctx, a.ID, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ProductID != a.StripeProductID {
+		t.Errorf("product = %q, want the app's %q", result.ProductID, a.StripeProductID)
+	}
+	pro = client.Plan.GetX(ctx, pro.ID)
+	archived := 0
+	for _, change := range result.Changes {
+		if change.Object != "price" || change.Action != PlanSyncArchive {
+			continue
+		}
+		archived++
+		if change.PlanID != 0 || change.StripeID == "" || change.StripeID == pro.StripePriceID {
+			t.Errorf("archived %+v, want a price no plan uses", change)
+		}
+	}
+	if archived == 0 {
+		t.Errorf("changes = %+v, want unused prices archived", result.Changes)
+	}
+}
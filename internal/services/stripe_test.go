@@ -0,0 +1,482 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stripe/stripe-go/v81"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/hook"
+	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/webhookevent"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// fakeStripe is a Stripe API that serves subscriptions from memory, for
+// tests that need particular subscriptions rather than stripe-mock's.
+type fakeStripe struct {
+	mu            sync.Mutex
+	subscriptions []*stripe.Subscription
+}
+
+// newFakeStripeService returns a StripeService using a fakeStripe with subs.
+func newFakeStripeService(t *testing.T, client *ent.Client, subs ...*stripe.Subscription) (*StripeService, *fakeStripe) {
+	t.Helper()
+	f := &fakeStripe{subscriptions: subs}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return NewStripeService(&config.Config{
+		StripeSecretKey: "sk_test_fake",
+		StripeAPIURL:    srv.URL,
+	}, client), f
+}
+
+func (f *fakeStripe) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch path := strings.TrimPrefix(r.URL.Path, "/v1/"); {
+	case r.Method == http.MethodGet && path == "subscriptions":
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"object":   "list",
+			"url":      "/v1/subscriptions",
+			"has_more": false,
+			"data":     f.subscriptions,
+		})
+		return
+
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "subscriptions/"):
+		if sub := f.subscription(strings.TrimPrefix(path, "subscriptions/")); sub != nil {
+			json.NewEncoder(w).Encode(sub)
+			return
+		}
+
+	case r.Method == http.MethodPost && strings.HasPrefix(path, "subscription_items/"):
+		id := strings.TrimPrefix(path, "subscription_items/")
+		for _, sub := range f.subscriptions {
+			for _, item := range sub.Items.Data {
+				if item.ID != id {
+					continue
+				}
+				if quantity, err := strconv.ParseInt(r.FormValue("quantity"), 10, 64); err == nil {
+					item.Quantity = quantity
+				}
+				json.NewEncoder(w).Encode(item)
+				return
+			}
+		}
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"error": map[string]string{
+			"type":    "invalid_request_error",
+			"message": "No such object: " + r.URL.Path,
+		},
+	})
+}
+
+// subscription returns the subscription with the ID, or nil.
+func (f *fakeStripe) subscription(id string) *stripe.Subscription {
+	for _, sub := range f.subscriptions {
+		if sub.ID == id {
+			return sub
+		}
+	}
+	return nil
+}
+
+// newStripeSubscription returns a Stripe subscription to the price with a
+// quantity of seats.
+func newStripeSubscription(id string, status stripe.SubscriptionStatus, priceID string, quantity int64, metadata map[string]string) *stripe.Subscription {
+	return &stripe.Subscription{
+		ID:       id,
+		Object:   "subscription",
+		Status:   status,
+		Metadata: metadata,
+		Items: &stripe.SubscriptionItemList{
+			Data: []*stripe.SubscriptionItem{{
+				ID:       "si_" + id,
+				Object:   "subscription_item",
+				Quantity: quantity,
+				Price:    &stripe.Price{ID: priceID, Object: "price"},
+			}},
+		},
+	}
+}
+
+func TestReconcileSubscriptions(t *testing.T) {
+	client := newTestClient(t)
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+	pro := client.Plan.Create().SetName("Pro").SetSlug("pro").SetPriceCents(999).SetStripePriceID("price_pro").SaveX(ctx)
+	for _, id := range []string{"sub_updated", "sub_unchanged"} {
+		client.Subscription.Create().
+			SetUserID(u.ID).
+			SetPlan(pro).
+			SetStripeSubscriptionID(id).
+			SetStatus(subscription.StatusACTIVE).
+			ExecX(ctx)
+	}
+
+	metadata := map[string]string{
+		"app_id":  strconv.Itoa(a.ID),
+		"user_id": strconv.Itoa(u.ID),
+	}
+	s, _ := newFakeStripeService(t, client,
+		newStripeSubscription("sub_updated", stripe.SubscriptionStatusPastDue, "price_pro", 1, nil),
+		newStripeSubscription("sub_unchanged", stripe.SubscriptionStatusActive, "price_pro", 1, nil),
+		newStripeSubscription("sub_missed", stripe.SubscriptionStatusActive, "price_pro", 1, metadata),
+		newStripeSubscription("sub_other_product", stripe.SubscriptionStatusActive, "price_other", 1, nil),
+	)
+
+	result, err := s.ReconcileSubscriptions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := ReconcileResult{Checked: 4, Unchanged: 1, Updated: 1, Created: 1, Skipped: 1}
+	if *result != want {
+		t.Errorf("result = %+v, want %+v", *result, want)
+	}
+
+	if sub := client.Subscription.Query().Where(subscription.StripeSubscriptionID("sub_updated")).OnlyX(ctx); sub.Status != subscription.StatusPAST_DUE {
+		t.Errorf("updated subscription is %s, want PAST_DUE", sub.Status)
+	}
+	missed := client.Subscription.Query().
+		Where(subscription.StripeSubscriptionID("sub_missed")).
+		WithUser().
+		WithPlan().
+		OnlyX(ctx)
+	if missed.Status != subscription.StatusACTIVE || missed.Edges.User.ID != u.ID || missed.Edges.Plan.ID != pro.ID {
+		t.Errorf("missed subscription created as %+v", missed)
+	}
+
+	// Nothing changed since
+	result, err = s.ReconcileSubscriptions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ReconcileResult{Checked: 4, Unchanged: 3, Skipped: 1}); *result != want {
+		t.Errorf("result of reconciling again = %+v, want %+v", *result, want)
+	}
+}
+
+func TestReconcileConcurrentlyCreatedSubscription(t *testing.T) {
+	client := newTestClient(t)
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+	pro := client.Plan.Create().SetName("Pro").SetSlug("pro").SetPriceCents(999).SetStripePriceID("price_pro").SaveX(ctx)
+
+	// A webhook event creates the subscription after reconciliation found
+	// none, just before reconciliation creates it
+	raced := false
+	client.Subscription.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.SubscriptionFunc(func(ctx context.Context, m *ent.SubscriptionMutation) (ent.Value, error) {
+			if id, _ := m.StripeSubscriptionID(); m.Op().Is(ent.OpCreate) && id == "sub_race" && !raced {
+				raced = true
+				m.Client().Subscription.Create().
+					SetAppID(a.ID).
+					SetUserID(u.ID).
+					SetPlan(pro).
+					SetStripeSubscriptionID("sub_race").
+					SetStatus(subscription.StatusINCOMPLETE).
+					ExecX(ctx)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	s, _ := newFakeStripeService(t, client,
+		newStripeSubscription("sub_race", stripe.SubscriptionStatusActive, "price_pro", 1, map[string]string{
+			"app_id":  strconv.Itoa(a.ID),
+			"user_id": strconv.Itoa(u.ID),
+		}),
+	)
+	result, err := s.ReconcileSubscriptions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (ReconcileResult{Checked: 1, Updated: 1}); *result != want {
+		t.Errorf("result = %+v, want %+v", *result, want)
+	}
+	if sub := client.Subscription.Query().OnlyX(ctx); sub.Status != subscription.StatusACTIVE {
+		t.Errorf("subscription is %s, want ACTIVE", sub.Status)
+	}
+}
+
+// newWebhookEvent stores a Stripe event of the type created at the Unix
+// time, with a subscription as its object.
+func newWebhookEvent(t *testing.T, client *ent.Client, id, typ string, created int64, subscriptionID string) *ent.WebhookEvent {
+	t.Helper()
+	payload, err := json.Marshal(map[string]interface{}{
+		"id":      id,
+		"object":  "event",
+		"type":    typ,
+		"created": created,
+		"data": map[string]interface{}{
+			"object": map[string]string{"id": subscriptionID, "object": "subscription"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client.WebhookEvent.Create().
+		SetEventID(id).
+		SetType(typ).
+		SetPayload(string(payload)).
+		SetEventCreatedAt(time.Unix(created, 0)).
+		SaveX(context.Background())
+}
+
+func TestProcessWebhookEventsInOrder(t *testing.T) {
+	client := newTestClient(t)
+	s, _ := newFakeStripeService(t, client)
+	ctx := context.Background()
+
+	// Stripe's creation time orders events, not when they were received
+	third := newWebhookEvent(t, client, "evt_3", "customer.created", 300, "")
+	first := newWebhookEvent(t, client, "evt_1", "customer.created", 100, "")
+	second := newWebhookEvent(t, client, "evt_2", "customer.created", 200, "")
+	// Not due, processed or failed
+	later := newWebhookEvent(t, client, "evt_later", "customer.created", 50, "")
+	client.WebhookEvent.UpdateOne(later).SetNextAttemptAt(time.Now().Add(time.Hour)).ExecX(ctx)
+	processed := newWebhookEvent(t, client, "evt_processed", "customer.created", 60, "")
+	client.WebhookEvent.UpdateOne(processed).SetStatus(webhookevent.StatusPROCESSED).ExecX(ctx)
+	failed := newWebhookEvent(t, client, "evt_failed", "customer.created", 70, "")
+	client.WebhookEvent.UpdateOne(failed).SetStatus(webhookevent.StatusFAILED).ExecX(ctx)
+
+	var order []int
+	client.WebhookEvent.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.WebhookEventFunc(func(ctx context.Context, m *ent.WebhookEventMutation) (ent.Value, error) {
+			if status, _ := m.Status(); status == webhookevent.StatusPROCESSED {
+				id, _ := m.ID()
+				order = append(order, id)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	for {
+		ok, err := s.ProcessNextWebhookEvent(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ok {
+			break
+		}
+	}
+	if want := []int{first.ID, second.ID, third.ID}; !slices.Equal(order, want) {
+		t.Errorf("processed events %v, want %v", order, want)
+	}
+	if evt := client.WebhookEvent.GetX(ctx, later.ID); evt.Status != webhookevent.StatusPENDING || evt.Attempts != 0 {
+		t.Errorf("event that isn't due is %s after %d attempts", evt.Status, evt.Attempts)
+	}
+}
+
+func TestProcessWebhookEventBackoff(t *testing.T) {
+	client := newTestClient(t)
+	s, _ := newFakeStripeService(t, client)
+	ctx := context.Background()
+
+	// The subscription doesn't exist in Stripe, so processing fails
+	evt := newWebhookEvent(t, client, "evt_1", "customer.subscription.updated", 100, "sub_missing")
+	for attempt := 1; attempt <= maxEventAttempts; attempt++ {
+		start := time.Now()
+		if ok, err := s.ProcessNextWebhookEvent(ctx); !ok || err != nil {
+			t.Fatalf("attempt %d: processed = %t, %v", attempt, ok, err)
+		}
+
+		evt = client.WebhookEvent.GetX(ctx, evt.ID)
+		if evt.Attempts != attempt || evt.LastError == "" {
+			t.Fatalf("attempt %d: event has %d attempts, last error %q", attempt, evt.Attempts, evt.LastError)
+		}
+		if attempt == maxEventAttempts {
+			break
+		}
+		if evt.Status != webhookevent.StatusPENDING {
+			t.Fatalf("attempt %d: event is %s, want PENDING", attempt, evt.Status)
+		}
+		if delay := evt.NextAttemptAt.Sub(start); delay < eventRetryDelay(attempt) || delay > eventRetryDelay(attempt)+time.Minute {
+			t.Errorf("attempt %d: retried after %s, want %s", attempt, delay, eventRetryDelay(attempt))
+		}
+
+		// Not due until then
+		if ok, err := s.ProcessNextWebhookEvent(ctx); ok || err != nil {
+			t.Fatalf("attempt %d: processed = %t, %v before it was due", attempt, ok, err)
+		}
+		client.WebhookEvent.UpdateOne(evt).SetNextAttemptAt(time.Now()).ExecX(ctx)
+	}
+	if evt.Status != webhookevent.StatusFAILED {
+		t.Errorf("event is %s after %d attempts, want FAILED", evt.Status, evt.Attempts)
+	}
+	if ok, err := s.ProcessNextWebhookEvent(ctx); ok || err != nil {
+		t.Errorf("processed = %t, %v after the event failed", ok, err)
+	}
+
+	if _, err := s.ReplayWebhookEvent(ctx, evt.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := s.ProcessNextWebhookEvent(ctx); !ok || err != nil {
+		t.Errorf("processed = %t, %v after replaying the event", ok, err)
+	}
+}
+
+func TestEventRetryDelay(t *testing.T) {
+	for attempts, want := range map[int]time.Duration{
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		6:  32 * time.Minute,
+		7:  time.Hour,
+		9:  time.Hour,
+		70: time.Hour,
+	} {
+		if got := eventRetryDelay(attempts); got != want {
+			t.Errorf("eventRetryDelay(%d) = %s, want %s", attempts, got, want)
+		}
+	}
+}
+
+func TestProcessWebhookEventForConcurrentlyCreatedSubscription(t *testing.T) {
+	client := newTestClient(t)
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	u := client.User.Create().SetEmail("user@example.com").SaveX(ctx)
+	pro := client.Plan.Create().SetName("Pro").SetSlug("pro").SetPriceCents(999).SetStripePriceID("price_pro").SaveX(ctx)
+	s, _ := newFakeStripeService(t, client,
+		newStripeSubscription("sub_race", stripe.SubscriptionStatusActive, "price_pro", 1, map[string]string{
+			"app_id":  strconv.Itoa(a.ID),
+			"user_id": strconv.Itoa(u.ID),
+		}),
+	)
+
+	// The subscription is created just before the event creates it
+	raced := false
+	client.Subscription.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.SubscriptionFunc(func(ctx context.Context, m *ent.SubscriptionMutation) (ent.Value, error) {
+			if id, _ := m.StripeSubscriptionID(); m.Op().Is(ent.OpCreate) && id == "sub_race" && !raced {
+				raced = true
+				m.Client().Subscription.Create().
+					SetAppID(a.ID).
+					SetUserID(u.ID).
+					SetPlan(pro).
+					SetStripeSubscriptionID("sub_race").
+					SetStatus(subscription.StatusINCOMPLETE).
+					ExecX(ctx)
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	evt := newWebhookEvent(t, client, "evt_1", "customer.subscription.created", 100, "sub_race")
+	if ok, err := s.ProcessNextWebhookEvent(context.Background()); !ok || err != nil {
+		t.Fatalf("processed = %t, %v", ok, err)
+	}
+	// Rolled back, to be processed again at once without counting a failure
+	if evt := client.WebhookEvent.GetX(ctx, evt.ID); evt.Status != webhookevent.StatusPENDING || evt.Attempts != 0 || evt.NextAttemptAt.After(time.Now()) {
+		t.Fatalf("event is %s after %d attempts, next at %s", evt.Status, evt.Attempts, evt.NextAttemptAt)
+	}
+
+	if ok, err := s.ProcessNextWebhookEvent(context.Background()); !ok || err != nil {
+		t.Fatalf("processed = %t, %v", ok, err)
+	}
+	if evt := client.WebhookEvent.GetX(ctx, evt.ID); evt.Status != webhookevent.StatusPROCESSED {
+		t.Errorf("event is %s, want PROCESSED", evt.Status)
+	}
+	if sub := client.Subscription.Query().OnlyX(ctx); sub.Status != subscription.StatusACTIVE {
+		t.Errorf("subscription is %s, want ACTIVE", sub.Status)
+	}
+}
+
+func TestSyncSeats(t *testing.T) {
+	client := newTestClient(t)
+	a := client.App.Create().SetName("App").SetSlug("app").SaveX(context.Background())
+	ctx := tenant.NewContext(context.Background(), a.ID)
+	pro := client.Plan.Create().SetName("Pro").SetSlug("pro").SetPriceCents(999).SetStripePriceID("price_pro").SaveX(ctx)
+	org := client.Organization.Create().SetName("School").SetSlug("school").SaveX(ctx)
+	unbilled := client.Organization.Create().SetName("Club").SetSlug("club").SaveX(ctx)
+	sub := client.Subscription.Create().
+		SetOrganization(org).
+		SetPlan(pro).
+		SetStripeSubscriptionID("sub_org").
+		SetStatus(subscription.StatusACTIVE).
+		SetQuantity(1).
+		SaveX(ctx)
+	s, fake := newFakeStripeService(t, client,
+		newStripeSubscription("sub_org", stripe.SubscriptionStatusActive, "price_pro", 1, nil),
+	)
+
+	join := func(org *ent.Organization, email string, seat bool) *ent.OrganizationMember {
+		u := client.User.Create().SetEmail(email).SaveX(ctx)
+		return client.OrganizationMember.Create().SetOrganization(org).SetUser(u).SetHasSeat(seat).SaveX(ctx)
+	}
+	seats := func() (int, int64) {
+		t.Helper()
+		fake.mu.Lock()
+		defer fake.mu.Unlock()
+		return client.Subscription.GetX(ctx, sub.ID).Quantity, fake.subscriptions[0].Items.Data[0].Quantity
+	}
+
+	join(org, "a@example.com", true)
+	join(org, "b@example.com", true)
+	join(org, "c@example.com", true)
+	join(org, "guest@example.com", false)
+	if synced, err := s.SyncSeats(ctx, org.ID); !synced || err != nil {
+		t.Fatalf("SyncSeats = %t, %v, want synced", synced, err)
+	}
+	if ours, stripes := seats(); ours != 3 || stripes != 3 {
+		t.Errorf("%d seats, %d in Stripe, want 3", ours, stripes)
+	}
+	if synced, err := s.SyncSeats(ctx, org.ID); synced || err != nil {
+		t.Errorf("SyncSeats again = %t, %v, want nothing to sync", synced, err)
+	}
+
+	// Members leaving don't take the subscription below 1
+	client.OrganizationMember.Update().SetHasSeat(false).ExecX(ctx)
+	s.SeatsChanged(ctx, org.ID)
+	if ours, stripes := seats(); ours != 1 || stripes != 1 {
+		t.Errorf("%d seats, %d in Stripe, want 1", ours, stripes)
+	}
+
+	// Organizations without a subscription have nothing to sync
+	join(unbilled, "d@example.com", true)
+	if synced, err := s.SyncSeats(ctx, unbilled.ID); synced || err != nil {
+		t.Errorf("SyncSeats without a subscription = %t, %v", synced, err)
+	}
+
+	// A failed sync leaves the seats for reconciliation
+	join(org, "e@example.com", true)
+	join(org, "f@example.com", true)
+	fake.mu.Lock()
+	fake.subscriptions[0].ID = "sub_renamed"
+	fake.mu.Unlock()
+	s.SeatsChanged(ctx, org.ID)
+	if ours, stripes := seats(); ours != 1 || stripes != 1 {
+		t.Errorf("%d seats, %d in Stripe after a failed sync, want 1", ours, stripes)
+	}
+	fake.mu.Lock()
+	fake.subscriptions[0].ID = "sub_org"
+	fake.mu.Unlock()
+	result, err := s.ReconcileSubscriptions(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.SeatsSynced != 1 || result.Failed != 0 {
+		t.Errorf("reconciliation synced %d organizations' seats with %d failures, want 1", result.SeatsSynced, result.Failed)
+	}
+	if ours, stripes := seats(); ours != 2 || stripes != 2 {
+		t.Errorf("%d seats, %d in Stripe after reconciling, want 2", ours, stripes)
+	}
+}
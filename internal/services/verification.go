@@ -0,0 +1,389 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/url"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"gigaboo.io/lem/internal/config"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/app"
+	"gigaboo.io/lem/internal/ent/predicate"
+	"gigaboo.io/lem/internal/ent/user"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/middleware"
+)
+
+const (
+	passwordResetTokenDuration     = time.Hour
+	emailVerificationTokenDuration = 24 * time.Hour
+	magicLinkTokenDuration         = 15 * time.Minute
+)
+
+// VerificationService handles password resets, email verification and
+// magic-link logins.
+type VerificationService struct {
+	cfg      *config.Config
+	client   *ent.Client
+	email    *EmailService
+	sessions *SessionService
+}
+
+// NewVerificationService creates a new verification service.
+func NewVerificationService(cfg *config.Config, client *ent.Client, email *EmailService, sessions *SessionService) *VerificationService {
+	return &VerificationService{
+		cfg:      cfg,
+		client:   client,
+		email:    email,
+		sessions: sessions,
+	}
+}
+
+// ForgotPasswordInput represents forgot password request data.
+type ForgotPasswordInput struct {
+	Email       string `json:"email" binding:"required,email"`
+	RedirectURL string `json:"redirect_url"`
+}
+
+// ResetPasswordInput represents reset password request data.
+type ResetPasswordInput struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required,min=6"`
+}
+
+// SendVerificationInput represents send verification request data.
+type SendVerificationInput struct {
+	RedirectURL string `json:"redirect_url"`
+}
+
+// ConfirmVerificationInput represents confirm verification request data.
+type ConfirmVerificationInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// MagicLinkInput represents magic link request data.
+type MagicLinkInput struct {
+	Email       string `json:"email" binding:"required,email"`
+	RedirectURL string `json:"redirect_url"`
+}
+
+// MagicLinkExchangeInput represents magic link exchange request data.
+type MagicLinkExchangeInput struct {
+	Token string `json:"token" binding:"required"`
+}
+
+// RequestPasswordReset emails a password reset link to the user with the given
+// email. Unknown emails are ignored so the endpoint cannot be used to find accounts.
+func (s *VerificationService) RequestPasswordReset(ctx context.Context, a *ent.App, input ForgotPasswordInput) error {
+	// Validate the redirect first so that the response does not depend on
+	// whether the account exists
+	if err := s.checkRedirect(a, input.RedirectURL); err != nil {
+		return err
+	}
+
+	u, err := s.client.User.Query().
+		Where(user.Email(input.Email)).
+		First(ctx)
+	if err != nil || !u.IsActive {
+		return nil
+	}
+
+	token, err := s.createToken(ctx, a.ID, u.ID, u.Email, verificationtoken.PurposePASSWORD_RESET, passwordResetTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	link, err := s.buildLink(a, input.RedirectURL, "/reset-password", token)
+	if err != nil {
+		return err
+	}
+
+	// Only existing accounts get an email, so failing to send one is logged
+	// rather than returned
+	if err := s.email.SendPasswordReset(ctx, a.ID, u.Email, link); err != nil {
+		log.Printf("Failed to send password reset email for app %d: %v", a.ID, err)
+	}
+	return nil
+}
+
+// ResetPassword sets a new password using a reset token and signs the user
+// out of every session.
+func (s *VerificationService) ResetPassword(ctx context.Context, input ResetPasswordInput) error {
+	vt, err := s.consumeToken(ctx, input.Token, verificationtoken.PurposePASSWORD_RESET)
+	if err != nil {
+		return err
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	u, err := s.client.User.UpdateOneID(vt.Edges.User.ID).
+		SetPasswordHash(string(hashedPassword)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Invalidate any other reset links that are still outstanding
+	_, err = s.client.VerificationToken.Update().
+		Where(
+			verificationtoken.HasUserWith(user.ID(u.ID)),
+			verificationtoken.PurposeEQ(verificationtoken.PurposePASSWORD_RESET),
+			verificationtoken.UsedAtIsNil(),
+		).
+		SetUsedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.sessions.RevokeEverywhere(ctx, u.ID, "password reset")
+	return err
+}
+
+// SendVerification emails an email verification link to the user.
+func (s *VerificationService) SendVerification(ctx context.Context, a *ent.App, u *ent.User, input SendVerificationInput) error {
+	if u.IsVerified {
+		return errors.New("email already verified")
+	}
+	if err := s.checkRedirect(a, input.RedirectURL); err != nil {
+		return err
+	}
+
+	token, err := s.createToken(ctx, a.ID, u.ID, u.Email, verificationtoken.PurposeEMAIL_VERIFICATION, emailVerificationTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	link, err := s.buildLink(a, input.RedirectURL, "/verify-email", token)
+	if err != nil {
+		return err
+	}
+
+	return s.email.SendEmailVerification(ctx, a.ID, u.Email, link)
+}
+
+// ConfirmVerification marks the user's email as verified using a verification token.
+func (s *VerificationService) ConfirmVerification(ctx context.Context, input ConfirmVerificationInput) (*ent.User, error) {
+	vt, err := s.consumeToken(ctx, input.Token, verificationtoken.PurposeEMAIL_VERIFICATION)
+	if err != nil {
+		return nil, err
+	}
+
+	// The link only proves ownership of the address it was sent to
+	if vt.Edges.User.Email != vt.Email {
+		return nil, errors.New("invalid or expired token")
+	}
+
+	return s.client.User.UpdateOneID(vt.Edges.User.ID).
+		SetIsVerified(true).
+		Save(ctx)
+}
+
+// RequestMagicLink emails a login link. Unknown emails only get a link if the
+// app allows magic-link signup; either way the result is the same so the
+// endpoint cannot be used to find accounts.
+func (s *VerificationService) RequestMagicLink(ctx context.Context, a *ent.App, input MagicLinkInput) error {
+	// Validate the redirect first so that the response does not depend on
+	// whether the account exists
+	if err := s.checkRedirect(a, input.RedirectURL); err != nil {
+		return err
+	}
+
+	userID := 0
+	u, err := s.client.User.Query().
+		Where(user.Email(input.Email)).
+		First(ctx)
+	switch {
+	case err == nil:
+		if !u.IsActive {
+			return nil
+		}
+		userID = u.ID
+	case ent.IsNotFound(err):
+		if !a.MagicLinkSignup {
+			return nil
+		}
+	default:
+		return err
+	}
+
+	token, err := s.createToken(ctx, a.ID, userID, input.Email, verificationtoken.PurposeMAGIC_LINK, magicLinkTokenDuration)
+	if err != nil {
+		return err
+	}
+
+	link, err := s.buildLink(a, input.RedirectURL, "/magic-link", token)
+	if err != nil {
+		return err
+	}
+
+	// As with password resets, whether an email is sent depends on the
+	// account, so failing to send one is not returned
+	if err := s.email.SendMagicLink(ctx, a.ID, input.Email, link); err != nil {
+		log.Printf("Failed to send magic link email for app %d: %v", a.ID, err)
+	}
+	return nil
+}
+
+// ConsumeMagicLink uses a magic link token issued for the app and returns the
+// user to log in, creating the account for links sent to a new email. Using
+// the link proves ownership of the email, so it is marked verified.
+func (s *VerificationService) ConsumeMagicLink(ctx context.Context, a *ent.App, input MagicLinkExchangeInput) (*ent.User, error) {
+	vt, err := s.consumeToken(ctx, input.Token, verificationtoken.PurposeMAGIC_LINK,
+		verificationtoken.HasAppWith(app.ID(a.ID)))
+	if err != nil {
+		return nil, err
+	}
+
+	u := vt.Edges.User
+	if u == nil {
+		// The account may have been created since the link was sent
+		u, err = s.client.User.Query().
+			Where(user.Email(vt.Email)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			if !a.MagicLinkSignup {
+				return nil, errors.New("invalid or expired token")
+			}
+			u, err = s.client.User.Create().
+				SetEmail(vt.Email).
+				SetIsVerified(true).
+				Save(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !u.IsActive {
+		return nil, errors.New("account is disabled")
+	}
+
+	if u.Email != vt.Email {
+		return nil, errors.New("invalid or expired token")
+	}
+
+	if !u.IsVerified {
+		u, err = s.client.User.UpdateOne(u).
+			SetIsVerified(true).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := ensureUserApp(ctx, s.client, u.ID, a.ID); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// createToken stores a new token for an email and returns its plaintext value.
+// userID is the account the email belongs to, or 0 if there is none yet.
+func (s *VerificationService) createToken(ctx context.Context, appID, userID int, email string, purpose verificationtoken.Purpose, ttl time.Duration) (string, error) {
+	token, err := generateInviteToken(32)
+	if err != nil {
+		return "", err
+	}
+
+	create := s.client.VerificationToken.Create().
+		SetAppID(appID).
+		SetPurpose(purpose).
+		SetEmail(email).
+		SetTokenHash(hashToken(token)).
+		SetExpiresAt(time.Now().Add(ttl))
+	if userID != 0 {
+		create.SetUserID(userID)
+	}
+	if _, err := create.Save(ctx); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// consumeToken marks a valid token as used and returns it with its user loaded.
+// Extra predicates further restrict which tokens are accepted.
+func (s *VerificationService) consumeToken(ctx context.Context, token string, purpose verificationtoken.Purpose, where ...predicate.VerificationToken) (*ent.VerificationToken, error) {
+	now := time.Now()
+	hash := hashToken(token)
+
+	// Mark the token as used only if it is still valid, so it can be used once
+	n, err := s.client.VerificationToken.Update().
+		Where(
+			verificationtoken.TokenHash(hash),
+			verificationtoken.PurposeEQ(purpose),
+			verificationtoken.UsedAtIsNil(),
+			verificationtoken.ExpiresAtGT(now),
+		).
+		Where(where...).
+		SetUsedAt(now).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, errors.New("invalid or expired token")
+	}
+
+	return s.client.VerificationToken.Query().
+		Where(verificationtoken.TokenHash(hash)).
+		WithUser().
+		Only(ctx)
+}
+
+// buildLink returns the link to email for a token. redirectURL must be on one
+// of the app's allowed origins; without it the link points at the API base URL.
+func (s *VerificationService) buildLink(a *ent.App, redirectURL, defaultPath, token string) (string, error) {
+	if err := s.checkRedirect(a, redirectURL); err != nil {
+		return "", err
+	}
+	if redirectURL == "" {
+		redirectURL = s.cfg.BaseURL + defaultPath
+	}
+
+	u, err := url.Parse(redirectURL)
+	if err != nil {
+		return "", errors.New("invalid redirect_url")
+	}
+
+	q := u.Query()
+	q.Set("token", token)
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// checkRedirect returns an error unless redirectURL is empty or allowed.
+func (s *VerificationService) checkRedirect(a *ent.App, redirectURL string) error {
+	if redirectURL != "" && !s.isAllowedRedirect(a, redirectURL) {
+		return errors.New("redirect_url is not allowed")
+	}
+	return nil
+}
+
+// isAllowedRedirect checks the origin of redirectURL against the app's allowed
+// origins, or the server's CORS origins if the app has none configured.
+func (s *VerificationService) isAllowedRedirect(a *ent.App, redirectURL string) bool {
+	return isAllowedRedirect(s.cfg, a, redirectURL)
+}
+
+func isAllowedRedirect(cfg *config.Config, a *ent.App, redirectURL string) bool {
+	u, err := url.Parse(redirectURL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return false
+	}
+
+	origins := a.AllowedOrigins
+	if len(origins) == 0 {
+		origins = cfg.CORSOrigins
+	}
+	return middleware.OriginAllowed(origins, u.Scheme+"://"+u.Host)
+}
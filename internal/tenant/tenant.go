@@ -0,0 +1,306 @@
+// Package tenant keeps every app's data separate.
+//
+// Requests carry the app they are made for in their context (see
+// NewContext). Queries and mutations of the entities that belong to an app
+// are then limited to that app's rows by an ent interceptor and hook, and new
+// rows are created in it. Code that deliberately works across apps, such as
+// the admin console or merging two accounts, says so with AllApps. Anything
+// else that touches app data without an app in its context fails with
+// ErrNoApp, so a missing app filter cannot go unnoticed.
+package tenant
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent/dialect/sql"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/assignment"
+	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/authsession"
+	"gigaboo.io/lem/internal/ent/battleroom"
+	"gigaboo.io/lem/internal/ent/battlesession"
+	"gigaboo.io/lem/internal/ent/classroom"
+	"gigaboo.io/lem/internal/ent/classroommembership"
+	"gigaboo.io/lem/internal/ent/classroomsession"
+	"gigaboo.io/lem/internal/ent/emailtemplate"
+	"gigaboo.io/lem/internal/ent/intercept"
+	"gigaboo.io/lem/internal/ent/livesession"
+	"gigaboo.io/lem/internal/ent/livesessionstudent"
+	"gigaboo.io/lem/internal/ent/oauthauthorizationcode"
+	"gigaboo.io/lem/internal/ent/oauthconsent"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationdomain"
+	"gigaboo.io/lem/internal/ent/organizationinvitation"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/plan"
+	"gigaboo.io/lem/internal/ent/refreshtoken"
+	"gigaboo.io/lem/internal/ent/shenbiprofile"
+	"gigaboo.io/lem/internal/ent/shenbisettings"
+	"gigaboo.io/lem/internal/ent/ssoconnection"
+	"gigaboo.io/lem/internal/ent/ssologin"
+	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/userapp"
+	"gigaboo.io/lem/internal/ent/userprogress"
+	"gigaboo.io/lem/internal/ent/verificationtoken"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+)
+
+var (
+	// ErrNoApp is returned when app data is used without an app in the
+	// context.
+	ErrNoApp = errors.New("tenant: no app in context")
+	// ErrWrongApp is returned when creating a row for another app than the
+	// one in the context.
+	ErrWrongApp = errors.New("tenant: row belongs to another app")
+)
+
+// scope is the app whose data a context may use. all is set for code that
+// works across apps.
+type scope struct {
+	appID int
+	all   bool
+}
+
+type contextKey struct{}
+
+// NewContext returns a context limited to the data of the app.
+func NewContext(ctx context.Context, appID int) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{appID: appID})
+}
+
+// AllApps returns a context that may use the data of every app.
+func AllApps(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, scope{all: true})
+}
+
+// FromContext returns the app ctx is limited to. ok is false if there is
+// none, including for contexts from AllApps.
+func FromContext(ctx context.Context) (appID int, ok bool) {
+	s, _ := ctx.Value(contextKey{}).(scope)
+	return s.appID, s.appID != 0
+}
+
+// appFrom returns the app ctx is limited to, or 0 if it may use every app.
+func appFrom(ctx context.Context) (int, error) {
+	s, ok := ctx.Value(contextKey{}).(scope)
+	switch {
+	case ok && s.all:
+		return 0, nil
+	case ok && s.appID != 0:
+		return s.appID, nil
+	default:
+		return 0, ErrNoApp
+	}
+}
+
+// predicates maps the entity types that belong to an app to the predicate
+// selecting an app's rows. Users, apps, admin accounts and audit events are
+// shared by every app.
+var predicates = map[string]func(appID int) func(*sql.Selector){
+	ent.TypeAchievement:            column(achievement.AppColumn),
+	ent.TypeAuthSession:            column(authsession.AppColumn),
+	ent.TypeBattleRoom:             column(battleroom.AppColumn),
+	ent.TypeBattleSession:          column(battlesession.AppColumn),
+	ent.TypeClassroom:              column(classroom.AppColumn),
+	ent.TypeClassroomSession:       column(classroomsession.AppColumn),
+	ent.TypeEmailTemplate:          column(emailtemplate.AppColumn),
+	ent.TypeLiveSession:            column(livesession.AppColumn),
+	ent.TypeOAuthAuthorizationCode: column(oauthauthorizationcode.AppColumn),
+	ent.TypeOAuthConsent:           column(oauthconsent.AppColumn),
+	ent.TypeOrganization:           column(organization.AppColumn),
+	ent.TypePlan:                   column(plan.AppColumn),
+	ent.TypeShenbiProfile:          column(shenbiprofile.AppColumn),
+	ent.TypeShenbiSettings:         column(shenbisettings.AppColumn),
+	ent.TypeSubscription:           column(subscription.AppColumn),
+	ent.TypeUserApp:                column(userapp.AppColumn),
+	ent.TypeUserProgress:           column(userprogress.AppColumn),
+	ent.TypeVerificationToken:      column(verificationtoken.AppColumn),
+	ent.TypeWebhookDelivery:        column(webhookdelivery.AppColumn),
+
+	// Entities that belong to an app through their parent
+	ent.TypeAssignment: func(appID int) func(*sql.Selector) {
+		return assignment.HasClassroomWith(sql.FieldEQ(classroom.AppColumn, appID))
+	},
+	ent.TypeAssignmentSubmission: func(appID int) func(*sql.Selector) {
+		return assignmentsubmission.HasAssignmentWith(
+			assignment.HasClassroomWith(sql.FieldEQ(classroom.AppColumn, appID)),
+		)
+	},
+	ent.TypeClassroomMembership: func(appID int) func(*sql.Selector) {
+		return classroommembership.HasClassroomWith(sql.FieldEQ(classroom.AppColumn, appID))
+	},
+	ent.TypeLiveSessionStudent: func(appID int) func(*sql.Selector) {
+		return livesessionstudent.HasSessionWith(sql.FieldEQ(livesession.AppColumn, appID))
+	},
+	ent.TypeOrganizationDomain: func(appID int) func(*sql.Selector) {
+		return organizationdomain.HasOrganizationWith(sql.FieldEQ(organization.AppColumn, appID))
+	},
+	ent.TypeOrganizationInvitation: func(appID int) func(*sql.Selector) {
+		return organizationinvitation.HasOrganizationWith(sql.FieldEQ(organization.AppColumn, appID))
+	},
+	ent.TypeOrganizationMember: func(appID int) func(*sql.Selector) {
+		return organizationmember.HasOrganizationWith(sql.FieldEQ(organization.AppColumn, appID))
+	},
+	ent.TypeRefreshToken: func(appID int) func(*sql.Selector) {
+		return refreshtoken.HasSessionWith(sql.FieldEQ(authsession.AppColumn, appID))
+	},
+	ent.TypeSSOConnection: func(appID int) func(*sql.Selector) {
+		return ssoconnection.HasOrganizationWith(sql.FieldEQ(organization.AppColumn, appID))
+	},
+	ent.TypeSSOLogin: func(appID int) func(*sql.Selector) {
+		return ssologin.HasOrganizationWith(sql.FieldEQ(organization.AppColumn, appID))
+	},
+	ent.TypeWebhookAttempt: func(appID int) func(*sql.Selector) {
+		return webhookattempt.HasDeliveryWith(sql.FieldEQ(webhookdelivery.AppColumn, appID))
+	},
+}
+
+// parents maps the entity types that belong to an app through their parent
+// to the edge to it. A row can only be created under a parent in the app.
+var parents = map[string]string{
+	ent.TypeAssignment:             assignment.EdgeClassroom,
+	ent.TypeAssignmentSubmission:   assignmentsubmission.EdgeAssignment,
+	ent.TypeClassroomMembership:    classroommembership.EdgeClassroom,
+	ent.TypeLiveSessionStudent:     livesessionstudent.EdgeSession,
+	ent.TypeOrganizationDomain:     organizationdomain.EdgeOrganization,
+	ent.TypeOrganizationInvitation: organizationinvitation.EdgeOrganization,
+	ent.TypeOrganizationMember:     organizationmember.EdgeOrganization,
+	ent.TypeRefreshToken:           refreshtoken.EdgeSession,
+	ent.TypeSSOConnection:          ssoconnection.EdgeOrganization,
+	ent.TypeSSOLogin:               ssologin.EdgeOrganization,
+	ent.TypeWebhookAttempt:         webhookattempt.EdgeDelivery,
+}
+
+func column(name string) func(appID int) func(*sql.Selector) {
+	return func(appID int) func(*sql.Selector) {
+		return sql.FieldEQ(name, appID)
+	}
+}
+
+// Register installs the interceptor and hook that limit app data to the app
+// in the context.
+func Register(client *ent.Client) {
+	client.Intercept(Interceptor())
+	client.Use(Hook())
+}
+
+// Interceptor limits queries of app data, including graph traversals and
+// eager loading, to the app in the context.
+func Interceptor() ent.Interceptor {
+	return intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+		predicate, ok := predicates[q.Type()]
+		if !ok {
+			return nil
+		}
+		appID, err := appFrom(ctx)
+		if err != nil {
+			return err
+		}
+		if appID != 0 {
+			q.WhereP(predicate(appID))
+		}
+		return nil
+	})
+}
+
+// Hook limits updates and deletes of app data to the app in the context, and
+// creates new rows in it.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			predicate, ok := predicates[m.Type()]
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			appID, err := appFrom(ctx)
+			if err != nil {
+				return nil, err
+			}
+			if appID == 0 {
+				return next.Mutate(ctx, m)
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				if err := setApp(ctx, m, appID); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			}
+
+			mut, ok := m.(interface {
+				WhereP(...func(*sql.Selector))
+			})
+			if !ok {
+				return nil, errors.New("tenant: cannot limit " + m.Type() + " mutation")
+			}
+			mut.WhereP(predicate(appID))
+			return next.Mutate(ctx, m)
+		})
+	}
+}
+
+// appMutation is implemented by the mutations of entities with an app edge.
+type appMutation interface {
+	AppID() (int, bool)
+	SetAppID(int)
+}
+
+// setApp creates a row in the app, or checks that its parent is in the app.
+func setApp(ctx context.Context, m ent.Mutation, appID int) error {
+	if am, ok := m.(appMutation); ok {
+		id, exists := am.AppID()
+		if !exists {
+			am.SetAppID(appID)
+			return nil
+		}
+		if id != appID {
+			return ErrWrongApp
+		}
+		return nil
+	}
+
+	edge, ok := parents[m.Type()]
+	if !ok {
+		return nil
+	}
+	client, ok := m.(interface{ Client() *ent.Client })
+	if !ok {
+		return errors.New("tenant: cannot check " + m.Type() + " mutation")
+	}
+	for _, id := range m.AddedIDs(edge) {
+		exists, err := parentExists(ctx, client.Client(), m.Type(), id.(int))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrWrongApp
+		}
+	}
+	return nil
+}
+
+// parentExists reports whether the parent of a new row of type typ is
+// visible in ctx, and so is in its app.
+func parentExists(ctx context.Context, client *ent.Client, typ string, id int) (bool, error) {
+	switch typ {
+	case ent.TypeAssignment, ent.TypeClassroomMembership:
+		return client.Classroom.Query().Where(classroom.ID(id)).Exist(ctx)
+	case ent.TypeAssignmentSubmission:
+		return client.Assignment.Query().Where(assignment.ID(id)).Exist(ctx)
+	case ent.TypeLiveSessionStudent:
+		return client.LiveSession.Query().Where(livesession.ID(id)).Exist(ctx)
+	case ent.TypeRefreshToken:
+		return client.AuthSession.Query().Where(authsession.ID(id)).Exist(ctx)
+	case ent.TypeOrganizationDomain, ent.TypeOrganizationInvitation, ent.TypeOrganizationMember,
+		ent.TypeSSOConnection, ent.TypeSSOLogin:
+		return client.Organization.Query().Where(organization.ID(id)).Exist(ctx)
+	case ent.TypeWebhookAttempt:
+		return client.WebhookDelivery.Query().Where(webhookdelivery.ID(id)).Exist(ctx)
+	default:
+		return false, errors.New("tenant: unsupported type " + typ)
+	}
+}
@@ -0,0 +1,123 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/enttest"
+	"gigaboo.io/lem/internal/ent/organization"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/plan"
+)
+
+func TestTenant(t *testing.T) {
+	client := enttest.Open(t, dialect.SQLite, "file:tenant?mode=memory&_fk=1")
+	defer client.Close()
+	Register(client)
+
+	all := AllApps(context.Background())
+	app1 := client.App.Create().SetName("One").SetSlug("one").SaveX(all)
+	app2 := client.App.Create().SetName("Two").SetSlug("two").SaveX(all)
+	ctx1 := NewContext(context.Background(), app1.ID)
+	ctx2 := NewContext(context.Background(), app2.ID)
+
+	u := client.User.Create().SetEmail("user@example.com").SaveX(all)
+	plan1 := client.Plan.Create().SetName("Pro").SetSlug("pro").SaveX(ctx1)
+	plan2 := client.Plan.Create().SetName("Pro").SetSlug("pro").SaveX(ctx2)
+	org1 := client.Organization.Create().SetName("School").SetSlug("school").SaveX(ctx1)
+	org2 := client.Organization.Create().SetName("School").SetSlug("school").SaveX(ctx2)
+	client.OrganizationMember.Create().SetOrganization(org1).SetUser(u).SaveX(ctx1)
+	client.OrganizationMember.Create().SetOrganization(org2).SetUser(u).SaveX(ctx2)
+
+	t.Run("rows are created in the context's app", func(t *testing.T) {
+		if got := client.Plan.Query().Where(plan.ID(plan2.ID)).QueryApp().OnlyIDX(all); got != app2.ID {
+			t.Errorf("plan created in app %d, want %d", got, app2.ID)
+		}
+	})
+
+	t.Run("queries see the context's app", func(t *testing.T) {
+		plans := client.Plan.Query().IDsX(ctx1)
+		if len(plans) != 1 || plans[0] != plan1.ID {
+			t.Errorf("plans = %v, want [%d]", plans, plan1.ID)
+		}
+		if _, err := client.Plan.Get(ctx1, plan2.ID); !ent.IsNotFound(err) {
+			t.Errorf("getting another app's plan: %v, want not found", err)
+		}
+
+		// Including through edges and eager loading
+		members := u.QueryOrganizationMemberships().WithOrganization().AllX(ctx1)
+		if len(members) != 1 || members[0].Edges.Organization.ID != org1.ID {
+			t.Errorf("memberships = %v, want the one in organization %d", members, org1.ID)
+		}
+		if n := client.OrganizationMember.Query().
+			Where(organizationmember.HasOrganizationWith(organization.ID(org2.ID))).
+			CountX(ctx1); n != 0 {
+			t.Errorf("%d memberships of another app's organization, want 0", n)
+		}
+	})
+
+	t.Run("AllApps sees every app", func(t *testing.T) {
+		if n := client.Plan.Query().CountX(all); n != 2 {
+			t.Errorf("%d plans, want 2", n)
+		}
+		if n := client.OrganizationMember.Query().CountX(all); n != 2 {
+			t.Errorf("%d memberships, want 2", n)
+		}
+	})
+
+	t.Run("no app in the context", func(t *testing.T) {
+		ctx := context.Background()
+		if _, err := client.Plan.Query().All(ctx); !errors.Is(err, ErrNoApp) {
+			t.Errorf("query: %v, want %v", err, ErrNoApp)
+		}
+		if _, err := client.Plan.Create().SetName("Free").SetSlug("free").Save(ctx); !errors.Is(err, ErrNoApp) {
+			t.Errorf("create: %v, want %v", err, ErrNoApp)
+		}
+		if _, err := client.Plan.UpdateOneID(plan1.ID).SetName("Renamed").Save(ctx); !errors.Is(err, ErrNoApp) {
+			t.Errorf("update: %v, want %v", err, ErrNoApp)
+		}
+
+		// Users and apps are shared
+		if _, err := client.User.Query().All(ctx); err != nil {
+			t.Errorf("querying users: %v", err)
+		}
+	})
+
+	t.Run("another app's rows can't be changed", func(t *testing.T) {
+		if _, err := client.Plan.UpdateOneID(plan2.ID).SetName("Renamed").Save(ctx1); !ent.IsNotFound(err) {
+			t.Errorf("updating another app's plan: %v, want not found", err)
+		}
+		if n := client.Plan.Update().SetName("Renamed").SaveX(ctx1); n != 1 {
+			t.Errorf("updated %d plans, want 1", n)
+		}
+		if err := client.Organization.DeleteOneID(org2.ID).Exec(ctx1); !ent.IsNotFound(err) {
+			t.Errorf("deleting another app's organization: %v, want not found", err)
+		}
+		if name := client.Plan.GetX(all, plan2.ID).Name; name != "Pro" {
+			t.Errorf("another app's plan was renamed %q", name)
+		}
+	})
+
+	t.Run("rows can't be created in another app", func(t *testing.T) {
+		if _, err := client.Plan.Create().SetName("Free").SetSlug("free").SetApp(app2).Save(ctx1); !errors.Is(err, ErrWrongApp) {
+			t.Errorf("creating a plan in another app: %v, want %v", err, ErrWrongApp)
+		}
+		if _, err := client.OrganizationMember.Create().SetOrganization(org2).SetUser(u).Save(ctx1); !errors.Is(err, ErrWrongApp) {
+			t.Errorf("joining another app's organization: %v, want %v", err, ErrWrongApp)
+		}
+	})
+
+	t.Run("AllApps can change every app", func(t *testing.T) {
+		if _, err := client.Plan.UpdateOneID(plan2.ID).SetName("Renamed").Save(all); err != nil {
+			t.Errorf("updating a plan: %v", err)
+		}
+		if _, err := client.Plan.Create().SetName("Free").SetSlug("free").SetApp(app2).Save(all); err != nil {
+			t.Errorf("creating a plan: %v", err)
+		}
+	})
+}
@@ -0,0 +1,308 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// MaxAttempts is the number of times a delivery is tried before it is dead.
+const MaxAttempts = 8
+
+const (
+	// pollInterval is how often the queue is checked for due deliveries.
+	pollInterval = 5 * time.Second
+	// batchSize is the most deliveries sent at once.
+	batchSize = 20
+	// requestTimeout is how long an app has to respond to a webhook.
+	requestTimeout = 10 * time.Second
+	// claimLease is how long a claimed delivery is left to its dispatcher
+	// before others may send it, in case the dispatcher stops mid-send.
+	claimLease = time.Minute
+	// firstRetryDelay is the wait after the first failed attempt. It is
+	// multiplied by 4 after every further failure, up to maxRetryDelay, so
+	// that a delivery is retried for about a day.
+	firstRetryDelay = 30 * time.Second
+	maxRetryDelay   = 12 * time.Hour
+	// maxResponseBody is how much of a response is logged with an attempt.
+	maxResponseBody = 1024
+)
+
+// Dispatcher sends queued webhooks. Several dispatchers, e.g. one per server
+// instance, may run at once: each delivery is claimed by one of them.
+type Dispatcher struct {
+	client       *ent.Client
+	sealer       *apikey.Sealer
+	httpClient   *http.Client
+	allowPrivate bool
+}
+
+// NewDispatcher creates a new dispatcher. sealer decrypts the secret keys
+// webhooks are signed with. Webhooks are only sent over https to public
+// addresses (see CheckURL), and redirects aren't followed, unless
+// allowPrivate is set for development.
+func NewDispatcher(client *ent.Client, sealer *apikey.Sealer, allowPrivate bool) *Dispatcher {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	if !allowPrivate {
+		dialer := &net.Dialer{
+			Timeout:   requestTimeout,
+			KeepAlive: 30 * time.Second,
+			Control:   dialPublic,
+		}
+		transport.DialContext = dialer.DialContext
+	}
+
+	return &Dispatcher{
+		client: client,
+		sealer: sealer,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		allowPrivate: allowPrivate,
+	}
+}
+
+// Run sends due deliveries until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while there is a backlog
+		for {
+			n, err := d.DispatchDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				log.Printf("Failed to dispatch webhooks: %v", err)
+			}
+			if err != nil || n < batchSize {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue sends a batch of the deliveries that are due and returns how
+// many were sent, successfully or not.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	ctx = tenant.AllApps(ctx)
+
+	deliveries, err := d.claim(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// Claimed deliveries are sent and recorded even if ctx is canceled
+	// meanwhile, which takes at most requestTimeout
+	sendCtx := context.WithoutCancel(ctx)
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *ent.WebhookDelivery) {
+			defer wg.Done()
+			if err := d.send(sendCtx, delivery); err != nil {
+				log.Printf("Failed to record webhook delivery %d: %v", delivery.ID, err)
+			}
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries), nil
+}
+
+// claim takes the due deliveries not being sent by another dispatcher and
+// counts an attempt for each. A delivery is claimed by moving its next
+// attempt past the lease, only if no other dispatcher has done so since it
+// was read.
+func (d *Dispatcher) claim(ctx context.Context) ([]*ent.WebhookDelivery, error) {
+	now := time.Now()
+	due, err := d.client.WebhookDelivery.Query().
+		Where(
+			webhookdelivery.StatusEQ(webhookdelivery.StatusPENDING),
+			webhookdelivery.NextAttemptAtLTE(now),
+		).
+		Order(ent.Asc(webhookdelivery.FieldNextAttemptAt)).
+		Limit(batchSize).
+		WithApp().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []*ent.WebhookDelivery
+	for _, delivery := range due {
+		n, err := d.client.WebhookDelivery.Update().
+			Where(
+				webhookdelivery.ID(delivery.ID),
+				webhookdelivery.StatusEQ(webhookdelivery.StatusPENDING),
+				webhookdelivery.NextAttemptAtLTE(now),
+				webhookdelivery.Attempts(delivery.Attempts),
+			).
+			AddAttempts(1).
+			SetNextAttemptAt(now.Add(claimLease)).
+			Save(ctx)
+		if err != nil {
+			// Deliveries claimed so far are sent once their lease ends
+			return nil, err
+		}
+		if n == 0 {
+			continue
+		}
+		delivery.Attempts++
+		deliveries = append(deliveries, delivery)
+	}
+	return deliveries, nil
+}
+
+// send POSTs a claimed delivery to its app and records the outcome.
+func (d *Dispatcher) send(ctx context.Context, delivery *ent.WebhookDelivery) error {
+	a := delivery.Edges.App
+	start := time.Now()
+
+	var (
+		statusCode *int
+		respBody   string
+		sendErr    error
+		retry      = true
+	)
+	_, urlErr := checkScheme(a.WebhookURL, d.allowPrivate)
+	switch {
+	case a.WebhookURL == "":
+		sendErr, retry = ErrNoWebhookURL, false
+	case !a.IsActive:
+		sendErr, retry = errors.New("app is inactive"), false
+	case urlErr != nil:
+		// URLs saved before they were checked may not be https
+		sendErr, retry = urlErr, false
+	default:
+		var resp *http.Response
+		resp, sendErr = d.post(ctx, a, delivery)
+		if sendErr == nil {
+			code := resp.StatusCode
+			statusCode = &code
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+			resp.Body.Close()
+			respBody = string(body)
+			if code < 200 || code > 299 {
+				sendErr = errors.New("app responded with status " + strconv.Itoa(code))
+			}
+		}
+	}
+	duration := time.Since(start)
+
+	tx, err := d.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	attempt := tx.WebhookAttempt.Create().
+		SetDeliveryID(delivery.ID).
+		SetNillableStatusCode(statusCode).
+		SetResponseBody(respBody).
+		SetDurationMs(int(duration.Milliseconds()))
+	if sendErr != nil {
+		attempt.SetError(sendErr.Error())
+	}
+	if err := attempt.Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	update := tx.WebhookDelivery.UpdateOne(delivery).
+		SetLastAttemptAt(start)
+	if statusCode != nil {
+		update.SetLastStatusCode(*statusCode)
+	} else {
+		update.ClearLastStatusCode()
+	}
+	switch {
+	case sendErr == nil:
+		update.SetStatus(webhookdelivery.StatusDELIVERED).
+			SetDeliveredAt(time.Now()).
+			SetLastError("")
+	case !retry || delivery.Attempts >= MaxAttempts:
+		update.SetStatus(webhookdelivery.StatusDEAD).
+			SetLastError(sendErr.Error())
+	default:
+		update.SetNextAttemptAt(time.Now().Add(RetryDelay(delivery.Attempts))).
+			SetLastError(sendErr.Error())
+	}
+	if err := update.Exec(ctx); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// post sends a delivery's payload to its app's webhook URL, signed with the
+// app's secret key if it has one stored to sign with.
+func (d *Dispatcher) post(ctx context.Context, a *ent.App, delivery *ent.WebhookDelivery) (*http.Response, error) {
+	u, err := url.Parse(a.WebhookURL)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "lem-webhooks/1")
+	req.Header.Set(EventHeader, delivery.EventType)
+	req.Header.Set(DeliveryHeader, delivery.EventID)
+
+	if a.APISecretEncrypted != "" {
+		secret, err := d.sealer.Open(a.APISecretEncrypted)
+		if err != nil {
+			return nil, err
+		}
+		timestamp := time.Now().Unix()
+		req.Header.Set(apikey.TimestampHeader, strconv.FormatInt(timestamp, 10))
+		req.Header.Set(apikey.SignatureHeader, apikey.Sign(secret, timestamp, http.MethodPost, u.RequestURI(), body))
+	}
+
+	return d.httpClient.Do(req)
+}
+
+// RetryDelay returns how long to wait before retrying a delivery that has
+// failed attempts times.
+func RetryDelay(attempts int) time.Duration {
+	delay := firstRetryDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 4
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
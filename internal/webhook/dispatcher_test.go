@@ -0,0 +1,354 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+
+	"gigaboo.io/lem/internal/apikey"
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/enttest"
+	"gigaboo.io/lem/internal/ent/webhookattempt"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+const testSecret = "lem_sk_test"
+
+// testReceiver is an app's webhook endpoint that responds with status and
+// keeps the requests it receives.
+type testReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newTestReceiver(t *testing.T) *testReceiver {
+	t.Helper()
+	rcv := &testReceiver{status: http.StatusOK}
+	rcv.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		rcv.mu.Lock()
+		defer rcv.mu.Unlock()
+		rcv.requests = append(rcv.requests, r)
+		rcv.bodies = append(rcv.bodies, body)
+		w.WriteHeader(rcv.status)
+		w.Write([]byte("response " + strconv.Itoa(rcv.status)))
+	}))
+	t.Cleanup(rcv.Close)
+	return rcv
+}
+
+func (rcv *testReceiver) respond(status int) {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	rcv.status = status
+}
+
+func (rcv *testReceiver) received() int {
+	rcv.mu.Lock()
+	defer rcv.mu.Unlock()
+	return len(rcv.requests)
+}
+
+// newTestDispatcher returns a dispatcher for an empty in-memory database. It
+// has a single connection, so dispatchers sending at once take turns instead
+// of locking each other out.
+func newTestDispatcher(t *testing.T) (*Dispatcher, *ent.Client, context.Context) {
+	t.Helper()
+	drv, err := entsql.Open(dialect.SQLite, "file:"+t.Name()+"?mode=memory&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	drv.DB().SetMaxOpenConns(1)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(drv)))
+	t.Cleanup(func() { client.Close() })
+	tenant.Register(client)
+	return NewDispatcher(client, apikey.NewSealer([32]byte{1}), true), client, tenant.AllApps(context.Background())
+}
+
+// newTestApp creates an app sending webhooks to webhookURL, signed if sign is set.
+func newTestApp(t *testing.T, d *Dispatcher, client *ent.Client, webhookURL string, sign bool) *ent.App {
+	t.Helper()
+	create := client.App.Create().
+		SetName("App").
+		SetSlug("app-" + strconv.FormatInt(time.Now().UnixNano(), 36)).
+		SetWebhookURL(webhookURL)
+	if sign {
+		sealed, err := d.sealer.Seal(testSecret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		create.SetAPISecretEncrypted(sealed)
+	}
+	return create.SaveX(context.Background())
+}
+
+func enqueue(t *testing.T, ctx context.Context, client *ent.Client, a *ent.App) *ent.WebhookDelivery {
+	t.Helper()
+	delivery, err := Enqueue(ctx, client, a, EventUserCreated, map[string]interface{}{"id": 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return delivery
+}
+
+func dispatch(t *testing.T, ctx context.Context, d *Dispatcher, want int) {
+	t.Helper()
+	n, err := d.DispatchDue(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != want {
+		t.Fatalf("dispatched %d deliveries, want %d", n, want)
+	}
+}
+
+func TestDispatchDueSigns(t *testing.T) {
+	d, client, ctx := newTestDispatcher(t)
+	rcv := newTestReceiver(t)
+	signed := enqueue(t, ctx, client, newTestApp(t, d, client, rcv.URL+"/hooks?app=signed", true))
+	unsigned := enqueue(t, ctx, client, newTestApp(t, d, client, rcv.URL+"/hooks?app=unsigned", false))
+
+	dispatch(t, ctx, d, 2)
+	if rcv.received() != 2 {
+		t.Fatalf("received %d requests, want 2", rcv.received())
+	}
+
+	for i, r := range rcv.requests {
+		delivery := signed
+		if r.URL.Query().Get("app") == "unsigned" {
+			delivery = unsigned
+		}
+		if r.Header.Get(EventHeader) != EventUserCreated || r.Header.Get(DeliveryHeader) != delivery.EventID {
+			t.Errorf("headers %v, want event %s and delivery %s", r.Header, EventUserCreated, delivery.EventID)
+		}
+
+		signature := r.Header.Get(apikey.SignatureHeader)
+		if delivery == unsigned {
+			if signature != "" {
+				t.Errorf("app without a stored secret key got signature %q", signature)
+			}
+			continue
+		}
+		timestamp, _ := strconv.ParseInt(r.Header.Get(apikey.TimestampHeader), 10, 64)
+		if !apikey.VerifySignature(signature, testSecret, timestamp, http.MethodPost, r.URL.RequestURI(), rcv.bodies[i]) {
+			t.Errorf("signature %q does not verify with the app's secret key", signature)
+		}
+	}
+
+	for _, delivery := range []*ent.WebhookDelivery{signed, unsigned} {
+		delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+		if delivery.Status != webhookdelivery.StatusDELIVERED || delivery.Attempts != 1 || delivery.DeliveredAt == nil {
+			t.Errorf("delivery = %+v, want delivered on the first attempt", delivery)
+		}
+	}
+	dispatch(t, ctx, d, 0)
+}
+
+func TestDispatchDueRetries(t *testing.T) {
+	d, client, ctx := newTestDispatcher(t)
+	rcv := newTestReceiver(t)
+	rcv.respond(http.StatusInternalServerError)
+	delivery := enqueue(t, ctx, client, newTestApp(t, d, client, rcv.URL, true))
+
+	before := time.Now()
+	dispatch(t, ctx, d, 1)
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusPENDING || delivery.Attempts != 1 {
+		t.Fatalf("delivery = %+v, want pending after one attempt", delivery)
+	}
+	if delivery.LastStatusCode == nil || *delivery.LastStatusCode != http.StatusInternalServerError || delivery.LastError == "" {
+		t.Errorf("last status %v, error %q, want the app's 500", delivery.LastStatusCode, delivery.LastError)
+	}
+	if next := delivery.NextAttemptAt.Sub(before); next < RetryDelay(1) || next > RetryDelay(1)+time.Minute {
+		t.Errorf("next attempt in %s, want %s", next, RetryDelay(1))
+	}
+	attempt := client.WebhookAttempt.Query().
+		Where(webhookattempt.HasDeliveryWith(webhookdelivery.ID(delivery.ID))).
+		OnlyX(ctx)
+	if attempt.StatusCode == nil || *attempt.StatusCode != http.StatusInternalServerError || attempt.ResponseBody != "response 500" {
+		t.Errorf("attempt = %+v, want the app's response", attempt)
+	}
+
+	// Not due until the delay has passed
+	dispatch(t, ctx, d, 0)
+
+	// The last attempt that fails kills the delivery
+	client.WebhookDelivery.UpdateOne(delivery).
+		SetAttempts(MaxAttempts - 1).
+		SetNextAttemptAt(time.Now()).
+		ExecX(ctx)
+	dispatch(t, ctx, d, 1)
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusDEAD || delivery.Attempts != MaxAttempts {
+		t.Errorf("delivery = %+v, want dead after %d attempts", delivery, MaxAttempts)
+	}
+	dispatch(t, ctx, d, 0)
+	if rcv.received() != 2 {
+		t.Errorf("received %d requests, want 2", rcv.received())
+	}
+}
+
+func TestDispatchDueWithoutWebhookURL(t *testing.T) {
+	d, client, ctx := newTestDispatcher(t)
+	a := newTestApp(t, d, client, "https://app.test/hooks", true)
+	delivery := enqueue(t, ctx, client, a)
+	client.App.UpdateOne(a).ClearWebhookURL().ExecX(ctx)
+
+	dispatch(t, ctx, d, 1)
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusDEAD || delivery.LastError != ErrNoWebhookURL.Error() {
+		t.Errorf("delivery = %+v, want dead without retries", delivery)
+	}
+}
+
+func TestDispatchDueOnlyToPublicAddresses(t *testing.T) {
+	_, client, ctx := newTestDispatcher(t)
+	d := NewDispatcher(client, apikey.NewSealer([32]byte{1}), false)
+	rcv := newTestReceiver(t)
+	overHTTP := enqueue(t, ctx, client, newTestApp(t, d, client, rcv.URL, true))
+	private := enqueue(t, ctx, client, newTestApp(t, d, client, strings.Replace(rcv.URL, "http:", "https:", 1), true))
+
+	dispatch(t, ctx, d, 2)
+	if rcv.received() != 0 {
+		t.Errorf("received %d requests, want none", rcv.received())
+	}
+
+	// A URL saved before URLs were checked isn't retried
+	overHTTP = client.WebhookDelivery.GetX(ctx, overHTTP.ID)
+	if overHTTP.Status != webhookdelivery.StatusDEAD || overHTTP.LastError != ErrInvalidURL.Error() {
+		t.Errorf("delivery over http = %+v, want dead", overHTTP)
+	}
+
+	// A host may get a public address back, so it is
+	private = client.WebhookDelivery.GetX(ctx, private.ID)
+	if private.Status != webhookdelivery.StatusPENDING || !strings.Contains(private.LastError, "127.0.0.1 is a private address") {
+		t.Errorf("delivery to a private address = %+v, want pending after connecting failed", private)
+	}
+}
+
+func TestRedeliver(t *testing.T) {
+	d, client, ctx := newTestDispatcher(t)
+	rcv := newTestReceiver(t)
+	delivery := enqueue(t, ctx, client, newTestApp(t, d, client, rcv.URL, true))
+	client.WebhookDelivery.UpdateOne(delivery).
+		SetStatus(webhookdelivery.StatusDEAD).
+		SetAttempts(MaxAttempts).
+		ExecX(ctx)
+
+	redelivered, err := Redeliver(ctx, client, delivery.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redelivered.Status != webhookdelivery.StatusPENDING || redelivered.Attempts != 0 {
+		t.Errorf("redelivered = %+v, want pending with no attempts", redelivered)
+	}
+
+	dispatch(t, ctx, d, 1)
+	delivery = client.WebhookDelivery.GetX(ctx, delivery.ID)
+	if delivery.Status != webhookdelivery.StatusDELIVERED || delivery.Attempts != 1 {
+		t.Errorf("delivery = %+v, want delivered on its first new attempt", delivery)
+	}
+	if rcv.received() != 1 || rcv.requests[0].Header.Get(DeliveryHeader) != delivery.EventID {
+		t.Errorf("received %d requests, want 1 for event %s", rcv.received(), delivery.EventID)
+	}
+}
+
+func TestClaimOnce(t *testing.T) {
+	d, client, ctx := newTestDispatcher(t)
+	a := newTestApp(t, d, client, "https://app.test/hooks", true)
+	for i := 0; i < 5; i++ {
+		enqueue(t, ctx, client, a)
+	}
+
+	// Every dispatcher reads the due deliveries before any claims them
+	const dispatchers = 4
+	var read sync.WaitGroup
+	read.Add(dispatchers)
+	client.WebhookDelivery.Intercept(ent.InterceptFunc(func(next ent.Querier) ent.Querier {
+		return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+			v, err := next.Query(ctx, q)
+			read.Done()
+			read.Wait()
+			return v, err
+		})
+	}))
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		claimed = make(map[int]int)
+	)
+	for i := 0; i < dispatchers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			deliveries, err := NewDispatcher(client, d.sealer, true).claim(ctx)
+			if err != nil {
+				t.Error(err)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, delivery := range deliveries {
+				claimed[delivery.ID]++
+				if delivery.Attempts != 1 {
+					t.Errorf("claimed delivery %d with %d attempts, want 1", delivery.ID, delivery.Attempts)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(claimed) != 5 {
+		t.Errorf("claimed %d deliveries, want 5", len(claimed))
+	}
+	for id, n := range claimed {
+		if n != 1 {
+			t.Errorf("delivery %d claimed %d times", id, n)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, 2 * time.Minute},
+		{3, 8 * time.Minute},
+		{4, 32 * time.Minute},
+		{5, 128 * time.Minute},
+		{6, 512 * time.Minute},
+		{7, 12 * time.Hour},
+		{MaxAttempts, 12 * time.Hour},
+		{100, 12 * time.Hour},
+	}
+
+	var total time.Duration
+	for _, tt := range tests {
+		if got := RetryDelay(tt.attempts); got != tt.want {
+			t.Errorf("RetryDelay(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+		if tt.attempts < MaxAttempts {
+			total += tt.want
+		}
+	}
+	if total < 20*time.Hour || total > 36*time.Hour {
+		t.Errorf("a delivery is retried for %s, want about a day", total)
+	}
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// ErrInvalidURL is returned for a webhook URL that webhooks may not be sent
+// to: one that isn't https, or whose host has a private address.
+var ErrInvalidURL = errors.New("webhook URL must be an https URL of a public host")
+
+// sharedAddressSpace is 100.64.0.0/10, used inside carrier and cloud
+// networks, e.g. for Alibaba Cloud's metadata service.
+var sharedAddressSpace = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// CheckURL checks that webhooks may be sent to rawURL: an https URL whose
+// host only has public addresses, so that webhooks can't be used to reach
+// the server's own network. With allowPrivate, for development, http URLs
+// and every host are allowed. An empty URL is valid and sends no webhooks.
+//
+// The addresses are checked again when connecting (see NewDispatcher), since
+// a host's addresses may change after its URL is saved.
+func CheckURL(ctx context.Context, rawURL string, allowPrivate bool) error {
+	if rawURL == "" {
+		return nil
+	}
+	u, err := checkScheme(rawURL, allowPrivate)
+	if err != nil || allowPrivate {
+		return err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidURL, err)
+	}
+	for _, addr := range addrs {
+		if !publicAddress(addr.IP) {
+			return fmt.Errorf("%w: %s has private address %s", ErrInvalidURL, u.Hostname(), addr.IP)
+		}
+	}
+	return nil
+}
+
+// checkScheme parses a webhook URL and checks that it is https, or with
+// allowPrivate http.
+func checkScheme(rawURL string, allowPrivate bool) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" || u.User != nil {
+		return nil, ErrInvalidURL
+	}
+	if u.Scheme != "https" && (!allowPrivate || u.Scheme != "http") {
+		return nil, ErrInvalidURL
+	}
+	return u, nil
+}
+
+// publicAddress reports whether ip is reachable on the internet, rather than
+// on the server itself or its network.
+func publicAddress(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip) &&
+		!(ip.To4() != nil && ip.To4()[0] == 0)
+}
+
+// dialPublic is a net.Dialer Control function that only connects to public
+// addresses.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !publicAddress(ip) {
+		return fmt.Errorf("%w: %s is a private address", ErrInvalidURL, host)
+	}
+	return nil
+}
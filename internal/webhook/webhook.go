@@ -0,0 +1,349 @@
+// Package webhook sends apps signed webhooks about changes to their data.
+//
+// Changes to the entities that emit events (see eventFor) are queued by an ent
+// hook as WebhookDelivery rows, in the same transaction as the change, for
+// apps with a webhook URL. A Dispatcher then POSTs them, retrying failures
+// with exponential backoff until MaxAttempts, after which a delivery is dead
+// and only sent again when redelivered.
+//
+// Each request carries the event type and ID in the EventHeader and
+// DeliveryHeader headers, and is signed like requests from apps to lem (see
+// apikey.Sign) with the app's secret key. Apps without a secret key, or
+// with one created before secret keys were stored to sign with, receive
+// unsigned webhooks.
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"gigaboo.io/lem/internal/ent"
+	"gigaboo.io/lem/internal/ent/achievement"
+	"gigaboo.io/lem/internal/ent/assignmentsubmission"
+	"gigaboo.io/lem/internal/ent/organizationmember"
+	"gigaboo.io/lem/internal/ent/subscription"
+	"gigaboo.io/lem/internal/ent/userapp"
+	"gigaboo.io/lem/internal/ent/webhookdelivery"
+	"gigaboo.io/lem/internal/tenant"
+)
+
+// Event types.
+const (
+	EventUserCreated         = "user.created"
+	EventSubscriptionCreated = "subscription.created"
+	EventSubscriptionUpdated = "subscription.updated"
+	EventAssignmentSubmitted = "assignment.submitted"
+	EventAchievementUnlocked = "achievement.unlocked"
+	EventOrgMemberAdded      = "org.member_added"
+
+	// Forwarded from Stripe about an existing subscription
+	EventSubscriptionTrialWillEnd  = "subscription.trial_will_end"
+	EventSubscriptionPaymentFailed = "subscription.payment_failed"
+
+	// EventPing is only sent when an admin tests an app's webhook URL.
+	EventPing = "ping"
+)
+
+// Headers of webhook requests besides apikey.TimestampHeader and
+// apikey.SignatureHeader.
+const (
+	EventHeader    = "X-Lem-Event"
+	DeliveryHeader = "X-Lem-Delivery"
+)
+
+// ErrNoWebhookURL is returned when queueing an event for an app without a
+// webhook URL.
+var ErrNoWebhookURL = errors.New("app has no webhook URL")
+
+// Register installs the hook that queues webhooks on client.
+func Register(client *ent.Client) {
+	client.Use(Hook())
+}
+
+// Hook queues a webhook for every change to an entity that emits an event,
+// if its app has a webhook URL. The delivery is created with the change's
+// client so that it is only sent if the change is committed.
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			eventType := eventFor(m)
+			if eventType == "" {
+				return next.Mutate(ctx, m)
+			}
+
+			mut, ok := m.(mutation)
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+			client := mut.Client()
+
+			var ids []int
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = mut.IDs(ctx); err != nil {
+					return nil, err
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				if id, exists := mut.ID(); exists {
+					ids = []int{id}
+				}
+			}
+
+			// Events are about a single app's rows, which are looked up
+			// by ID wherever the change was made from
+			allApps := tenant.AllApps(ctx)
+			for _, id := range ids {
+				a, data, err := load(allApps, client, m.Type(), id)
+				if err != nil {
+					return nil, err
+				}
+				if a.WebhookURL == "" {
+					continue
+				}
+				if _, err := Enqueue(allApps, client, a, eventType, data); err != nil {
+					return nil, err
+				}
+			}
+
+			return v, nil
+		})
+	}
+}
+
+// mutation is implemented by all generated mutations.
+type mutation interface {
+	Client() *ent.Client
+	ID() (int, bool)
+	IDs(ctx context.Context) ([]int, error)
+}
+
+// eventFor returns the event emitted by a mutation, or "" if there is none.
+func eventFor(m ent.Mutation) string {
+	create := m.Op().Is(ent.OpCreate)
+	update := m.Op().Is(ent.OpUpdate | ent.OpUpdateOne)
+
+	switch m.Type() {
+	case ent.TypeUserApp:
+		if create {
+			return EventUserCreated
+		}
+	case ent.TypeSubscription:
+		if create {
+			return EventSubscriptionCreated
+		}
+		if update {
+			return EventSubscriptionUpdated
+		}
+	case ent.TypeAssignmentSubmission:
+		if _, submitted := m.Field(assignmentsubmission.FieldSubmittedAt); submitted && (create || update) {
+			return EventAssignmentSubmitted
+		}
+	case ent.TypeAchievement:
+		if create {
+			return EventAchievementUnlocked
+		}
+	case ent.TypeOrganizationMember:
+		if create {
+			return EventOrgMemberAdded
+		}
+	}
+	return ""
+}
+
+// load returns an entity that emits events as event data, with its app.
+func load(ctx context.Context, client *ent.Client, typ string, id int) (*ent.App, map[string]interface{}, error) {
+	switch typ {
+	case ent.TypeUserApp:
+		ua, err := client.UserApp.Query().
+			Where(userapp.ID(id)).
+			WithUser().
+			WithApp().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		u := ua.Edges.User
+		return ua.Edges.App, map[string]interface{}{
+			"user": map[string]interface{}{
+				"id":          u.ID,
+				"email":       u.Email,
+				"name":        u.Name,
+				"is_verified": u.IsVerified,
+				"created_at":  u.CreatedAt,
+			},
+		}, nil
+
+	case ent.TypeSubscription:
+		sub, err := client.Subscription.Query().
+			Where(subscription.ID(id)).
+			WithUser().
+			WithOrganization().
+			WithPlan().
+			WithApp().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		data := map[string]interface{}{
+			"id":                   sub.ID,
+			"status":               sub.Status,
+			"current_period_start": sub.CurrentPeriodStart,
+			"current_period_end":   sub.CurrentPeriodEnd,
+			"canceled_at":          sub.CanceledAt,
+			"trial_end":            sub.TrialEnd,
+			"quantity":             sub.Quantity,
+			"updated_at":           sub.UpdatedAt,
+			"plan": map[string]interface{}{
+				"id":   sub.Edges.Plan.ID,
+				"slug": sub.Edges.Plan.Slug,
+			},
+		}
+		if sub.Edges.User != nil {
+			data["user_id"] = sub.Edges.User.ID
+		}
+		if sub.Edges.Organization != nil {
+			data["organization_id"] = sub.Edges.Organization.ID
+		}
+		return sub.Edges.App, map[string]interface{}{"subscription": data}, nil
+
+	case ent.TypeAssignmentSubmission:
+		s, err := client.AssignmentSubmission.Query().
+			Where(assignmentsubmission.ID(id)).
+			WithStudent().
+			WithAssignment(func(q *ent.AssignmentQuery) {
+				q.WithClassroom(func(q *ent.ClassroomQuery) {
+					q.WithApp()
+				})
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		asg := s.Edges.Assignment
+		return asg.Edges.Classroom.Edges.App, map[string]interface{}{
+			"submission": map[string]interface{}{
+				"id":               s.ID,
+				"assignment_id":    asg.ID,
+				"classroom_id":     asg.Edges.Classroom.ID,
+				"student_id":       s.Edges.Student.ID,
+				"levels_completed": s.LevelsCompleted,
+				"total_levels":     s.TotalLevels,
+				"total_stars":      s.TotalStars,
+				"grade_percentage": s.GradePercentage,
+				"submitted_at":     s.SubmittedAt,
+			},
+		}, nil
+
+	case ent.TypeAchievement:
+		a, err := client.Achievement.Query().
+			Where(achievement.ID(id)).
+			WithUser().
+			WithApp().
+			Only(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return a.Edges.App, map[string]interface{}{
+			"achievement": map[string]interface{}{
+				"id":             a.ID,
+				"achievement_id": a.AchievementID,
+				"user_id":        a.Edges.User.ID,
+				"earned_at":      a.EarnedAt,
+			},
+		}, nil
+
+	case ent.TypeOrganizationMember:
+		m, err := client.OrganizationMember.Query().
+			Where(organizationmember.ID(id)).
+			WithUser().
+			WithOrganization(func(q *ent.OrganizationQuery) {
+				q.WithApp()
+			}).
+			Only(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		org := m.Edges.Organization
+		return org.Edges.App, map[string]interface{}{
+			"member": map[string]interface{}{
+				"id":              m.ID,
+				"organization_id": org.ID,
+				"user_id":         m.Edges.User.ID,
+				"role":            m.Role,
+				"joined_at":       m.JoinedAt,
+			},
+		}, nil
+
+	default:
+		return nil, nil, errors.New("webhook: unsupported type " + typ)
+	}
+}
+
+// Emit queues an event about an entity that emits events, with the same data
+// as the hook's events about it, if its app has a webhook URL.
+func Emit(ctx context.Context, client *ent.Client, typ string, id int, eventType string) error {
+	ctx = tenant.AllApps(ctx)
+	a, data, err := load(ctx, client, typ, id)
+	if err != nil {
+		return err
+	}
+	if a.WebhookURL == "" {
+		return nil
+	}
+	_, err = Enqueue(ctx, client, a, eventType, data)
+	return err
+}
+
+// Enqueue queues an event for an app, to be sent by a Dispatcher.
+func Enqueue(ctx context.Context, client *ent.Client, a *ent.App, eventType string, data map[string]interface{}) (*ent.WebhookDelivery, error) {
+	if a.WebhookURL == "" {
+		return nil, ErrNoWebhookURL
+	}
+
+	eventID, err := newEventID()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.WebhookDelivery.Create().
+		SetAppID(a.ID).
+		SetEventID(eventID).
+		SetEventType(eventType).
+		SetPayload(map[string]interface{}{
+			"id":         eventID,
+			"type":       eventType,
+			"app_id":     a.ID,
+			"created_at": time.Now().UTC().Format(time.RFC3339),
+			"data":       data,
+		}).
+		Save(ctx)
+}
+
+func newEventID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+	return "evt_" + hex.EncodeToString(bytes), nil
+}
+
+// Redeliver queues a delivery to be sent again as soon as possible with a
+// fresh set of attempts, whatever its status. The event ID is kept so that
+// the app can tell it has seen the event.
+func Redeliver(ctx context.Context, client *ent.Client, deliveryID int) (*ent.WebhookDelivery, error) {
+	return client.WebhookDelivery.UpdateOneID(deliveryID).
+		SetStatus(webhookdelivery.StatusPENDING).
+		SetAttempts(0).
+		SetNextAttemptAt(time.Now()).
+		Save(ctx)
+}
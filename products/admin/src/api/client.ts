@@ -9,9 +9,41 @@ export interface App {
   id: number;
   name: string;
   slug: string;
+  api_key_prefix: string;
+  previous_api_key_expires_at: string | null;
+  has_api_secret: boolean;
+  api_secret_signs: boolean;
+  previous_api_secret_expires_at: string | null;
+  allowed_origins: string[] | null;
+  oauth_redirect_uris: string[] | null;
+  webhook_url: string;
+  stripe_product_id: string;
+  rate_limit_per_minute: number | null;
+  rate_limit_burst: number | null;
+  magic_link_signup: boolean;
+  is_active: boolean;
   created_at: string;
 }
 
+export interface AppCreate {
+  name: string;
+  slug: string;
+  allowed_origins?: string[];
+  oauth_redirect_uris?: string[];
+  webhook_url?: string;
+}
+
+export interface AppUpdate {
+  name?: string;
+  allowed_origins?: string[];
+  oauth_redirect_uris?: string[];
+  webhook_url?: string;
+  stripe_product_id?: string;
+  rate_limit_per_minute?: number;
+  rate_limit_burst?: number;
+  magic_link_signup?: boolean;
+}
+
 export interface User {
   id: number;
   email: string;
@@ -42,7 +74,7 @@ export interface Plan {
   currency?: string;
   billing_interval?: 'monthly' | 'yearly' | 'lifetime';
   stripe_price_id?: string | null;
-  features?: string | null;
+  features?: Record<string, boolean | number> | null;
   is_active?: boolean;
   is_default?: boolean;
   created_at?: string;
@@ -73,6 +105,22 @@ export interface PlanUpdate {
   is_active?: boolean;
 }
 
+export interface PlanSyncChange {
+  object: 'product' | 'price';
+  action: 'create' | 'update' | 'replace' | 'archive';
+  plan_id?: number;
+  plan_slug?: string;
+  stripe_id?: string;
+  new_stripe_id?: string;
+  reasons: string[];
+}
+
+export interface PlanSyncResult {
+  dry_run: boolean;
+  product_id: string;
+  changes: PlanSyncChange[];
+}
+
 export interface ShenbiProfile {
   id: number;
   user_id: number;
@@ -154,6 +202,35 @@ export interface StorageFile {
   updated: string | null;
 }
 
+export interface WebhookDelivery {
+  id: number;
+  event_id: string;
+  event_type: string;
+  status: 'PENDING' | 'DELIVERED' | 'DEAD';
+  attempts: number;
+  max_attempts: number;
+  next_attempt_at: string | null;
+  last_attempt_at: string | null;
+  last_status_code: number | null;
+  last_error: string;
+  delivered_at: string | null;
+  created_at: string;
+}
+
+export interface WebhookAttempt {
+  id: number;
+  status_code: number | null;
+  error: string;
+  response_body: string;
+  duration_ms: number;
+  created_at: string;
+}
+
+export interface WebhookDeliveryDetail extends WebhookDelivery {
+  payload: Record<string, unknown>;
+  attempt_logs: WebhookAttempt[];
+}
+
 export const api = {
   // Apps
   async getApps(): Promise<App[]> {
@@ -169,6 +246,69 @@ export const api = {
     return res.json();
   },
 
+  async createApp(data: AppCreate): Promise<App & { api_key: string }> {
+    const res = await fetchApi(`${API_BASE}/apps`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(data),
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to create app');
+    }
+    return res.json();
+  },
+
+  async updateApp(appId: number, data: AppUpdate): Promise<App> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}`, {
+      method: 'PUT',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify(data),
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to update app');
+    }
+    return res.json();
+  },
+
+  async toggleAppStatus(appId: number): Promise<{ is_active: boolean }> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/toggle-status`, {
+      method: 'POST',
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to toggle app status');
+    }
+    return res.json();
+  },
+
+  async regenerateApiKey(appId: number, overlapHours: number): Promise<App & { api_key: string }> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/regenerate-key`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ overlap_hours: overlapHours }),
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to regenerate API key');
+    }
+    return res.json();
+  },
+
+  async regenerateApiSecret(appId: number, overlapHours: number): Promise<App & { api_secret: string }> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/regenerate-secret`, {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ overlap_hours: overlapHours }),
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to regenerate secret key');
+    }
+    return res.json();
+  },
+
   // Users
   async getAppUsers(appId: number): Promise<{ users: AppUser[]; is_shenbi_app: boolean; active_count: number; paid_count: number }> {
     const res = await fetchApi(`${API_BASE}/apps/${appId}/users`);
@@ -188,14 +328,14 @@ export const api = {
     }
   },
 
-  async generateToken(appId: number, userId: number): Promise<{ access_token: string; refresh_token: string; expires_in: number }> {
-    const res = await fetchApi(`${API_BASE}/apps/${appId}/users/${userId}/generate-token`, {
+  async impersonate(appId: number, userId: number): Promise<{ access_token: string; token_type: string; expires_in: number; impersonator: string }> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/users/${userId}/impersonate`, {
       method: 'POST',
       headers: { 'Content-Type': 'application/json' },
     });
     if (!res.ok) {
       const error = await res.json();
-      throw new Error(error.detail || 'Failed to generate token');
+      throw new Error(error.detail || 'Failed to impersonate user');
     }
     return res.json();
   },
@@ -339,6 +479,26 @@ export const api = {
     }
   },
 
+  async getPlansStripeDiff(appId: number): Promise<PlanSyncResult> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/plans/stripe-diff`);
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to compare plans with Stripe');
+    }
+    return res.json();
+  },
+
+  async publishPlans(appId: number): Promise<PlanSyncResult> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/plans/publish`, {
+      method: 'POST',
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to publish plans');
+    }
+    return res.json();
+  },
+
   // Organizations
   async getOrganizations(appId: number): Promise<Organization[]> {
     const res = await fetchApi(`${API_BASE}/apps/${appId}/organizations`);
@@ -434,6 +594,43 @@ export const api = {
     }
   },
 
+  // Webhooks
+  async getWebhookDeliveries(appId: number, status: string = '', offset: number = 0): Promise<{ deliveries: WebhookDelivery[]; total: number }> {
+    const params = new URLSearchParams({ offset: offset.toString() });
+    if (status) params.set('status', status);
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/webhook-deliveries?${params}`);
+    if (!res.ok) throw new Error('Failed to fetch webhook deliveries');
+    return res.json();
+  },
+
+  async getWebhookDelivery(appId: number, deliveryId: number): Promise<WebhookDeliveryDetail> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/webhook-deliveries/${deliveryId}`);
+    if (!res.ok) throw new Error('Failed to fetch webhook delivery');
+    return res.json();
+  },
+
+  async redeliverWebhook(appId: number, deliveryId: number): Promise<WebhookDelivery> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/webhook-deliveries/${deliveryId}/redeliver`, {
+      method: 'POST',
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to redeliver webhook');
+    }
+    return res.json();
+  },
+
+  async sendTestWebhook(appId: number): Promise<WebhookDelivery> {
+    const res = await fetchApi(`${API_BASE}/apps/${appId}/webhooks/test`, {
+      method: 'POST',
+    });
+    if (!res.ok) {
+      const error = await res.json();
+      throw new Error(error.detail || 'Failed to send test webhook');
+    }
+    return res.json();
+  },
+
   // Auth
   async getAdminUser(): Promise<AdminUser | null> {
     const res = await fetchApi(`${API_BASE}/me`);
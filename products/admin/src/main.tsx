@@ -11,6 +11,8 @@ import EmailTemplatesTab from './pages/tabs/EmailTemplatesTab'
 import PlansTab from './pages/tabs/PlansTab'
 import OrganizationsTab from './pages/tabs/OrganizationsTab'
 import StorageTab from './pages/tabs/StorageTab'
+import SettingsTab from './pages/tabs/SettingsTab'
+import WebhooksTab from './pages/tabs/WebhooksTab'
 
 createRoot(document.getElementById('root')!).render(
   <StrictMode>
@@ -27,6 +29,8 @@ createRoot(document.getElementById('root')!).render(
             <Route path="plans" element={<PlansTab />} />
             <Route path="orgs" element={<OrganizationsTab />} />
             <Route path="storage" element={<StorageTab />} />
+            <Route path="webhooks" element={<WebhooksTab />} />
+            <Route path="settings" element={<SettingsTab />} />
           </Route>
         </Route>
         <Route path="*" element={<Navigate to="/" replace />} />
@@ -11,6 +11,8 @@ const baseTabs: TabConfig[] = [
   { path: 'plans', label: 'Plans' },
   { path: 'orgs', label: 'Organizations' },
   { path: 'storage', label: 'Storage' },
+  { path: 'webhooks', label: 'Webhooks' },
+  { path: 'settings', label: 'Settings' },
 ]
 
 function AppDetailPage() {
@@ -1,5 +1,5 @@
 import { useEffect, useState } from 'react'
-import { Link } from 'react-router-dom'
+import { Link, useNavigate } from 'react-router-dom'
 import { api } from '../api/client'
 import type { App } from '../api/client'
 
@@ -7,6 +7,13 @@ function AppsPage() {
   const [apps, setApps] = useState<App[]>([])
   const [loading, setLoading] = useState(true)
   const [error, setError] = useState<string | null>(null)
+  const navigate = useNavigate()
+
+  // Create modal state
+  const [showModal, setShowModal] = useState(false)
+  const [saving, setSaving] = useState(false)
+  const [formData, setFormData] = useState({ name: '', slug: '' })
+  const [createdKey, setCreatedKey] = useState<{ appId: number; apiKey: string } | null>(null)
 
   useEffect(() => {
     api.getApps()
@@ -15,6 +22,27 @@ function AppsPage() {
       .finally(() => setLoading(false))
   }, [])
 
+  const generateSlug = (name: string) => {
+    return name.toLowerCase().replace(/[^a-z0-9]+/g, '-').replace(/(^-|-$)/g, '')
+  }
+
+  const handleSubmit = async (e: React.FormEvent) => {
+    e.preventDefault()
+    setSaving(true)
+
+    try {
+      const created = await api.createApp(formData)
+      setShowModal(false)
+      setFormData({ name: '', slug: '' })
+      setApps([created, ...apps])
+      setCreatedKey({ appId: created.id, apiKey: created.api_key })
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    } finally {
+      setSaving(false)
+    }
+  }
+
   if (loading) {
     return <div className="text-center py-12 text-gray-500">Loading apps...</div>
   }
@@ -25,7 +53,15 @@ function AppsPage() {
 
   return (
     <div>
-      <h1 className="text-2xl font-bold text-gray-900 mb-6">Apps</h1>
+      <div className="flex justify-between items-center mb-6">
+        <h1 className="text-2xl font-bold text-gray-900">Apps</h1>
+        <button
+          onClick={() => setShowModal(true)}
+          className="px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 text-sm"
+        >
+          + New App
+        </button>
+      </div>
 
       <div className="grid grid-cols-1 md:grid-cols-2 lg:grid-cols-3 gap-6">
         {apps.map(app => (
@@ -41,6 +77,9 @@ function AppsPage() {
               <div>
                 <h2 className="text-lg font-semibold text-gray-900">{app.name}</h2>
                 <p className="text-sm text-gray-500">{app.slug}</p>
+                {!app.is_active && (
+                  <span className="px-2 py-0.5 text-xs rounded-full bg-gray-100 text-gray-600">Inactive</span>
+                )}
               </div>
             </div>
           </Link>
@@ -52,6 +91,88 @@ function AppsPage() {
           No apps found.
         </div>
       )}
+
+      {/* Create Modal */}
+      {showModal && (
+        <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+          <div className="bg-white rounded-lg shadow-xl max-w-md w-full mx-4">
+            <div className="px-6 py-4 border-b">
+              <h3 className="text-lg font-semibold text-gray-900">Create App</h3>
+            </div>
+
+            <form onSubmit={handleSubmit}>
+              <div className="px-6 py-4 space-y-4">
+                <div>
+                  <label className="block text-sm font-medium text-gray-700 mb-1">Name *</label>
+                  <input
+                    type="text"
+                    required
+                    value={formData.name}
+                    onChange={(e) => setFormData({ name: e.target.value, slug: generateSlug(e.target.value) })}
+                    placeholder="e.g., Shenbi"
+                    className="w-full px-3 py-2 border border-gray-300 rounded-lg text-sm focus:ring-2 focus:ring-blue-500 focus:border-blue-500"
+                  />
+                </div>
+
+                <div>
+                  <label className="block text-sm font-medium text-gray-700 mb-1">Slug *</label>
+                  <input
+                    type="text"
+                    required
+                    value={formData.slug}
+                    onChange={(e) => setFormData({ ...formData, slug: e.target.value })}
+                    placeholder="e.g., shenbi"
+                    className="w-full px-3 py-2 border border-gray-300 rounded-lg text-sm focus:ring-2 focus:ring-blue-500 focus:border-blue-500"
+                  />
+                  <p className="text-xs text-gray-500 mt-1">Also the app's OpenID Connect client ID; it cannot be changed later.</p>
+                </div>
+              </div>
+
+              <div className="px-6 py-4 bg-gray-50 border-t flex justify-end space-x-3">
+                <button
+                  type="button"
+                  onClick={() => setShowModal(false)}
+                  className="px-4 py-2 text-gray-600 hover:text-gray-800"
+                >
+                  Cancel
+                </button>
+                <button
+                  type="submit"
+                  disabled={saving}
+                  className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700 disabled:opacity-50"
+                >
+                  {saving ? 'Creating...' : 'Create'}
+                </button>
+              </div>
+            </form>
+          </div>
+        </div>
+      )}
+
+      {/* New API Key Modal */}
+      {createdKey && (
+        <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+          <div className="bg-white rounded-lg shadow-xl max-w-md w-full mx-4">
+            <div className="px-6 py-4 border-b">
+              <h3 className="text-lg font-semibold text-gray-900">App Created</h3>
+            </div>
+            <div className="px-6 py-4 space-y-3">
+              <p className="text-sm text-gray-600">
+                Copy the API key now. It is stored hashed and will not be shown again.
+              </p>
+              <code className="block p-3 bg-gray-100 rounded text-sm break-all">{createdKey.apiKey}</code>
+            </div>
+            <div className="px-6 py-4 bg-gray-50 border-t flex justify-end">
+              <button
+                onClick={() => navigate(`/apps/${createdKey.appId}/settings`)}
+                className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700"
+              >
+                Done
+              </button>
+            </div>
+          </div>
+        </div>
+      )}
     </div>
   )
 }
@@ -1,7 +1,7 @@
 import { useEffect, useState } from 'react'
 import { useParams } from 'react-router-dom'
 import { api } from '../../api/client'
-import type { Plan } from '../../api/client'
+import type { Plan, PlanSyncResult } from '../../api/client'
 
 function PlansTab() {
   const { appId: appIdParam } = useParams<{ appId: string }>()
@@ -15,6 +15,8 @@ function PlansTab() {
   const [editingPlan, setEditingPlan] = useState<Plan | null>(null)
   const [deleteConfirm, setDeleteConfirm] = useState<Plan | null>(null)
   const [saving, setSaving] = useState(false)
+  const [stripeSync, setStripeSync] = useState<PlanSyncResult | null>(null)
+  const [syncing, setSyncing] = useState(false)
 
   // Form state
   const [formData, setFormData] = useState({
@@ -74,7 +76,7 @@ function PlansTab() {
       currency: plan.currency || 'usd',
       billing_interval: plan.billing_interval || 'monthly',
       stripe_price_id: plan.stripe_price_id || '',
-      features: plan.features || '',
+      features: plan.features ? JSON.stringify(plan.features, null, 2) : '',
       is_default: plan.is_default || false,
     })
     setShowModal(true)
@@ -153,6 +155,29 @@ function PlansTab() {
     }
   }
 
+  const handleCompareStripe = async () => {
+    setSyncing(true)
+    try {
+      setStripeSync(await api.getPlansStripeDiff(appId))
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    } finally {
+      setSyncing(false)
+    }
+  }
+
+  const handlePublish = async () => {
+    setSyncing(true)
+    try {
+      setStripeSync(await api.publishPlans(appId))
+      loadPlans()
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    } finally {
+      setSyncing(false)
+    }
+  }
+
   const formatPrice = (cents: number, currency: string) => {
     const amount = cents / 100
     return new Intl.NumberFormat('en-US', {
@@ -174,12 +199,21 @@ function PlansTab() {
       {/* Header */}
       <div className="flex justify-between items-center mb-6">
         <h2 className="text-lg font-semibold text-gray-900">Subscription Plans</h2>
-        <button
-          onClick={openCreateModal}
-          className="px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 text-sm"
-        >
-          + Add Plan
-        </button>
+        <div className="space-x-3">
+          <button
+            onClick={handleCompareStripe}
+            disabled={syncing}
+            className="px-4 py-2 border border-gray-300 text-gray-700 rounded-lg hover:bg-gray-50 text-sm disabled:opacity-50"
+          >
+            Sync to Stripe
+          </button>
+          <button
+            onClick={openCreateModal}
+            className="px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 text-sm"
+          >
+            + Add Plan
+          </button>
+        </div>
       </div>
 
       {/* Plans Table */}
@@ -362,6 +396,9 @@ function PlansTab() {
                     placeholder="price_xxxxx"
                     className="w-full px-3 py-2 border border-gray-300 rounded-lg text-sm focus:ring-2 focus:ring-blue-500 focus:border-blue-500"
                   />
+                  <p className="text-xs text-gray-500 mt-1">
+                    Leave empty and use Sync to Stripe to create it
+                  </p>
                 </div>
 
                 <div>
@@ -370,7 +407,7 @@ function PlansTab() {
                     rows={3}
                     value={formData.features}
                     onChange={(e) => setFormData({ ...formData, features: e.target.value })}
-                    placeholder='["Feature 1", "Feature 2", "Feature 3"]'
+                    placeholder='{"battles": true, "live_sessions": true, "classrooms": 3}'
                     className="w-full px-3 py-2 border border-gray-300 rounded-lg text-sm font-mono focus:ring-2 focus:ring-blue-500 focus:border-blue-500"
                   />
                 </div>
@@ -410,6 +447,61 @@ function PlansTab() {
         </div>
       )}
 
+      {/* Stripe Sync Modal */}
+      {stripeSync && (
+        <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+          <div className="bg-white rounded-lg shadow-xl max-w-2xl w-full mx-4 max-h-[90vh] overflow-y-auto">
+            <div className="px-6 py-4 border-b">
+              <h3 className="text-lg font-semibold text-gray-900">
+                {stripeSync.dry_run ? 'Changes to Publish' : 'Published to Stripe'}
+              </h3>
+              {stripeSync.product_id && (
+                <p className="text-xs text-gray-500 font-mono">{stripeSync.product_id}</p>
+              )}
+            </div>
+            <div className="px-6 py-4">
+              {stripeSync.changes.length === 0 ? (
+                <p className="text-gray-600">Stripe is in sync with these plans.</p>
+              ) : (
+                <ul className="divide-y divide-gray-200">
+                  {stripeSync.changes.map((change, i) => (
+                    <li key={i} className="py-2 text-sm">
+                      <span className="px-2 py-1 text-xs rounded-full bg-gray-100 text-gray-800 mr-2">
+                        {change.action} {change.object}
+                      </span>
+                      <span className="font-medium text-gray-900">
+                        {change.plan_slug || change.stripe_id || ''}
+                      </span>
+                      {change.new_stripe_id && (
+                        <span className="text-xs text-gray-500 font-mono ml-2">&rarr; {change.new_stripe_id}</span>
+                      )}
+                      <div className="text-xs text-gray-500 mt-1">{change.reasons.join(', ')}</div>
+                    </li>
+                  ))}
+                </ul>
+              )}
+            </div>
+            <div className="px-6 py-4 bg-gray-50 border-t flex justify-end space-x-3">
+              <button
+                onClick={() => setStripeSync(null)}
+                className="px-4 py-2 text-gray-600 hover:text-gray-800"
+              >
+                {stripeSync.dry_run ? 'Cancel' : 'Close'}
+              </button>
+              {stripeSync.dry_run && stripeSync.changes.length > 0 && (
+                <button
+                  onClick={handlePublish}
+                  disabled={syncing}
+                  className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700 disabled:opacity-50"
+                >
+                  {syncing ? 'Publishing...' : 'Publish'}
+                </button>
+              )}
+            </div>
+          </div>
+        </div>
+      )}
+
       {/* Delete Confirmation Modal */}
       {deleteConfirm && (
         <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
@@ -0,0 +1,344 @@
+import { useEffect, useState } from 'react'
+import { useParams } from 'react-router-dom'
+import { api } from '../../api/client'
+import type { App } from '../../api/client'
+
+const lines = (value: string) => value.split('\n').map(s => s.trim()).filter(Boolean)
+
+function SettingsTab() {
+  const { appId: appIdParam } = useParams<{ appId: string }>()
+  const appId = parseInt(appIdParam!)
+  const [app, setApp] = useState<App | null>(null)
+  const [loading, setLoading] = useState(true)
+  const [error, setError] = useState<string | null>(null)
+  const [saving, setSaving] = useState(false)
+
+  // Form state
+  const [formData, setFormData] = useState({
+    name: '',
+    allowed_origins: '',
+    oauth_redirect_uris: '',
+    webhook_url: '',
+    stripe_product_id: '',
+    rate_limit_per_minute: '',
+    rate_limit_burst: '',
+    magic_link_signup: false,
+  })
+
+  // Credential rotation state
+  const [overlapHours, setOverlapHours] = useState(24)
+  const [newCredential, setNewCredential] = useState<{ label: string; value: string } | null>(null)
+
+  useEffect(() => {
+    loadApp()
+  }, [appId])
+
+  const loadApp = async () => {
+    try {
+      const data = await api.getApp(appId)
+      setFormFromApp(data)
+    } catch (err) {
+      setError((err as Error).message)
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const setFormFromApp = (data: App) => {
+    setApp(data)
+    setFormData({
+      name: data.name,
+      allowed_origins: (data.allowed_origins || []).join('\n'),
+      oauth_redirect_uris: (data.oauth_redirect_uris || []).join('\n'),
+      webhook_url: data.webhook_url,
+      stripe_product_id: data.stripe_product_id,
+      rate_limit_per_minute: data.rate_limit_per_minute?.toString() || '',
+      rate_limit_burst: data.rate_limit_burst?.toString() || '',
+      magic_link_signup: data.magic_link_signup,
+    })
+  }
+
+  const handleSubmit = async (e: React.FormEvent) => {
+    e.preventDefault()
+    setSaving(true)
+
+    try {
+      const updated = await api.updateApp(appId, {
+        name: formData.name,
+        allowed_origins: lines(formData.allowed_origins),
+        oauth_redirect_uris: lines(formData.oauth_redirect_uris),
+        webhook_url: formData.webhook_url,
+        stripe_product_id: formData.stripe_product_id,
+        rate_limit_per_minute: parseInt(formData.rate_limit_per_minute) || 0,
+        rate_limit_burst: parseInt(formData.rate_limit_burst) || 0,
+        magic_link_signup: formData.magic_link_signup,
+      })
+      setFormFromApp(updated)
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    } finally {
+      setSaving(false)
+    }
+  }
+
+  const handleToggleStatus = async () => {
+    if (!app) return
+    if (app.is_active && !confirm(`Deactivate ${app.name}? Its API keys will stop working.`)) return
+
+    try {
+      const { is_active } = await api.toggleAppStatus(appId)
+      setApp({ ...app, is_active })
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  const handleRegenerateKey = async () => {
+    if (!confirm(`Generate a new publishable API key? The current key will keep working for ${overlapHours} hours.`)) return
+
+    try {
+      const { api_key, ...updated } = await api.regenerateApiKey(appId, overlapHours)
+      setApp(updated)
+      setNewCredential({ label: 'publishable API key', value: api_key })
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  const handleRegenerateSecret = async () => {
+    if (!confirm(`Generate a new secret key? The current secret key will keep working for ${overlapHours} hours.`)) return
+
+    try {
+      const { api_secret, ...updated } = await api.regenerateApiSecret(appId, overlapHours)
+      setApp(updated)
+      setNewCredential({ label: 'secret key', value: api_secret })
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  if (loading) {
+    return <div className="text-center py-12 text-gray-500">Loading settings...</div>
+  }
+
+  if (error || !app) {
+    return <div className="text-center py-12 text-red-500">Error: {error}</div>
+  }
+
+  const inputClass = 'w-full px-3 py-2 border border-gray-300 rounded-lg text-sm focus:ring-2 focus:ring-blue-500 focus:border-blue-500'
+
+  return (
+    <div className="space-y-6">
+      {/* Credentials */}
+      <div className="bg-white rounded-lg shadow p-6">
+        <div className="flex justify-between items-center mb-4">
+          <h2 className="text-lg font-semibold text-gray-900">Credentials</h2>
+          <button
+            onClick={handleToggleStatus}
+            className={`px-2 py-1 text-xs rounded-full ${
+              app.is_active ? 'bg-green-100 text-green-800' : 'bg-gray-100 text-gray-600'
+            }`}
+          >
+            {app.is_active ? 'Active' : 'Inactive'}
+          </button>
+        </div>
+
+        <div className="space-y-4">
+          <div className="flex justify-between items-center">
+            <div>
+              <div className="text-sm font-medium text-gray-700">Publishable API key</div>
+              <div className="text-sm text-gray-500 font-mono">
+                {app.api_key_prefix ? `${app.api_key_prefix}…` : 'None'}
+              </div>
+              {app.previous_api_key_expires_at && (
+                <div className="text-xs text-amber-600">
+                  Previous key accepted until {new Date(app.previous_api_key_expires_at).toLocaleString()}
+                </div>
+              )}
+            </div>
+            <button
+              onClick={handleRegenerateKey}
+              className="px-3 py-1.5 text-sm border border-gray-300 rounded hover:bg-gray-50"
+            >
+              Regenerate
+            </button>
+          </div>
+
+          <div className="flex justify-between items-center">
+            <div>
+              <div className="text-sm font-medium text-gray-700">Secret key</div>
+              <div className="text-sm text-gray-500">
+                {app.has_api_secret
+                  ? 'Signs server-to-server requests and is the OpenID Connect client secret'
+                  : 'None: the app cannot sign server-to-server requests'}
+              </div>
+              {app.has_api_secret && !app.api_secret_signs && (
+                <div className="text-xs text-amber-600">
+                  Created before secret keys could sign: regenerate it to sign requests and webhooks
+                </div>
+              )}
+              {app.previous_api_secret_expires_at && (
+                <div className="text-xs text-amber-600">
+                  Previous secret accepted until {new Date(app.previous_api_secret_expires_at).toLocaleString()}
+                </div>
+              )}
+            </div>
+            <button
+              onClick={handleRegenerateSecret}
+              className="px-3 py-1.5 text-sm border border-gray-300 rounded hover:bg-gray-50"
+            >
+              {app.has_api_secret ? 'Regenerate' : 'Generate'}
+            </button>
+          </div>
+
+          <div className="flex items-center space-x-2 text-sm text-gray-600">
+            <span>Keep replaced credentials working for</span>
+            <input
+              type="number"
+              min={0}
+              max={720}
+              value={overlapHours}
+              onChange={(e) => setOverlapHours(parseInt(e.target.value) || 0)}
+              className="w-20 px-2 py-1 border border-gray-300 rounded text-sm"
+            />
+            <span>hours</span>
+          </div>
+        </div>
+      </div>
+
+      {/* Settings */}
+      <form onSubmit={handleSubmit} className="bg-white rounded-lg shadow">
+        <div className="px-6 py-4 border-b">
+          <h2 className="text-lg font-semibold text-gray-900">Settings</h2>
+        </div>
+
+        <div className="px-6 py-4 space-y-4">
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-1">Name *</label>
+            <input
+              type="text"
+              required
+              value={formData.name}
+              onChange={(e) => setFormData({ ...formData, name: e.target.value })}
+              className={inputClass}
+            />
+          </div>
+
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-1">Allowed origins</label>
+            <textarea
+              rows={3}
+              value={formData.allowed_origins}
+              onChange={(e) => setFormData({ ...formData, allowed_origins: e.target.value })}
+              placeholder="One origin per line, e.g. https://app.example.com or https://*.example.com"
+              className={`${inputClass} font-mono`}
+            />
+          </div>
+
+          <div>
+            <label className="block text-sm font-medium text-gray-700 mb-1">OAuth redirect URIs</label>
+            <textarea
+              rows={3}
+              value={formData.oauth_redirect_uris}
+              onChange={(e) => setFormData({ ...formData, oauth_redirect_uris: e.target.value })}
+              placeholder="One URI per line"
+              className={`${inputClass} font-mono`}
+            />
+          </div>
+
+          <div className="grid grid-cols-2 gap-4">
+            <div>
+              <label className="block text-sm font-medium text-gray-700 mb-1">Webhook URL</label>
+              <input
+                type="url"
+                value={formData.webhook_url}
+                onChange={(e) => setFormData({ ...formData, webhook_url: e.target.value })}
+                className={inputClass}
+              />
+            </div>
+            <div>
+              <label className="block text-sm font-medium text-gray-700 mb-1">Stripe product ID</label>
+              <input
+                type="text"
+                value={formData.stripe_product_id}
+                onChange={(e) => setFormData({ ...formData, stripe_product_id: e.target.value })}
+                className={inputClass}
+              />
+            </div>
+          </div>
+
+          <div className="grid grid-cols-2 gap-4">
+            <div>
+              <label className="block text-sm font-medium text-gray-700 mb-1">Rate limit (requests/minute)</label>
+              <input
+                type="number"
+                min={0}
+                value={formData.rate_limit_per_minute}
+                onChange={(e) => setFormData({ ...formData, rate_limit_per_minute: e.target.value })}
+                placeholder="Server default"
+                className={inputClass}
+              />
+            </div>
+            <div>
+              <label className="block text-sm font-medium text-gray-700 mb-1">Rate limit burst</label>
+              <input
+                type="number"
+                min={0}
+                value={formData.rate_limit_burst}
+                onChange={(e) => setFormData({ ...formData, rate_limit_burst: e.target.value })}
+                placeholder="Server default"
+                className={inputClass}
+              />
+            </div>
+          </div>
+
+          <label className="flex items-center space-x-2 text-sm text-gray-700">
+            <input
+              type="checkbox"
+              checked={formData.magic_link_signup}
+              onChange={(e) => setFormData({ ...formData, magic_link_signup: e.target.checked })}
+            />
+            <span>Create accounts for unknown emails from magic links</span>
+          </label>
+        </div>
+
+        <div className="px-6 py-4 bg-gray-50 border-t flex justify-end">
+          <button
+            type="submit"
+            disabled={saving}
+            className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700 disabled:opacity-50"
+          >
+            {saving ? 'Saving...' : 'Save'}
+          </button>
+        </div>
+      </form>
+
+      {/* New Credential Modal */}
+      {newCredential && (
+        <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+          <div className="bg-white rounded-lg shadow-xl max-w-md w-full mx-4">
+            <div className="px-6 py-4 border-b">
+              <h3 className="text-lg font-semibold text-gray-900">New {newCredential.label}</h3>
+            </div>
+            <div className="px-6 py-4 space-y-3">
+              <p className="text-sm text-gray-600">
+                Copy the {newCredential.label} now. It is stored hashed and will not be shown again.
+              </p>
+              <code className="block p-3 bg-gray-100 rounded text-sm break-all">{newCredential.value}</code>
+            </div>
+            <div className="px-6 py-4 bg-gray-50 border-t flex justify-end">
+              <button
+                onClick={() => setNewCredential(null)}
+                className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700"
+              >
+                Done
+              </button>
+            </div>
+          </div>
+        </div>
+      )}
+    </div>
+  )
+}
+
+export default SettingsTab
@@ -15,7 +15,7 @@ function UsersTab() {
   const [error, setError] = useState<string | null>(null)
 
   // Modal states
-  const [tokenModal, setTokenModal] = useState<{ email: string; accessToken: string; refreshToken: string; expiresIn: number } | null>(null)
+  const [tokenModal, setTokenModal] = useState<{ email: string; accessToken: string; impersonator: string; expiresIn: number } | null>(null)
   const [emailModal, setEmailModal] = useState<{ userId: number; email: string; name: string } | null>(null)
   const [emailMode, setEmailMode] = useState<'template' | 'custom'>('template')
   const [emailTemplates, setEmailTemplates] = useState<EmailTemplate[]>([])
@@ -69,14 +69,17 @@ function UsersTab() {
     }
   }
 
-  const handleGenerateToken = async (userId: number, email: string) => {
+  const handleImpersonate = async (userId: number, email: string) => {
     setOpenDropdown(null)
+    if (!confirm(`Impersonate ${email}? Every request made with the token is recorded in the audit log.`)) {
+      return
+    }
     try {
-      const data = await api.generateToken(appId, userId)
+      const data = await api.impersonate(appId, userId)
       setTokenModal({
         email,
         accessToken: data.access_token,
-        refreshToken: data.refresh_token,
+        impersonator: data.impersonator,
         expiresIn: data.expires_in,
       })
     } catch (err) {
@@ -353,11 +356,11 @@ function UsersTab() {
           <button
             onClick={() => {
               const user = users.find(u => u.user.id === openDropdown)
-              if (user) handleGenerateToken(user.user.id, user.user.email)
+              if (user) handleImpersonate(user.user.id, user.user.email)
             }}
             className="block w-full px-4 py-2 text-left text-sm text-gray-700 hover:bg-gray-100 rounded-t-lg"
           >
-            Generate JWT
+            Impersonate
           </button>
           {isShenbiApp && (
             <button
@@ -397,7 +400,7 @@ function UsersTab() {
         <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
           <div className="bg-white rounded-lg shadow-xl max-w-2xl w-full mx-4 max-h-[90vh] overflow-y-auto">
             <div className="px-6 py-4 border-b flex justify-between items-center">
-              <h3 className="text-lg font-semibold text-gray-900">Generated JWT Token</h3>
+              <h3 className="text-lg font-semibold text-gray-900">Impersonation Token</h3>
               <button onClick={() => setTokenModal(null)} className="text-gray-400 hover:text-gray-600">
                 <svg className="w-6 h-6" fill="none" stroke="currentColor" viewBox="0 0 24 24">
                   <path strokeLinecap="round" strokeLinejoin="round" strokeWidth={2} d="M6 18L18 6M6 6l12 12" />
@@ -406,10 +409,11 @@ function UsersTab() {
             </div>
             <div className="px-6 py-4">
               <p className="text-sm text-gray-600 mb-2">User: <span className="font-medium">{tokenModal.email}</span></p>
+              <p className="text-sm text-gray-600 mb-2">Acting as: <span className="font-medium">{tokenModal.impersonator}</span></p>
               <p className="text-sm text-gray-600 mb-4">Expires in: <span className="font-medium">{Math.floor(tokenModal.expiresIn / 60)} minutes</span></p>
 
               <label className="block text-sm font-medium text-gray-700 mb-1">Access Token</label>
-              <div className="relative mb-4">
+              <div className="relative mb-2">
                 <textarea
                   readOnly
                   rows={4}
@@ -424,21 +428,9 @@ function UsersTab() {
                 </button>
               </div>
 
-              <label className="block text-sm font-medium text-gray-700 mb-1">Refresh Token</label>
-              <div className="relative">
-                <textarea
-                  readOnly
-                  rows={3}
-                  value={tokenModal.refreshToken}
-                  className="w-full px-3 py-2 border border-gray-300 rounded-lg font-mono text-xs bg-gray-50"
-                />
-                <button
-                  onClick={(e) => copyToClipboard(tokenModal.refreshToken, e.currentTarget)}
-                  className="absolute top-2 right-2 px-2 py-1 bg-gray-200 hover:bg-gray-300 rounded text-xs"
-                >
-                  Copy
-                </button>
-              </div>
+              <p className="text-xs text-gray-500">
+                This token cannot be refreshed and is blocked from payments, credential and other destructive endpoints.
+              </p>
             </div>
             <div className="px-6 py-4 bg-gray-50 border-t flex justify-end">
               <button onClick={() => setTokenModal(null)} className="px-4 py-2 bg-gray-600 text-white rounded hover:bg-gray-700">
@@ -0,0 +1,269 @@
+import { useEffect, useState } from 'react'
+import { useParams } from 'react-router-dom'
+import { api } from '../../api/client'
+import type { WebhookDelivery, WebhookDeliveryDetail } from '../../api/client'
+
+const PAGE_SIZE = 50
+
+const statusStyles: Record<WebhookDelivery['status'], string> = {
+  PENDING: 'bg-yellow-100 text-yellow-800',
+  DELIVERED: 'bg-green-100 text-green-800',
+  DEAD: 'bg-red-100 text-red-800',
+}
+
+function WebhooksTab() {
+  const { appId: appIdParam } = useParams<{ appId: string }>()
+  const appId = parseInt(appIdParam!)
+  const [deliveries, setDeliveries] = useState<WebhookDelivery[]>([])
+  const [total, setTotal] = useState(0)
+  const [loading, setLoading] = useState(true)
+  const [error, setError] = useState<string | null>(null)
+  const [status, setStatus] = useState('')
+  const [offset, setOffset] = useState(0)
+  const [selected, setSelected] = useState<WebhookDeliveryDetail | null>(null)
+
+  useEffect(() => {
+    loadDeliveries()
+  }, [appId, status, offset])
+
+  const loadDeliveries = async () => {
+    setLoading(true)
+    try {
+      const data = await api.getWebhookDeliveries(appId, status, offset)
+      setDeliveries(data.deliveries)
+      setTotal(data.total)
+      setError(null)
+    } catch (err) {
+      setError((err as Error).message)
+    } finally {
+      setLoading(false)
+    }
+  }
+
+  const handleSendTest = async () => {
+    try {
+      await api.sendTestWebhook(appId)
+      loadDeliveries()
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  const handleView = async (delivery: WebhookDelivery) => {
+    try {
+      setSelected(await api.getWebhookDelivery(appId, delivery.id))
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  const handleRedeliver = async (delivery: WebhookDelivery) => {
+    try {
+      await api.redeliverWebhook(appId, delivery.id)
+      setSelected(null)
+      loadDeliveries()
+    } catch (err) {
+      alert('Error: ' + (err as Error).message)
+    }
+  }
+
+  return (
+    <div>
+      {/* Header */}
+      <div className="flex justify-between items-center mb-6">
+        <div className="flex items-center space-x-4">
+          <h2 className="text-lg font-semibold text-gray-900">Webhook Deliveries</h2>
+          <select
+            value={status}
+            onChange={(e) => { setStatus(e.target.value); setOffset(0) }}
+            className="px-3 py-2 border border-gray-300 rounded-lg text-sm focus:ring-2 focus:ring-blue-500 focus:border-blue-500"
+          >
+            <option value="">All statuses</option>
+            <option value="PENDING">Pending</option>
+            <option value="DELIVERED">Delivered</option>
+            <option value="DEAD">Dead</option>
+          </select>
+        </div>
+        <div className="flex space-x-3">
+          <button
+            onClick={loadDeliveries}
+            className="px-4 py-2 border border-gray-300 rounded-lg hover:bg-gray-50 text-sm"
+          >
+            Refresh
+          </button>
+          <button
+            onClick={handleSendTest}
+            className="px-4 py-2 bg-blue-600 text-white rounded-lg hover:bg-blue-700 text-sm"
+          >
+            Send Test Webhook
+          </button>
+        </div>
+      </div>
+
+      {/* Deliveries Table */}
+      <div className="bg-white rounded-lg shadow">
+        {loading ? (
+          <div className="text-center py-12 text-gray-500">Loading deliveries...</div>
+        ) : error ? (
+          <div className="text-center py-12 text-red-500">Error: {error}</div>
+        ) : (
+          <div className="overflow-x-auto">
+            <table className="min-w-full divide-y divide-gray-200">
+              <thead className="bg-gray-50">
+                <tr>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">Event</th>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">Status</th>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">Attempts</th>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">Last Response</th>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider">Created</th>
+                  <th className="px-6 py-3 text-left text-xs font-medium text-gray-500 uppercase tracking-wider w-32">Actions</th>
+                </tr>
+              </thead>
+              <tbody className="bg-white divide-y divide-gray-200">
+                {deliveries.map(delivery => (
+                  <tr key={delivery.id} className="hover:bg-gray-50">
+                    <td className="px-6 py-4 whitespace-nowrap">
+                      <div className="text-sm font-medium text-gray-900">{delivery.event_type}</div>
+                      <div className="text-xs text-gray-500 font-mono">{delivery.event_id}</div>
+                    </td>
+                    <td className="px-6 py-4 whitespace-nowrap">
+                      <span className={`px-2 py-1 text-xs rounded-full ${statusStyles[delivery.status]}`}>
+                        {delivery.status}
+                      </span>
+                      {delivery.next_attempt_at && delivery.attempts > 0 && (
+                        <div className="text-xs text-gray-500 mt-1">
+                          Retry at {new Date(delivery.next_attempt_at).toLocaleString()}
+                        </div>
+                      )}
+                    </td>
+                    <td className="px-6 py-4 whitespace-nowrap text-sm text-gray-600">
+                      {delivery.attempts} / {delivery.max_attempts}
+                    </td>
+                    <td className="px-6 py-4 text-sm text-gray-500">
+                      {delivery.last_status_code ?? (delivery.last_error ? '' : '-')}
+                      {delivery.last_error && (
+                        <div className="text-xs text-red-600 truncate max-w-xs" title={delivery.last_error}>
+                          {delivery.last_error}
+                        </div>
+                      )}
+                    </td>
+                    <td className="px-6 py-4 whitespace-nowrap text-sm text-gray-500">
+                      {new Date(delivery.created_at).toLocaleString()}
+                    </td>
+                    <td className="px-6 py-4 whitespace-nowrap text-sm">
+                      <button
+                        onClick={() => handleView(delivery)}
+                        className="text-blue-600 hover:text-blue-800 mr-3"
+                      >
+                        View
+                      </button>
+                      <button
+                        onClick={() => handleRedeliver(delivery)}
+                        className="text-blue-600 hover:text-blue-800"
+                      >
+                        Redeliver
+                      </button>
+                    </td>
+                  </tr>
+                ))}
+                {deliveries.length === 0 && (
+                  <tr>
+                    <td colSpan={6} className="px-6 py-12 text-center text-gray-500">
+                      No webhook deliveries. Set a webhook URL in the app's settings to receive events.
+                    </td>
+                  </tr>
+                )}
+              </tbody>
+            </table>
+          </div>
+        )}
+
+        {/* Pagination */}
+        {total > PAGE_SIZE && (
+          <div className="px-6 py-3 border-t flex justify-between items-center text-sm text-gray-600">
+            <span>
+              {offset + 1}–{Math.min(offset + PAGE_SIZE, total)} of {total}
+            </span>
+            <div className="space-x-2">
+              <button
+                disabled={offset === 0}
+                onClick={() => setOffset(Math.max(0, offset - PAGE_SIZE))}
+                className="px-3 py-1 border border-gray-300 rounded disabled:opacity-50"
+              >
+                Previous
+              </button>
+              <button
+                disabled={offset + PAGE_SIZE >= total}
+                onClick={() => setOffset(offset + PAGE_SIZE)}
+                className="px-3 py-1 border border-gray-300 rounded disabled:opacity-50"
+              >
+                Next
+              </button>
+            </div>
+          </div>
+        )}
+      </div>
+
+      {/* Delivery Modal */}
+      {selected && (
+        <div className="fixed inset-0 bg-black bg-opacity-50 flex items-center justify-center z-50">
+          <div className="bg-white rounded-lg shadow-xl max-w-2xl w-full mx-4 max-h-[90vh] overflow-y-auto">
+            <div className="px-6 py-4 border-b">
+              <h3 className="text-lg font-semibold text-gray-900">{selected.event_type}</h3>
+              <div className="text-xs text-gray-500 font-mono">{selected.event_id}</div>
+            </div>
+            <div className="px-6 py-4 space-y-4">
+              <div>
+                <div className="text-sm font-medium text-gray-700 mb-1">Payload</div>
+                <pre className="p-3 bg-gray-100 rounded text-xs overflow-x-auto">
+                  {JSON.stringify(selected.payload, null, 2)}
+                </pre>
+              </div>
+              <div>
+                <div className="text-sm font-medium text-gray-700 mb-1">Attempts</div>
+                {selected.attempt_logs.length === 0 ? (
+                  <div className="text-sm text-gray-500">Not attempted yet.</div>
+                ) : (
+                  <div className="space-y-2">
+                    {selected.attempt_logs.map(attempt => (
+                      <div key={attempt.id} className="border border-gray-200 rounded p-3 text-sm">
+                        <div className="flex justify-between">
+                          <span className={attempt.error ? 'text-red-600' : 'text-green-700'}>
+                            {attempt.status_code ?? 'No response'}
+                          </span>
+                          <span className="text-gray-500">
+                            {new Date(attempt.created_at).toLocaleString()} · {attempt.duration_ms} ms
+                          </span>
+                        </div>
+                        {attempt.error && <div className="text-xs text-red-600 mt-1">{attempt.error}</div>}
+                        {attempt.response_body && (
+                          <pre className="mt-2 p-2 bg-gray-50 rounded text-xs overflow-x-auto">{attempt.response_body}</pre>
+                        )}
+                      </div>
+                    ))}
+                  </div>
+                )}
+              </div>
+            </div>
+            <div className="px-6 py-4 bg-gray-50 border-t flex justify-end space-x-3">
+              <button
+                onClick={() => setSelected(null)}
+                className="px-4 py-2 text-gray-600 hover:text-gray-800"
+              >
+                Close
+              </button>
+              <button
+                onClick={() => handleRedeliver(selected)}
+                className="px-4 py-2 bg-blue-600 text-white rounded hover:bg-blue-700"
+              >
+                Redeliver
+              </button>
+            </div>
+          </div>
+        </div>
+      )}
+    </div>
+  )
+}
+
+export default WebhooksTab